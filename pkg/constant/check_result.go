@@ -23,4 +23,5 @@ const (
 	CheckResultChecking   CheckResult = "checking"
 	CheckResultPassed     CheckResult = "passed"
 	CheckResultFailed     CheckResult = "failed"
+	CheckResultWarning    CheckResult = "warning" // The item failed, but it doesn't block deploying
 )
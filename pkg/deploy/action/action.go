@@ -27,12 +27,18 @@ import (
 type Type string
 
 const (
-	ActionTypeNodeCheck       Type = "NodeCheck"
-	ActionTypeDeployEtcd      Type = "DeployEtcd"
-	ActionTypeDeployMaster    Type = "DeployMaster"
-	ActionTypeDeployWorker    Type = "DeployWorker"
-	ActionTypeDeployIngress   Type = "DeployIngress"
-	ActionTypeFetchKubeConfig Type = "FetchKubeConfig"
+	ActionTypeNodeCheck             Type = "NodeCheck"
+	ActionTypeNodePortCheck         Type = "NodePortCheck"
+	ActionTypeNodeConnectivityCheck Type = "NodeConnectivityCheck"
+	ActionTypeNodeHostnameCheck     Type = "NodeHostnameCheck"
+	ActionTypeInit                  Type = "Init"
+	ActionTypeDistributeImages      Type = "DistributeImages"
+	ActionTypeDeployEtcd            Type = "DeployEtcd"
+	ActionTypeDeployHA              Type = "DeployHA"
+	ActionTypeDeployMaster          Type = "DeployMaster"
+	ActionTypeDeployWorker          Type = "DeployWorker"
+	ActionTypeDeployIngress         Type = "DeployIngress"
+	ActionTypeFetchKubeConfig       Type = "FetchKubeConfig"
 )
 
 // Status represents the status of an action
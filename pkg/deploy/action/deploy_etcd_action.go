@@ -20,18 +20,27 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/kpaas-io/kpaas/pkg/deploy/pki"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
 // DeployEtcdActionConfig represents the config for a ectd deploy in a node
 type DeployEtcdActionConfig struct {
-	Node            *pb.Node
+	Node *pb.Node
+	// Nodes are all members of the etcd cluster
+	Nodes         []*pb.Node
+	ClusterConfig *pb.ClusterConfig
+	// PKI is the pki of the cluster, the etcd ca of which signs the certificates of the etcd members
+	PKI             *pki.ClusterPKI
 	LogFileBasePath string
 }
 
 type deployEtcdAction struct {
 	base
-	node *pb.Node
+	node          *pb.Node
+	nodes         []*pb.Node
+	clusterConfig *pb.ClusterConfig
+	pki           *pki.ClusterPKI
 }
 
 // NewDeployEtcdAction returns a deploy etcd action based on the config.
@@ -41,7 +50,11 @@ func NewDeployEtcdAction(cfg *DeployEtcdActionConfig) (Action, error) {
 	if cfg == nil {
 		err = fmt.Errorf("action config is nil")
 	} else if cfg.Node == nil {
-		err = fmt.Errorf("Invalid node check config: node is nil")
+		err = fmt.Errorf("Invalid deploy etcd config: node is nil")
+	} else if len(cfg.Nodes) == 0 {
+		err = fmt.Errorf("Invalid deploy etcd config: nodes is empty")
+	} else if cfg.PKI == nil {
+		err = fmt.Errorf("Invalid deploy etcd config: pki is nil")
 	}
 
 	if err != nil {
@@ -58,7 +71,10 @@ func NewDeployEtcdAction(cfg *DeployEtcdActionConfig) (Action, error) {
 			logFilePath:       GenActionLogFilePath(cfg.LogFileBasePath, actionName),
 			creationTimestamp: time.Now(),
 		},
-		node: cfg.Node,
+		node:          cfg.Node,
+		nodes:         cfg.Nodes,
+		clusterConfig: cfg.ClusterConfig,
+		pki:           cfg.PKI,
 	}, nil
 }
 
@@ -16,10 +16,23 @@ package action
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
 	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/etcd"
+	"github.com/kpaas-io/kpaas/pkg/deploy/pki"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+var (
+	// etcdHealthCheckTimeout is how long to wait for the etcd cluster to be healthy after etcd is started,
+	// the members of a new cluster wait for each other to have a quorum.
+	etcdHealthCheckTimeout  = 3 * time.Minute
+	etcdHealthCheckInterval = 5 * time.Second
 )
 
 type deployEtcdExecutor struct {
@@ -41,11 +54,116 @@ func (a *deployEtcdExecutor) Execute(act Action) error {
 
 	logger.Debugf("Start to deploy etcd on nodes: %s", node.Name)
 
-	// TODO: install etcd on the node
+	m, err := machine.NewMachine(node)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
 
-	// TODO: update action status
-	etcdAction.status = ActionDone
+	deployEtcd(m, etcdAction, logger)
 
 	logger.Debug("Finish to execute deploy etcd action")
 	return nil
 }
+
+// deployEtcd deploys the etcd member on the machine and waits for the etcd cluster to be healthy
+func deployEtcd(m machine.Machine, etcdAction *deployEtcdAction, logger *logrus.Entry) {
+	if pbErr := putEtcdFiles(m, etcdAction); pbErr != nil {
+		etcdAction.status = ActionFailed
+		etcdAction.err = pbErr
+		logger.Error(pbErr.GetDetail())
+		return
+	}
+
+	step, err := etcd.DeployStep(etcdAction.clusterConfig)
+	if err != nil {
+		etcdAction.status = ActionFailed
+		etcdAction.err = &pb.Error{
+			Reason:     "invalid cluster config",
+			Detail:     err.Error(),
+			FixMethods: "please check the container runtime of the cluster",
+		}
+		return
+	}
+	if result := runInitStep(m, step); result.Status != InitStepDone {
+		etcdAction.status = ActionFailed
+		etcdAction.err = result.Err
+		logger.Errorf("failed to deploy etcd: %v, logs: %v", result.Err.GetDetail(), result.Logs)
+		return
+	}
+
+	logger.Debug("Start to wait for the etcd cluster to be healthy")
+	if err := waitEtcdHealthy(m, etcdAction.nodes); err != nil {
+		etcdAction.status = ActionFailed
+		etcdAction.err = &pb.Error{
+			Reason:     "etcd cluster is not healthy",
+			Detail:     err.Error(),
+			FixMethods: "please check the etcd service on the etcd nodes by: journalctl -u etcd",
+		}
+		logger.Error(err)
+		return
+	}
+
+	etcdAction.status = ActionDone
+}
+
+// putEtcdFiles puts the certificates and the config of the etcd member to the node
+func putEtcdFiles(m machine.Machine, act *deployEtcdAction) *pb.Error {
+	files, err := act.pki.EtcdFiles(act.node)
+	if err != nil {
+		return &pb.Error{
+			Reason:     "failed to issue etcd certificates",
+			Detail:     err.Error(),
+			FixMethods: "please check the ip of the node",
+		}
+	}
+	files = append(files, &pki.File{
+		Path:    etcd.ConfigPath,
+		Content: []byte(etcd.Config(act.node, act.nodes, act.clusterConfig)),
+		Mode:    0644,
+	})
+
+	if err := pki.Distribute(m, files); err != nil {
+		return &pb.Error{
+			Reason:     "failed to put etcd files",
+			Detail:     err.Error(),
+			FixMethods: "please check the connection to the node",
+		}
+	}
+	return nil
+}
+
+// waitEtcdHealthy checks the etcd cluster periodically until it's healthy or timeout,
+// the error of the last check is returned if it's timeout.
+func waitEtcdHealthy(m machine.Machine, nodes []*pb.Node) error {
+	deadline := time.Now().Add(etcdHealthCheckTimeout)
+	for {
+		err := checkEtcdHealth(m, nodes)
+		if err == nil {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timeout after %v, last error: %v", etcdHealthCheckTimeout, err)
+		}
+		logrus.Debugf("etcd cluster is not healthy yet: %v", err)
+		time.Sleep(etcdHealthCheckInterval)
+	}
+}
+
+func checkEtcdHealth(m machine.Machine, nodes []*pb.Node) error {
+	op, err := etcd.NewHealthOperation(m, nodes)
+	if err != nil {
+		return err
+	}
+
+	stdErr, stdOut, err := op.Do()
+	if err != nil {
+		return fmt.Errorf("run command failed: %v, stderr: %v", err, strings.TrimSpace(string(stdErr)))
+	}
+
+	health, err := etcd.ParseHealth(string(stdOut))
+	if err != nil {
+		return err
+	}
+	return etcd.CheckHealth(health, nodes)
+}
@@ -0,0 +1,102 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine/fake"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/etcd"
+	"github.com/kpaas-io/kpaas/pkg/deploy/pki"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestDeployEtcd(t *testing.T) {
+	etcdHealthCheckTimeout = 100 * time.Millisecond
+	etcdHealthCheckInterval = 10 * time.Millisecond
+
+	clusterPKI, err := pki.NewClusterPKI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	nodes := []*pb.Node{{Name: "node1", Ip: "192.168.1.1"}, {Name: "node2", Ip: "192.168.1.2"}}
+
+	var members, healthy string
+	for _, node := range nodes {
+		members += fmt.Sprintf("member=%v, started, %v, %v, %v, false\n", node.Name, node.Name, etcd.PeerURL(node), etcd.ClientURL(node))
+		healthy += fmt.Sprintf("health=%v is healthy: successfully committed proposal: took = 2ms\n", etcd.ClientURL(node))
+	}
+
+	tests := []struct {
+		deployOutput string
+		healthOutput string
+		wantStatus   Status
+		wantReason   string
+	}{
+		{
+			deployOutput: "etcd service is restarted\nexit=0\n",
+			healthOutput: members + healthy,
+			wantStatus:   ActionDone,
+		},
+		{
+			deployOutput: "failed to pull k8s.gcr.io/etcd:3.4.3-0\nexit=1\n",
+			wantStatus:   ActionFailed,
+			wantReason:   "deploy_etcd.sh exited with code 1",
+		},
+		{
+			// only one of two members is healthy, there is no quorum
+			deployOutput: "etcd service is unchanged\nexit=0\n",
+			healthOutput: members + healthy[:len(healthy)/2],
+			wantStatus:   ActionFailed,
+			wantReason:   "etcd cluster is not healthy",
+		},
+	}
+
+	for _, test := range tests {
+		m := fake.NewMachine(nodes[0]).
+			On(initStepPattern("deploy_etcd.sh", "docker", "k8s.gcr.io/etcd:3.4.3-0", "/etc/etcd/etcd.env"), fake.Response{Stdout: test.deployOutput}).
+			On(`'check_etcd_health.sh' '/etc/kubernetes/pki/etcd' 'https://192.168.1.1:2379,https://192.168.1.2:2379'`, fake.Response{Stdout: test.healthOutput})
+
+		act, err := NewDeployEtcdAction(&DeployEtcdActionConfig{
+			Node:          nodes[0],
+			Nodes:         nodes,
+			ClusterConfig: &pb.ClusterConfig{EtcdDataDir: "/data/etcd"},
+			PKI:           clusterPKI,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		deployEtcd(m, act.(*deployEtcdAction), logrus.WithField("test", t.Name()))
+		assert.Equal(t, test.wantStatus, act.GetStatus())
+		if test.wantReason != "" {
+			assert.Equal(t, test.wantReason, act.GetErr().GetReason())
+		}
+
+		for _, name := range []string{"ca.crt", "server.crt", "server.key", "peer.crt", "peer.key", "healthcheck-client.crt", "healthcheck-client.key"} {
+			assert.NotNil(t, m.File(pki.EtcdDir+"/"+name), name)
+		}
+		assert.EqualValues(t, 0600, m.File(pki.EtcdDir+"/server.key").Mode)
+		assert.Contains(t, string(m.File(etcd.ConfigPath).Content), "ETCD_DATA_DIR=/data/etcd\n")
+	}
+
+	_, err = NewDeployEtcdAction(&DeployEtcdActionConfig{Node: nodes[0], Nodes: nodes})
+	assert.Error(t, err)
+}
@@ -0,0 +1,82 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// DeployHAActionConfig represents the config for a haproxy and keepalived deploy in a master
+type DeployHAActionConfig struct {
+	Node *pb.Node
+	// Masters are all masters of the cluster, which are the upstreams of haproxy
+	Masters       []*pb.Node
+	ClusterConfig *pb.ClusterConfig
+	// Check is true if the action checks the vip after the masters are deployed, or the action deploys
+	// haproxy and keepalived
+	Check           bool
+	LogFileBasePath string
+}
+
+type deployHAAction struct {
+	base
+	node          *pb.Node
+	masters       []*pb.Node
+	clusterConfig *pb.ClusterConfig
+	check         bool
+}
+
+// NewDeployHAAction returns a deploy ha action based on the config.
+// User should use this function to create a deploy ha action.
+func NewDeployHAAction(cfg *DeployHAActionConfig) (Action, error) {
+	var err error
+	if cfg == nil {
+		err = fmt.Errorf("action config is nil")
+	} else if cfg.Node == nil {
+		err = fmt.Errorf("Invalid deploy ha config: node is nil")
+	} else if len(cfg.Masters) == 0 {
+		err = fmt.Errorf("Invalid deploy ha config: masters is empty")
+	}
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	actionName := getDeployHAActionName(cfg)
+	return &deployHAAction{
+		base: base{
+			name:              actionName,
+			actionType:        ActionTypeDeployHA,
+			status:            ActionPending,
+			logFilePath:       GenActionLogFilePath(cfg.LogFileBasePath, actionName),
+			creationTimestamp: time.Now(),
+		},
+		node:          cfg.Node,
+		masters:       cfg.Masters,
+		clusterConfig: cfg.ClusterConfig,
+		check:         cfg.Check,
+	}, nil
+}
+
+func getDeployHAActionName(cfg *DeployHAActionConfig) string {
+	// used the node name as the the action name for now, this may be changed in the future.
+	return cfg.Node.GetName()
+}
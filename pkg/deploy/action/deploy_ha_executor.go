@@ -0,0 +1,84 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/ha"
+	initop "github.com/kpaas-io/kpaas/pkg/deploy/operation/init"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+type deployHAExecutor struct {
+}
+
+func (a *deployHAExecutor) Execute(act Action) error {
+	haAction, ok := act.(*deployHAAction)
+	if !ok {
+		return fmt.Errorf("the action type is not match: should be deploy ha action, but is %T", act)
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldAction: act.GetName(),
+	})
+
+	logger.Debug("Start to execute deploy ha action")
+
+	m, err := machine.NewMachine(haAction.node)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	deployHA(m, haAction, logger)
+
+	logger.Debug("Finish to execute deploy ha action")
+	return nil
+}
+
+// deployHA checks the vip and deploys haproxy and keepalived on the master, or checks the vip answers through the
+// master after the masters are deployed. The steps are run in order and stop at the first failed one.
+func deployHA(m machine.Machine, haAction *deployHAAction, logger *logrus.Entry) {
+	steps := []*initop.Step{ha.CheckStep(haAction.clusterConfig)}
+	if !haAction.check {
+		var err error
+		if steps, err = ha.DeploySteps(haAction.clusterConfig, haAction.masters); err != nil {
+			haAction.status = ActionFailed
+			haAction.err = &pb.Error{
+				Reason:     "invalid keepalived config",
+				Detail:     err.Error(),
+				FixMethods: "please check the vip and the network interface of keepalived, and the ips of the masters",
+			}
+			logger.Error(err)
+			return
+		}
+	}
+
+	for _, step := range steps {
+		if result := runInitStep(m, step); result.Status != InitStepDone {
+			haAction.status = ActionFailed
+			haAction.err = result.Err
+			logger.Errorf("ha step %v failed: %v, logs: %v", step.Name, result.Err.GetDetail(), result.Logs)
+			return
+		}
+	}
+
+	haAction.status = ActionDone
+}
@@ -0,0 +1,115 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine/fake"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestDeployHA(t *testing.T) {
+	masters := []*pb.Node{{Name: "master1", Ip: "192.168.1.1"}, {Name: "master2", Ip: "192.168.1.2"}}
+	clusterConfig := &pb.ClusterConfig{
+		KubeAPIServerConnect: &pb.KubeAPIServerConnect{
+			Type:       "keepalived",
+			Keepalived: &pb.Keepalived{Vip: "192.168.1.100", NetInterfaceName: "eth0"},
+		},
+	}
+
+	tests := []struct {
+		clusterConfig *pb.ClusterConfig
+		check         bool
+		vipOutput     string
+		checkOutput   string
+		wantScripts   []string
+		wantStatus    Status
+		wantReason    string
+	}{
+		{
+			clusterConfig: clusterConfig,
+			vipOutput:     "vip 192.168.1.100 is unused\nexit=0\n",
+			wantScripts:   []string{"check_vip.sh", "init_deploy_haproxy.sh", "init_deploy_keepalived.sh"},
+			wantStatus:    ActionDone,
+		},
+		{
+			// haproxy and keepalived are not deployed if the vip is used by another host
+			clusterConfig: clusterConfig,
+			vipOutput:     "vip 192.168.1.100 is used by another host with mac 00:00:00:00:00:01\nexit=1\n",
+			wantScripts:   []string{"check_vip.sh"},
+			wantStatus:    ActionFailed,
+			wantReason:    "check_vip.sh exited with code 1",
+		},
+		{
+			clusterConfig: &pb.ClusterConfig{KubeAPIServerConnect: &pb.KubeAPIServerConnect{Type: "keepalived"}},
+			wantStatus:    ActionFailed,
+			wantReason:    "invalid keepalived config",
+		},
+		{
+			clusterConfig: clusterConfig,
+			check:         true,
+			checkOutput:   "vip 192.168.1.100 answers on port 6443 with status 200\nexit=0\n",
+			wantScripts:   []string{"check_ha.sh"},
+			wantStatus:    ActionDone,
+		},
+		{
+			clusterConfig: clusterConfig,
+			check:         true,
+			checkOutput:   "vip 192.168.1.100 doesn't answer on port 6443\nexit=1\n",
+			wantScripts:   []string{"check_ha.sh"},
+			wantStatus:    ActionFailed,
+			wantReason:    "check_ha.sh exited with code 1",
+		},
+	}
+
+	for i, test := range tests {
+		m := fake.NewMachine(masters[0]).
+			On(initStepPattern("check_vip.sh", "192.168.1.100", "eth0", "192.168.1.1", "192.168.1.2"), fake.Response{Stdout: test.vipOutput}).
+			On(initStepPattern("init_deploy_haproxy.sh", "192.168.1.1:6443 192.168.1.2:6443"), fake.Response{Stdout: "exit=0\n"}).
+			On(initStepPattern("init_deploy_keepalived.sh", "192.168.1.100", "eth0"), fake.Response{Stdout: "exit=0\n"}).
+			On(initStepPattern("check_ha.sh", "192.168.1.100", "6443", "4443"), fake.Response{Stdout: test.checkOutput})
+
+		act, err := NewDeployHAAction(&DeployHAActionConfig{
+			Node:          masters[0],
+			Masters:       masters,
+			ClusterConfig: test.clusterConfig,
+			Check:         test.check,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		deployHA(m, act.(*deployHAAction), logrus.WithField("test", t.Name()))
+		assert.Equal(t, test.wantStatus, act.GetStatus(), i)
+		if test.wantReason != "" {
+			assert.Equal(t, test.wantReason, act.GetErr().GetReason(), i)
+		}
+
+		var scripts []string
+		for _, command := range m.Commands() {
+			if script, _, ok := parseInitStep(command); ok {
+				scripts = append(scripts, script)
+			}
+		}
+		assert.Equal(t, test.wantScripts, scripts, i)
+	}
+
+	_, err := NewDeployHAAction(&DeployHAActionConfig{Node: masters[0]})
+	assert.Error(t, err)
+}
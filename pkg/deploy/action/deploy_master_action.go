@@ -0,0 +1,100 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/pki"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// DeployMasterActionConfig represents the config for a master deploy in a node
+type DeployMasterActionConfig struct {
+	Node *pb.Node
+	// Masters are all masters of the cluster, the first of which initializes the control plane
+	Masters []*pb.Node
+	// EtcdNodes are the members of the etcd cluster used by the apiservers
+	EtcdNodes     []*pb.Node
+	ClusterConfig *pb.ClusterConfig
+	// PKI is the pki of the cluster, which issues the certificates of the control plane
+	PKI *pki.ClusterPKI
+	// BootstrapToken is the token for the nodes to join the cluster
+	BootstrapToken string
+	// Init is true if the node initializes the control plane, or the node joins the control plane
+	Init            bool
+	LogFileBasePath string
+}
+
+type deployMasterAction struct {
+	base
+	node           *pb.Node
+	masters        []*pb.Node
+	etcdNodes      []*pb.Node
+	clusterConfig  *pb.ClusterConfig
+	pki            *pki.ClusterPKI
+	bootstrapToken string
+	init           bool
+}
+
+// NewDeployMasterAction returns a deploy master action based on the config.
+// User should use this function to create a deploy master action.
+func NewDeployMasterAction(cfg *DeployMasterActionConfig) (Action, error) {
+	var err error
+	if cfg == nil {
+		err = fmt.Errorf("action config is nil")
+	} else if cfg.Node == nil {
+		err = fmt.Errorf("Invalid deploy master config: node is nil")
+	} else if len(cfg.Masters) == 0 {
+		err = fmt.Errorf("Invalid deploy master config: masters is empty")
+	} else if len(cfg.EtcdNodes) == 0 {
+		err = fmt.Errorf("Invalid deploy master config: etcd nodes is empty")
+	} else if cfg.PKI == nil {
+		err = fmt.Errorf("Invalid deploy master config: pki is nil")
+	} else if cfg.BootstrapToken == "" {
+		err = fmt.Errorf("Invalid deploy master config: bootstrap token is empty")
+	}
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	actionName := getDeployMasterActionName(cfg)
+	return &deployMasterAction{
+		base: base{
+			name:              actionName,
+			actionType:        ActionTypeDeployMaster,
+			status:            ActionPending,
+			logFilePath:       GenActionLogFilePath(cfg.LogFileBasePath, actionName),
+			creationTimestamp: time.Now(),
+		},
+		node:           cfg.Node,
+		masters:        cfg.Masters,
+		etcdNodes:      cfg.EtcdNodes,
+		clusterConfig:  cfg.ClusterConfig,
+		pki:            cfg.PKI,
+		bootstrapToken: cfg.BootstrapToken,
+		init:           cfg.Init,
+	}, nil
+}
+
+func getDeployMasterActionName(cfg *DeployMasterActionConfig) string {
+	// used the node name as the the action name for now, this may be changed in the future.
+	return cfg.Node.GetName()
+}
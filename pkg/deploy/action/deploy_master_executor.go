@@ -0,0 +1,110 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/kubeadm"
+	"github.com/kpaas-io/kpaas/pkg/deploy/pki"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+type deployMasterExecutor struct {
+}
+
+func (a *deployMasterExecutor) Execute(act Action) error {
+	masterAction, ok := act.(*deployMasterAction)
+	if !ok {
+		return fmt.Errorf("the action type is not match: should be deploy master action, but is %T", act)
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldAction: act.GetName(),
+	})
+
+	logger.Debug("Start to execute deploy master action")
+
+	m, err := machine.NewMachine(masterAction.node)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	deployMaster(m, masterAction, logger)
+
+	logger.Debug("Finish to execute deploy master action")
+	return nil
+}
+
+// deployMaster puts the certificates and the kubeadm config to the master, then initializes or joins the control plane
+func deployMaster(m machine.Machine, masterAction *deployMasterAction, logger *logrus.Entry) {
+	if pbErr := putMasterFiles(m, masterAction); pbErr != nil {
+		masterAction.status = ActionFailed
+		masterAction.err = pbErr
+		logger.Error(pbErr.GetDetail())
+		return
+	}
+
+	step := kubeadm.DeployMasterStep(masterAction.clusterConfig, masterAction.bootstrapToken, masterAction.init)
+	if result := runInitStep(m, step); result.Status != InitStepDone {
+		masterAction.status = ActionFailed
+		masterAction.err = result.Err
+		logger.Errorf("failed to deploy master: %v, logs: %v", result.Err.GetDetail(), result.Logs)
+		return
+	}
+
+	masterAction.status = ActionDone
+}
+
+// putMasterFiles puts the certificates of the control plane and the kubeadm config to the master
+func putMasterFiles(m machine.Machine, act *deployMasterAction) *pb.Error {
+	files, err := act.pki.MasterFiles(act.node, act.masters, act.clusterConfig)
+	if err != nil {
+		return &pb.Error{
+			Reason:     "failed to issue master certificates",
+			Detail:     err.Error(),
+			FixMethods: "please check the ips of the masters and the kube apiserver connect config",
+		}
+	}
+
+	var config []byte
+	if act.init {
+		config, err = kubeadm.InitConfig(act.node, act.masters, act.etcdNodes, act.clusterConfig, act.bootstrapToken)
+	} else {
+		config, err = kubeadm.JoinConfig(act.node, act.masters, act.clusterConfig, act.pki.CA, act.bootstrapToken, true)
+	}
+	if err != nil {
+		return &pb.Error{
+			Reason:     "failed to generate kubeadm config",
+			Detail:     err.Error(),
+			FixMethods: "please check the cluster config",
+		}
+	}
+	files = append(files, &pki.File{Path: kubeadm.ConfigPath, Content: config, Mode: 0600})
+
+	if err := pki.Distribute(m, files); err != nil {
+		return &pb.Error{
+			Reason:     "failed to put master files",
+			Detail:     err.Error(),
+			FixMethods: "please check the connection to the node",
+		}
+	}
+	return nil
+}
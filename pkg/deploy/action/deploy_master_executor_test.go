@@ -0,0 +1,116 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine/fake"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/kubeadm"
+	"github.com/kpaas-io/kpaas/pkg/deploy/pki"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestDeployMaster(t *testing.T) {
+	clusterPKI, err := pki.NewClusterPKI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	masters := []*pb.Node{{Name: "master1", Ip: "192.168.1.1"}, {Name: "master2", Ip: "192.168.1.2"}}
+	etcdNodes := []*pb.Node{{Name: "etcd1", Ip: "192.168.1.11"}}
+	token := "abcdef.0123456789abcdef"
+
+	tests := []struct {
+		node          *pb.Node
+		init          bool
+		clusterConfig *pb.ClusterConfig
+		output        string
+		wantMode      string
+		wantKind      string
+		wantStatus    Status
+		wantReason    string
+	}{
+		{
+			node:          masters[0],
+			init:          true,
+			clusterConfig: &pb.ClusterConfig{KubernetesVersion: "v1.16.4"},
+			output:        "apiserver is healthy\nexit=0\n",
+			wantMode:      "init",
+			wantKind:      "kind: InitConfiguration",
+			wantStatus:    ActionDone,
+		},
+		{
+			node:          masters[1],
+			clusterConfig: &pb.ClusterConfig{KubernetesVersion: "v1.16.4"},
+			output:        "failed to join the control plane\nexit=1\n",
+			wantMode:      "join",
+			wantKind:      "kind: JoinConfiguration",
+			wantStatus:    ActionFailed,
+			wantReason:    "deploy_master.sh exited with code 1",
+		},
+		{
+			// the kubeadm config can't be generated without the kubernetes version
+			node:          masters[0],
+			init:          true,
+			clusterConfig: &pb.ClusterConfig{},
+			wantStatus:    ActionFailed,
+			wantReason:    "failed to generate kubeadm config",
+		},
+	}
+
+	for _, test := range tests {
+		m := fake.NewMachine(test.node).
+			On(initStepPattern("deploy_master.sh", test.wantMode, "/etc/kubernetes/kubeadm-config.yaml", "v1.16.4", token),
+				fake.Response{Stdout: test.output})
+
+		act, err := NewDeployMasterAction(&DeployMasterActionConfig{
+			Node:           test.node,
+			Masters:        masters,
+			EtcdNodes:      etcdNodes,
+			ClusterConfig:  test.clusterConfig,
+			PKI:            clusterPKI,
+			BootstrapToken: token,
+			Init:           test.init,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		deployMaster(m, act.(*deployMasterAction), logrus.WithField("test", t.Name()))
+		assert.Equal(t, test.wantStatus, act.GetStatus())
+		if test.wantReason != "" {
+			assert.Equal(t, test.wantReason, act.GetErr().GetReason())
+		}
+		if test.wantKind == "" {
+			assert.Empty(t, m.Commands())
+			continue
+		}
+
+		config := m.File(kubeadm.ConfigPath)
+		if assert.NotNil(t, config) {
+			assert.Contains(t, string(config.Content), test.wantKind)
+			assert.EqualValues(t, 0600, config.Mode)
+		}
+		for _, name := range []string{"ca.crt", "ca.key", "apiserver.crt", "apiserver-etcd-client.key", "sa.pub", "front-proxy-client.crt", "etcd/ca.crt"} {
+			assert.NotNil(t, m.File(pki.Dir+"/"+name), name)
+		}
+	}
+
+	_, err = NewDeployMasterAction(&DeployMasterActionConfig{Node: masters[0], Masters: masters, EtcdNodes: etcdNodes, PKI: clusterPKI})
+	assert.Error(t, err)
+}
@@ -0,0 +1,91 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/pki"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// DeployWorkerActionConfig represents the config for a worker deploy in a node
+type DeployWorkerActionConfig struct {
+	// NodeConfig is the deploy config of the worker or master, whose labels and taints are applied to the node
+	NodeConfig *pb.NodeDeployConfig
+	// Masters are all masters of the cluster, the bootstrap token is created on the first one
+	Masters       []*pb.Node
+	ClusterConfig *pb.ClusterConfig
+	// PKI is the pki of the cluster, which issues the client certificate to talk to the apiservers
+	PKI *pki.ClusterPKI
+	// BootstrapToken is the short-lived token for the worker to join the cluster
+	BootstrapToken  string
+	LogFileBasePath string
+}
+
+type deployWorkerAction struct {
+	base
+	nodeConfig     *pb.NodeDeployConfig
+	masters        []*pb.Node
+	clusterConfig  *pb.ClusterConfig
+	pki            *pki.ClusterPKI
+	bootstrapToken string
+}
+
+// NewDeployWorkerAction returns a deploy worker action based on the config.
+// User should use this function to create a deploy worker action.
+func NewDeployWorkerAction(cfg *DeployWorkerActionConfig) (Action, error) {
+	var err error
+	if cfg == nil {
+		err = fmt.Errorf("action config is nil")
+	} else if cfg.NodeConfig.GetNode() == nil {
+		err = fmt.Errorf("Invalid deploy worker config: node is nil")
+	} else if len(cfg.Masters) == 0 {
+		err = fmt.Errorf("Invalid deploy worker config: masters is empty")
+	} else if cfg.PKI == nil {
+		err = fmt.Errorf("Invalid deploy worker config: pki is nil")
+	} else if cfg.BootstrapToken == "" {
+		err = fmt.Errorf("Invalid deploy worker config: bootstrap token is empty")
+	}
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	actionName := getDeployWorkerActionName(cfg)
+	return &deployWorkerAction{
+		base: base{
+			name:              actionName,
+			actionType:        ActionTypeDeployWorker,
+			status:            ActionPending,
+			logFilePath:       GenActionLogFilePath(cfg.LogFileBasePath, actionName),
+			creationTimestamp: time.Now(),
+		},
+		nodeConfig:     cfg.NodeConfig,
+		masters:        cfg.Masters,
+		clusterConfig:  cfg.ClusterConfig,
+		pki:            cfg.PKI,
+		bootstrapToken: cfg.BootstrapToken,
+	}, nil
+}
+
+func getDeployWorkerActionName(cfg *DeployWorkerActionConfig) string {
+	// used the node name as the the action name for now, this may be changed in the future.
+	return cfg.NodeConfig.GetNode().GetName()
+}
@@ -0,0 +1,239 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/kube"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/kubeadm"
+	"github.com/kpaas-io/kpaas/pkg/deploy/pki"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+var (
+	// nodeReadyTimeout is how long to wait for the worker to be ready after it joins the cluster,
+	// the node is ready after the network plugin is running on it.
+	nodeReadyTimeout  = 5 * time.Minute
+	nodeReadyInterval = 5 * time.Second
+	// waitReady is false if the network plugin is installed after the cluster is deployed,
+	// then the node is only waited to be registered.
+	waitReady = true
+)
+
+// SetNodeReadyTimeout sets how long to wait for the nodes to be ready after they join the cluster. The nodes are
+// only waited to be registered if it's 0, e.g. the network plugin is installed after the cluster is deployed.
+func SetNodeReadyTimeout(timeout time.Duration) {
+	waitReady = timeout > 0
+	if waitReady {
+		nodeReadyTimeout = timeout
+	}
+}
+
+// nodeClient is the part of the kubernetes client used to deploy the workers
+type nodeClient interface {
+	GetNode(name string) (*kube.Node, error)
+	ApplyNodeSettings(name string, settings *kube.NodeSettings) error
+}
+
+type deployWorkerExecutor struct {
+}
+
+func (a *deployWorkerExecutor) Execute(act Action) error {
+	workerAction, ok := act.(*deployWorkerAction)
+	if !ok {
+		return fmt.Errorf("the action type is not match: should be deploy worker action, but is %T", act)
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldAction: act.GetName(),
+	})
+
+	logger.Debug("Start to execute deploy worker action")
+
+	endpoint, err := kubeadm.ControlPlaneEndpoint(workerAction.clusterConfig, workerAction.masters)
+	if err != nil {
+		return err
+	}
+	client, err := kube.NewClient(endpoint, workerAction.pki)
+	if err != nil {
+		return err
+	}
+
+	master, err := machine.NewMachine(workerAction.masters[0])
+	if err != nil {
+		return err
+	}
+	defer master.Close()
+
+	m, err := machine.NewMachine(workerAction.nodeConfig.GetNode())
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	deployWorker(master, m, client, workerAction, logger)
+
+	logger.Debug("Finish to execute deploy worker action")
+	return nil
+}
+
+// deployWorker joins the worker to the cluster by the bootstrap token created on the master, then waits for the node
+// to be ready and applies the labels, annotations and taints to it. A master has already joined the cluster, only the
+// settings are applied to it.
+func deployWorker(master, m machine.Machine, client nodeClient, workerAction *deployWorkerAction, logger *logrus.Entry) {
+	if !workerAction.isMaster() {
+		if pbErr := joinWorker(master, m, workerAction, logger); pbErr != nil {
+			workerAction.status = ActionFailed
+			workerAction.err = pbErr
+			return
+		}
+	}
+
+	name := workerAction.nodeConfig.GetNode().GetName()
+	logger.Debug("Start to wait for the node to be ready")
+	if err := waitNodeReady(client, name); err != nil {
+		workerAction.status = ActionFailed
+		workerAction.err = &pb.Error{
+			Reason:     "node is not ready",
+			Detail:     err.Error(),
+			FixMethods: "please check the kubelet and the network plugin on the node, the kubelet logs are shown by: journalctl -u kubelet",
+		}
+		logger.Error(err)
+		return
+	}
+
+	if err := client.ApplyNodeSettings(name, workerAction.nodeSettings()); err != nil {
+		workerAction.status = ActionFailed
+		workerAction.err = &pb.Error{
+			Reason:     "failed to apply labels, annotations and taints of the node",
+			Detail:     err.Error(),
+			FixMethods: "please check the labels and taints of the node",
+		}
+		logger.Error(err)
+		return
+	}
+
+	workerAction.status = ActionDone
+}
+
+// joinWorker creates the bootstrap token on the master, then puts the kubeadm config to the worker and joins it
+func joinWorker(master, m machine.Machine, act *deployWorkerAction, logger *logrus.Entry) *pb.Error {
+	step := kubeadm.CreateTokenStep(act.bootstrapToken, kubeadm.WorkerTokenTTL)
+	if result := runInitStep(master, step); result.Status != InitStepDone {
+		logger.Errorf("failed to create bootstrap token: %v, logs: %v", result.Err.GetDetail(), result.Logs)
+		return result.Err
+	}
+
+	config, err := kubeadm.JoinConfig(act.nodeConfig.GetNode(), act.masters, act.clusterConfig, act.pki.CA, act.bootstrapToken, false)
+	if err != nil {
+		return &pb.Error{
+			Reason:     "failed to generate kubeadm config",
+			Detail:     err.Error(),
+			FixMethods: "please check the cluster config",
+		}
+	}
+	if err := pki.Distribute(m, []*pki.File{{Path: kubeadm.ConfigPath, Content: config, Mode: 0600}}); err != nil {
+		return &pb.Error{
+			Reason:     "failed to put kubeadm config",
+			Detail:     err.Error(),
+			FixMethods: "please check the connection to the node",
+		}
+	}
+
+	if result := runInitStep(m, kubeadm.JoinWorkerStep(act.clusterConfig)); result.Status != InitStepDone {
+		logger.Errorf("failed to join worker: %v, logs: %v", result.Err.GetDetail(), result.Logs)
+		return result.Err
+	}
+	return nil
+}
+
+// waitNodeReady gets the node periodically until it's ready or timeout, or until it's registered if the nodes are
+// not waited to be ready
+func waitNodeReady(client nodeClient, name string) error {
+	deadline := time.Now().Add(nodeReadyTimeout)
+	for {
+		node, err := client.GetNode(name)
+		if err == nil {
+			if node == nil {
+				err = fmt.Errorf("node %v is not registered", name)
+			} else if waitReady && !node.Ready() {
+				err = fmt.Errorf("node %v is not ready", name)
+			} else {
+				return nil
+			}
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timeout after %v, last error: %v", nodeReadyTimeout, err)
+		}
+		logrus.Debugf("node is not ready yet: %v", err)
+		time.Sleep(nodeReadyInterval)
+	}
+}
+
+// isMaster returns if the node is a master of the cluster
+func (a *deployWorkerAction) isMaster() bool {
+	for _, master := range a.masters {
+		if master.GetName() == a.nodeConfig.GetNode().GetName() {
+			return true
+		}
+	}
+	return false
+}
+
+// isWorker returns if the node has the worker role
+func (a *deployWorkerAction) isWorker() bool {
+	for _, role := range a.nodeConfig.GetRoles() {
+		if consts.NodeRole(role) == consts.NodeRoleWorker {
+			return true
+		}
+	}
+	return false
+}
+
+// nodeSettings returns the settings of the node: the node labels of the cluster and the labels of the node, the
+// latter takes precedence, the node annotations of the cluster, and the taints of the node. The worker role label
+// is added to a worker and the taint of the masters is removed from it, since the worker runs pods.
+func (a *deployWorkerAction) nodeSettings() *kube.NodeSettings {
+	labels := make(map[string]string)
+	var removeTaints []kube.Taint
+	if a.isWorker() {
+		labels[kube.WorkerRoleLabel] = ""
+		removeTaints = append(removeTaints, kube.MasterTaint)
+	}
+	for k, v := range a.clusterConfig.GetNodeLabels() {
+		labels[k] = v
+	}
+	for k, v := range a.nodeConfig.GetLabels() {
+		labels[k] = v
+	}
+
+	taints := make([]kube.Taint, 0, len(a.nodeConfig.GetTaints()))
+	for _, taint := range a.nodeConfig.GetTaints() {
+		taints = append(taints, kube.Taint{Key: taint.GetKey(), Value: taint.GetValue(), Effect: taint.GetEffect()})
+	}
+
+	return &kube.NodeSettings{
+		Labels:       labels,
+		Annotations:  a.clusterConfig.GetNodeAnnotations(),
+		Taints:       taints,
+		RemoveTaints: removeTaints,
+	}
+}
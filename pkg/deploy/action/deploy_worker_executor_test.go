@@ -0,0 +1,262 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/kube"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine/fake"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/kubeadm"
+	"github.com/kpaas-io/kpaas/pkg/deploy/pki"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// fakeNodeClient returns the node which gets ready after notReadyTimes gets, or is never ready if it's negative
+type fakeNodeClient struct {
+	node          *kube.Node
+	notReadyTimes int
+	getErr        error
+	applied       *kube.NodeSettings
+}
+
+func (c *fakeNodeClient) GetNode(name string) (*kube.Node, error) {
+	if c.getErr != nil || c.node == nil || c.node.Metadata.Name != name {
+		return nil, c.getErr
+	}
+	node := *c.node
+	if c.notReadyTimes > 0 {
+		c.notReadyTimes--
+	} else if c.notReadyTimes < 0 {
+		node.Status.Conditions = []kube.NodeCondition{{Type: kube.ConditionReady, Status: "False", Reason: "KubeletNotReady"}}
+	} else {
+		node.Status.Conditions = []kube.NodeCondition{{Type: kube.ConditionReady, Status: "True"}}
+	}
+	return &node, nil
+}
+
+func (c *fakeNodeClient) ApplyNodeSettings(name string, settings *kube.NodeSettings) error {
+	c.applied = settings
+	return nil
+}
+
+func TestDeployWorker(t *testing.T) {
+	nodeReadyTimeout = 100 * time.Millisecond
+	nodeReadyInterval = 10 * time.Millisecond
+
+	clusterPKI, err := pki.NewClusterPKI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	masters := []*pb.Node{{Name: "master1", Ip: "192.168.1.1"}}
+	worker := &pb.Node{Name: "worker1", Ip: "192.168.1.21"}
+	token := "abcdef.0123456789abcdef"
+	clusterConfig := &pb.ClusterConfig{
+		KubernetesVersion: "v1.16.4",
+		NodeLabels:        map[string]string{"env": "test", "zone": "a"},
+		NodeAnnotations:   map[string]string{"owner": "kpaas"},
+	}
+
+	tests := []struct {
+		nodeConfig  *pb.NodeDeployConfig
+		tokenOutput string
+		joinOutput  string
+		client      *fakeNodeClient
+		wantJoin    bool
+		wantStatus  Status
+		wantReason  string
+	}{
+		{
+			nodeConfig: &pb.NodeDeployConfig{
+				Node:   worker,
+				Roles:  []string{"worker"},
+				Labels: map[string]string{"zone": "b"},
+				Taints: []*pb.Taint{{Key: "dedicated", Value: "db", Effect: "NoSchedule"}},
+			},
+			tokenOutput: "bootstrap token is created\nexit=0\n",
+			joinOutput:  "node has joined the cluster\nexit=0\n",
+			client:      &fakeNodeClient{node: &kube.Node{Metadata: kube.ObjectMeta{Name: "worker1"}}, notReadyTimes: 2},
+			wantJoin:    true,
+			wantStatus:  ActionDone,
+		},
+		{
+			// the worker which is also a master doesn't join again
+			nodeConfig: &pb.NodeDeployConfig{Node: masters[0], Roles: []string{"master", "worker"}},
+			client:     &fakeNodeClient{node: &kube.Node{Metadata: kube.ObjectMeta{Name: "master1"}}},
+			wantStatus: ActionDone,
+		},
+		{
+			// only the labels and taints are applied to a master
+			nodeConfig: &pb.NodeDeployConfig{
+				Node:   masters[0],
+				Roles:  []string{"master", "etcd"},
+				Labels: map[string]string{"zone": "c"},
+				Taints: []*pb.Taint{{Key: "dedicated", Value: "control-plane", Effect: "NoExecute"}},
+			},
+			client:     &fakeNodeClient{node: &kube.Node{Metadata: kube.ObjectMeta{Name: "master1"}}},
+			wantStatus: ActionDone,
+		},
+		{
+			nodeConfig:  &pb.NodeDeployConfig{Node: worker},
+			tokenOutput: "failed to create the bootstrap token\nexit=1\n",
+			client:      &fakeNodeClient{},
+			wantStatus:  ActionFailed,
+			wantReason:  "create_bootstrap_token.sh exited with code 1",
+		},
+		{
+			nodeConfig:  &pb.NodeDeployConfig{Node: worker},
+			tokenOutput: "bootstrap token already exists\nexit=0\n",
+			joinOutput:  "failed to join the cluster\nexit=1\n",
+			client:      &fakeNodeClient{},
+			wantJoin:    true,
+			wantStatus:  ActionFailed,
+			wantReason:  "join_worker.sh exited with code 1",
+		},
+		{
+			// the node is never registered
+			nodeConfig:  &pb.NodeDeployConfig{Node: worker},
+			tokenOutput: "bootstrap token already exists\nexit=0\n",
+			joinOutput:  "node has already joined the cluster\nexit=0\n",
+			client:      &fakeNodeClient{},
+			wantJoin:    true,
+			wantStatus:  ActionFailed,
+			wantReason:  "node is not ready",
+		},
+		{
+			// the network plugin is not running on the node
+			nodeConfig:  &pb.NodeDeployConfig{Node: worker},
+			tokenOutput: "bootstrap token already exists\nexit=0\n",
+			joinOutput:  "node has already joined the cluster\nexit=0\n",
+			client:      &fakeNodeClient{node: &kube.Node{Metadata: kube.ObjectMeta{Name: "worker1"}}, notReadyTimes: -1},
+			wantJoin:    true,
+			wantStatus:  ActionFailed,
+			wantReason:  "node is not ready",
+		},
+		{
+			nodeConfig:  &pb.NodeDeployConfig{Node: worker},
+			tokenOutput: "bootstrap token already exists\nexit=0\n",
+			joinOutput:  "node has joined the cluster\nexit=0\n",
+			client:      &fakeNodeClient{getErr: fmt.Errorf("connection refused")},
+			wantJoin:    true,
+			wantStatus:  ActionFailed,
+			wantReason:  "node is not ready",
+		},
+	}
+
+	for i, test := range tests {
+		master := fake.NewMachine(masters[0]).
+			On(initStepPattern("create_bootstrap_token.sh", token, "1h"), fake.Response{Stdout: test.tokenOutput})
+		m := fake.NewMachine(test.nodeConfig.Node).
+			On(initStepPattern("join_worker.sh", "/etc/kubernetes/kubeadm-config.yaml", "v1.16.4"), fake.Response{Stdout: test.joinOutput})
+
+		act, err := NewDeployWorkerAction(&DeployWorkerActionConfig{
+			NodeConfig:     test.nodeConfig,
+			Masters:        masters,
+			ClusterConfig:  clusterConfig,
+			PKI:            clusterPKI,
+			BootstrapToken: token,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		deployWorker(master, m, test.client, act.(*deployWorkerAction), logrus.WithField("test", t.Name()))
+		assert.Equal(t, test.wantStatus, act.GetStatus(), i)
+		if test.wantReason != "" {
+			assert.Equal(t, test.wantReason, act.GetErr().GetReason(), i)
+		}
+		if test.wantJoin {
+			config := m.File(kubeadm.ConfigPath)
+			if assert.NotNil(t, config, i) {
+				assert.Contains(t, string(config.Content), "kind: JoinConfiguration", i)
+				assert.NotContains(t, string(config.Content), "controlPlane", i)
+			}
+		} else {
+			assert.Empty(t, m.Commands(), i)
+		}
+		if test.wantStatus == ActionFailed {
+			assert.Nil(t, test.client.applied, i)
+		}
+	}
+
+	settings := tests[0].client.applied
+	assert.Equal(t, map[string]string{kube.WorkerRoleLabel: "", "env": "test", "zone": "b"}, settings.Labels)
+	assert.Equal(t, map[string]string{"owner": "kpaas"}, settings.Annotations)
+	assert.Equal(t, []kube.Taint{{Key: "dedicated", Value: "db", Effect: "NoSchedule"}}, settings.Taints)
+	assert.Equal(t, []kube.Taint{kube.MasterTaint}, settings.RemoveTaints)
+	assert.Empty(t, tests[1].client.applied.Taints)
+	assert.Equal(t, []kube.Taint{kube.MasterTaint}, tests[1].client.applied.RemoveTaints)
+
+	// the master keeps its taint and doesn't get the worker role label
+	settings = tests[2].client.applied
+	assert.Equal(t, map[string]string{"env": "test", "zone": "c"}, settings.Labels)
+	assert.Equal(t, []kube.Taint{{Key: "dedicated", Value: "control-plane", Effect: "NoExecute"}}, settings.Taints)
+	assert.Empty(t, settings.RemoveTaints)
+
+	_, err = NewDeployWorkerAction(&DeployWorkerActionConfig{NodeConfig: &pb.NodeDeployConfig{Node: worker}, Masters: masters, PKI: clusterPKI})
+	assert.Error(t, err)
+	_, err = NewDeployWorkerAction(&DeployWorkerActionConfig{NodeConfig: &pb.NodeDeployConfig{}, Masters: masters, PKI: clusterPKI, BootstrapToken: token})
+	assert.Error(t, err)
+}
+
+func TestDeployWorkerWithoutWaitingReady(t *testing.T) {
+	nodeReadyTimeout = 100 * time.Millisecond
+	nodeReadyInterval = 10 * time.Millisecond
+	SetNodeReadyTimeout(0)
+	defer SetNodeReadyTimeout(nodeReadyTimeout)
+
+	clusterPKI, err := pki.NewClusterPKI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	master := &pb.Node{Name: "master1", Ip: "192.168.1.1"}
+
+	tests := []struct {
+		client     *fakeNodeClient
+		wantStatus Status
+	}{
+		{
+			// the network plugin is installed after the cluster is deployed
+			client:     &fakeNodeClient{node: &kube.Node{Metadata: kube.ObjectMeta{Name: "master1"}}, notReadyTimes: -1},
+			wantStatus: ActionDone,
+		},
+		{
+			// the node is still waited to be registered
+			client:     &fakeNodeClient{},
+			wantStatus: ActionFailed,
+		},
+	}
+
+	for i, test := range tests {
+		act, err := NewDeployWorkerAction(&DeployWorkerActionConfig{
+			NodeConfig:     &pb.NodeDeployConfig{Node: master, Roles: []string{"master", "worker"}},
+			Masters:        []*pb.Node{master},
+			ClusterConfig:  &pb.ClusterConfig{KubernetesVersion: "v1.16.4"},
+			PKI:            clusterPKI,
+			BootstrapToken: "abcdef.0123456789abcdef",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		deployWorker(fake.NewMachine(master), fake.NewMachine(master), test.client, act.(*deployWorkerAction), logrus.WithField("test", t.Name()))
+		assert.Equal(t, test.wantStatus, act.GetStatus(), i)
+	}
+}
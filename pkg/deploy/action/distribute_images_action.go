@@ -0,0 +1,75 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	distributeImagesActionName = "distribute-images"
+)
+
+// DistributeImagesActionConfig represents the config for distributing the image tarballs to all nodes
+type DistributeImagesActionConfig struct {
+	Nodes         []*pb.Node
+	ClusterConfig *pb.ClusterConfig
+	// Tarballs are the paths of the image tarballs on the controller
+	Tarballs        []string
+	LogFileBasePath string
+}
+
+type distributeImagesAction struct {
+	base
+	nodes         []*pb.Node
+	clusterConfig *pb.ClusterConfig
+	tarballs      []string
+}
+
+// NewDistributeImagesAction returns a distribute images action based on the config.
+// User should use this function to create a distribute images action.
+func NewDistributeImagesAction(cfg *DistributeImagesActionConfig) (Action, error) {
+	var err error
+	if cfg == nil {
+		err = fmt.Errorf("action config is nil")
+	} else if len(cfg.Nodes) == 0 {
+		err = fmt.Errorf("Invalid distribute images config: nodes is empty")
+	} else if len(cfg.Tarballs) == 0 {
+		err = fmt.Errorf("Invalid distribute images config: tarballs is empty")
+	}
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return &distributeImagesAction{
+		base: base{
+			name:              distributeImagesActionName,
+			actionType:        ActionTypeDistributeImages,
+			status:            ActionPending,
+			logFilePath:       GenActionLogFilePath(cfg.LogFileBasePath, distributeImagesActionName),
+			creationTimestamp: time.Now(),
+		},
+		nodes:         cfg.Nodes,
+		clusterConfig: cfg.ClusterConfig,
+		tarballs:      cfg.Tarballs,
+	}, nil
+}
@@ -0,0 +1,147 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/distribution"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/images"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+type distributeImagesExecutor struct {
+}
+
+func (a *distributeImagesExecutor) Execute(act Action) error {
+	imagesAction, ok := act.(*distributeImagesAction)
+	if !ok {
+		return fmt.Errorf("the action type is not match: should be distribute images action, but is %T", act)
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldAction: act.GetName(),
+	})
+
+	logger.Debug("Start to execute distribute images action")
+
+	step, err := images.LoadStep(imagesAction.clusterConfig, imagesAction.tarballs)
+	if err != nil {
+		imagesAction.status = ActionFailed
+		imagesAction.err = &pb.Error{
+			Reason:     "invalid cluster config",
+			Detail:     err.Error(),
+			FixMethods: "please check the container runtime of the cluster",
+		}
+		logger.Error(err)
+		return nil
+	}
+
+	distributor, err := distribution.NewDistributor(&distribution.Config{Nodes: imagesAction.nodes})
+	if err != nil {
+		return err
+	}
+
+	// failures are the errors of the nodes which failed to get or load the images
+	failures := make(map[string]string)
+	for _, tarball := range imagesAction.tarballs {
+		result, err := distributor.Distribute(&distribution.Artifact{LocalPath: tarball, RemotePath: images.RemotePath(tarball)})
+		if err != nil {
+			imagesAction.status = ActionFailed
+			imagesAction.err = &pb.Error{
+				Reason:     "failed to distribute image tarball",
+				Detail:     err.Error(),
+				FixMethods: "please check the image tarballs on the deploy controller",
+			}
+			logger.Error(err)
+			return nil
+		}
+		for _, node := range result.Failed() {
+			failures[node.NodeName] = node.Err.Error()
+		}
+	}
+
+	// the nodes are selected before loading, since failures is written by the loading goroutines
+	var loadNodes []*pb.Node
+	for _, node := range imagesAction.nodes {
+		if _, failed := failures[node.GetName()]; !failed {
+			loadNodes = append(loadNodes, node)
+		}
+	}
+
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	for _, node := range loadNodes {
+		wg.Add(1)
+		go func(node *pb.Node) {
+			defer wg.Done()
+
+			m, err := machine.NewMachine(node)
+			if err != nil {
+				lock.Lock()
+				failures[node.GetName()] = err.Error()
+				lock.Unlock()
+				return
+			}
+			defer m.Close()
+
+			if result := runInitStep(m, step); result.Status != InitStepDone {
+				logger.Errorf("failed to load images on %v: %v, logs: %v", node.GetName(), result.Err.GetDetail(), result.Logs)
+				lock.Lock()
+				failures[node.GetName()] = fmt.Sprintf("%v: %v", result.Err.GetReason(), result.Err.GetDetail())
+				lock.Unlock()
+			}
+		}(node)
+	}
+	wg.Wait()
+
+	if len(failures) > 0 {
+		imagesAction.status = ActionFailed
+		imagesAction.err = distributeImagesError(failures)
+		logger.Error(imagesAction.err.GetDetail())
+	} else {
+		imagesAction.status = ActionDone
+	}
+
+	logger.Debug("Finish to execute distribute images action")
+	return nil
+}
+
+// distributeImagesError returns the error of the nodes failed to get or load the images, in the order of the nodes
+func distributeImagesError(failures map[string]string) *pb.Error {
+	names := make([]string, 0, len(failures))
+	for name := range failures {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	details := make([]string, 0, len(names))
+	for _, name := range names {
+		details = append(details, fmt.Sprintf("%v: %v", name, failures[name]))
+	}
+
+	return &pb.Error{
+		Reason:     fmt.Sprintf("failed to distribute images to %d nodes", len(names)),
+		Detail:     strings.Join(details, "\n"),
+		FixMethods: "please check the connection between the nodes and the free space of the nodes",
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine/fake"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/images"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestNewDistributeImagesAction(t *testing.T) {
+	nodes := []*pb.Node{{Name: "node1", Ip: "192.168.1.1"}}
+
+	_, err := NewDistributeImagesAction(&DistributeImagesActionConfig{Tarballs: []string{"/images/pause.tar"}})
+	assert.Error(t, err)
+	_, err = NewDistributeImagesAction(&DistributeImagesActionConfig{Nodes: nodes})
+	assert.Error(t, err)
+
+	act, err := NewDistributeImagesAction(&DistributeImagesActionConfig{Nodes: nodes, Tarballs: []string{"/images/pause.tar"}})
+	assert.NoError(t, err)
+	assert.Equal(t, ActionTypeDistributeImages, act.GetType())
+
+	executor, err := NewExecutor(act.GetType())
+	assert.NoError(t, err)
+	assert.IsType(t, &distributeImagesExecutor{}, executor)
+}
+
+func TestLoadImages(t *testing.T) {
+	step, err := images.LoadStep(&pb.ClusterConfig{ContainerRuntime: "containerd"}, []string{"/images/etcd.tar", "/images/pause.tar"})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		output     string
+		wantStatus InitStepStatus
+		wantReason string
+	}{
+		{
+			output:     "unpacking k8s.gcr.io/pause:3.1\nexit=0\n",
+			wantStatus: InitStepDone,
+		},
+		{
+			output:     "image tarball /var/lib/kpaas/images/etcd.tar is not found\nexit=1\n",
+			wantStatus: InitStepFailed,
+			wantReason: "load_images.sh exited with code 1",
+		},
+	}
+
+	for _, test := range tests {
+		m := fake.NewMachine(&pb.Node{Name: "node1", Ip: "192.168.1.1"}).
+			On(initStepPattern("load_images.sh", "containerd", "/var/lib/kpaas/images/etcd.tar", "/var/lib/kpaas/images/pause.tar"), fake.Response{Stdout: test.output})
+
+		result := runInitStep(m, step)
+		assert.Equal(t, test.wantStatus, result.Status)
+		assert.Equal(t, test.wantReason, result.Err.GetReason())
+	}
+}
+
+func TestDistributeImagesError(t *testing.T) {
+	err := distributeImagesError(map[string]string{
+		"node2": "load_images.sh exited with code 1: ctr: failed to import",
+		"node1": "failed to push artifact to node1",
+	})
+	assert.Equal(t, "failed to distribute images to 2 nodes", err.Reason)
+	assert.Equal(t, "node1: failed to push artifact to node1\nnode2: load_images.sh exited with code 1: ctr: failed to import", err.Detail)
+}
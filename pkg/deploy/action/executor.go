@@ -28,14 +28,36 @@ type Executor interface {
 	Execute(act Action) error
 }
 
+// fixer is implemented by the executors of the check actions which can fix the failed check items.
+// The fixes change the nodes, so they are run by FixActions after all actions of a task are executed.
+type fixer interface {
+	Fix(act Action) error
+}
+
 // NewExecutor is a simple factory method to return an action executor based on action type.
 func NewExecutor(actionType Type) (Executor, error) {
 	var executor Executor
 	switch actionType {
 	case ActionTypeNodeCheck:
 		executor = &nodeCheckExecutor{}
+	case ActionTypeNodePortCheck:
+		executor = &nodePortCheckExecutor{}
+	case ActionTypeNodeConnectivityCheck:
+		executor = &nodeConnectivityCheckExecutor{}
+	case ActionTypeNodeHostnameCheck:
+		executor = &nodeHostnameCheckExecutor{}
+	case ActionTypeInit:
+		executor = &initExecutor{}
+	case ActionTypeDistributeImages:
+		executor = &distributeImagesExecutor{}
 	case ActionTypeDeployEtcd:
 		executor = &deployEtcdExecutor{}
+	case ActionTypeDeployHA:
+		executor = &deployHAExecutor{}
+	case ActionTypeDeployMaster:
+		executor = &deployMasterExecutor{}
+	case ActionTypeDeployWorker:
+		executor = &deployWorkerExecutor{}
 	default:
 		return nil, fmt.Errorf("%s: %s", consts.MsgActionTypeUnsupported, actionType)
 	}
@@ -74,5 +96,55 @@ func ExecuteAction(act Action, wg *sync.WaitGroup) {
 		return
 	}
 
-	act.SetStatus(ActionDone)
+	// the executor may set the status by itself, e.g. a node check action is failed if any item failed
+	if act.GetStatus() == ActionDoing {
+		act.SetStatus(ActionDone)
+	}
+}
+
+// FixActions fixes the failed check items of the executed actions. The node check actions are fixed
+// parallelly since each of them only changes its own node, and then the other actions are fixed one by one.
+func FixActions(acts []Action) {
+	var wg sync.WaitGroup
+	var others []Action
+	for _, act := range acts {
+		if _, ok := act.(*NodeCheckAction); !ok {
+			others = append(others, act)
+			continue
+		}
+		wg.Add(1)
+		go func(act Action) {
+			defer wg.Done()
+			fixAction(act)
+		}(act)
+	}
+	wg.Wait()
+
+	for _, act := range others {
+		fixAction(act)
+	}
+}
+
+func fixAction(act Action) {
+	if act == nil {
+		return
+	}
+
+	executor, err := NewExecutor(act.GetType())
+	if err != nil {
+		return
+	}
+
+	f, ok := executor.(fixer)
+	if !ok {
+		return
+	}
+
+	if err := f.Fix(act); err != nil {
+		act.SetStatus(ActionFailed)
+		act.SetErr(&pb.Error{
+			Reason: consts.MsgActionExecutionFailed,
+			Detail: err.Error(),
+		})
+	}
 }
@@ -0,0 +1,113 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// InitActionConfig represents the config for the os initialization of a node
+type InitActionConfig struct {
+	Node *pb.Node
+	// Nodes are all nodes of the cluster, which are resolved by their names on the node
+	Nodes           []*pb.Node
+	ClusterConfig   *pb.ClusterConfig
+	LogFileBasePath string
+}
+
+// InitAction initializes the os of a node by the init scripts, e.g. turning off swap and setting the hostname
+type InitAction struct {
+	base
+	node          *pb.Node
+	nodes         []*pb.Node
+	clusterConfig *pb.ClusterConfig
+
+	lock  sync.RWMutex
+	steps []*InitStep
+}
+
+type InitStepStatus string
+
+const (
+	InitStepDone   InitStepStatus = "done"
+	InitStepFailed InitStepStatus = "failed"
+	// InitStepSkipped means the step was not run because a previous step failed
+	InitStepSkipped InitStepStatus = "skipped"
+)
+
+// InitStep is the outcome of a step to initialize a node
+type InitStep struct {
+	Name   string
+	Status InitStepStatus
+	Err    *pb.Error
+	// Logs is the output of the step
+	Logs string
+}
+
+// NewInitAction returns a node init action based on the config.
+// User should use this function to create a node init action.
+func NewInitAction(cfg *InitActionConfig) (Action, error) {
+	var err error
+	if cfg == nil {
+		err = fmt.Errorf("action config is nil")
+	} else if cfg.Node == nil {
+		err = fmt.Errorf("Invalid init config: node is nil")
+	}
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	actionName := getInitActionName(cfg)
+	return &InitAction{
+		base: base{
+			name:              actionName,
+			actionType:        ActionTypeInit,
+			status:            ActionPending,
+			logFilePath:       GenActionLogFilePath(cfg.LogFileBasePath, actionName),
+			creationTimestamp: time.Now(),
+		},
+		node:          cfg.Node,
+		nodes:         cfg.Nodes,
+		clusterConfig: cfg.ClusterConfig,
+	}, nil
+}
+
+// GetSteps returns the outcomes of the steps which are finished
+func (a *InitAction) GetSteps() []*InitStep {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	return append([]*InitStep(nil), a.steps...)
+}
+
+func (a *InitAction) addStep(step *InitStep) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	a.steps = append(a.steps, step)
+}
+
+func getInitActionName(cfg *InitActionConfig) string {
+	// used the node name as the the action name for now, this may be changed in the future.
+	return cfg.Node.GetName()
+}
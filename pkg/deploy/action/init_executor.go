@@ -0,0 +1,130 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	initop "github.com/kpaas-io/kpaas/pkg/deploy/operation/init"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+type initExecutor struct {
+}
+
+func (a *initExecutor) Execute(act Action) error {
+	initAction, ok := act.(*InitAction)
+	if !ok {
+		return fmt.Errorf("the action type is not match: should be init action, but is %T", act)
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldAction: act.GetName(),
+	})
+
+	logger.Debug("Start to execute init action")
+
+	m, err := machine.NewMachine(initAction.node)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	// the steps depend on the previous ones, e.g. the host aliases use the hostname, so they are run
+	// in order and the rest are skipped after a step failed
+	var failedStep string
+	for _, step := range initop.NodeSteps(initAction.node, initAction.nodes, initAction.clusterConfig) {
+		if failedStep != "" {
+			initAction.addStep(&InitStep{Name: step.Name, Status: InitStepSkipped})
+			continue
+		}
+
+		result := runInitStep(m, step)
+		initAction.addStep(result)
+		if result.Status == InitStepFailed {
+			failedStep = step.Name
+			logger.Errorf("init step %v failed: %v", step.Name, result.Err.GetDetail())
+		}
+	}
+
+	if failedStep != "" {
+		initAction.status = ActionFailed
+		initAction.err = &pb.Error{
+			Reason:     fmt.Sprintf("init step %v failed", failedStep),
+			Detail:     fmt.Sprintf("the steps after %v are skipped", failedStep),
+			FixMethods: "check the logs of the failed step, the init steps can be run again",
+		}
+	} else {
+		initAction.status = ActionDone
+	}
+
+	logger.Debug("Finish to execute init action")
+	return nil
+}
+
+func runInitStep(m machine.Machine, step *initop.Step) *InitStep {
+	result := &InitStep{
+		Name:   step.Name,
+		Status: InitStepFailed,
+	}
+
+	op, err := initop.NewStepOperation(m, step)
+	if err != nil {
+		result.Err = &pb.Error{
+			Reason:     "failed to create init operation",
+			Detail:     err.Error(),
+			FixMethods: "please check the connection to the node",
+		}
+		return result
+	}
+
+	stdErr, stdOut, err := op.Do()
+	if err != nil {
+		result.Logs = string(stdOut) + string(stdErr)
+		result.Err = &pb.Error{
+			Reason:     "run command failed",
+			Detail:     err.Error(),
+			FixMethods: "please check the connection to the node",
+		}
+		return result
+	}
+
+	output, exitCode, err := initop.ParseStepOutput(string(stdOut))
+	result.Logs = output + string(stdErr)
+	if err != nil {
+		result.Err = &pb.Error{
+			Reason:     "failed to get the result of the init script",
+			Detail:     err.Error(),
+			FixMethods: "please check your scripts",
+		}
+		return result
+	}
+	if exitCode != 0 {
+		result.Err = &pb.Error{
+			Reason:     fmt.Sprintf("%v exited with code %d", step.Script, exitCode),
+			Detail:     strings.TrimSpace(string(stdErr)),
+			FixMethods: "please check the logs of the step",
+		}
+		return result
+	}
+
+	result.Status = InitStepDone
+	return result
+}
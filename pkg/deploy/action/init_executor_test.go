@@ -0,0 +1,145 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	"github.com/kpaas-io/kpaas/pkg/deploy/bundle"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine/sshtest"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func init() {
+	// the ssh test servers listen on the loopback ip, whose nodes are the local machine by default
+	machine.SetLoopbackLocal(false)
+}
+
+// the init scripts are run by the rendered runner, which is quoted as the stdin of the command,
+// so the quotes of the rendered arguments are escaped in the command
+const escapedQuote = `'"'"'`
+
+// initStepPattern returns the pattern of the command which runs the init script with the arguments
+func initStepPattern(script string, args ...string) string {
+	words := []string{"run_step", deploy.ShellQuote(script)}
+	for _, arg := range args {
+		words = append(words, deploy.ShellQuote(arg))
+	}
+	return regexp.QuoteMeta(strings.ReplaceAll(strings.Join(words, " "), "'", escapedQuote))
+}
+
+// parseInitStep returns the init script and the arguments run by the command, ok is false if the
+// command doesn't run an init script
+func parseInitStep(cmd string) (script string, args []string, ok bool) {
+	i := strings.Index(cmd, "\nrun_step ")
+	if i < 0 || !strings.Contains(cmd, "| bash '-s'") {
+		return "", nil, false
+	}
+	line := cmd[i+len("\nrun_step "):]
+	line = strings.ReplaceAll(line[:strings.Index(line, "\n")], escapedQuote, "'")
+
+	words := strings.Split(strings.Trim(line, "'"), "' '")
+	return words[0], words[1:], true
+}
+
+func TestInitExecutorExecute(t *testing.T) {
+	tests := []struct {
+		clusterConfig   *pb.ClusterConfig
+		failedScript    string
+		wantStatus      Status
+		wantScripts     []string
+		wantStepsStatus map[InitStepStatus]int
+	}{
+		{
+			clusterConfig: &pb.ClusterConfig{NtpServers: []string{"ntp.example.com"}},
+			wantStatus:    ActionDone,
+			wantScripts: []string{"init_change_swap.sh", "init_change_firewall.sh", "init_change_hostname.sh", "init_change_timezone.sh",
+				"init_change_hostalias.sh", "init_change_route.sh", "init_change_network.sh", "init_change_ntp.sh"},
+			wantStepsStatus: map[InitStepStatus]int{InitStepDone: 8},
+		},
+		{
+			// the steps after the failed one are skipped
+			failedScript:    "init_change_hostalias.sh",
+			wantStatus:      ActionFailed,
+			wantScripts:     []string{"init_change_swap.sh", "init_change_firewall.sh", "init_change_hostname.sh", "init_change_timezone.sh", "init_change_hostalias.sh"},
+			wantStepsStatus: map[InitStepStatus]int{InitStepDone: 4, InitStepFailed: 1, InitStepSkipped: 2},
+		},
+	}
+
+	for _, test := range tests {
+		var lock sync.Mutex
+		var scripts []string
+		var shell sshtest.Handler
+		server, err := sshtest.NewServer(func(cmd string, stdin io.Reader, stdout, stderr io.Writer) int {
+			script, _, ok := parseInitStep(cmd)
+			if !ok {
+				return shell(cmd, stdin, stdout, stderr)
+			}
+
+			lock.Lock()
+			defer lock.Unlock()
+			scripts = append(scripts, script)
+			if script == test.failedScript {
+				fmt.Fprint(stderr, "permission denied")
+				fmt.Fprint(stdout, "exit=1\n")
+				return 1
+			}
+			fmt.Fprint(stdout, "exit=0\n")
+			return 0
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		shell = sshtest.ShellHandler(server.Root)
+		bundle.RemoteRoot = filepath.Join(server.Root, "scripts")
+
+		node := server.Node("node1")
+		act, err := NewInitAction(&InitActionConfig{
+			Node:          node,
+			Nodes:         []*pb.Node{node},
+			ClusterConfig: test.clusterConfig,
+		})
+		assert.NoError(t, err)
+
+		executor := &initExecutor{}
+		assert.NoError(t, executor.Execute(act))
+		server.Close()
+
+		assert.Equal(t, test.wantStatus, act.GetStatus())
+		assert.Equal(t, test.wantScripts, scripts)
+
+		stepsStatus := make(map[InitStepStatus]int)
+		for _, step := range act.(*InitAction).GetSteps() {
+			stepsStatus[step.Status]++
+			if step.Status == InitStepFailed {
+				assert.Contains(t, step.Err.GetDetail(), "permission denied")
+			}
+		}
+		assert.Equal(t, test.wantStepsStatus, stepsStatus)
+		if test.wantStatus == ActionFailed {
+			assert.NotNil(t, act.GetErr())
+		}
+	}
+}
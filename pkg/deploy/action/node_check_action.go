@@ -16,37 +16,74 @@ package action
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/kpaas-io/kpaas/pkg/deploy/profile"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
 // NodeCheckActionConfig represents the config for a node check action
 type NodeCheckActionConfig struct {
 	NodeCheckConfig *pb.NodeCheckConfig
+	// ClusterConfig is optional, it's used to tell how to fix the failed items
+	ClusterConfig   *pb.ClusterConfig
 	LogFileBasePath string
+	// Profile decides the requirements and severities of check items, the default profile is used if it's nil
+	Profile *profile.Profile
+	// FixItems are the names of the items to be fixed if they failed
+	FixItems []string
+	// Cache keeps the results of the items, no result is cached if it's nil
+	Cache *NodeCheckCache
+	// RecheckFailedOnly reuses the passed items in the cache if the node is not changed since they were checked
+	RecheckFailedOnly bool
 }
 
-type nodeCheckAction struct {
+type NodeCheckAction struct {
 	base
-	nodeCheckConfig *pb.NodeCheckConfig
-	checkItems      []*nodeCheckItem
+	nodeCheckConfig   *pb.NodeCheckConfig
+	clusterConfig     *pb.ClusterConfig
+	profile           *profile.Profile
+	fixItems          []string
+	cache             *NodeCheckCache
+	recheckFailedOnly bool
+	// fingerprint is the fingerprint of the node when it's checked, the results are cached with it
+	fingerprint string
+
+	lock       sync.RWMutex
+	checkItems []*NodeCheckItem
 }
 
-type nodeCheckItemStatus string
+type NodeCheckItemStatus string
 
 const (
-	nodeCheckItemFailed    nodeCheckItemStatus = "failed"
-	nodeCheckItemSucessful nodeCheckItemStatus = "sucessful"
+	NodeCheckItemFailed     NodeCheckItemStatus = "failed"
+	NodeCheckItemWarning    NodeCheckItemStatus = "warning"
+	NodeCheckItemSuccessful NodeCheckItemStatus = "successful"
 )
 
-type nodeCheckItem struct {
-	name        string
-	description string
-	status      nodeCheckItemStatus
-	err         *pb.Error
+// NodeCheckItem is the result of a check item on a node
+type NodeCheckItem struct {
+	Name        string
+	Description string
+	Status      NodeCheckItemStatus
+	Err         *pb.Error
+	// Logs is the output of the check
+	Logs string
+	// Fix is set if the item failed and was fixed, the item is the result checked again after fixing
+	Fix *NodeCheckItemFix
+	// Cached is set if the item passed in the last check and was not checked again
+	Cached bool
+}
+
+// NodeCheckItemFix is the fix of a failed check item
+type NodeCheckItemFix struct {
+	// Before is the result of the item before fixing
+	Before *NodeCheckItem
+	// Logs is the output of the fix
+	Logs string
 }
 
 // NewNodeCheckAction returns a node check action based on the config.
@@ -66,8 +103,13 @@ func NewNodeCheckAction(cfg *NodeCheckActionConfig) (Action, error) {
 		return nil, err
 	}
 
+	checkProfile := cfg.Profile
+	if checkProfile == nil {
+		checkProfile = profile.Default()
+	}
+
 	actionName := getNodeCheckActionName(cfg)
-	return &nodeCheckAction{
+	return &NodeCheckAction{
 		base: base{
 			name:              actionName,
 			actionType:        ActionTypeNodeCheck,
@@ -75,10 +117,42 @@ func NewNodeCheckAction(cfg *NodeCheckActionConfig) (Action, error) {
 			logFilePath:       GenActionLogFilePath(cfg.LogFileBasePath, actionName),
 			creationTimestamp: time.Now(),
 		},
-		nodeCheckConfig: cfg.NodeCheckConfig,
+		nodeCheckConfig:   cfg.NodeCheckConfig,
+		clusterConfig:     cfg.ClusterConfig,
+		profile:           checkProfile,
+		fixItems:          cfg.FixItems,
+		cache:             cfg.Cache,
+		recheckFailedOnly: cfg.RecheckFailedOnly,
 	}, nil
 }
 
+// GetCheckItems returns the results of all check items which are finished
+func (a *NodeCheckAction) GetCheckItems() []*NodeCheckItem {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	return append([]*NodeCheckItem(nil), a.checkItems...)
+}
+
+func (a *NodeCheckAction) setCheckItems(items []*NodeCheckItem) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	a.checkItems = items
+}
+
+// shouldFix returns if the items are selected to be fixed
+func shouldFix(fixItems []string, items ...string) bool {
+	for _, fixItem := range fixItems {
+		for _, item := range items {
+			if fixItem == item {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func getNodeCheckActionName(cfg *NodeCheckActionConfig) string {
 	// now we used the node name as the the action name, this may be changed in the future.
 	return cfg.NodeCheckConfig.Node.GetName()
@@ -0,0 +1,76 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"sync"
+)
+
+// NodeCheckCache caches the results of the node check items by node, with the fingerprint of the
+// node when they were checked. The results are reused if the nodes are re-checked with the failed
+// items only, and the fingerprints are not changed.
+type NodeCheckCache struct {
+	lock  sync.RWMutex
+	nodes map[string]*nodeCheckCacheEntry
+}
+
+type nodeCheckCacheEntry struct {
+	fingerprint string
+	items       map[string]*NodeCheckItem
+}
+
+// NewNodeCheckCache returns an empty node check cache
+func NewNodeCheckCache() *NodeCheckCache {
+	return &NodeCheckCache{
+		nodes: make(map[string]*nodeCheckCacheEntry),
+	}
+}
+
+// get returns the cached items of a node by name, it returns nil if the node was not checked
+// or the fingerprint is changed
+func (c *NodeCheckCache) get(nodeName, fingerprint string) map[string]*NodeCheckItem {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	entry, ok := c.nodes[nodeName]
+	if !ok || entry.fingerprint != fingerprint {
+		return nil
+	}
+	return entry.items
+}
+
+// set replaces the cached items of a node
+func (c *NodeCheckCache) set(nodeName, fingerprint string, items []*NodeCheckItem) {
+	entry := &nodeCheckCacheEntry{
+		fingerprint: fingerprint,
+		items:       make(map[string]*NodeCheckItem, len(items)),
+	}
+	for _, item := range items {
+		entry.items[item.Name] = item
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.nodes[nodeName] = entry
+}
+
+// cachedNodeCheckItem returns a copy of a passed item to be reused, the fix is kept if the item
+// passed after fixing
+func cachedNodeCheckItem(item *NodeCheckItem) *NodeCheckItem {
+	cached := *item
+	cached.Cached = true
+	return &cached
+}
@@ -15,31 +15,27 @@
 package action
 
 import (
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/sirupsen/logrus"
 
 	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
-	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
-	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check/docker"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check/fingerprint"
+	"github.com/kpaas-io/kpaas/pkg/deploy/profile"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
-const (
-	desiredDockerVersion              = "18.09.0"
-	desiredKernelVersion              = "4.19.46"
-	desiredCPUCore            float64 = 8
-	desiredMemoryBase         float64 = 16
-	desiredMemory                     = desiredMemoryBase * operation.GiByteUnits
-	desiredRootDiskVolumeBase float64 = 200
-	desiredRootDiskVolume     float64 = desiredRootDiskVolumeBase * operation.GiByteUnits
-)
-
 type nodeCheckExecutor struct {
 }
 
 func (a *nodeCheckExecutor) Execute(act Action) error {
-	nodeCheckAction, ok := act.(*nodeCheckAction)
+	nodeCheckAction, ok := act.(*NodeCheckAction)
 	if !ok {
 		return fmt.Errorf("the action type is not match: should be node check action, but is %T", act)
 	}
@@ -50,57 +46,262 @@ func (a *nodeCheckExecutor) Execute(act Action) error {
 
 	logger.Debug("Start to execute node check action")
 
-	var (
-		reason    string
-		detail    string
-		status    nodeCheckItemStatus
-		fixmethod string
-	)
+	m, err := machine.NewMachine(nodeCheckAction.nodeCheckConfig.Node)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	definitions := nodeCheckItemDefinitions(nodeCheckAction.profile, nodeCheckAction.clusterConfig, nodeCheckAction.nodeCheckConfig.GetRoles())
+
+	var fingerprint string
+	var cachedItems map[string]*NodeCheckItem
+	if nodeCheckAction.cache != nil {
+		if fingerprint, err = nodeFingerprint(m, nodeCheckAction); err != nil {
+			// all items are checked, and the results are not cached
+			logger.Warnf("failed to get the fingerprint of the node: %v", err)
+		} else if nodeCheckAction.recheckFailedOnly {
+			cachedItems = nodeCheckAction.cache.get(nodeCheckAction.GetName(), fingerprint)
+		}
+	}
+
+	items := make([]*NodeCheckItem, len(definitions))
+	var checked []int
+	var cachedCount int
+	for i, definition := range definitions {
+		// only the failed items are checked again
+		if cached, ok := cachedItems[definition.name]; ok && cached.Status == NodeCheckItemSuccessful {
+			items[i] = cachedNodeCheckItem(cached)
+			cachedCount++
+			continue
+		}
+		checked = append(checked, i)
+	}
+	for j, item := range runNodeCheckItems(m, definitions, checked) {
+		items[checked[j]] = item
+	}
+
+	nodeCheckAction.fingerprint = fingerprint
+	setNodeCheckResult(nodeCheckAction, items, logger)
+
+	logger.Debugf("Finish to execute node check action with profile %v: %d items reused", nodeCheckAction.profile.Name, cachedCount)
+	return nil
+}
+
+// Fix fixes the failed items which are selected to be fixed, and checks them again. It's called after all
+// actions of the task are executed, so the fixes don't change the node while the other actions check it.
+func (a *nodeCheckExecutor) Fix(act Action) error {
+	nodeCheckAction, ok := act.(*NodeCheckAction)
+	if !ok {
+		return fmt.Errorf("the action type is not match: should be node check action, but is %T", act)
+	}
+
+	// the definitions are decided by the config of the action, so they are the same as the checked ones
+	definitions := nodeCheckItemDefinitions(nodeCheckAction.profile, nodeCheckAction.clusterConfig, nodeCheckAction.nodeCheckConfig.GetRoles())
+	items := nodeCheckAction.GetCheckItems()
+	if len(items) != len(definitions) {
+		return nil
+	}
+	fixes := fixableNodeCheckItems(definitions, items, nodeCheckAction.fixItems)
+	if len(fixes) == 0 {
+		return nil
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldAction: act.GetName(),
+	})
+
+	logger.Debug("Start to fix node check items")
 
-	op, err := docker.NewCheckDockerOperation(nodeCheckAction.nodeCheckConfig)
+	m, err := machine.NewMachine(nodeCheckAction.nodeCheckConfig.Node)
 	if err != nil {
-		return fmt.Errorf("failed to create docker check operation, error: %v", err)
+		return err
+	}
+	defer m.Close()
+
+	fixNodeCheckItems(m, definitions, items, fixes)
+	setNodeCheckResult(nodeCheckAction, items, logger)
+
+	logger.Debugf("Finish to fix node check items: %d items fixed", len(fixes))
+	return nil
+}
+
+// setNodeCheckResult sets the items to the action, caches them, and sets the status of the action by them
+func setNodeCheckResult(nodeCheckAction *NodeCheckAction, items []*NodeCheckItem, logger *logrus.Entry) {
+	nodeCheckAction.setCheckItems(items)
+	if nodeCheckAction.cache != nil && nodeCheckAction.fingerprint != "" {
+		nodeCheckAction.cache.set(nodeCheckAction.GetName(), nodeCheckAction.fingerprint, items)
+	}
+
+	var failedItems []string
+	for _, item := range items {
+		switch item.Status {
+		case NodeCheckItemFailed:
+			failedItems = append(failedItems, item.Name)
+		case NodeCheckItemWarning:
+			logger.Warnf("check item %v failed with warning severity: %v", item.Name, item.Err.GetDetail())
+		}
+	}
+
+	if len(failedItems) > 0 {
+		nodeCheckAction.status = ActionFailed
+		nodeCheckAction.err = &pb.Error{
+			Reason:     "one or more check items failed",
+			Detail:     fmt.Sprintf("failed items: %v", strings.Join(failedItems, ", ")),
+			FixMethods: "check the fix methods of the failed items",
+		}
+	} else {
+		nodeCheckAction.status = ActionDone
+		nodeCheckAction.err = nil
 	}
 
+	logger.Debugf("%d of %d node check items failed", len(failedItems), len(items))
+}
+
+// nodeFingerprint returns the fingerprint of the node facts and the inputs of the check items
+func nodeFingerprint(m machine.Machine, act *NodeCheckAction) (string, error) {
+	op, err := fingerprint.NewFingerprintOperation(m)
+	if err != nil {
+		return "", err
+	}
 	stdErr, stdOut, err := op.Do()
 	if err != nil {
-		reason = "run command failed"
-		detail = string(stdErr)
-		status = nodeCheckItemFailed
-		fixmethod = "please check your scripts"
-		return err
+		return "", fmt.Errorf("%v, stderr: %s", err, stdErr)
 	}
 
-	comparedDockerVersion := string(stdOut[:])
-	err = docker.CheckDockerVersion(comparedDockerVersion, desiredDockerVersion, ">")
+	checkProfile, err := json.Marshal(act.profile)
 	if err != nil {
-		reason = "docker version not satisfied"
-		detail = string(stdErr)
-		status = nodeCheckItemFailed
-		fixmethod = fmt.Sprintf("please upgrade docker version to %v+", desiredDockerVersion)
-		return err
+		return "", err
 	}
+	roles := append([]string(nil), act.nodeCheckConfig.GetRoles()...)
+	sort.Strings(roles)
 
-	status = nodeCheckItemSucessful
+	return fingerprint.Fingerprint(string(stdOut),
+		act.nodeCheckConfig.GetNode().GetIp(),
+		strings.Join(roles, ","),
+		string(checkProfile),
+		proto.CompactTextString(act.clusterConfig),
+	), nil
+}
 
-	dockerVersionItem := &nodeCheckItem{
-		name:        "docker version check",
-		description: "docker version check",
-		status:      status,
-		err: &pb.Error{
-			Reason:     reason,
-			Detail:     detail,
-			FixMethods: fixmethod,
-		},
+func runNodeCheckItem(m machine.Machine, definition *nodeCheckItemDefinition) *NodeCheckItem {
+	item := &NodeCheckItem{
+		Name:        definition.name,
+		Description: definition.description,
+		Status:      NodeCheckItemFailed,
+	}
+	// a failed item with warning severity doesn't fail the node
+	if definition.severity == profile.SeverityWarning {
+		item.Status = NodeCheckItemWarning
 	}
-	nodeCheckAction.checkItems = append(nodeCheckAction.checkItems, dockerVersionItem)
 
-	// TODO: other checks
+	op, err := definition.newOperation(m)
+	if err != nil {
+		item.Err = &pb.Error{
+			Reason:     "failed to create check operation",
+			Detail:     err.Error(),
+			FixMethods: "please check the connection to the node",
+		}
+		return item
+	}
 
-	// TODO: update action status
-	nodeCheckAction.status = ActionFailed
-	nodeCheckAction.err = dockerVersionItem.err
+	stdErr, stdOut, err := op.Do()
+	item.Logs = string(stdOut) + string(stdErr)
+	if err != nil {
+		item.Err = &pb.Error{
+			Reason:     "run command failed",
+			Detail:     err.Error(),
+			FixMethods: "please check your scripts",
+		}
+		return item
+	}
 
-	logger.Debug("Finish to execute node check action")
-	return nil
+	if err := definition.check(strings.TrimSpace(string(stdOut)), string(stdErr)); err != nil {
+		item.Err = &pb.Error{
+			Reason:     definition.reason,
+			Detail:     err.Error(),
+			FixMethods: definition.fixMethod,
+		}
+		return item
+	}
+
+	item.Status = NodeCheckItemSuccessful
+	if definition.measured != nil {
+		if measured := definition.measured(strings.TrimSpace(string(stdOut))); measured != "" {
+			item.Description = fmt.Sprintf("%v, measured: %v", item.Description, measured)
+		}
+	}
+	return item
+}
+
+// fixNodeCheckItems runs the fixes of the items at the indexes, then checks the items again. The fixes
+// change the node, e.g. the container runtime is reinstalled or the node is cleaned, so they are run one by one in the
+// order of the items, and a fix shared by more than one item is run once.
+func fixNodeCheckItems(m machine.Machine, definitions []*nodeCheckItemDefinition, items []*NodeCheckItem, fixes []int) {
+	fixLogs := make(map[string]string)
+	for _, i := range fixes {
+		if _, ok := fixLogs[definitions[i].getFixName()]; !ok {
+			fixLogs[definitions[i].getFixName()] = runNodeCheckFix(m, definitions[i])
+		}
+	}
+
+	// the fixed items are checked again after all fixes are done
+	for j, item := range runNodeCheckItems(m, definitions, fixes) {
+		i := fixes[j]
+		item.Fix = &NodeCheckItemFix{
+			Before: items[i],
+			Logs:   fixLogs[definitions[i].getFixName()],
+		}
+		items[i] = item
+	}
+}
+
+// runNodeCheckItems runs the items of the definitions at the indexes, and returns the results in the same order.
+// The read-only items are run in parallel, then the items writing to the node are run one by one.
+func runNodeCheckItems(m machine.Machine, definitions []*nodeCheckItemDefinition, indexes []int) []*NodeCheckItem {
+	items := make([]*NodeCheckItem, len(indexes))
+	var wg sync.WaitGroup
+	for j, i := range indexes {
+		if definitions[i].writes {
+			continue
+		}
+		wg.Add(1)
+		go func(j int, definition *nodeCheckItemDefinition) {
+			defer wg.Done()
+			items[j] = runNodeCheckItem(m, definition)
+		}(j, definitions[i])
+	}
+	wg.Wait()
+
+	for j, i := range indexes {
+		if definitions[i].writes {
+			items[j] = runNodeCheckItem(m, definitions[i])
+		}
+	}
+	return items
+}
+
+// fixableNodeCheckItems returns the indexes of the failed items which can be fixed and are selected to be fixed
+func fixableNodeCheckItems(definitions []*nodeCheckItemDefinition, items []*NodeCheckItem, fixItems []string) []int {
+	var fixes []int
+	for i, definition := range definitions {
+		if items[i].Status != NodeCheckItemSuccessful && definition.fix != nil && shouldFix(fixItems, definition.name) {
+			fixes = append(fixes, i)
+		}
+	}
+	return fixes
+}
+
+// runNodeCheckFix runs the fix of the item and returns its logs
+func runNodeCheckFix(m machine.Machine, definition *nodeCheckItemDefinition) string {
+	op, err := definition.fix(m)
+	if err != nil {
+		return fmt.Sprintf("failed to fix: %v", err)
+	}
+	stdErr, stdOut, err := op.Do()
+	logs := string(stdOut) + string(stdErr)
+	if err != nil {
+		logs += fmt.Sprintf("failed to fix: %v", err)
+	}
+	return logs
 }
@@ -0,0 +1,608 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/bundle"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine/sshtest"
+	"github.com/kpaas-io/kpaas/pkg/deploy/profile"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+var passedOutputs = map[string]string{
+	"check_docker_version.sh":      "18.09.7",
+	"check_kernel_version.sh":      "4.19.46-1.el7",
+	"check_cpu_num.sh":             "8",
+	"check_memory_capacity.sh":     "16330000",
+	"check_root_disk_volume.sh":    "209715200",
+	"check_system_distribution.sh": "centos",
+	"check_system_preference.sh":   "",
+	// the time of the node is filled when the script is run
+	"check_time_sync.sh": "service=chronyd\nsynchronized=yes\ntime=%v",
+	"check_residue.sh":   "",
+	// the scripts below are run with the container runtime as the argument
+	"check_cri_socket.sh":         "socket=/var/run/docker.sock\nready=yes",
+	"check_cgroup_driver.sh":      "driver=systemd\ninit=systemd",
+	"check_containerd_version.sh": "1.2.10",
+	"check_kubelet_version.sh":    "1.17.3",
+	"disk_probe.sh": `{"dir": "/var/lib/etcd", "fsync": {"count": 500, "p50Ms": 0.5, "p99Ms": 2.25, "maxMs": 4.0}, ` +
+		`"write": {"mib": 64, "seconds": 0.5, "mibps": 128.0}, "error": ""}`,
+}
+
+func TestNodeCheckExecutorExecute(t *testing.T) {
+
+	labProfile := &profile.Profile{
+		Name:          "lab",
+		DockerVersion: "18.09.0",
+		KernelVersion: "4.19.46",
+		Roles: map[string]*profile.Requirement{
+			profile.DefaultRole: {CPUCores: 8, MemoryGiB: 16, RootDiskGiB: 200},
+			"worker":            {CPUCores: 2, MemoryGiB: 4, RootDiskGiB: 50},
+		},
+		Severities: map[string]profile.Severity{
+			"kernel version check": profile.SeverityWarning,
+		},
+		SkipItems: []string{"system distribution check"},
+	}
+
+	tests := []struct {
+		profile          *profile.Profile
+		clusterConfig    *pb.ClusterConfig
+		roles            []string
+		outputs          map[string]string
+		clockOffset      time.Duration
+		wantStatus       Status
+		wantItems        int
+		wantFailedItems  []string
+		wantWarningItems []string
+	}{
+		{
+			outputs:    map[string]string{},
+			wantStatus: ActionDone,
+			wantItems:  11,
+		},
+		{
+			outputs: map[string]string{
+				"check_memory_capacity.sh": "8000000",
+			},
+			wantStatus:      ActionFailed,
+			wantItems:       11,
+			wantFailedItems: []string{"memory capacity check"},
+		},
+		{
+			outputs: map[string]string{
+				"check_docker_version.sh":      "17.03.2",
+				"check_system_distribution.sh": "debian",
+			},
+			wantStatus:      ActionFailed,
+			wantItems:       11,
+			wantFailedItems: []string{"docker version check", "system distribution check"},
+		},
+		{
+			// the clock of the node is an hour behind the controller
+			outputs: map[string]string{
+				"check_time_sync.sh": "service=ntpd\nsynchronized=yes\ntime=%v",
+			},
+			clockOffset:     -time.Hour,
+			wantStatus:      ActionFailed,
+			wantItems:       11,
+			wantFailedItems: []string{"clock synchronization check"},
+		},
+		{
+			// containerd is checked instead of docker, the cri plugin is disabled and the cgroup driver is not set
+			clusterConfig: &pb.ClusterConfig{ContainerRuntime: "containerd"},
+			outputs: map[string]string{
+				"check_cri_socket.sh":    "socket=/run/containerd/containerd.sock\nready=no\nerror=the cri plugin of containerd is not loaded",
+				"check_cgroup_driver.sh": "driver=cgroupfs\ninit=systemd",
+			},
+			wantStatus:      ActionFailed,
+			wantItems:       11,
+			wantFailedItems: []string{"cri socket check", "cgroup driver check"},
+		},
+		{
+			// the etcd disk is checked on etcd nodes only
+			roles:      []string{"etcd"},
+			outputs:    map[string]string{},
+			wantStatus: ActionDone,
+			wantItems:  12,
+		},
+		{
+			roles: []string{"etcd", "master"},
+			outputs: map[string]string{
+				"disk_probe.sh": `{"dir": "/var/lib/etcd", "fsync": {"count": 500, "p50Ms": 8.0, "p99Ms": 25.5, "maxMs": 40.0}, ` +
+					`"write": {"mib": 64, "seconds": 0.5, "mibps": 128.0}, "error": ""}`,
+			},
+			wantStatus:      ActionFailed,
+			wantItems:       12,
+			wantFailedItems: []string{"etcd disk performance check"},
+		},
+		{
+			// docker with a vendor suffix satisfies the constraint, kubelet is checked against the kubernetes version
+			clusterConfig: &pb.ClusterConfig{KubernetesVersion: "v1.16.4"},
+			outputs: map[string]string{
+				"check_docker_version.sh": "19.03.5-ce",
+			},
+			wantStatus:      ActionFailed,
+			wantItems:       12,
+			wantFailedItems: []string{"kubelet version check"},
+		},
+		{
+			// the node ran a cluster before
+			outputs: map[string]string{
+				"check_residue.sh": "directory=/etc/kubernetes\nprocess=kubelet\ninterface=cali0123456789a",
+			},
+			wantStatus:      ActionFailed,
+			wantItems:       11,
+			wantFailedItems: []string{"residue check"},
+		},
+		{
+			// the lab profile lowers the requirements of workers, only warns the kernel version and skips the distribution
+			profile: labProfile,
+			roles:   []string{"worker"},
+			outputs: map[string]string{
+				"check_kernel_version.sh":      "3.10.0-957.el7.x86_64",
+				"check_cpu_num.sh":             "2",
+				"check_memory_capacity.sh":     "8000000",
+				"check_root_disk_volume.sh":    "52428800",
+				"check_system_distribution.sh": "debian",
+			},
+			wantStatus:       ActionDone,
+			wantItems:        10,
+			wantWarningItems: []string{"kernel version check"},
+		},
+		{
+			// the requirement of a node is the maximum of its roles
+			profile: labProfile,
+			roles:   []string{"worker", "master"},
+			outputs: map[string]string{
+				"check_cpu_num.sh": "2",
+			},
+			wantStatus:      ActionFailed,
+			wantItems:       10,
+			wantFailedItems: []string{"cpu cores check"},
+		},
+	}
+
+	for _, test := range tests {
+		var shell sshtest.Handler
+		server, err := sshtest.NewServer(func(cmd string, stdin io.Reader, stdout, stderr io.Writer) int {
+			for script, output := range passedOutputs {
+				if !strings.Contains(cmd, fmt.Sprintf("bash '%v'", script)) {
+					continue
+				}
+				if o, ok := test.outputs[script]; ok {
+					output = o
+				}
+				if script == "check_time_sync.sh" {
+					output = fmt.Sprintf(output, float64(time.Now().Add(test.clockOffset).UnixNano())/float64(time.Second))
+				}
+				fmt.Fprint(stdout, output)
+				return 0
+			}
+			return shell(cmd, stdin, stdout, stderr)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		shell = sshtest.ShellHandler(server.Root)
+		bundle.RemoteRoot = filepath.Join(server.Root, "scripts")
+
+		act, err := NewNodeCheckAction(&NodeCheckActionConfig{
+			NodeCheckConfig: &pb.NodeCheckConfig{
+				Node:  server.Node("node1"),
+				Roles: test.roles,
+			},
+			ClusterConfig: test.clusterConfig,
+			Profile:       test.profile,
+		})
+		assert.NoError(t, err)
+
+		executor := &nodeCheckExecutor{}
+		err = executor.Execute(act)
+
+		b, bundleErr := bundle.Get()
+		assert.NoError(t, bundleErr)
+		assert.FileExists(t, b.RemotePath("lib.sh"))
+		assert.Contains(t, server.Commands(), fmt.Sprintf("cd '%v' && bash 'check_kernel_version.sh'", b.RemoteDir()))
+		server.Close()
+
+		assert.NoError(t, err)
+		assert.Equal(t, test.wantStatus, act.GetStatus())
+
+		checkItems := act.(*NodeCheckAction).GetCheckItems()
+		assert.Len(t, checkItems, test.wantItems)
+		var failedItems, warningItems []string
+		for _, item := range checkItems {
+			if item.Name == "etcd disk performance check" && item.Status == NodeCheckItemSuccessful {
+				assert.Contains(t, item.Description, "measured: fsync p99 2.25ms")
+			}
+			switch item.Status {
+			case NodeCheckItemFailed:
+				failedItems = append(failedItems, item.Name)
+				assert.NotNil(t, item.Err)
+			case NodeCheckItemWarning:
+				warningItems = append(warningItems, item.Name)
+				assert.NotNil(t, item.Err)
+			}
+		}
+		assert.Equal(t, test.wantFailedItems, failedItems)
+		assert.Equal(t, test.wantWarningItems, warningItems)
+		if test.wantStatus == ActionFailed {
+			assert.NotNil(t, act.GetErr())
+		}
+	}
+}
+
+func TestNodeCheckExecutorFix(t *testing.T) {
+	tests := []struct {
+		fixItems   []string
+		wantStatus Status
+		wantFixed  bool
+	}{
+		{
+			wantStatus: ActionFailed,
+		},
+		{
+			fixItems:   []string{"system preference check"},
+			wantStatus: ActionDone,
+			wantFixed:  true,
+		},
+	}
+
+	for _, test := range tests {
+		var shell sshtest.Handler
+		var fixed bool
+		server, err := sshtest.NewServer(func(cmd string, stdin io.Reader, stdout, stderr io.Writer) int {
+			if strings.HasSuffix(cmd, "bash 'check_system_preference.sh' '--fix'") {
+				fixed = true
+				fmt.Fprint(stdout, "successfully set net.ipv4.ip_forward to 1")
+				return 0
+			}
+			for script, output := range passedOutputs {
+				if !strings.Contains(cmd, fmt.Sprintf("bash '%v'", script)) {
+					continue
+				}
+				switch script {
+				case "check_time_sync.sh":
+					output = fmt.Sprintf(output, float64(time.Now().UnixNano())/float64(time.Second))
+				case "check_system_preference.sh":
+					if !fixed {
+						fmt.Fprint(stderr, "sysctl errors: ip_forward not opened")
+						return 1
+					}
+				}
+				fmt.Fprint(stdout, output)
+				return 0
+			}
+			return shell(cmd, stdin, stdout, stderr)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		shell = sshtest.ShellHandler(server.Root)
+		bundle.RemoteRoot = filepath.Join(server.Root, "scripts")
+
+		act, err := NewNodeCheckAction(&NodeCheckActionConfig{
+			NodeCheckConfig: &pb.NodeCheckConfig{
+				Node: server.Node("node1"),
+			},
+			FixItems: test.fixItems,
+		})
+		assert.NoError(t, err)
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		ExecuteAction(act, &wg)
+		// the items are only fixed after all check actions of the task are executed
+		assert.False(t, fixed)
+		FixActions([]Action{act})
+		server.Close()
+
+		assert.Equal(t, test.wantStatus, act.GetStatus())
+		assert.Equal(t, test.wantFixed, fixed)
+		for _, item := range act.(*NodeCheckAction).GetCheckItems() {
+			if item.Name != "system preference check" || !test.wantFixed {
+				assert.Nil(t, item.Fix, item.Name)
+				continue
+			}
+			assert.Equal(t, NodeCheckItemSuccessful, item.Status)
+			if assert.NotNil(t, item.Fix) {
+				assert.Equal(t, NodeCheckItemFailed, item.Fix.Before.Status)
+				assert.Contains(t, item.Fix.Before.Err.GetDetail(), "ip_forward not opened")
+				assert.Contains(t, item.Fix.Logs, "successfully set")
+			}
+		}
+	}
+}
+
+func TestNodeCheckExecutorFixSerially(t *testing.T) {
+	var lock sync.Mutex
+	var running, maxRunning int
+	runs := make(map[string]int)
+	fixed := make(map[string]bool)
+	runFix := func(script string) {
+		lock.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		runs[script]++
+		lock.Unlock()
+
+		time.Sleep(50 * time.Millisecond)
+
+		lock.Lock()
+		running--
+		fixed[script] = true
+		lock.Unlock()
+	}
+	isFixed := func(script string) bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return fixed[script]
+	}
+
+	var shell sshtest.Handler
+	server, err := sshtest.NewServer(func(cmd string, stdin io.Reader, stdout, stderr io.Writer) int {
+		if script, _, ok := parseInitStep(cmd); ok && script == "init_container_runtime.sh" {
+			runFix(script)
+			fmt.Fprint(stdout, "exit=0\n")
+			return 0
+		}
+		if strings.Contains(cmd, "bash 'clean_node.sh'") {
+			runFix("clean_node.sh")
+			return 0
+		}
+		for script, output := range passedOutputs {
+			if !strings.Contains(cmd, fmt.Sprintf("bash '%v'", script)) {
+				continue
+			}
+			switch script {
+			case "check_time_sync.sh":
+				output = fmt.Sprintf(output, float64(time.Now().UnixNano())/float64(time.Second))
+			case "check_cri_socket.sh":
+				if !isFixed("init_container_runtime.sh") {
+					output = "socket=/var/run/docker.sock\nready=no\nerror=docker is not running"
+				}
+			case "check_cgroup_driver.sh":
+				if !isFixed("init_container_runtime.sh") {
+					output = "driver=cgroupfs\ninit=systemd"
+				}
+			case "check_residue.sh":
+				if !isFixed("clean_node.sh") {
+					output = "directory=/etc/kubernetes"
+				}
+			}
+			fmt.Fprint(stdout, output)
+			return 0
+		}
+		return shell(cmd, stdin, stdout, stderr)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+	shell = sshtest.ShellHandler(server.Root)
+	bundle.RemoteRoot = filepath.Join(server.Root, "scripts")
+
+	act, err := NewNodeCheckAction(&NodeCheckActionConfig{
+		NodeCheckConfig: &pb.NodeCheckConfig{
+			Node: server.Node("node1"),
+		},
+		FixItems: []string{"cri socket check", "cgroup driver check", "residue check"},
+	})
+	assert.NoError(t, err)
+
+	executor := &nodeCheckExecutor{}
+	assert.NoError(t, executor.Execute(act))
+	assert.NoError(t, executor.Fix(act))
+	assert.Equal(t, ActionDone, act.GetStatus())
+
+	// the container runtime is initialized once for both items, and the fixes are not run at the same time
+	assert.Equal(t, map[string]int{"init_container_runtime.sh": 1, "clean_node.sh": 1}, runs)
+	assert.Equal(t, 1, maxRunning)
+	for _, item := range act.(*NodeCheckAction).GetCheckItems() {
+		switch item.Name {
+		case "cri socket check", "cgroup driver check", "residue check":
+			assert.Equal(t, NodeCheckItemSuccessful, item.Status, item.Name)
+			if assert.NotNil(t, item.Fix, item.Name) {
+				assert.Equal(t, NodeCheckItemFailed, item.Fix.Before.Status, item.Name)
+			}
+		default:
+			assert.Nil(t, item.Fix, item.Name)
+		}
+	}
+}
+
+func TestNodeCheckExecutorRecheckFailedOnly(t *testing.T) {
+	var lock sync.Mutex
+	runs := make(map[string]int)
+	bootID := "6c5e3861-a057-4136-98ce-c84f051d3713"
+	preferenceFixed := false
+
+	var shell sshtest.Handler
+	server, err := sshtest.NewServer(func(cmd string, stdin io.Reader, stdout, stderr io.Writer) int {
+		lock.Lock()
+		defer lock.Unlock()
+
+		if strings.Contains(cmd, "bash 'node_fingerprint.sh'") {
+			fmt.Fprintf(stdout, "hostname=node1\nboot=%v\nkernel=4.19.46-1.el7\n", bootID)
+			return 0
+		}
+		for script, output := range passedOutputs {
+			if !strings.Contains(cmd, fmt.Sprintf("bash '%v'", script)) {
+				continue
+			}
+			runs[script]++
+			switch script {
+			case "check_time_sync.sh":
+				output = fmt.Sprintf(output, float64(time.Now().UnixNano())/float64(time.Second))
+			case "check_system_preference.sh":
+				if !preferenceFixed {
+					fmt.Fprint(stderr, "sysctl errors: ip_forward not opened")
+					return 1
+				}
+			}
+			fmt.Fprint(stdout, output)
+			return 0
+		}
+		return shell(cmd, stdin, stdout, stderr)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+	shell = sshtest.ShellHandler(server.Root)
+	bundle.RemoteRoot = filepath.Join(server.Root, "scripts")
+
+	cache := NewNodeCheckCache()
+	check := func(recheckFailedOnly bool) *NodeCheckAction {
+		act, err := NewNodeCheckAction(&NodeCheckActionConfig{
+			NodeCheckConfig: &pb.NodeCheckConfig{
+				Node: server.Node("node1"),
+			},
+			Cache:             cache,
+			RecheckFailedOnly: recheckFailedOnly,
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, (&nodeCheckExecutor{}).Execute(act))
+		return act.(*NodeCheckAction)
+	}
+	cachedItems := func(act *NodeCheckAction) []string {
+		var items []string
+		for _, item := range act.GetCheckItems() {
+			if item.Cached {
+				items = append(items, item.Name)
+			}
+		}
+		return items
+	}
+
+	// the first check runs all items
+	act := check(true)
+	assert.Equal(t, ActionFailed, act.GetStatus())
+	assert.Empty(t, cachedItems(act))
+	assert.Equal(t, 1, runs["check_kernel_version.sh"])
+	assert.Equal(t, 1, runs["check_system_preference.sh"])
+
+	// only the failed item is checked again after the user fixed it
+	lock.Lock()
+	preferenceFixed = true
+	lock.Unlock()
+	act = check(true)
+	assert.Equal(t, ActionDone, act.GetStatus())
+	assert.Len(t, act.GetCheckItems(), 11)
+	assert.Len(t, cachedItems(act), 10)
+	assert.NotContains(t, cachedItems(act), "system preference check")
+	assert.Equal(t, 1, runs["check_kernel_version.sh"])
+	assert.Equal(t, 2, runs["check_system_preference.sh"])
+
+	// the node rebooted, so all items are checked again
+	lock.Lock()
+	bootID = "0f1e2d3c-0000-4000-8000-000000000000"
+	lock.Unlock()
+	act = check(true)
+	assert.Equal(t, ActionDone, act.GetStatus())
+	assert.Empty(t, cachedItems(act))
+	assert.Equal(t, 2, runs["check_kernel_version.sh"])
+
+	// a full check doesn't reuse the results
+	act = check(false)
+	assert.Empty(t, cachedItems(act))
+	assert.Equal(t, 3, runs["check_kernel_version.sh"])
+}
+
+func TestNodeCheckItemNames(t *testing.T) {
+	// the names of all items should be registered, so they can be skipped or have their severities set by profiles
+	for _, runtime := range []string{"docker", "containerd"} {
+		clusterConfig := &pb.ClusterConfig{ContainerRuntime: runtime, KubernetesVersion: "v1.16.4"}
+		for _, definition := range nodeCheckItemDefinitions(profile.Default(), clusterConfig, []string{string(consts.NodeRoleEtcd)}) {
+			assert.Contains(t, nodeCheckItemNames, definition.name)
+			assert.True(t, profile.Registered(definition.name), definition.name)
+		}
+	}
+}
+
+func TestNodeCheckExecutorWritingItemsLast(t *testing.T) {
+	var lock sync.Mutex
+	var running int
+	var probing, probeWithOthers bool
+
+	var shell sshtest.Handler
+	server, err := sshtest.NewServer(func(cmd string, stdin io.Reader, stdout, stderr io.Writer) int {
+		for script, output := range passedOutputs {
+			if !strings.Contains(cmd, fmt.Sprintf("bash '%v'", script)) {
+				continue
+			}
+
+			lock.Lock()
+			if probing || (script == "disk_probe.sh" && running > 0) {
+				probeWithOthers = true
+			}
+			if script == "disk_probe.sh" {
+				probing = true
+			}
+			running++
+			lock.Unlock()
+
+			time.Sleep(50 * time.Millisecond)
+			if script == "check_time_sync.sh" {
+				output = fmt.Sprintf(output, float64(time.Now().UnixNano())/float64(time.Second))
+			}
+			fmt.Fprint(stdout, output)
+
+			lock.Lock()
+			running--
+			if script == "disk_probe.sh" {
+				probing = false
+			}
+			lock.Unlock()
+			return 0
+		}
+		return shell(cmd, stdin, stdout, stderr)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+	shell = sshtest.ShellHandler(server.Root)
+	bundle.RemoteRoot = filepath.Join(server.Root, "scripts")
+
+	act, err := NewNodeCheckAction(&NodeCheckActionConfig{
+		NodeCheckConfig: &pb.NodeCheckConfig{
+			Node:  server.Node("node1"),
+			Roles: []string{string(consts.NodeRoleEtcd)},
+		},
+	})
+	assert.NoError(t, err)
+
+	executor := &nodeCheckExecutor{}
+	assert.NoError(t, executor.Execute(act))
+	assert.NoError(t, executor.Fix(act))
+	assert.Equal(t, ActionDone, act.GetStatus())
+	// the disk probe writes to the etcd data directory, which is a residue if the residue check is running
+	assert.False(t, probeWithOthers)
+}
@@ -0,0 +1,358 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check/clock"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check/containerd"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check/cri"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check/disk"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check/docker"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check/kubelet"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check/residue"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check/system"
+	"github.com/kpaas-io/kpaas/pkg/deploy/profile"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// nodeCheckItemNames are the names of all items which could be checked on a node
+var nodeCheckItemNames = []string{
+	"docker version check",
+	"containerd version check",
+	"cri socket check",
+	"cgroup driver check",
+	"kernel version check",
+	"cpu cores check",
+	"memory capacity check",
+	"root disk volume check",
+	"system distribution check",
+	"system preference check",
+	"clock synchronization check",
+	"residue check",
+	"kubelet version check",
+	"etcd disk performance check",
+}
+
+func init() {
+	profile.RegisterItems(nodeCheckItemNames...)
+}
+
+// nodeCheckItemDefinition defines how to run a check item and how to judge its output
+type nodeCheckItemDefinition struct {
+	name         string
+	description  string
+	newOperation func(m machine.Machine) (operation.Operation, error)
+	// check judges the trimmed stdout and the stderr of the operation
+	check     func(stdout, stderr string) error
+	reason    string
+	fixMethod string
+	severity  profile.Severity
+	// fix is the operation to fix the item if it failed, it's nil if the item can't be fixed automatically
+	fix func(m machine.Machine) (operation.Operation, error)
+	// fixName names the fix, the items with the same fix share the name so the fix is run once for all of them,
+	// it's the name of the item if not set
+	fixName string
+	// measured describes the value measured by a passed item from its stdout, it's appended to the description
+	measured func(stdout string) string
+	// writes is set if the item writes to the node while checking, e.g. the probe files of the disk check,
+	// such items are run after the read-only items, which could see the written files otherwise
+	writes bool
+}
+
+func (d *nodeCheckItemDefinition) getFixName() string {
+	if d.fixName != "" {
+		return d.fixName
+	}
+	return d.name
+}
+
+// nodeCheckItemDefinitions returns the items to be checked on a node with the roles,
+// the requirements, severities and skipped items are decided by the profile.
+func nodeCheckItemDefinitions(checkProfile *profile.Profile, clusterConfig *pb.ClusterConfig, roles []string) []*nodeCheckItemDefinition {
+	requirement := checkProfile.Requirement(roles)
+	kernelVersion := checkProfile.KernelVersion
+	maxClockSkew := time.Duration(checkProfile.MaxClockSkewSeconds * float64(time.Second))
+	// the runtime of the cluster config is validated by the controller
+	runtime, _ := cri.Runtime(clusterConfig)
+	// the clock check needs the time of the controller when the operation runs
+	var clockOperation *clock.Operation
+
+	all := []*nodeCheckItemDefinition{
+		runtimeVersionItem(checkProfile, runtime),
+		{
+			name:        "cri socket check",
+			description: fmt.Sprintf("%v should serve on %v for kubelet", runtime, cri.Socket(runtime)),
+			newOperation: func(m machine.Machine) (operation.Operation, error) {
+				return cri.NewCheckSocketOperation(m, runtime)
+			},
+			check: func(stdout, stderr string) error {
+				return cri.CheckSocket(stdout)
+			},
+			reason:    "container runtime not ready",
+			fixMethod: fmt.Sprintf("please start %v with its cri enabled, or check with the item to be fixed", runtime),
+			fix:       runtimeFix(runtime),
+			fixName:   runtimeFixName,
+		},
+		{
+			name:        "cgroup driver check",
+			description: fmt.Sprintf("cgroup driver of %v should be systemd if systemd is the init system", runtime),
+			newOperation: func(m machine.Machine) (operation.Operation, error) {
+				return cri.NewCheckCgroupDriverOperation(m, runtime)
+			},
+			check: func(stdout, stderr string) error {
+				return cri.CheckCgroupDriver(stdout)
+			},
+			reason:    "cgroup driver not satisfied",
+			fixMethod: fmt.Sprintf("please configure %v with the systemd cgroup driver, or check with the item to be fixed", runtime),
+			fix:       runtimeFix(runtime),
+			fixName:   runtimeFixName,
+		},
+		{
+			name:         "kernel version check",
+			description:  fmt.Sprintf("kernel version should be %v", versionRequirement(kernelVersion)),
+			newOperation: system.NewCheckKernelVersionOperation,
+			check: func(stdout, stderr string) error {
+				return system.CheckKernelVersion(stdout, kernelVersion)
+			},
+			reason:    "kernel version not satisfied",
+			fixMethod: fmt.Sprintf("please upgrade kernel version to %v", versionRequirement(kernelVersion)),
+		},
+		{
+			name:         "cpu cores check",
+			description:  fmt.Sprintf("cpu cores should be at least %v", requirement.CPUCores),
+			newOperation: system.NewCheckCPUNumsOperation,
+			check: func(stdout, stderr string) error {
+				return system.CheckCPUNums(stdout, requirement.CPUCores)
+			},
+			reason:    "cpu cores not enough",
+			fixMethod: fmt.Sprintf("please add cpu cores to %v or more", requirement.CPUCores),
+		},
+		{
+			name:         "memory capacity check",
+			description:  fmt.Sprintf("memory capacity should be at least %vGiB", requirement.MemoryGiB),
+			newOperation: system.NewCheckMemoryCapacityOperation,
+			check: func(stdout, stderr string) error {
+				return system.CheckMemoryCapacity(stdout, requirement.MemoryGiB*operation.GiByteUnits)
+			},
+			reason:    "memory capacity not enough",
+			fixMethod: fmt.Sprintf("please add memory to %vGiB or more", requirement.MemoryGiB),
+		},
+		{
+			name:         "root disk volume check",
+			description:  fmt.Sprintf("root disk volume should be at least %vGiB", requirement.RootDiskGiB),
+			newOperation: system.NewCheckRootDiskVolumeOperation,
+			check: func(stdout, stderr string) error {
+				return system.CheckRootDiskVolume(stdout, requirement.RootDiskGiB*operation.GiByteUnits)
+			},
+			reason:    "root disk volume not enough",
+			fixMethod: fmt.Sprintf("please extend root disk volume to %vGiB or more", requirement.RootDiskGiB),
+		},
+		{
+			name:         "system distribution check",
+			description:  fmt.Sprintf("system distribution should be one of %v, %v and %v", system.DistributionCentos, system.DistributionUbuntu, system.DistributionRHEL),
+			newOperation: system.NewCheckSystemDistributionOperation,
+			check: func(stdout, stderr string) error {
+				return system.CheckSystemDistribution(stdout)
+			},
+			reason:    "system distribution not supported",
+			fixMethod: fmt.Sprintf("please use %v, %v or %v", system.DistributionCentos, system.DistributionUbuntu, system.DistributionRHEL),
+		},
+		{
+			name:         "system preference check",
+			description:  "ip forwarding should be enabled by sysctl",
+			newOperation: system.NewCheckSystemPreferenceOperation,
+			check: func(stdout, stderr string) error {
+				return system.CheckSystemPreference(stderr)
+			},
+			reason:    "system preference not satisfied",
+			fixMethod: "please enable net.ipv4.ip_forward and net.ipv4.conf.all.forwarding by sysctl, or check with the item to be fixed",
+			fix:       system.NewFixSystemPreferenceOperation,
+		},
+		{
+			name:        "clock synchronization check",
+			description: fmt.Sprintf("clock should be synchronized by chronyd, ntpd or systemd-timesyncd, and the skew from the controller should be at most %v", maxClockSkew),
+			newOperation: func(m machine.Machine) (operation.Operation, error) {
+				op, err := clock.NewCheckTimeSyncOperation(m)
+				if err != nil {
+					return nil, err
+				}
+				clockOperation = op
+				return op, nil
+			},
+			check: func(stdout, stderr string) error {
+				return clock.CheckTimeSync(stdout, clockOperation.Sent, clockOperation.Received, maxClockSkew)
+			},
+			reason:    "clock not synchronized",
+			fixMethod: clockFixMethod(clusterConfig.GetNtpServers()),
+			fix:       clockFix(clusterConfig.GetNtpServers()),
+		},
+		{
+			name:         "residue check",
+			description:  "there should be no directories, processes, iptables chains or network interfaces left by a previous kubernetes or etcd installation",
+			newOperation: residue.NewCheckResidueOperation,
+			check: func(stdout, stderr string) error {
+				return residue.CheckResidues(stdout)
+			},
+			reason: "node not clean",
+			// cleaning loses the data of the previous cluster, so it's only run if the item is selected to be fixed
+			fixMethod: "please remove the previous installation, or check with the item to be fixed to clean the node, which deletes all data of the previous cluster on the node",
+			fix:       residue.NewCleanNodeOperation,
+		},
+	}
+
+	if kubernetesVersion := clusterConfig.GetKubernetesVersion(); kubernetesVersion != "" {
+		all = append(all, kubeletVersionItem(kubernetesVersion))
+	}
+
+	if hasRole(roles, consts.NodeRoleEtcd) {
+		all = append(all, etcdDiskItem(checkProfile, disk.EtcdDataDir(clusterConfig)))
+	}
+
+	definitions := make([]*nodeCheckItemDefinition, 0, len(all))
+	for _, definition := range all {
+		if checkProfile.Skipped(definition.name) {
+			continue
+		}
+		definition.severity = checkProfile.Severity(definition.name)
+		definitions = append(definitions, definition)
+	}
+
+	return definitions
+}
+
+// runtimeVersionItem returns the version check item of the container runtime
+func runtimeVersionItem(checkProfile *profile.Profile, runtime consts.ContainerRuntime) *nodeCheckItemDefinition {
+	if runtime == consts.ContainerRuntimeContainerd {
+		containerdVersion := checkProfile.ContainerdVersion
+		return &nodeCheckItemDefinition{
+			name:         "containerd version check",
+			description:  fmt.Sprintf("containerd version should be %v", versionRequirement(containerdVersion)),
+			newOperation: containerd.NewCheckContainerdOperation,
+			check: func(stdout, stderr string) error {
+				return containerd.CheckContainerdVersion(stdout, containerdVersion)
+			},
+			reason:    "containerd version not satisfied",
+			fixMethod: fmt.Sprintf("please install containerd version %v", versionRequirement(containerdVersion)),
+		}
+	}
+
+	dockerVersion := checkProfile.DockerVersion
+	return &nodeCheckItemDefinition{
+		name:         "docker version check",
+		description:  fmt.Sprintf("docker version should be %v", versionRequirement(dockerVersion)),
+		newOperation: docker.NewCheckDockerOperation,
+		check: func(stdout, stderr string) error {
+			return docker.CheckDockerVersion(stdout, dockerVersion)
+		},
+		reason:    "docker version not satisfied",
+		fixMethod: fmt.Sprintf("please install docker version %v", versionRequirement(dockerVersion)),
+	}
+}
+
+// kubeletVersionItem returns the check item of the installed kubelet, which should match the kubernetes version
+func kubeletVersionItem(kubernetesVersion string) *nodeCheckItemDefinition {
+	// the kubernetes version of the cluster config is validated by the controller
+	constraint, _ := kubelet.VersionConstraint(kubernetesVersion)
+	return &nodeCheckItemDefinition{
+		name:         "kubelet version check",
+		description:  fmt.Sprintf("installed kubelet version should be %v for kubernetes %v", constraint, kubernetesVersion),
+		newOperation: kubelet.NewCheckKubeletVersionOperation,
+		check: func(stdout, stderr string) error {
+			return kubelet.CheckKubeletVersion(stdout, kubernetesVersion)
+		},
+		reason:    "kubelet version not satisfied",
+		fixMethod: fmt.Sprintf("please uninstall kubelet or install kubelet version %v", constraint),
+	}
+}
+
+// versionRequirement describes a version constraint, a bare version is the minimum version, e.g. "18.09.0+"
+func versionRequirement(constraint string) string {
+	if _, err := operation.ParseVersion(constraint); err == nil {
+		return constraint + "+"
+	}
+	return constraint
+}
+
+// etcdDiskItem returns the disk performance check item of the etcd data directory
+func etcdDiskItem(checkProfile *profile.Profile, dataDir string) *nodeCheckItemDefinition {
+	maxFsyncP99 := checkProfile.EtcdFsyncP99Milliseconds
+	minWrite := checkProfile.EtcdWriteMiBps
+	return &nodeCheckItemDefinition{
+		name: "etcd disk performance check",
+		description: fmt.Sprintf("fsync p99 of the etcd data directory %v should be at most %vms, and its sequential write throughput should be at least %vMiB/s",
+			dataDir, maxFsyncP99, minWrite),
+		newOperation: func(m machine.Machine) (operation.Operation, error) {
+			return disk.NewProbeOperation(m, dataDir)
+		},
+		check: func(stdout, stderr string) error {
+			return disk.CheckEtcdDisk(stdout, maxFsyncP99, minWrite)
+		},
+		reason:    "disk too slow for etcd",
+		fixMethod: "please put the etcd data directory on a local ssd, or a disk which isn't shared with other busy services",
+		measured: func(stdout string) string {
+			result, err := disk.ParseProbeResult(stdout)
+			if err != nil {
+				return ""
+			}
+			return result.String()
+		},
+		// the probe creates the data directory, which is a residue of etcd
+		writes: true,
+	}
+}
+
+func hasRole(roles []string, role consts.NodeRole) bool {
+	for _, r := range roles {
+		if r == string(role) {
+			return true
+		}
+	}
+	return false
+}
+
+// runtimeFixName is the name of the fix which installs and configures the container runtime
+const runtimeFixName = "init container runtime"
+
+// runtimeFix installs containerd if it's missing and configures the container runtime for kubelet
+func runtimeFix(runtime consts.ContainerRuntime) func(m machine.Machine) (operation.Operation, error) {
+	return func(m machine.Machine) (operation.Operation, error) {
+		return cri.NewInitOperation(m, runtime)
+	}
+}
+
+func clockFixMethod(ntpServers []string) string {
+	if len(ntpServers) == 0 {
+		return "please set the ntp servers in the cluster config, or run chronyd or ntpd with reachable ntp servers"
+	}
+	return fmt.Sprintf("please synchronize the clock with the ntp servers of the cluster: %v, or check with the item to be fixed",
+		strings.Join(ntpServers, ", "))
+}
+
+// clockFix configures the ntp servers of the cluster on the node, the clock can't be fixed without ntp servers
+func clockFix(ntpServers []string) func(m machine.Machine) (operation.Operation, error) {
+	if len(ntpServers) == 0 {
+		return nil
+	}
+	return func(m machine.Machine) (operation.Operation, error) {
+		return clock.NewChangeNTPOperation(m, ntpServers)
+	}
+}
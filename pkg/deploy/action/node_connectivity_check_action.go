@@ -0,0 +1,191 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/profile"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	nodeConnectivityCheckActionName = "connectivity-check"
+	// NodeConnectivityCheckItemName is the check item name of the connectivity, which can be skipped by profiles
+	NodeConnectivityCheckItemName = "network connectivity check"
+)
+
+func init() {
+	profile.RegisterItems(NodeConnectivityCheckItemName)
+}
+
+// ConnectivityCheck is the result of checking a protocol from a node to another node
+type ConnectivityCheck struct {
+	// Protocol could be "tcp", "udp", "icmp" or "mtu"
+	Protocol string
+	Port     uint32
+	Status   NodeCheckItemStatus
+	Detail   string
+}
+
+// ConnectivityResult is the connectivity from a node to another node
+type ConnectivityResult struct {
+	Source      string
+	Destination string
+	Status      NodeCheckItemStatus
+	Checks      []*ConnectivityCheck
+	PathMTU     uint32
+}
+
+// NodeConnectivityCheckActionConfig represents the config for a connectivity check between all nodes
+type NodeConnectivityCheckActionConfig struct {
+	NodeCheckConfigs []*pb.NodeCheckConfig
+	LogFileBasePath  string
+	// Profile decides the severity of the check, the default profile is used if it's nil
+	Profile *profile.Profile
+}
+
+// NodeConnectivityCheckAction checks the connectivity of the CNI ports, icmp and the path mtu
+// between every two nodes, the results form an N×N matrix.
+type NodeConnectivityCheckAction struct {
+	base
+	nodeCheckConfigs []*pb.NodeCheckConfig
+	profile          *profile.Profile
+
+	lock    sync.RWMutex
+	results map[string]map[string]*ConnectivityResult
+}
+
+// NewNodeConnectivityCheckAction returns a node connectivity check action based on the config.
+// User should use this function to create a node connectivity check action.
+func NewNodeConnectivityCheckAction(cfg *NodeConnectivityCheckActionConfig) (Action, error) {
+	var err error
+	if cfg == nil {
+		err = fmt.Errorf("action config is nil")
+	} else if len(cfg.NodeCheckConfigs) == 0 {
+		err = fmt.Errorf("Invalid config: node check configs is empty")
+	} else {
+		for _, nodeCheckConfig := range cfg.NodeCheckConfigs {
+			if nodeCheckConfig.GetNode() == nil {
+				err = fmt.Errorf("Invalid node check config: node is nil")
+				break
+			}
+		}
+	}
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	checkProfile := cfg.Profile
+	if checkProfile == nil {
+		checkProfile = profile.Default()
+	}
+
+	return &NodeConnectivityCheckAction{
+		base: base{
+			name:              nodeConnectivityCheckActionName,
+			actionType:        ActionTypeNodeConnectivityCheck,
+			status:            ActionPending,
+			logFilePath:       GenActionLogFilePath(cfg.LogFileBasePath, nodeConnectivityCheckActionName),
+			creationTimestamp: time.Now(),
+		},
+		nodeCheckConfigs: cfg.NodeCheckConfigs,
+		profile:          checkProfile,
+		results:          make(map[string]map[string]*ConnectivityResult),
+	}, nil
+}
+
+// GetNodes returns the names of the nodes, which are the rows and the columns of the matrix
+func (a *NodeConnectivityCheckAction) GetNodes() []string {
+	nodes := make([]string, 0, len(a.nodeCheckConfigs))
+	for _, config := range a.nodeCheckConfigs {
+		nodes = append(nodes, config.GetNode().GetName())
+	}
+	return nodes
+}
+
+// GetResult returns the connectivity from source to destination, it's nil if it's not checked yet
+func (a *NodeConnectivityCheckAction) GetResult(source, destination string) *ConnectivityResult {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	return a.results[source][destination]
+}
+
+func (a *NodeConnectivityCheckAction) setResult(result *ConnectivityResult) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if a.results[result.Source] == nil {
+		a.results[result.Source] = make(map[string]*ConnectivityResult)
+	}
+	a.results[result.Source][result.Destination] = result
+}
+
+// GetCheckItems returns the connectivity from a node to other nodes as a check item,
+// it's empty until all destinations of the node are checked.
+func (a *NodeConnectivityCheckAction) GetCheckItems(nodeName string) []*NodeCheckItem {
+	item := &NodeCheckItem{
+		Name:        NodeConnectivityCheckItemName,
+		Description: "the CNI ports, icmp and the path mtu to other nodes should be reachable",
+		Status:      NodeCheckItemSuccessful,
+	}
+
+	var failures []string
+	for _, destination := range a.GetNodes() {
+		if destination == nodeName {
+			continue
+		}
+
+		result := a.GetResult(nodeName, destination)
+		if result == nil {
+			return nil
+		}
+		if result.Status == NodeCheckItemSuccessful {
+			continue
+		}
+
+		item.Status = result.Status
+		for _, check := range result.Checks {
+			if check.Status != NodeCheckItemSuccessful {
+				failures = append(failures, fmt.Sprintf("%v -> %v %v: %v", nodeName, destination, check.name(), check.Detail))
+			}
+		}
+	}
+
+	if len(failures) > 0 {
+		item.Err = &pb.Error{
+			Reason:     "network not connected",
+			Detail:     strings.Join(failures, "; "),
+			FixMethods: "please allow the CNI traffic between the nodes and make sure the path mtu is large enough",
+		}
+	}
+
+	return []*NodeCheckItem{item}
+}
+
+func (c *ConnectivityCheck) name() string {
+	if c.Port == 0 {
+		return c.Protocol
+	}
+	return fmt.Sprintf("%v/%d", c.Protocol, c.Port)
+}
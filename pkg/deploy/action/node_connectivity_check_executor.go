@@ -0,0 +1,253 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check/network"
+	"github.com/kpaas-io/kpaas/pkg/deploy/profile"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	// the probe server lives long enough for the probes of all nodes, it's stopped once the probes finished
+	probeServerBaseSeconds    = 60
+	probeServerSecondsPerNode = 30
+)
+
+type nodeConnectivityCheckExecutor struct {
+}
+
+// connectivityCheckNode is a node to be checked along with its machine and probe server
+type connectivityCheckNode struct {
+	node    *pb.Node
+	machine machine.Machine
+	// err is set if the node can't be connected or the probe server can't be started
+	err    error
+	server *network.ProbeServer
+}
+
+func (a *nodeConnectivityCheckExecutor) Execute(act Action) error {
+	connectivityAction, ok := act.(*NodeConnectivityCheckAction)
+	if !ok {
+		return fmt.Errorf("the action type is not match: should be node connectivity check action, but is %T", act)
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldAction: act.GetName(),
+	})
+
+	logger.Debug("Start to execute node connectivity check action")
+
+	nodes := make([]*connectivityCheckNode, 0, len(connectivityAction.nodeCheckConfigs))
+	for _, config := range connectivityAction.nodeCheckConfigs {
+		node := &connectivityCheckNode{node: config.GetNode()}
+		node.machine, node.err = machine.NewMachine(node.node)
+		if node.err == nil {
+			defer node.machine.Close()
+		}
+		nodes = append(nodes, node)
+	}
+
+	// Step 1: start the probe servers on all nodes
+	serverSeconds := probeServerBaseSeconds + probeServerSecondsPerNode*len(nodes)
+	var wg sync.WaitGroup
+	for _, node := range nodes {
+		if node.err != nil {
+			continue
+		}
+		wg.Add(1)
+		go func(node *connectivityCheckNode) {
+			defer wg.Done()
+			node.server, node.err = startProbeServer(node.machine, serverSeconds)
+		}(node)
+	}
+	wg.Wait()
+
+	defer func() {
+		for _, node := range nodes {
+			if node.server == nil {
+				continue
+			}
+			if op, err := network.NewStopProbeServerOperation(node.machine, node.server.Pid); err == nil {
+				if _, _, err := op.Do(); err != nil {
+					logger.Warnf("failed to stop probe server on %v: %v", node.node.GetName(), err)
+				}
+			}
+		}
+	}()
+
+	// Step 2: probe all other nodes from every node, the probes from a node are run one by one
+	severity := connectivityAction.profile.Severity(NodeConnectivityCheckItemName)
+	for _, src := range nodes {
+		wg.Add(1)
+		go func(src *connectivityCheckNode) {
+			defer wg.Done()
+			for _, dst := range nodes {
+				if dst == src {
+					continue
+				}
+				connectivityAction.setResult(probeConnectivity(src, dst, severity))
+			}
+		}(src)
+	}
+	wg.Wait()
+
+	var failed []string
+	for _, src := range nodes {
+		for _, dst := range nodes {
+			if result := connectivityAction.GetResult(src.node.GetName(), dst.node.GetName()); result != nil && result.Status == NodeCheckItemFailed {
+				failed = append(failed, fmt.Sprintf("%v -> %v", src.node.GetName(), dst.node.GetName()))
+			}
+		}
+	}
+
+	if len(failed) > 0 {
+		connectivityAction.status = ActionFailed
+		connectivityAction.err = &pb.Error{
+			Reason:     "network not connected",
+			Detail:     fmt.Sprintf("failed pairs: %v", strings.Join(failed, ", ")),
+			FixMethods: "check the connectivity matrix for the detail",
+		}
+	} else {
+		connectivityAction.status = ActionDone
+	}
+
+	logger.Debugf("Finish to execute node connectivity check action: %d pairs failed", len(failed))
+	return nil
+}
+
+func startProbeServer(m machine.Machine, seconds int) (*network.ProbeServer, error) {
+	op, err := network.NewStartProbeServerOperation(m, seconds, network.TCPPorts, network.UDPPorts)
+	if err != nil {
+		return nil, err
+	}
+
+	stdErr, stdOut, err := op.Do()
+	if err != nil {
+		return nil, err
+	}
+	if errMsg := strings.TrimSpace(string(stdErr)); errMsg != "" {
+		return nil, fmt.Errorf("%v", errMsg)
+	}
+
+	return network.ParseProbeServer(string(stdOut))
+}
+
+// probeConnectivity probes dst from src, the failed checks are taken as warnings if the severity is warning
+func probeConnectivity(src, dst *connectivityCheckNode, severity profile.Severity) *ConnectivityResult {
+	failedStatus := NodeCheckItemFailed
+	if severity == profile.SeverityWarning {
+		failedStatus = NodeCheckItemWarning
+	}
+
+	result := &ConnectivityResult{
+		Source:      src.node.GetName(),
+		Destination: dst.node.GetName(),
+		Status:      NodeCheckItemSuccessful,
+	}
+	fail := func(check *ConnectivityCheck) {
+		check.Status = failedStatus
+		result.Status = failedStatus
+		result.Checks = append(result.Checks, check)
+	}
+
+	if src.err != nil || dst.err != nil {
+		detail := fmt.Sprintf("node %v is not available: %v", src.node.GetName(), src.err)
+		if src.err == nil {
+			detail = fmt.Sprintf("node %v is not available: %v", dst.node.GetName(), dst.err)
+		}
+		fail(&ConnectivityCheck{Protocol: "probe", Detail: detail})
+		return result
+	}
+
+	op, err := network.NewProbeOperation(src.machine, dst.node.GetIp(), network.TCPPorts, network.UDPPorts, network.DesiredPathMTU)
+	var probe *network.ProbeResult
+	if err == nil {
+		var stdOut []byte
+		if _, stdOut, err = op.Do(); err == nil {
+			probe, err = network.ParseProbeResult(string(stdOut))
+		}
+	}
+	if err != nil {
+		fail(&ConnectivityCheck{Protocol: "probe", Detail: err.Error()})
+		return result
+	}
+
+	for _, port := range probe.TCP {
+		check := &ConnectivityCheck{Protocol: "tcp", Port: port.Port, Status: NodeCheckItemSuccessful}
+		if !port.OK {
+			check.Detail = port.Error
+			fail(check)
+			continue
+		}
+		result.Checks = append(result.Checks, check)
+	}
+
+	for _, port := range probe.UDP {
+		check := &ConnectivityCheck{Protocol: "udp", Port: port.Port, Status: NodeCheckItemSuccessful}
+		if !port.OK {
+			if !containsPort(dst.server.UDP, port.Port) {
+				// no echo is expected if the port is used by others on dst
+				check.Status = NodeCheckItemWarning
+				check.Detail = fmt.Sprintf("port is in use on %v, can't be probed", dst.node.GetName())
+				if result.Status == NodeCheckItemSuccessful {
+					result.Status = NodeCheckItemWarning
+				}
+				result.Checks = append(result.Checks, check)
+				continue
+			}
+			check.Detail = port.Error
+			fail(check)
+			continue
+		}
+		result.Checks = append(result.Checks, check)
+	}
+
+	icmp := &ConnectivityCheck{Protocol: "icmp", Status: NodeCheckItemSuccessful}
+	if !probe.ICMP.OK {
+		icmp.Detail = probe.ICMP.Error
+		fail(icmp)
+	} else {
+		result.Checks = append(result.Checks, icmp)
+	}
+
+	result.PathMTU = probe.MTU.PathMTU
+	mtu := &ConnectivityCheck{Protocol: "mtu", Status: NodeCheckItemSuccessful}
+	if !probe.MTU.OK {
+		mtu.Detail = fmt.Sprintf("path mtu %d is less than %d: %v", probe.MTU.PathMTU, network.DesiredPathMTU, probe.MTU.Error)
+		fail(mtu)
+	} else {
+		result.Checks = append(result.Checks, mtu)
+	}
+
+	return result
+}
+
+func containsPort(ports []uint32, port uint32) bool {
+	for _, p := range ports {
+		if p == port {
+			return true
+		}
+	}
+	return false
+}
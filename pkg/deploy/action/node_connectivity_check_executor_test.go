@@ -0,0 +1,134 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/bundle"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine/sshtest"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	probeAllOK = `{"tcp": [{"port": 179, "ok": true, "error": ""}], "udp": [{"port": 4789, "ok": true, "error": ""}], ` +
+		`"icmp": {"ok": true, "error": ""}, "mtu": {"ok": true, "pathMTU": 1450, "error": ""}}`
+	probeNoUDPEcho = `{"tcp": [{"port": 179, "ok": true, "error": ""}], "udp": [{"port": 4789, "ok": false, "error": "no echo received"}], ` +
+		`"icmp": {"ok": true, "error": ""}, "mtu": {"ok": true, "pathMTU": 1450, "error": ""}}`
+	probeSmallMTU = `{"tcp": [{"port": 179, "ok": true, "error": ""}], "udp": [{"port": 4789, "ok": true, "error": ""}], ` +
+		`"icmp": {"ok": true, "error": ""}, "mtu": {"ok": false, "pathMTU": 1400, "error": "message too long, mtu=1400"}}`
+)
+
+// newConnectivityCheckServer returns a ssh server which answers net_probe.sh with the outputs
+func newConnectivityCheckServer(t *testing.T, serveOutput, probeOutput string) *sshtest.Server {
+	var shell sshtest.Handler
+	server, err := sshtest.NewServer(func(cmd string, stdin io.Reader, stdout, stderr io.Writer) int {
+		switch {
+		case strings.Contains(cmd, "bash 'net_probe.sh' 'serve'"):
+			fmt.Fprintln(stdout, serveOutput)
+		case strings.Contains(cmd, "bash 'net_probe.sh' 'stop'"):
+		case strings.Contains(cmd, "bash 'net_probe.sh' 'probe'"):
+			fmt.Fprintln(stdout, probeOutput)
+		default:
+			return shell(cmd, stdin, stdout, stderr)
+		}
+		return 0
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	shell = sshtest.ShellHandler(server.Root)
+	return server
+}
+
+func TestNodeConnectivityCheckExecutorExecute(t *testing.T) {
+	dir, err := ioutil.TempDir("", "connectivity-check")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	bundle.RemoteRoot = filepath.Join(dir, "scripts")
+
+	a := newConnectivityCheckServer(t, `{"tcp": [179], "udp": [4789], "errors": [], "pid": 101}`, probeNoUDPEcho)
+	defer a.Close()
+	// the udp port is in use on b, so no echo is expected from b
+	b := newConnectivityCheckServer(t, `{"tcp": [179], "udp": [], "errors": ["udp/4789: in use"], "pid": 102}`, probeAllOK)
+	defer b.Close()
+	c := newConnectivityCheckServer(t, `{"tcp": [179], "udp": [4789], "errors": [], "pid": 103}`, probeSmallMTU)
+	defer c.Close()
+
+	act, err := NewNodeConnectivityCheckAction(&NodeConnectivityCheckActionConfig{
+		NodeCheckConfigs: []*pb.NodeCheckConfig{
+			{Node: a.Node("a")},
+			{Node: b.Node("b")},
+			{Node: c.Node("c")},
+		},
+	})
+	assert.NoError(t, err)
+
+	executor := &nodeConnectivityCheckExecutor{}
+	assert.NoError(t, executor.Execute(act))
+	assert.Equal(t, ActionFailed, act.GetStatus())
+
+	connectivityAction := act.(*NodeConnectivityCheckAction)
+	assert.Equal(t, []string{"a", "b", "c"}, connectivityAction.GetNodes())
+
+	wantStatus := map[[2]string]NodeCheckItemStatus{
+		{"a", "b"}: NodeCheckItemWarning,
+		{"a", "c"}: NodeCheckItemFailed,
+		{"b", "a"}: NodeCheckItemSuccessful,
+		{"b", "c"}: NodeCheckItemSuccessful,
+		{"c", "a"}: NodeCheckItemFailed,
+		{"c", "b"}: NodeCheckItemFailed,
+	}
+	for pair, status := range wantStatus {
+		result := connectivityAction.GetResult(pair[0], pair[1])
+		if assert.NotNil(t, result, "%v", pair) {
+			assert.Equal(t, status, result.Status, "%v", pair)
+		}
+	}
+	assert.Nil(t, connectivityAction.GetResult("a", "a"))
+	assert.Equal(t, uint32(1400), connectivityAction.GetResult("c", "a").PathMTU)
+
+	aItems := connectivityAction.GetCheckItems("a")
+	if assert.Len(t, aItems, 1) {
+		assert.Equal(t, NodeCheckItemFailed, aItems[0].Status)
+		assert.Contains(t, aItems[0].Err.GetDetail(), "a -> c udp/4789: no echo received")
+	}
+	bItems := connectivityAction.GetCheckItems("b")
+	if assert.Len(t, bItems, 1) {
+		assert.Equal(t, NodeCheckItemSuccessful, bItems[0].Status)
+	}
+
+	// the probe servers are stopped
+	bundleDir := bundle.RemoteRoot
+	for _, server := range []*sshtest.Server{a, b, c} {
+		var stopped bool
+		for _, cmd := range server.Commands() {
+			if strings.HasPrefix(cmd, "cd '"+bundleDir) && strings.Contains(cmd, "bash 'net_probe.sh' 'stop'") {
+				stopped = true
+			}
+		}
+		assert.True(t, stopped)
+	}
+}
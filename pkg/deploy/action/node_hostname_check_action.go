@@ -0,0 +1,108 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/profile"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const nodeHostnameCheckActionName = "hostname-check"
+
+// NodeHostnameCheckActionConfig represents the config for a hostname check across nodes
+type NodeHostnameCheckActionConfig struct {
+	NodeCheckConfigs []*pb.NodeCheckConfig
+	LogFileBasePath  string
+	// Profile decides the severities of check items, the default profile is used if it's nil
+	Profile *profile.Profile
+	// FixItems are the names of the items to be fixed if they failed, the hostnames are set to the node
+	// names and the host entries of all nodes are added to /etc/hosts to fix them.
+	FixItems []string
+}
+
+// NodeHostnameCheckAction checks the hostname of each node is a valid node name which is the same as
+// the name of the node, unique among the nodes, and resolvable from the other nodes.
+type NodeHostnameCheckAction struct {
+	base
+	nodeCheckConfigs []*pb.NodeCheckConfig
+	profile          *profile.Profile
+	fixItems         []string
+
+	lock       sync.RWMutex
+	checkItems map[string][]*NodeCheckItem
+}
+
+// NewNodeHostnameCheckAction returns a node hostname check action based on the config.
+// User should use this function to create a node hostname check action.
+func NewNodeHostnameCheckAction(cfg *NodeHostnameCheckActionConfig) (Action, error) {
+	var err error
+	if cfg == nil {
+		err = fmt.Errorf("action config is nil")
+	} else if len(cfg.NodeCheckConfigs) == 0 {
+		err = fmt.Errorf("Invalid config: node check configs is empty")
+	} else {
+		for _, nodeCheckConfig := range cfg.NodeCheckConfigs {
+			if nodeCheckConfig.GetNode() == nil {
+				err = fmt.Errorf("Invalid node check config: node is nil")
+				break
+			}
+		}
+	}
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	checkProfile := cfg.Profile
+	if checkProfile == nil {
+		checkProfile = profile.Default()
+	}
+
+	return &NodeHostnameCheckAction{
+		base: base{
+			name:              nodeHostnameCheckActionName,
+			actionType:        ActionTypeNodeHostnameCheck,
+			status:            ActionPending,
+			logFilePath:       GenActionLogFilePath(cfg.LogFileBasePath, nodeHostnameCheckActionName),
+			creationTimestamp: time.Now(),
+		},
+		nodeCheckConfigs: cfg.NodeCheckConfigs,
+		profile:          checkProfile,
+		fixItems:         cfg.FixItems,
+		checkItems:       make(map[string][]*NodeCheckItem),
+	}, nil
+}
+
+// GetCheckItems returns the results of the check items of a node which are finished
+func (a *NodeHostnameCheckAction) GetCheckItems(nodeName string) []*NodeCheckItem {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	return append([]*NodeCheckItem(nil), a.checkItems[nodeName]...)
+}
+
+func (a *NodeHostnameCheckAction) setCheckItems(nodeName string, items []*NodeCheckItem) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	a.checkItems[nodeName] = items
+}
@@ -0,0 +1,443 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check/hostname"
+	"github.com/kpaas-io/kpaas/pkg/deploy/profile"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	hostnameItemName           = "hostname check"
+	hostnameUniquenessItemName = "hostname uniqueness check"
+	hostnameResolutionItemName = "hostname resolution check"
+)
+
+// NodeHostnameCheckItemNames are the check items of the hostname check action, which can be skipped by profiles
+var NodeHostnameCheckItemNames = []string{hostnameItemName, hostnameUniquenessItemName, hostnameResolutionItemName}
+
+func init() {
+	profile.RegisterItems(NodeHostnameCheckItemNames...)
+}
+
+type nodeHostnameCheckExecutor struct {
+}
+
+// hostnameCheckNode is a node to be checked along with its machine and hostname information
+type hostnameCheckNode struct {
+	node    *pb.Node
+	machine machine.Machine
+	info    *hostname.HostInfo
+	// err is set if the node can't be connected or the hostname information can't be gathered
+	err error
+	// logs are the outputs of the fixes on the node
+	logs string
+}
+
+func (a *nodeHostnameCheckExecutor) Execute(act Action) error {
+	hostnameAction, ok := act.(*NodeHostnameCheckAction)
+	if !ok {
+		return fmt.Errorf("the action type is not match: should be node hostname check action, but is %T", act)
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldAction: act.GetName(),
+	})
+
+	logger.Debug("Start to execute node hostname check action")
+
+	nodes, names := connectHostnameCheckNodes(hostnameAction)
+	defer closeHostnameCheckNodes(nodes)
+
+	gatherHostInfos(nodes, names)
+	setHostnameCheckResult(hostnameAction, checkHostnames(hostnameAction.profile, nodes))
+
+	logger.Debug("Finish to execute node hostname check action")
+	return nil
+}
+
+// Fix fixes the failed items which are selected to be fixed, and checks all nodes again. It's called after all
+// actions of the task are executed, so the fixes don't change the nodes while the other actions check them.
+func (a *nodeHostnameCheckExecutor) Fix(act Action) error {
+	hostnameAction, ok := act.(*NodeHostnameCheckAction)
+	if !ok {
+		return fmt.Errorf("the action type is not match: should be node hostname check action, but is %T", act)
+	}
+
+	items := make(map[string][]*NodeCheckItem, len(hostnameAction.nodeCheckConfigs))
+	var failed bool
+	for _, config := range hostnameAction.nodeCheckConfigs {
+		nodeName := config.GetNode().GetName()
+		items[nodeName] = hostnameAction.GetCheckItems(nodeName)
+		for _, item := range items[nodeName] {
+			if item.Status != NodeCheckItemSuccessful && shouldFix(hostnameAction.fixItems, item.Name) {
+				failed = true
+			}
+		}
+	}
+	if !failed {
+		return nil
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldAction: act.GetName(),
+	})
+
+	logger.Debug("Start to fix node hostname check items")
+
+	nodes, names := connectHostnameCheckNodes(hostnameAction)
+	defer closeHostnameCheckNodes(nodes)
+
+	// the fixes are decided by the current hostnames of the nodes
+	gatherHostInfos(nodes, names)
+	if !fixHostnames(nodes, items, hostnameAction.fixItems) {
+		logger.Debug("No node hostname check item can be fixed")
+		return nil
+	}
+
+	gatherHostInfos(nodes, names)
+	fixedItems := checkHostnames(hostnameAction.profile, nodes)
+	for _, node := range nodes {
+		nodeName := node.node.GetName()
+		for _, item := range fixedItems[nodeName] {
+			if before := findCheckItem(items[nodeName], item.Name); before != nil && before.Status != NodeCheckItemSuccessful &&
+				shouldFix(hostnameAction.fixItems, item.Name) && fixableHostnameItem(node, item.Name) {
+				item.Fix = &NodeCheckItemFix{Before: before, Logs: node.logs}
+			}
+		}
+	}
+	setHostnameCheckResult(hostnameAction, fixedItems)
+
+	logger.Debug("Finish to fix node hostname check items")
+	return nil
+}
+
+// connectHostnameCheckNodes connects the nodes of the action, and returns them with their names
+func connectHostnameCheckNodes(hostnameAction *NodeHostnameCheckAction) ([]*hostnameCheckNode, []string) {
+	nodes := make([]*hostnameCheckNode, 0, len(hostnameAction.nodeCheckConfigs))
+	names := make([]string, 0, len(hostnameAction.nodeCheckConfigs))
+	for _, config := range hostnameAction.nodeCheckConfigs {
+		node := &hostnameCheckNode{node: config.GetNode()}
+		node.machine, node.err = machine.NewMachine(node.node)
+		nodes = append(nodes, node)
+		names = append(names, node.node.GetName())
+	}
+	return nodes, names
+}
+
+func closeHostnameCheckNodes(nodes []*hostnameCheckNode) {
+	for _, node := range nodes {
+		if node.machine != nil {
+			node.machine.Close()
+		}
+	}
+}
+
+// setHostnameCheckResult sets the items of the nodes to the action, and sets the status of the action by them
+func setHostnameCheckResult(hostnameAction *NodeHostnameCheckAction, items map[string][]*NodeCheckItem) {
+	var failedItems []string
+	for _, config := range hostnameAction.nodeCheckConfigs {
+		nodeName := config.GetNode().GetName()
+		hostnameAction.setCheckItems(nodeName, items[nodeName])
+		for _, item := range items[nodeName] {
+			if item.Status == NodeCheckItemFailed {
+				failedItems = append(failedItems, fmt.Sprintf("%v: %v", nodeName, item.Name))
+			}
+		}
+	}
+
+	if len(failedItems) > 0 {
+		hostnameAction.status = ActionFailed
+		hostnameAction.err = &pb.Error{
+			Reason:     "one or more check items failed",
+			Detail:     fmt.Sprintf("failed items: %v", strings.Join(failedItems, ", ")),
+			FixMethods: "check the fix methods of the failed items",
+		}
+	} else {
+		hostnameAction.status = ActionDone
+		hostnameAction.err = nil
+	}
+}
+
+func newHostnameCheckItem(checkProfile *profile.Profile, name string, err *pb.Error) *NodeCheckItem {
+	item := &NodeCheckItem{
+		Name:   name,
+		Status: NodeCheckItemSuccessful,
+		Err:    err,
+	}
+
+	switch name {
+	case hostnameItemName:
+		item.Description = "the hostname should be a valid node name which is the same as the name of the node"
+	case hostnameUniquenessItemName:
+		item.Description = "the hostname should be unique among the nodes"
+	case hostnameResolutionItemName:
+		item.Description = "the names of the other nodes should be resolved to their ips"
+	}
+
+	if err != nil {
+		item.Status = NodeCheckItemFailed
+		if checkProfile.Severity(name) == profile.SeverityWarning {
+			item.Status = NodeCheckItemWarning
+		}
+	}
+
+	return item
+}
+
+// gatherHostInfos gathers the hostname information of the connected nodes, the names are resolved on every node
+func gatherHostInfos(nodes []*hostnameCheckNode, names []string) {
+	var wg sync.WaitGroup
+	for _, node := range nodes {
+		if node.machine == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(node *hostnameCheckNode) {
+			defer wg.Done()
+			node.info, node.err = gatherHostInfo(node.machine, names)
+		}(node)
+	}
+	wg.Wait()
+}
+
+func gatherHostInfo(m machine.Machine, names []string) (*hostname.HostInfo, error) {
+	op, err := hostname.NewCheckHostnameOperation(m, names)
+	if err != nil {
+		return nil, err
+	}
+
+	_, stdOut, err := op.Do()
+	if err != nil {
+		return nil, err
+	}
+
+	return hostname.ParseHostInfo(string(stdOut))
+}
+
+// checkHostnames returns the check items of the nodes by node name, the items skipped by the profile are excluded
+func checkHostnames(checkProfile *profile.Profile, nodes []*hostnameCheckNode) map[string][]*NodeCheckItem {
+	items := make(map[string][]*NodeCheckItem, len(nodes))
+	for _, node := range nodes {
+		nodeName := node.node.GetName()
+		addItem := func(name string, err *pb.Error) {
+			if !checkProfile.Skipped(name) {
+				items[nodeName] = append(items[nodeName], newHostnameCheckItem(checkProfile, name, err))
+			}
+		}
+
+		if node.err != nil {
+			addItem(hostnameItemName, &pb.Error{
+				Reason:     "failed to gather hostname",
+				Detail:     node.err.Error(),
+				FixMethods: "please check the connection to the node",
+			})
+			continue
+		}
+
+		addItem(hostnameItemName, checkHostname(node))
+		addItem(hostnameUniquenessItemName, checkHostnameUnique(node, nodes))
+		addItem(hostnameResolutionItemName, checkHostnameResolution(node, nodes))
+	}
+	return items
+}
+
+// fixHostnames fixes the failed items of the nodes which are selected to be fixed: sets the hostnames to the node
+// names, and adds the names and ips of all nodes to /etc/hosts. It returns if any node is fixed, the results are
+// checked again later, so the errors are only recorded in the logs of the nodes.
+func fixHostnames(nodes []*hostnameCheckNode, items map[string][]*NodeCheckItem, fixItems []string) bool {
+	aliases := make(map[string]string, len(nodes))
+	for _, node := range nodes {
+		aliases[node.node.GetName()] = node.node.GetIp()
+	}
+
+	var fixed bool
+	var wg sync.WaitGroup
+	for _, node := range nodes {
+		if node.err != nil {
+			continue
+		}
+
+		name := node.node.GetName()
+		failed := func(item string) bool {
+			checkItem := findCheckItem(items[name], item)
+			return checkItem != nil && checkItem.Status != NodeCheckItemSuccessful && shouldFix(fixItems, item) &&
+				fixableHostnameItem(node, item)
+		}
+		// a duplicated hostname is fixed by the node name as well, which is unique
+		fixHostname := (failed(hostnameItemName) || failed(hostnameUniquenessItemName)) && node.info.Hostname != name
+		fixAliases := failed(hostnameResolutionItemName)
+		if !fixHostname && !fixAliases {
+			continue
+		}
+
+		fixed = true
+		wg.Add(1)
+		go func(node *hostnameCheckNode) {
+			defer wg.Done()
+			var logs []string
+			run := func(script string, op operation.Operation, err error) {
+				if err == nil {
+					var stdErr, stdOut []byte
+					stdErr, stdOut, err = op.Do()
+					logs = append(logs, fmt.Sprintf("run %v: %s%s", script, stdOut, stdErr))
+				}
+				if err != nil {
+					logs = append(logs, fmt.Sprintf("failed to run %v: %v", script, err))
+				}
+			}
+
+			if fixHostname {
+				op, err := hostname.NewChangeHostnameOperation(node.machine, name)
+				run(hostname.ChangeHostnameScript, op, err)
+			}
+			if fixAliases {
+				op, err := hostname.NewChangeHostAliasOperation(node.machine, aliases)
+				run(hostname.ChangeHostAliasScript, op, err)
+			}
+
+			node.logs = strings.Join(logs, "\n")
+		}(node)
+	}
+	wg.Wait()
+
+	return fixed
+}
+
+// fixableHostnameItem returns if the item can be fixed on the node: the hostname can't be set to a node name which
+// isn't a valid hostname
+func fixableHostnameItem(node *hostnameCheckNode, item string) bool {
+	if item == hostnameItemName || item == hostnameUniquenessItemName {
+		return hostname.ValidateHostname(node.node.GetName()) == nil
+	}
+	return true
+}
+
+func findCheckItem(items []*NodeCheckItem, name string) *NodeCheckItem {
+	for _, item := range items {
+		if item.Name == name {
+			return item
+		}
+	}
+	return nil
+}
+
+func checkHostname(node *hostnameCheckNode) *pb.Error {
+	name := node.node.GetName()
+	if err := hostname.ValidateHostname(name); err != nil {
+		return &pb.Error{
+			Reason:     "invalid node name",
+			Detail:     err.Error(),
+			FixMethods: "please rename the node with lowercase alphanumeric characters, '-' or '.', which can't be fixed automatically",
+		}
+	}
+
+	if node.info.Hostname != name {
+		return &pb.Error{
+			Reason:     "hostname not matched",
+			Detail:     fmt.Sprintf("hostname %q is different from the node name %q, which kubelet registers the node with", node.info.Hostname, name),
+			FixMethods: fmt.Sprintf("please set the hostname to %q, or check with the item to be fixed", name),
+		}
+	}
+
+	return nil
+}
+
+func checkHostnameUnique(node *hostnameCheckNode, nodes []*hostnameCheckNode) *pb.Error {
+	var duplicates []string
+	for _, other := range nodes {
+		if other == node || other.info == nil {
+			continue
+		}
+		if other.info.Hostname == node.info.Hostname || (node.info.FQDN != "" && other.info.FQDN == node.info.FQDN) {
+			duplicates = append(duplicates, other.node.GetName())
+		}
+	}
+
+	if len(duplicates) == 0 {
+		return nil
+	}
+
+	return &pb.Error{
+		Reason:     "hostname duplicated",
+		Detail:     fmt.Sprintf("hostname %q or fqdn %q is also used by %v", node.info.Hostname, node.info.FQDN, strings.Join(duplicates, ", ")),
+		FixMethods: "please set the hostname to the node name, or check with the item to be fixed",
+	}
+}
+
+func checkHostnameResolution(node *hostnameCheckNode, nodes []*hostnameCheckNode) *pb.Error {
+	var failures []string
+	for _, other := range nodes {
+		if other == node {
+			continue
+		}
+
+		name, ips := other.node.GetName(), nodeIPs(other)
+		if resolvesToAny(node.info, name, ips) {
+			continue
+		}
+
+		// the entries of /etc/hosts take precedence over the dns server
+		source := "the dns server"
+		if hostsIPs := node.info.HostsIPs(name); len(hostsIPs) > 0 {
+			source = fmt.Sprintf("/etc/hosts (%v)", strings.Join(hostsIPs, ", "))
+		}
+		if resolved := node.info.Resolved[name]; len(resolved) > 0 {
+			failures = append(failures, fmt.Sprintf("%v is resolved to %v by %v, expected %v", name, strings.Join(resolved, ", "),
+				source, strings.Join(ips, " or ")))
+		} else {
+			failures = append(failures, fmt.Sprintf("%v can't be resolved", name))
+		}
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+
+	return &pb.Error{
+		Reason:     "hostname not resolvable",
+		Detail:     strings.Join(failures, "; "),
+		FixMethods: "please add the nodes to /etc/hosts or the dns server, or check with the item to be fixed",
+	}
+}
+
+// nodeIPs returns the ips which the name of the node may be resolved to: the ip of the node, and the primary ip
+// gathered from the node if it's different, which is the ip the kubelet registers the node with
+func nodeIPs(node *hostnameCheckNode) []string {
+	ips := []string{node.node.GetIp()}
+	if node.info != nil && node.info.PrimaryIP != "" && node.info.PrimaryIP != node.node.GetIp() {
+		ips = append(ips, node.info.PrimaryIP)
+	}
+	return ips
+}
+
+func resolvesToAny(info *hostname.HostInfo, name string, ips []string) bool {
+	for _, ip := range ips {
+		if info.ResolvesTo(name, ip) {
+			return true
+		}
+	}
+	return false
+}
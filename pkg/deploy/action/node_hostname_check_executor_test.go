@@ -0,0 +1,295 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/bundle"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine/sshtest"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// fakeHost is the hostname and the resolvable names of a test node, which can be changed by the fix scripts
+type fakeHost struct {
+	lock     sync.Mutex
+	hostname string
+	// ip is the primary ip of the node, which is the loopback ip of the ssh test server if it's empty
+	ip       string
+	resolved map[string]string
+	// hosts are the names in /etc/hosts by ip
+	hosts map[string]string
+}
+
+// newHostnameCheckServer returns a ssh server which answers the hostname scripts with the fake host
+func newHostnameCheckServer(t *testing.T, host *fakeHost) *sshtest.Server {
+	var shell sshtest.Handler
+	server, err := sshtest.NewServer(func(cmd string, stdin io.Reader, stdout, stderr io.Writer) int {
+		host.lock.Lock()
+		defer host.lock.Unlock()
+
+		args := strings.Split(cmd, "' '")
+		script, stepArgs, _ := parseInitStep(cmd)
+		switch {
+		case strings.Contains(cmd, "bash 'check_hostname.sh'"):
+			ip := host.ip
+			if ip == "" {
+				ip = "127.0.0.1"
+			}
+			fmt.Fprintf(stdout, "hostname=%v\nfqdn=%v\nip=%v\n", host.hostname, host.hostname, ip)
+			for hostsIP, names := range host.hosts {
+				fmt.Fprintf(stdout, "hosts=%v %v\n", hostsIP, names)
+			}
+			for _, name := range args[1:] {
+				name = strings.Trim(name, "'")
+				fmt.Fprintf(stdout, "resolve=%v %v\n", name, host.resolved[name])
+			}
+		case script == "init_change_hostname.sh":
+			host.hostname = stepArgs[0]
+			fmt.Fprint(stdout, "exit=0\n")
+		case script == "init_change_hostalias.sh":
+			for i := 0; i+1 < len(stepArgs); i += 2 {
+				host.resolved[stepArgs[i+1]] = stepArgs[i]
+			}
+			fmt.Fprint(stdout, "exit=0\n")
+		default:
+			return shell(cmd, stdin, stdout, stderr)
+		}
+		return 0
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	shell = sshtest.ShellHandler(server.Root)
+	return server
+}
+
+func TestNodeHostnameCheckExecutorExecute(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hostname-check")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	bundle.RemoteRoot = filepath.Join(dir, "scripts")
+
+	tests := []struct {
+		fixItems        []string
+		wantStatus      Status
+		wantFailedItems map[string][]string
+		wantFixedItems  map[string][]string
+	}{
+		{
+			wantStatus: ActionFailed,
+			wantFailedItems: map[string][]string{
+				"a": {hostnameUniquenessItemName},
+				"b": {hostnameItemName, hostnameResolutionItemName},
+				"c": {hostnameItemName, hostnameUniquenessItemName},
+			},
+		},
+		{
+			fixItems:   []string{hostnameResolutionItemName},
+			wantStatus: ActionFailed,
+			wantFailedItems: map[string][]string{
+				"a": {hostnameUniquenessItemName},
+				"b": {hostnameItemName},
+				"c": {hostnameItemName, hostnameUniquenessItemName},
+			},
+			wantFixedItems: map[string][]string{
+				"b": {hostnameResolutionItemName},
+			},
+		},
+		{
+			fixItems:        NodeHostnameCheckItemNames,
+			wantStatus:      ActionDone,
+			wantFailedItems: map[string][]string{},
+			wantFixedItems: map[string][]string{
+				// the hostname of a is not changed, but it's unique after fixing c
+				"a": {hostnameUniquenessItemName},
+				"b": {hostnameItemName, hostnameResolutionItemName},
+				"c": {hostnameItemName, hostnameUniquenessItemName},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		// c reports the same hostname as a, b can't resolve any node
+		hosts := map[string]*fakeHost{
+			"a": {hostname: "a", resolved: map[string]string{"b": "127.0.0.1", "c": "127.0.0.1"}},
+			"b": {hostname: "localhost", resolved: map[string]string{}},
+			"c": {hostname: "a", resolved: map[string]string{"a": "127.0.0.1", "b": "127.0.0.1"}},
+		}
+
+		var configs []*pb.NodeCheckConfig
+		servers := make(map[string]*sshtest.Server)
+		for _, name := range []string{"a", "b", "c"} {
+			server := newHostnameCheckServer(t, hosts[name])
+			defer server.Close()
+			servers[name] = server
+			configs = append(configs, &pb.NodeCheckConfig{Node: server.Node(name)})
+		}
+
+		act, err := NewNodeHostnameCheckAction(&NodeHostnameCheckActionConfig{
+			NodeCheckConfigs: configs,
+			FixItems:         test.fixItems,
+		})
+		assert.NoError(t, err)
+
+		executor := &nodeHostnameCheckExecutor{}
+		assert.NoError(t, executor.Execute(act))
+		assert.NoError(t, executor.Fix(act))
+		assert.Equal(t, test.wantStatus, act.GetStatus())
+
+		hostnameAction := act.(*NodeHostnameCheckAction)
+		for _, name := range []string{"a", "b", "c"} {
+			items := hostnameAction.GetCheckItems(name)
+			assert.Len(t, items, 3)
+			var failedItems, fixedItems []string
+			for _, item := range items {
+				if item.Status == NodeCheckItemFailed {
+					failedItems = append(failedItems, item.Name)
+					assert.NotNil(t, item.Err)
+				}
+				if item.Fix != nil {
+					fixedItems = append(fixedItems, item.Name)
+					assert.Equal(t, NodeCheckItemFailed, item.Fix.Before.Status)
+				}
+			}
+			assert.Equal(t, test.wantFailedItems[name], failedItems, name)
+			assert.Equal(t, test.wantFixedItems[name], fixedItems, name)
+		}
+
+		if len(test.fixItems) == len(NodeHostnameCheckItemNames) {
+			assert.Equal(t, "b", hosts["b"].hostname)
+			assert.Equal(t, "c", hosts["c"].hostname)
+			assert.Equal(t, "127.0.0.1", hosts["b"].resolved["c"])
+			for _, cmd := range servers["a"].Commands() {
+				script, _, _ := parseInitStep(cmd)
+				assert.NotEqual(t, "init_change_hostname.sh", script)
+			}
+		}
+	}
+}
+
+func TestNodeHostnameCheckExecutorInvalidName(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hostname-check")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	bundle.RemoteRoot = filepath.Join(dir, "scripts")
+
+	// Node_D isn't a valid hostname, and it can't resolve a
+	hosts := map[string]*fakeHost{
+		"a":      {hostname: "a", resolved: map[string]string{"Node_D": "127.0.0.1"}},
+		"Node_D": {hostname: "d", resolved: map[string]string{}},
+	}
+
+	var configs []*pb.NodeCheckConfig
+	servers := make(map[string]*sshtest.Server)
+	for _, name := range []string{"a", "Node_D"} {
+		server := newHostnameCheckServer(t, hosts[name])
+		defer server.Close()
+		servers[name] = server
+		configs = append(configs, &pb.NodeCheckConfig{Node: server.Node(name)})
+	}
+
+	act, err := NewNodeHostnameCheckAction(&NodeHostnameCheckActionConfig{
+		NodeCheckConfigs: configs,
+		FixItems:         NodeHostnameCheckItemNames,
+	})
+	assert.NoError(t, err)
+
+	executor := &nodeHostnameCheckExecutor{}
+	assert.NoError(t, executor.Execute(act))
+	assert.NoError(t, executor.Fix(act))
+	assert.Equal(t, ActionFailed, act.GetStatus())
+
+	hostnameAction := act.(*NodeHostnameCheckAction)
+	for _, item := range hostnameAction.GetCheckItems("Node_D") {
+		switch item.Name {
+		case hostnameItemName:
+			assert.Equal(t, NodeCheckItemFailed, item.Status)
+			assert.Equal(t, "invalid node name", item.Err.Reason)
+			assert.Nil(t, item.Fix)
+		case hostnameResolutionItemName:
+			assert.Equal(t, NodeCheckItemSuccessful, item.Status)
+			assert.NotNil(t, item.Fix)
+		}
+	}
+
+	assert.Equal(t, "d", hosts["Node_D"].hostname)
+	for _, cmd := range servers["Node_D"].Commands() {
+		script, _, _ := parseInitStep(cmd)
+		assert.NotEqual(t, "init_change_hostname.sh", script)
+	}
+}
+
+func TestNodeHostnameCheckExecutorResolution(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hostname-check")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	bundle.RemoteRoot = filepath.Join(dir, "scripts")
+
+	// a resolves b to its primary ip, b resolves a to a wrong ip by /etc/hosts, and c by the dns server
+	hosts := map[string]*fakeHost{
+		"a": {hostname: "a", resolved: map[string]string{"b": "10.0.0.2", "c": "127.0.0.1"}},
+		"b": {hostname: "b", ip: "10.0.0.2", resolved: map[string]string{"a": "10.0.0.9", "c": "127.0.0.1"},
+			hosts: map[string]string{"10.0.0.9": "a a.example.com"}},
+		"c": {hostname: "c", resolved: map[string]string{"a": "10.0.0.8", "b": "127.0.0.1"}},
+	}
+
+	var configs []*pb.NodeCheckConfig
+	for _, name := range []string{"a", "b", "c"} {
+		server := newHostnameCheckServer(t, hosts[name])
+		defer server.Close()
+		configs = append(configs, &pb.NodeCheckConfig{Node: server.Node(name)})
+	}
+
+	act, err := NewNodeHostnameCheckAction(&NodeHostnameCheckActionConfig{NodeCheckConfigs: configs})
+	assert.NoError(t, err)
+
+	executor := &nodeHostnameCheckExecutor{}
+	assert.NoError(t, executor.Execute(act))
+	assert.Equal(t, ActionFailed, act.GetStatus())
+
+	hostnameAction := act.(*NodeHostnameCheckAction)
+	wantDetails := map[string]string{
+		"b": "a is resolved to 10.0.0.9 by /etc/hosts (10.0.0.9), expected 127.0.0.1",
+		"c": "a is resolved to 10.0.0.8 by the dns server, expected 127.0.0.1",
+	}
+	for _, name := range []string{"a", "b", "c"} {
+		item := findCheckItem(hostnameAction.GetCheckItems(name), hostnameResolutionItemName)
+		if !assert.NotNil(t, item, name) {
+			continue
+		}
+		if wantDetails[name] == "" {
+			assert.Equal(t, NodeCheckItemSuccessful, item.Status, name)
+			continue
+		}
+		assert.Equal(t, NodeCheckItemFailed, item.Status, name)
+		assert.Equal(t, wantDetails[name], item.Err.GetDetail(), name)
+	}
+}
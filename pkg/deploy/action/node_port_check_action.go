@@ -0,0 +1,107 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/profile"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const nodePortCheckActionName = "port-check"
+
+// NodePortCheckActionConfig represents the config for a port check across nodes
+type NodePortCheckActionConfig struct {
+	NodeCheckConfigs []*pb.NodeCheckConfig
+	ClusterConfig    *pb.ClusterConfig
+	LogFileBasePath  string
+	// Profile decides the severities of check items, the default profile is used if it's nil
+	Profile *profile.Profile
+}
+
+// NodePortCheckAction checks if the ports required by the roles are free on each node and
+// reachable from the other nodes which need them. Unlike the node check action, it runs
+// on all nodes, and the check items are recorded by node.
+type NodePortCheckAction struct {
+	base
+	nodeCheckConfigs []*pb.NodeCheckConfig
+	clusterConfig    *pb.ClusterConfig
+	profile          *profile.Profile
+
+	lock       sync.RWMutex
+	checkItems map[string][]*NodeCheckItem
+}
+
+// NewNodePortCheckAction returns a node port check action based on the config.
+// User should use this function to create a node port check action.
+func NewNodePortCheckAction(cfg *NodePortCheckActionConfig) (Action, error) {
+	var err error
+	if cfg == nil {
+		err = fmt.Errorf("action config is nil")
+	} else if len(cfg.NodeCheckConfigs) == 0 {
+		err = fmt.Errorf("Invalid config: node check configs is empty")
+	} else {
+		for _, nodeCheckConfig := range cfg.NodeCheckConfigs {
+			if nodeCheckConfig.GetNode() == nil {
+				err = fmt.Errorf("Invalid node check config: node is nil")
+				break
+			}
+		}
+	}
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	checkProfile := cfg.Profile
+	if checkProfile == nil {
+		checkProfile = profile.Default()
+	}
+
+	return &NodePortCheckAction{
+		base: base{
+			name:              nodePortCheckActionName,
+			actionType:        ActionTypeNodePortCheck,
+			status:            ActionPending,
+			logFilePath:       GenActionLogFilePath(cfg.LogFileBasePath, nodePortCheckActionName),
+			creationTimestamp: time.Now(),
+		},
+		nodeCheckConfigs: cfg.NodeCheckConfigs,
+		clusterConfig:    cfg.ClusterConfig,
+		profile:          checkProfile,
+		checkItems:       make(map[string][]*NodeCheckItem),
+	}, nil
+}
+
+// GetCheckItems returns the results of the check items of a node which are finished
+func (a *NodePortCheckAction) GetCheckItems(nodeName string) []*NodeCheckItem {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	return append([]*NodeCheckItem(nil), a.checkItems[nodeName]...)
+}
+
+func (a *NodePortCheckAction) addCheckItem(nodeName string, item *NodeCheckItem) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	a.checkItems[nodeName] = append(a.checkItems[nodeName], item)
+}
@@ -0,0 +1,338 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check/port"
+	"github.com/kpaas-io/kpaas/pkg/deploy/profile"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	portOccupiedItemName     = "port occupied check"
+	portReachabilityItemName = "port reachability check"
+
+	// the temporary listener exits by itself after the seconds even if it's not stopped
+	portListenerSeconds         = 60
+	portReachableTimeoutSeconds = 3
+)
+
+func init() {
+	profile.RegisterItems(portOccupiedItemName, portReachabilityItemName)
+}
+
+type nodePortCheckExecutor struct {
+}
+
+// portCheckNode is a node to be checked along with its machine
+type portCheckNode struct {
+	config  *pb.NodeCheckConfig
+	machine machine.Machine
+	// occupied are the required ports which are in use on the node
+	occupied map[uint32]bool
+}
+
+func (n *portCheckNode) hasRole(roles ...consts.NodeRole) bool {
+	for _, r := range n.config.GetRoles() {
+		for _, role := range roles {
+			if consts.NodeRole(r) == role {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (a *nodePortCheckExecutor) Execute(act Action) error {
+	portCheckAction, ok := act.(*NodePortCheckAction)
+	if !ok {
+		return fmt.Errorf("the action type is not match: should be node port check action, but is %T", act)
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldAction: act.GetName(),
+	})
+
+	logger.Debug("Start to execute node port check action")
+
+	var nodes []*portCheckNode
+	for _, config := range portCheckAction.nodeCheckConfigs {
+		m, err := machine.NewMachine(config.GetNode())
+		if err != nil {
+			portCheckAction.addCheckItem(config.GetNode().GetName(), newPortCheckItem(portCheckAction.profile, portOccupiedItemName, &pb.Error{
+				Reason:     "failed to connect to node",
+				Detail:     err.Error(),
+				FixMethods: "please check the connection to the node",
+			}))
+			continue
+		}
+		defer m.Close()
+
+		nodes = append(nodes, &portCheckNode{
+			config:   config,
+			machine:  m,
+			occupied: make(map[uint32]bool),
+		})
+	}
+
+	// Step 1: check the required ports are free on every node. It must be finished before starting any
+	// temporary listener, otherwise the listeners may be taken as occupied ports.
+	var wg sync.WaitGroup
+	for _, node := range nodes {
+		wg.Add(1)
+		go func(node *portCheckNode) {
+			defer wg.Done()
+			checkErr := checkPortsOccupied(node, portCheckAction.clusterConfig)
+			if !portCheckAction.profile.Skipped(portOccupiedItemName) {
+				portCheckAction.addCheckItem(node.config.GetNode().GetName(), newPortCheckItem(portCheckAction.profile, portOccupiedItemName, checkErr))
+			}
+		}(node)
+	}
+	wg.Wait()
+
+	// Step 2: check the ports of every node are reachable from the nodes which need them
+	if !portCheckAction.profile.Skipped(portReachabilityItemName) {
+		for _, node := range nodes {
+			wg.Add(1)
+			go func(node *portCheckNode) {
+				defer wg.Done()
+				checkErr := checkPortsReachable(node, nodes)
+				portCheckAction.addCheckItem(node.config.GetNode().GetName(), newPortCheckItem(portCheckAction.profile, portReachabilityItemName, checkErr))
+			}(node)
+		}
+		wg.Wait()
+	}
+
+	var failedItems []string
+	for _, config := range portCheckAction.nodeCheckConfigs {
+		nodeName := config.GetNode().GetName()
+		for _, item := range portCheckAction.GetCheckItems(nodeName) {
+			if item.Status == NodeCheckItemFailed {
+				failedItems = append(failedItems, fmt.Sprintf("%v: %v", nodeName, item.Name))
+			}
+		}
+	}
+
+	if len(failedItems) > 0 {
+		portCheckAction.status = ActionFailed
+		portCheckAction.err = &pb.Error{
+			Reason:     "one or more check items failed",
+			Detail:     fmt.Sprintf("failed items: %v", strings.Join(failedItems, ", ")),
+			FixMethods: "check the fix methods of the failed items",
+		}
+	} else {
+		portCheckAction.status = ActionDone
+	}
+
+	logger.Debugf("Finish to execute node port check action: %d items failed", len(failedItems))
+	return nil
+}
+
+// newPortCheckItem returns a successful item if err is nil, otherwise a failed or warning item by the severity
+func newPortCheckItem(checkProfile *profile.Profile, name string, err *pb.Error) *NodeCheckItem {
+	item := &NodeCheckItem{
+		Name:   name,
+		Status: NodeCheckItemSuccessful,
+		Err:    err,
+	}
+
+	switch name {
+	case portOccupiedItemName:
+		item.Description = "the ports required by the roles of the node should not be in use"
+	case portReachabilityItemName:
+		item.Description = "the ports of the node should be reachable from the nodes which need them"
+	}
+
+	if err != nil {
+		item.Status = NodeCheckItemFailed
+		if checkProfile.Severity(name) == profile.SeverityWarning {
+			item.Status = NodeCheckItemWarning
+		}
+	}
+
+	return item
+}
+
+func checkPortsOccupied(node *portCheckNode, clusterConfig *pb.ClusterConfig) *pb.Error {
+	ranges := port.RequiredPorts(node.config.GetRoles(), clusterConfig)
+	if len(ranges) == 0 {
+		return nil
+	}
+
+	op, err := port.NewCheckPortOccupiedOperation(node.machine, ranges)
+	if err != nil {
+		return &pb.Error{
+			Reason:     "failed to create check operation",
+			Detail:     err.Error(),
+			FixMethods: "please check the connection to the node",
+		}
+	}
+
+	_, stdOut, err := op.Do()
+	if err != nil {
+		return &pb.Error{
+			Reason:     "run command failed",
+			Detail:     err.Error(),
+			FixMethods: "please check your scripts",
+		}
+	}
+
+	occupied, err := port.ParseOccupiedPorts(string(stdOut))
+	if err == nil {
+		for _, p := range occupied {
+			node.occupied[p] = true
+		}
+		err = port.CheckPortsOccupied(string(stdOut))
+	}
+	if err != nil {
+		return &pb.Error{
+			Reason:     "port occupied",
+			Detail:     err.Error(),
+			FixMethods: "please stop the processes using the ports or clean the node",
+		}
+	}
+
+	return nil
+}
+
+// checkPortsReachable checks the ports of dst from all nodes which need them
+func checkPortsReachable(dst *portCheckNode, nodes []*portCheckNode) *pb.Error {
+	// collect the source nodes by port, a port may be required by more than one rule
+	sourcesByPort := make(map[uint32][]*portCheckNode)
+	for _, rule := range port.ReachabilityRules {
+		if !dst.hasRole(rule.Destination) {
+			continue
+		}
+		for _, src := range nodes {
+			if src == dst || !src.hasRole(rule.Sources...) || containsPortCheckNode(sourcesByPort[rule.Port], src) {
+				continue
+			}
+			sourcesByPort[rule.Port] = append(sourcesByPort[rule.Port], src)
+		}
+	}
+
+	ports := make([]int, 0, len(sourcesByPort))
+	for p := range sourcesByPort {
+		ports = append(ports, int(p))
+	}
+	sort.Ints(ports)
+
+	var failures []string
+	for _, p := range ports {
+		failures = append(failures, checkPortReachable(dst, uint32(p), sourcesByPort[uint32(p)])...)
+	}
+
+	if len(failures) > 0 {
+		return &pb.Error{
+			Reason:     "port not reachable",
+			Detail:     strings.Join(failures, "; "),
+			FixMethods: "please allow the traffic between the nodes in the firewall or the security group",
+		}
+	}
+
+	return nil
+}
+
+// checkPortReachable checks a port of dst from the sources, a temporary listener is started if
+// the port is free. It returns the failures in the form of "<source> -> <destination>:<port>: <reason>".
+func checkPortReachable(dst *portCheckNode, p uint32, sources []*portCheckNode) []string {
+	dstNode := dst.config.GetNode()
+	logger := logrus.WithFields(logrus.Fields{
+		"node": dstNode.GetName(),
+		"port": p,
+	})
+
+	if !dst.occupied[p] {
+		pid, err := startPortListener(dst.machine, p)
+		if err != nil {
+			return []string{fmt.Sprintf("failed to listen on %v:%d: %v", dstNode.GetName(), p, err)}
+		}
+		defer func() {
+			if op, err := port.NewStopPortListenerOperation(dst.machine, pid); err == nil {
+				if _, _, err := op.Do(); err != nil {
+					logger.Warnf("failed to stop port listener: %v", err)
+				}
+			}
+		}()
+	}
+
+	var lock sync.Mutex
+	var failures []string
+	var wg sync.WaitGroup
+	for _, src := range sources {
+		wg.Add(1)
+		go func(src *portCheckNode) {
+			defer wg.Done()
+			if err := checkPortReachableFrom(src.machine, dstNode.GetIp(), p); err != nil {
+				lock.Lock()
+				defer lock.Unlock()
+				failures = append(failures, fmt.Sprintf("%v -> %v:%d: %v", src.config.GetNode().GetName(), dstNode.GetName(), p, err))
+			}
+		}(src)
+	}
+	wg.Wait()
+
+	sort.Strings(failures)
+	return failures
+}
+
+func startPortListener(m machine.Machine, p uint32) (int, error) {
+	op, err := port.NewStartPortListenerOperation(m, p, portListenerSeconds)
+	if err != nil {
+		return 0, err
+	}
+
+	stdErr, stdOut, err := op.Do()
+	if err != nil {
+		return 0, err
+	}
+	if errMsg := strings.TrimSpace(string(stdErr)); errMsg != "" {
+		return 0, fmt.Errorf("%v", errMsg)
+	}
+
+	return port.ParsePortListener(string(stdOut))
+}
+
+func checkPortReachableFrom(m machine.Machine, ip string, p uint32) error {
+	op, err := port.NewCheckPortReachableOperation(m, ip, p, portReachableTimeoutSeconds)
+	if err != nil {
+		return err
+	}
+
+	_, stdOut, err := op.Do()
+	if err != nil {
+		return err
+	}
+
+	return port.CheckPortReachable(string(stdOut))
+}
+
+func containsPortCheckNode(nodes []*portCheckNode, node *portCheckNode) bool {
+	for _, n := range nodes {
+		if n == node {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,127 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/bundle"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine/sshtest"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// newPortCheckServer returns a ssh server which answers the port check scripts with the outputs,
+// the keys of unreachable are the ports not reachable from the node.
+func newPortCheckServer(t *testing.T, occupied string, unreachable map[string]string) *sshtest.Server {
+	var shell sshtest.Handler
+	server, err := sshtest.NewServer(func(cmd string, stdin io.Reader, stdout, stderr io.Writer) int {
+		switch {
+		case strings.Contains(cmd, "bash 'check_port_occupied.sh'"):
+			fmt.Fprint(stdout, occupied)
+		case strings.Contains(cmd, "bash 'port_listener.sh' 'start'"):
+			fmt.Fprintln(stdout, "listening 4242")
+		case strings.Contains(cmd, "bash 'port_listener.sh' 'stop'"):
+		case strings.Contains(cmd, "bash 'check_port_reachable.sh'"):
+			for port, reason := range unreachable {
+				if strings.Contains(cmd, fmt.Sprintf("'%v'", port)) {
+					fmt.Fprintf(stdout, "unreachable: %v\n", reason)
+					return 0
+				}
+			}
+			fmt.Fprintln(stdout, "reachable")
+		default:
+			return shell(cmd, stdin, stdout, stderr)
+		}
+		return 0
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	shell = sshtest.ShellHandler(server.Root)
+	return server
+}
+
+func TestNodePortCheckExecutorExecute(t *testing.T) {
+	dir, err := ioutil.TempDir("", "port-check")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	bundle.RemoteRoot = filepath.Join(dir, "scripts")
+
+	master := newPortCheckServer(t, "", nil)
+	defer master.Close()
+	etcd := newPortCheckServer(t, "", map[string]string{"2380": "connection timed out"})
+	defer etcd.Close()
+	worker := newPortCheckServer(t, "30080\n", nil)
+	defer worker.Close()
+
+	act, err := NewNodePortCheckAction(&NodePortCheckActionConfig{
+		NodeCheckConfigs: []*pb.NodeCheckConfig{
+			{Node: master.Node("master"), Roles: []string{"master", "etcd"}},
+			{Node: etcd.Node("etcd"), Roles: []string{"etcd"}},
+			{Node: worker.Node("worker"), Roles: []string{"worker"}},
+		},
+	})
+	assert.NoError(t, err)
+
+	executor := &nodePortCheckExecutor{}
+	assert.NoError(t, executor.Execute(act))
+	assert.Equal(t, ActionFailed, act.GetStatus())
+
+	portCheckAction := act.(*NodePortCheckAction)
+	itemStatus := func(node string) map[string]NodeCheckItemStatus {
+		statuses := make(map[string]NodeCheckItemStatus)
+		for _, item := range portCheckAction.GetCheckItems(node) {
+			statuses[item.Name] = item.Status
+		}
+		return statuses
+	}
+
+	assert.Equal(t, map[string]NodeCheckItemStatus{
+		portOccupiedItemName:     NodeCheckItemSuccessful,
+		portReachabilityItemName: NodeCheckItemFailed,
+	}, itemStatus("master"))
+	assert.Equal(t, map[string]NodeCheckItemStatus{
+		portOccupiedItemName:     NodeCheckItemSuccessful,
+		portReachabilityItemName: NodeCheckItemSuccessful,
+	}, itemStatus("etcd"))
+	assert.Equal(t, map[string]NodeCheckItemStatus{
+		portOccupiedItemName:     NodeCheckItemFailed,
+		portReachabilityItemName: NodeCheckItemSuccessful,
+	}, itemStatus("worker"))
+
+	// the failure reports the source and the destination
+	masterItems := portCheckAction.GetCheckItems("master")
+	assert.Equal(t, "etcd -> master:2380: unreachable: connection timed out", masterItems[1].Err.GetDetail())
+	workerItems := portCheckAction.GetCheckItems("worker")
+	assert.Contains(t, workerItems[0].Err.GetDetail(), "30080")
+
+	// the ports are checked by the roles
+	b, err := bundle.Get()
+	assert.NoError(t, err)
+	assert.Contains(t, worker.Commands(), fmt.Sprintf("cd '%v' && bash 'check_port_occupied.sh' '10250' '30000-32767'", b.RemoteDir()))
+	assert.Contains(t, worker.Commands(), fmt.Sprintf("cd '%v' && bash 'check_port_reachable.sh' '127.0.0.1' '6443' '3'", b.RemoteDir()))
+	// the listeners are stopped after checking
+	assert.Contains(t, master.Commands(), fmt.Sprintf("cd '%v' && bash 'port_listener.sh' 'stop' '4242'", b.RemoteDir()))
+}
@@ -35,8 +35,10 @@ const (
 var scripts http.FileSystem = filter.Keep(
 	http.Dir(relativeScriptsPath),
 	func(path string, fi os.FileInfo) bool {
-		return path == "/" ||
-			strings.HasSuffix(path, ".sh")
+		// directories are kept so that scripts can source their sibling libraries
+		return fi.IsDir() ||
+			strings.HasSuffix(path, ".sh") ||
+			strings.HasSuffix(path, ".py")
 	},
 )
 
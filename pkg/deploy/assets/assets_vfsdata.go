@@ -25,19 +25,420 @@ var Assets = func() http.FileSystem {
 		},
 		"/scripts": &vfsgen۰DirInfo{
 			name:    "scripts",
-			modTime: time.Date(2019, 11, 27, 9, 33, 22, 574604984, time.UTC),
+			modTime: time.Date(2026, 10, 19, 19, 51, 31, 23552077, time.UTC),
 		},
-		"/scripts/test.sh": &vfsgen۰FileInfo{
-			name:    "test.sh",
-			modTime: time.Date(2019, 11, 27, 9, 33, 22, 575003714, time.UTC),
-			content: []byte("\x23\x21\x2f\x62\x69\x6e\x2f\x62\x61\x73\x68\x0a\x0a\x65\x63\x68\x6f\x20\x22\x54\x68\x69\x73\x20\x69\x73\x20\x61\x20\x62\x61\x73\x68\x20\x73\x63\x72\x69\x70\x74\x20\x74\x65\x73\x74\x20\x66\x69\x6c\x65\x2e\x22"),
+		"/scripts/check_cgroup_driver.sh": &vfsgen۰CompressedFileInfo{
+			name:             "check_cgroup_driver.sh",
+			modTime:          time.Date(2026, 10, 19, 18, 33, 51, 315776981, time.UTC),
+			uncompressedSize: 1755,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x54\x5d\x6f\xe2\x46\x14\x7d\xf7\xaf\x38\x35\x68\xf3\x21\x3e\x02\x6f\x0d\x4b\x24\x9a\xa4\x2a\x6d\x44\xa4\xc0\x76\xb5\x6a\xda\x68\x18\x5f\xdb\x57\x31\x33\xde\x99\x31\x04\x25\xfc\xf7\x6a\x6c\x83\x43\xb6\xe5\x69\xf0\x3d\x73\xce\xb9\xf7\x1e\xbb\xf5\x53\x7f\xc9\xaa\xbf\x14\x36\x0d\x5a\x2d\x5c\xeb\x7c\x6b\x38\x49\x1d\x86\x17\x83\x9f\x31\x4f\x85\x4a\x52\xc1\xf8\x9d\x55\x72\x53\x68\x4c\x55\xac\xcd\x4a\x38\xd6\x0a\x0b\x92\xa9\xd2\x99\x4e\xb6\x90\xba\xd7\xc1\x9d\x8b\x7a\x41\xab\xe5\x69\xee\x58\x92\xb2\x14\xa1\x50\x11\x19\xb8\x94\x30\xc9\x85\x4c\x69\x5f\xe9\xe0\x4f\x32\xd6\xb3\x0c\x7b\x17\x38\xf5\x80\xb0\x2e\x85\x67\x23\x4f\xb1\xd5\x05\x56\x62\x0b\xa5\x1d\x0a\x4b\x70\x29\x5b\xc4\x9c\x11\xe8\x45\x52\xee\xc0\x0a\x52\xaf\xf2\x8c\x85\x92\x84\x0d\xbb\x14\xae\x11\xf0\x4e\xf0\xad\xe6\xd0\x4b\x27\x58\x41\x40\xea\x7c\x0b\x1d\xbf\x07\x42\xb8\xda\x74\xf9\x4b\x9d\xcb\x2f\xfb\xfd\xcd\x66\xd3\x13\xa5\xe3\x9e\x36\x49\x3f\xab\xb0\xb6\x7f\x37\xbd\xbe\x9d\xcd\x6f\xbb\xc3\xde\x45\x7d\xeb\x8b\xca\xc8\x5a\x18\xfa\x5e\xb0\xa1\x08\xcb\x2d\x44\x9e\x67\x2c\xc5\x32\x23\x64\x62\x03\x6d\x20\x12\x43\x14\xc1\x69\xef\x7a\x63\xd8\xb1\x4a\x3a\xb0\x3a\x76\x1b\x61\xc8\x5b\x8d\xd8\x3a\xc3\xcb\xc2\x1d\x0d\x6d\xef\x91\xed\x11\x40\x2b\x08\x85\x70\x32\xc7\x74\x1e\xe2\x97\xc9\x7c\x3a\xef\x78\x92\xaf\xd3\xc5\x6f\xf7\x5f\x16\xf8\x3a\x79\x78\x98\xcc\x16\xd3\xdb\x39\xee\x1f\x70\x7d\x3f\xbb\x99\x2e\xa6\xf7\xb3\x39\xee\x7f\xc5\x64\xf6\x0d\x7f\x4c\x67\x37\x1d\x10\xbb\x94\x0c\xe8\x25\x37\xbe\x03\x6d\xc0\x7e\x9c\x54\x6e\x11\x73\xa2\x23\x0b\xb1\xae\xf6\x68\x73\x92\x1c\xb3\x44\x26\x54\x52\x88\x84\x90\xe8\x35\x19\xc5\x2a\x41\x4e\x66\xc5\xd6\xaf\xd5\x42\xa8\xc8\xd3\x64\xbc\x62\x57\xe6\xc5\xfe\xd8\x57\x2f\x08\x5a\x58\xf8\xc5\x5a\x69\x38\x77\xc8\x0d\x2b\x67\x4b\x88\x4c\x8c\x2e\x72\x44\x86\xd7\x64\xf6\x3b\x93\x5a\xf9\x4d\x92\x81\x29\x94\xe3\x15\x79\x99\x12\xce\x8a\x1d\xec\xd6\x3a\x5a\xed\xc1\x4a\x47\xd4\x09\x5a\x78\x2e\x96\x94\x51\x99\xa2\x8a\xda\x8a\xd5\x47\x7e\x51\x55\x6a\xd6\x0e\x36\x29\xcb\x14\x36\xd5\x45\x16\x61\x49\x35\x73\x04\x8e\x9b\xa3\xfd\x28\xdc\x0b\x5a\x28\xac\x48\xe8\x12\x32\x25\xf9\xfc\x54\x69\x3c\x55\x3d\xf4\x6c\x8a\xcf\x91\x96\xcf\x64\xde\x0e\x7d\x44\x57\x41\x0b\xba\x70\x79\xe1\x2e\x83\x16\x50\xfb\x19\x7f\xae\x55\xde\x2a\x8a\xd8\xbe\x15\xea\x59\xe9\x8d\xf2\x78\x94\xa2\x0d\x46\xfb\x3d\x5e\x05\x41\xed\x7e\xdc\x1e\x04\x8d\xc0\x93\xd4\x2a\xe6\x64\xdc\x27\x27\xfb\xcd\xe3\x7e\xf5\xb8\xe7\xf4\x2a\x0b\x82\x5a\x35\x90\xc2\x12\xc2\xf6\x6b\xcd\xb4\x0b\xc1\x2a\xf0\xaf\x45\xe5\xfb\xac\x3c\xa3\xb1\xd9\x3e\xad\x0a\x60\x15\x6b\x74\xbb\xd5\xc7\x01\x27\xaf\xaf\xbd\xeb\xd2\xf8\x4d\x49\xbc\xdb\x9d\x60\x78\x85\x7e\x44\xeb\xbe\x2a\xb2\xac\xe1\x19\x8d\xca\x63\xe3\xab\x29\xb5\x30\xaf\x66\x50\x31\xed\xe7\xad\x73\x1f\xa6\xfd\x8e\x4d\xa1\x24\xd6\x43\xd8\x94\x57\x9d\xfd\x6a\x9e\xe4\xf1\x0d\x45\x7b\xf8\x7a\x50\x22\x0f\x1a\x1c\x23\x31\x94\xa3\x7b\xfb\x1d\x27\xff\x3c\xda\xf3\xd3\x23\xcd\xb7\x63\xc2\xb3\x47\x7b\x3e\x7e\xb4\xe7\xce\x14\x74\xe2\xc7\xf4\xc3\x94\x77\xe1\x51\x9f\x23\xaf\xaf\x0e\x6a\xef\x06\x57\x13\x1f\x4a\x94\x71\xec\x3f\x67\x2b\x9f\xe7\xee\xba\x09\x7a\x84\x77\x7c\x18\x5e\x7d\x1a\xfc\x3f\xe9\x3e\x2b\x87\x5a\xcc\x1f\x07\x7d\xde\xcc\x97\x64\xaa\x11\xd6\xb1\xfa\x8f\x37\xeb\x7d\x0a\xae\x3e\x0d\x9b\x7b\x2f\xec\x30\x38\xfc\x1d\x8d\x02\xb2\x42\x06\x41\x99\xc9\x32\x8a\x01\xc7\xf8\x0b\x61\xfb\x34\xb7\xe8\xe6\x18\xa0\xab\xcb\xde\xc6\xc7\x21\x08\x31\x46\x58\x0f\x22\xc4\xdf\xef\xfa\x2a\xa9\xf6\x23\x8a\x39\x08\x2a\xaf\x75\x9b\xed\xd7\xea\x70\xd9\xad\xcd\xef\xc2\x1a\x50\xde\x6b\xbf\xb2\x62\xb7\x0b\x83\x7f\x07\x00\x58\x3e\x45\xe3\xdb\x06\x00\x00"),
+		},
+		"/scripts/check_containerd_version.sh": &vfsgen۰CompressedFileInfo{
+			name:             "check_containerd_version.sh",
+			modTime:          time.Date(2026, 10, 19, 18, 33, 51, 310670086, time.UTC),
+			uncompressedSize: 795,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x51\x6f\xd3\x3e\x14\xc5\xdf\xf3\x29\xce\x3f\xfd\x4b\x05\xa9\x4b\xba\xf2\x04\x48\x48\x65\x1b\x22\x30\xb5\xd2\xd2\x31\xed\x05\xc9\x75\x6e\x9c\x2b\x5c\xdb\xd8\x4e\xb3\x8a\xf1\xdd\x91\xb3\x4e\xac\xc2\xaf\x3e\xf7\xdc\xdf\xbd\xe7\x4e\xfe\x2b\xb7\x6c\xca\xad\x08\x5d\x36\x99\xe0\xc2\xba\x83\x67\xd5\x45\x2c\xe6\xe7\x6f\x51\x77\xc2\xa8\x4e\x30\xbe\xb0\x51\x97\xbd\x45\x65\x5a\xeb\x77\x22\xb2\x35\xd8\x90\xec\x8c\xd5\x56\x1d\x20\x6d\x31\xc3\x75\x6c\x8a\x6c\x32\x49\x36\xd7\x2c\xc9\x04\x6a\xd0\x9b\x86\x3c\x62\x47\x58\x3a\x21\x3b\x7a\xfe\x99\xe1\x1b\xf9\x90\x5c\x16\xc5\x1c\xaf\x92\x20\x3f\x7e\xe5\xaf\xdf\x27\x8b\x83\xed\xb1\x13\x07\x18\x1b\xd1\x07\x42\xec\x38\xa0\x65\x4d\xa0\x07\x49\x2e\x82\x0d\xa4\xdd\x39\xcd\xc2\x48\xc2\xc0\xb1\x43\xfc\xdb\x20\x91\xe0\xfe\xe8\x61\xb7\x51\xb0\x81\x80\xb4\xee\x00\xdb\xbe\x14\x42\xc4\x23\xf4\xf8\xba\x18\xdd\xbb\xb2\x1c\x86\xa1\x10\x23\x71\x61\xbd\x2a\xf5\x93\x36\x94\xd7\xd5\xc5\xd5\xaa\xbe\x3a\x5b\x14\xf3\x63\xd5\xad\xd1\x14\x02\x3c\xfd\xec\xd9\x53\x83\xed\x01\xc2\x39\xcd\x52\x6c\x35\x41\x8b\x01\xd6\x43\x28\x4f\xd4\x20\xda\x44\x3d\x78\x8e\x6c\xd4\x0c\xc1\xb6\x71\x10\x9e\x12\x6a\xc3\x21\x7a\xde\xf6\xf1\x64\x69\xcf\x8c\x1c\x4e\x04\xd6\x40\x18\xe4\xcb\x1a\x55\x9d\xe3\xe3\xb2\xae\xea\x59\x32\xb9\xab\x36\x9f\xd7\xb7\x1b\xdc\x2d\x6f\x6e\x96\xab\x4d\x75\x55\x63\x7d\x83\x8b\xf5\xea\xb2\xda\x54\xeb\x55\x8d\xf5\x27\x2c\x57\xf7\xf8\x5a\xad\x2e\x67\x20\x8e\x1d\x79\xd0\x83\xf3\x69\x02\xeb\xc1\x69\x9d\x34\xa6\x88\x9a\xe8\x04\xa1\xb5\x4f\x39\x06\x47\x92\x5b\x96\xd0\xc2\xa8\x5e\x28\x82\xb2\x7b\xf2\x86\x8d\x82\x23\xbf\xe3\x90\x62\x0d\x10\xa6\x49\x36\x9a\x77\x1c\xc7\x7b\x09\xff\xce\x55\x64\xd9\x04\x9b\x14\x6c\x90\x9e\x5d\x84\xf3\x6c\x62\x18\x25\xfb\xe3\x7d\xd8\x16\xd2\x9a\x94\x1f\xf9\x66\x8c\xd9\xf6\x71\x54\x68\x12\x4d\xea\x9a\xef\xf3\x19\xa8\x50\x05\xf2\xf3\x62\x51\x9c\xcf\xf3\x2c\x7b\x51\x72\x76\xf6\x6c\xb5\xf8\x80\xb2\xa1\x7d\x69\x7a\xad\xf1\x08\x31\xfc\xc0\xf4\xd7\xd8\x12\xff\xbf\xf9\x3d\xc5\x23\xd2\xc9\x4e\x43\xf9\x7d\x5f\x96\xd3\xec\xcf\x00\x0a\xbe\x14\xf7\x1b\x03\x00\x00"),
+		},
+		"/scripts/check_cpu_num.sh": &vfsgen۰CompressedFileInfo{
+			name:             "check_cpu_num.sh",
+			modTime:          time.Date(2019, 12, 9, 5, 38, 12, 0, time.UTC),
+			uncompressedSize: 708,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x4d\x6f\xd4\x30\x10\x86\xef\xfb\x2b\x5e\x76\x91\x0a\xd2\x36\x29\xbd\x01\xa7\xd0\x0f\x11\xa8\xb2\x52\xb3\xa5\xea\xd1\x71\x26\xce\x88\xac\xc7\xd8\x0e\x69\x44\xf9\xef\xc8\xdb\xad\x60\x45\x8e\x99\xd7\x8f\x9f\x99\xf1\xea\x55\xde\xb0\xcd\x1b\x15\xfa\xc5\x6a\x85\x0b\x71\xb3\x67\xd3\x47\x9c\x9f\xbd\x7b\x8f\xba\x57\xd6\xf4\x8a\xf1\x85\xad\xb9\x1c\x05\xa5\xed\xc4\xef\x54\x64\xb1\xd8\x92\xee\xad\x0c\x62\x66\x68\xc9\xd6\xb8\x89\x6d\xb6\x58\xad\x12\xe6\x86\x35\xd9\x40\x2d\x46\xdb\x92\x47\xec\x09\x85\x53\xba\xa7\x97\xca\x1a\xdf\xc8\x87\x44\x39\xcf\xce\xf0\x26\x05\x96\x87\xd2\xf2\xed\xc7\x84\x98\x65\xc4\x4e\xcd\xb0\x12\x31\x06\x42\xec\x39\xa0\xe3\x81\x40\x8f\x9a\x5c\x04\x5b\x68\xd9\xb9\x81\x95\xd5\x84\x89\x63\x8f\xf8\xf7\x82\x64\x82\x87\x03\x43\x9a\xa8\xd8\x42\x41\x8b\x9b\x21\xdd\xbf\x41\xa8\x78\x90\xde\x7f\x7d\x8c\xee\x43\x9e\x4f\xd3\x94\xa9\xbd\x71\x26\xde\xe4\xc3\x73\x36\xe4\x37\xe5\xc5\x55\x55\x5f\x9d\x9e\x67\x67\x87\x53\x77\x76\xa0\x10\xe0\xe9\xc7\xc8\x9e\x5a\x34\x33\x94\x73\x03\x6b\xd5\x0c\x84\x41\x4d\x10\x0f\x65\x3c\x51\x8b\x28\xc9\x7a\xf2\x1c\xd9\x9a\x35\x82\x74\x71\x52\x9e\x92\x6a\xcb\x21\x7a\x6e\xc6\x78\x34\xb4\x17\x47\x0e\x47\x01\xb1\x50\x16\xcb\xa2\x46\x59\x2f\xf1\xa9\xa8\xcb\x7a\x9d\x20\xf7\xe5\xf6\xf3\xe6\x6e\x8b\xfb\xe2\xf6\xb6\xa8\xb6\xe5\x55\x8d\xcd\x2d\x2e\x36\xd5\x65\xb9\x2d\x37\x55\x8d\xcd\x35\x8a\xea\x01\x5f\xcb\xea\x72\x0d\xe2\xd8\x93\x07\x3d\x3a\x9f\x3a\x10\x0f\x4e\xe3\xa4\xfd\x16\x51\x13\x1d\x29\x74\xf2\xbc\xc7\xe0\x48\x73\xc7\x1a\x83\xb2\x66\x54\x86\x60\xe4\x27\x79\xcb\xd6\xc0\x91\xdf\x71\x48\x6b\x0d\x50\xb6\x4d\x98\x81\x77\x1c\xf7\xef\x25\xfc\xdf\x57\xb6\x58\x68\x15\x91\x3b\x2f\x3a\xd7\x6e\x64\xdb\x09\x9e\x60\x3c\x39\x9c\x4e\x58\xa6\xff\x14\x82\xf8\x25\x9e\xa0\xa6\xef\x38\xf9\xe5\x3c\xdb\x88\xd7\xd5\xf5\xef\x13\x3c\x61\xd2\x38\x1d\x16\x7f\x06\x00\x16\x60\x25\xa5\xc4\x02\x00\x00"),
+		},
+		"/scripts/check_cri_socket.sh": &vfsgen۰CompressedFileInfo{
+			name:             "check_cri_socket.sh",
+			modTime:          time.Date(2026, 10, 19, 18, 33, 51, 313293272, time.UTC),
+			uncompressedSize: 2022,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x54\x4d\x6f\xe3\x36\x10\xbd\xeb\x57\xbc\xc8\xc6\x7e\x14\x5e\x3b\xf1\xad\xeb\x38\x85\x9b\x64\x51\xb7\x81\x03\xc4\xde\x2e\x16\x8b\x62\x41\x53\x23\x69\x60\x99\x54\x49\x2a\x8a\x9a\xe4\xbf\x17\x94\x64\xcb\xc9\xe6\x90\x1e\x2a\x5d\xa8\xe1\xcc\xe3\x9b\xf7\x46\xec\x1d\x8d\xd6\xac\x46\x6b\x61\xd3\xa0\xd7\xc3\xb9\xce\x2b\xc3\x49\xea\x30\x3e\x3e\xf9\x19\xcb\x54\xa8\x24\x15\x8c\xdf\x59\x25\x17\x85\xc6\x5c\xc5\xda\x6c\x85\x63\xad\xb0\x22\x99\x2a\x9d\xe9\xa4\x82\xd4\xc3\x01\xae\x5c\x34\x0c\x7a\x3d\x0f\x73\xc5\x92\x94\xa5\x08\x85\x8a\xc8\xc0\xa5\x84\x59\x2e\x64\x4a\xbb\x9d\x01\xfe\x24\x63\x3d\xca\x78\x78\x8c\x77\x3e\x21\x6c\xb7\xc2\xf7\x13\x0f\x51\xe9\x02\x5b\x51\x41\x69\x87\xc2\x12\x5c\xca\x16\x31\x67\x04\xba\x93\x94\x3b\xb0\x82\xd4\xdb\x3c\x63\xa1\x24\xa1\x64\x97\xc2\x75\x07\x78\x26\xf8\xda\x62\xe8\xb5\x13\xac\x20\x20\x75\x5e\x41\xc7\x87\x89\x10\xae\x25\x5d\x3f\xa9\x73\xf9\xc7\xd1\xa8\x2c\xcb\xa1\xa8\x19\x0f\xb5\x49\x46\x59\x93\x6b\x47\x57\xf3\xf3\xcb\xc5\xf2\xf2\xc3\x78\x78\xdc\x56\x7d\x56\x19\x59\x0b\x43\x7f\x17\x6c\x28\xc2\xba\x82\xc8\xf3\x8c\xa5\x58\x67\x84\x4c\x94\xd0\x06\x22\x31\x44\x11\x9c\xf6\xac\x4b\xc3\x8e\x55\x32\x80\xd5\xb1\x2b\x85\x21\x4f\x35\x62\xeb\x0c\xaf\x0b\xf7\x44\xb4\x1d\x47\xb6\x4f\x12\xb4\x82\x50\x08\x67\x4b\xcc\x97\x21\x7e\x9d\x2d\xe7\xcb\x81\x07\xf9\x32\x5f\xfd\x76\xfd\x79\x85\x2f\xb3\x9b\x9b\xd9\x62\x35\xbf\x5c\xe2\xfa\x06\xe7\xd7\x8b\x8b\xf9\x6a\x7e\xbd\x58\xe2\xfa\x13\x66\x8b\xaf\xf8\x63\xbe\xb8\x18\x80\xd8\xa5\x64\x40\x77\xb9\xf1\x1d\x68\x03\xf6\x72\x52\xed\x22\x96\x44\x4f\x28\xc4\xba\xf1\xd1\xe6\x24\x39\x66\x89\x4c\xa8\xa4\x10\x09\x21\xd1\xb7\x64\x14\xab\x04\x39\x99\x2d\x5b\x6f\xab\x85\x50\x91\x87\xc9\x78\xcb\xae\x9e\x17\xfb\x63\x5f\xc3\x20\xe8\x61\xe5\x8d\xb5\xd2\x70\xee\x20\x53\x92\x1b\x0b\x6e\x1c\x92\x5a\x79\xdf\xc8\xc0\x14\xca\xf1\x96\x60\xc9\xdc\x92\x85\x56\x60\x67\x61\xb5\xdc\x90\x1b\xa0\x4c\x59\xa6\xd8\x14\x6b\xca\xc8\xc1\x89\x6c\x63\xe1\xf4\x30\xe8\xa1\xb0\x22\xa1\x8f\x0d\xec\x77\x69\xf8\x7b\x53\x32\xb4\x29\x4e\x23\xbf\x34\x0f\xfb\x43\xa2\xb3\xa0\x07\x5d\xb8\xbc\x70\x1f\x83\x1e\xd0\xc2\x4f\x4f\x73\xe1\x52\xbf\x07\x18\x12\x51\x35\x3d\xad\xc8\x3e\x28\xdd\x84\xc8\x18\x6d\xa6\xa7\x65\x5a\x81\xdd\x5b\x5b\x8f\x6b\x9d\x76\x16\x04\x2d\xeb\x69\xff\x24\x90\xc2\x12\xc2\xfe\x7d\x1b\x7a\x0c\xc1\x2a\xf0\xf3\xd6\xb0\x78\x5f\xaf\xd1\x1d\x3a\xba\x15\x66\x64\x0a\x35\x6a\xf6\x87\x3e\xbc\xcf\x99\x4c\xea\x65\xc7\xfc\xc7\x72\x5f\xda\xed\x1f\x2c\x5f\x44\xfa\xa9\x03\x20\x99\x6a\x84\x85\xda\x28\x5d\xaa\x17\x1c\x38\x6c\xe1\xec\xcd\xb8\xab\xbb\x63\x87\x93\xfd\xe7\x64\x12\x90\x15\x32\x08\x1a\xbc\x96\x56\xff\xbe\x59\x3c\x86\x41\xc0\x31\xbe\xe1\x08\x1f\x96\x08\xbb\x30\xfe\x9a\x78\xe7\x1b\x69\x9a\xd2\x5a\xcc\xa9\xd2\xe1\x41\xac\x11\x7d\x5f\x06\x6e\x74\x17\x6d\xfb\x03\x1f\xe8\x88\xfa\xe9\xf1\xe3\xf9\x4b\x0b\xe1\x99\x1e\x07\x31\x07\xaf\x77\x85\x63\x1c\xb5\x41\xb0\x8a\x35\xce\x30\x8a\xe8\x76\xa4\x8a\x2c\xc3\xf8\xec\xcd\xc9\x01\xed\xdd\xfb\x12\xfd\xdd\x73\xd8\x46\x0b\x1b\x69\x6a\xbb\x50\xb6\x24\xe3\x67\xbc\xd3\xe5\x69\x71\xd3\xc0\xee\x33\xe6\xe7\x76\x76\x6e\x77\x1d\xf4\x3c\x41\x48\xc3\xc8\xb3\x22\x61\xd5\x5e\x29\xfe\x92\xaa\xef\xac\xf6\x7f\x8b\x39\x81\x4d\x39\xcf\x29\xea\xae\xd4\x0e\x6f\xc8\x1a\xb9\x90\x1b\x91\xd0\x1e\x59\x1a\x9e\xf6\xdf\x49\x67\x5a\x64\x8b\xcc\x62\x7c\x28\xd0\x03\x44\xb9\xc1\xdb\xfe\x18\xd3\x29\x42\x69\x38\xc4\x7d\x6e\x58\x39\xf4\x17\x9f\x1e\xdf\x3e\x91\xf9\x1b\x3e\xfc\xe3\x27\x42\x1a\x7e\x36\x0e\xff\x55\xd7\x67\xfd\xea\xf8\xa0\x8f\xdd\xc4\x64\x5a\x44\x14\xbd\x56\xde\x9a\xde\x9e\xdb\xd1\x14\xa1\xde\xfc\xbf\x1c\xdb\xb3\x5e\xc9\xef\xd9\x4f\x67\x48\x44\xd5\xb4\x22\x1b\x06\xff\x0e\x00\x18\x58\x2d\xa2\xe6\x07\x00\x00"),
+		},
+		"/scripts/check_docker_version.sh": &vfsgen۰CompressedFileInfo{
+			name:             "check_docker_version.sh",
+			modTime:          time.Date(2019, 12, 9, 5, 38, 12, 0, time.UTC),
+			uncompressedSize: 715,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xc1\x6e\xdb\x3c\x10\x84\xef\x7e\x8a\xf9\xe5\x1f\x48\x0b\x38\x52\xe2\x5b\xd3\x93\xea\xa4\xa8\xda\x40\x06\x2c\xa7\x41\x8e\x14\xb5\xa2\x16\x91\x49\x96\xa4\xa2\x08\x6d\xdf\xbd\xa0\xa3\xb4\x35\xaa\xe3\xee\x68\xf8\xed\xee\x2c\xff\xcb\x6a\xd6\x59\x2d\x7c\xb7\x58\x2e\xb1\x31\x76\x72\xac\xba\x80\xf5\xc5\xe5\x3b\x54\x9d\xd0\xaa\x13\x8c\xcf\xac\xd5\xf5\x60\x50\xe8\xd6\xb8\x83\x08\x6c\x34\xf6\x24\x3b\x6d\x7a\xa3\x26\x48\x93\xae\x70\x1b\x9a\x74\xb1\x5c\x46\x9b\x5b\x96\xa4\x3d\x35\x18\x74\x43\x0e\xa1\x23\xe4\x56\xc8\x8e\x5e\x3b\x2b\x7c\x25\xe7\xa3\xcb\x3a\xbd\xc0\x9b\x28\x48\xe6\x56\xf2\xf6\x7d\xb4\x98\xcc\x80\x83\x98\xa0\x4d\xc0\xe0\x09\xa1\x63\x8f\x96\x7b\x02\x3d\x4b\xb2\x01\xac\x21\xcd\xc1\xf6\x2c\xb4\x24\x8c\x1c\x3a\x84\x3f\x0f\x44\x12\x3c\xcc\x1e\xa6\x0e\x82\x35\x04\xa4\xb1\x13\x4c\xfb\xb7\x10\x22\xcc\xd0\xc7\xaf\x0b\xc1\x5e\x65\xd9\x38\x8e\xa9\x38\x12\xa7\xc6\xa9\xac\x7f\xd1\xfa\xec\xb6\xd8\xdc\x94\xd5\xcd\xf9\x3a\xbd\x98\xff\xba\xd3\x3d\x79\x0f\x47\xdf\x06\x76\xd4\xa0\x9e\x20\xac\xed\x59\x8a\xba\x27\xf4\x62\x84\x71\x10\xca\x11\x35\x08\x26\x52\x8f\x8e\x03\x6b\xb5\x82\x37\x6d\x18\x85\xa3\x88\xda\xb0\x0f\x8e\xeb\x21\x9c\x2c\xed\x95\x91\xfd\x89\xc0\x68\x08\x8d\x24\xaf\x50\x54\x09\x3e\xe4\x55\x51\xad\xa2\xc9\x7d\xb1\xff\xb4\xbd\xdb\xe3\x3e\xdf\xed\xf2\x72\x5f\xdc\x54\xd8\xee\xb0\xd9\x96\xd7\xc5\xbe\xd8\x96\x15\xb6\x1f\x91\x97\x0f\xf8\x52\x94\xd7\x2b\x10\x87\x8e\x1c\xe8\xd9\xba\x38\x81\x71\xe0\xb8\x4e\x3a\x5e\x11\x15\xd1\x09\x42\x6b\x5e\xee\xe8\x2d\x49\x6e\x59\xa2\x17\x5a\x0d\x42\x11\x94\x79\x22\xa7\x59\x2b\x58\x72\x07\xf6\xf1\xac\x1e\x42\x37\xd1\xa6\xe7\x03\x87\x63\x5e\xfc\xbf\x73\xa5\x8b\x45\x63\xe4\x23\x39\x3c\xcd\x69\xf8\x01\xe5\xc8\xe2\x7c\x73\x89\x64\xd3\x33\xe9\x90\xfc\xae\x8d\x48\xe6\xd0\x5c\xc5\xa2\x18\x1f\x71\xf6\xdd\x3a\xd6\x01\xff\xaf\x7f\x9e\x2d\x7e\x0d\x00\x8c\xa7\x07\xd3\xcb\x02\x00\x00"),
+		},
+		"/scripts/check_etcd_health.sh": &vfsgen۰CompressedFileInfo{
+			name:             "check_etcd_health.sh",
+			modTime:          time.Date(2026, 10, 19, 18, 56, 30, 620673323, time.UTC),
+			uncompressedSize: 1679,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x54\x61\x6f\xdb\x36\x14\xfc\xce\x5f\x71\x95\x8d\x39\x01\x2c\xc9\xc9\xb0\x0f\x4b\x6d\x77\x5e\x92\x61\xde\x02\x67\xa8\xdd\x15\x45\xd1\x05\x34\xf5\x2c\x11\xa1\x48\x8d\xa4\x66\x1b\x49\xfe\xfb\x40\x49\x8e\x93\x0e\xdb\xfc\xc5\x14\x79\xbc\x77\xef\xee\x49\xbd\x37\xe9\x5a\xea\x74\xcd\x5d\xc1\x7a\x3d\x5c\x9a\x6a\x6f\x65\x5e\x78\x9c\x8f\xce\xbe\xc7\xb2\xe0\x3a\x2f\xb8\xc4\x2f\x52\xe7\x57\xb5\xc1\x5c\x6f\x8c\x2d\xb9\x97\x46\x63\x45\xa2\xd0\x46\x99\x7c\x0f\x61\x92\x21\x6e\x7c\x96\xb0\x5e\x2f\xd0\xdc\x48\x41\xda\x51\x86\x5a\x67\x64\xe1\x0b\xc2\xac\xe2\xa2\xa0\xc3\xc9\x10\xbf\x93\x75\x81\xe5\x3c\x19\xe1\x24\x00\xa2\xee\x28\x3a\x7d\x1b\x28\xf6\xa6\x46\xc9\xf7\xd0\xc6\xa3\x76\x04\x5f\x48\x87\x8d\x54\x04\xda\x09\xaa\x3c\xa4\x86\x30\x65\xa5\x24\xd7\x82\xb0\x95\xbe\x80\x3f\x16\x08\x4a\xf0\xa9\xe3\x30\x6b\xcf\xa5\x06\x87\x30\xd5\x1e\x66\xf3\x12\x08\xee\x3b\xd1\xcd\xaf\xf0\xbe\xba\x48\xd3\xed\x76\x9b\xf0\x46\x71\x62\x6c\x9e\xaa\x16\xeb\xd2\x9b\xf9\xe5\xf5\x62\x79\x1d\x9f\x27\xa3\xee\xd6\x07\xad\xc8\x39\x58\xfa\xb3\x96\x96\x32\xac\xf7\xe0\x55\xa5\xa4\xe0\x6b\x45\x50\x7c\x0b\x63\xc1\x73\x4b\x94\xc1\x9b\xa0\x7a\x6b\xa5\x97\x3a\x1f\xc2\x99\x8d\xdf\x72\x4b\x41\x6a\x26\x9d\xb7\x72\x5d\xfb\x57\xa6\x1d\x34\x4a\xf7\x0a\x60\x34\xb8\x46\x34\x5b\x62\xbe\x8c\xf0\xe3\x6c\x39\x5f\x0e\x03\xc9\xc7\xf9\xea\xe7\xdb\x0f\x2b\x7c\x9c\xbd\x7f\x3f\x5b\xac\xe6\xd7\x4b\xdc\xbe\xc7\xe5\xed\xe2\x6a\xbe\x9a\xdf\x2e\x96\xb8\xfd\x09\xb3\xc5\x27\xfc\x3a\x5f\x5c\x0d\x41\xd2\x17\x64\x41\xbb\xca\x86\x0e\x8c\x85\x0c\x76\x52\x93\x22\x96\x44\xaf\x24\x6c\x4c\x9b\xa3\xab\x48\xc8\x8d\x14\x50\x5c\xe7\x35\xcf\x09\xb9\xf9\x8b\xac\x96\x3a\x47\x45\xb6\x94\x2e\xc4\xea\xc0\x75\x16\x68\x94\x2c\xa5\x6f\xe6\xc5\xfd\xb3\xaf\x84\xb1\x1e\x56\x21\x58\x27\xac\xac\x3c\x94\x74\xde\x35\x88\x92\xca\x35\x59\x77\x08\x8b\xbc\xc8\x20\x54\xed\x3c\xd9\x40\x0d\x51\x90\xb8\x6f\xa1\x05\x71\xe5\x8b\x67\xa4\xce\x2a\x23\xb5\x77\xcf\x23\xc1\x7a\x1d\xa4\xb9\x03\xa1\x24\x69\x0f\x41\xd6\x87\x3e\xb8\xa7\x10\x4a\xb8\x5a\xdd\x4b\x64\xd2\x92\xf0\xc6\xee\x13\xd6\x43\xed\x78\x4e\x17\x6d\xad\xbb\x20\xe1\xae\x25\x4a\x5c\x81\x71\x87\x9e\x62\x7c\x28\x39\xfd\x3c\x3c\xae\xbf\x24\x49\xa0\x30\xb5\xaf\x6a\x7f\xc1\x7a\x40\xd7\xd3\x64\x2c\xb3\xe9\x10\x63\xe7\xb9\xaf\x5d\x58\x69\x5e\x52\xf8\xaf\x88\x2c\x6a\xab\x9a\xcd\x4e\xe6\xe1\x51\x3a\x28\xe2\x56\x93\x9d\x36\x5c\xad\x90\xc9\xb1\x1e\xa4\xeb\xda\xdc\x3f\xd6\xba\x5b\x5d\x60\x9c\x91\xe7\x52\xb5\x97\xc8\x5a\x63\x27\xe3\x92\x5c\x68\x6c\x0a\xb9\x79\xe5\xb5\xe0\x7a\xe0\xb1\xa6\x26\x06\xca\x18\xab\xee\xe5\x5d\x26\xed\xa4\x7f\xc6\x0e\x75\xdc\xa4\x7f\xce\x82\x15\xc2\xab\x49\x5a\x3b\x9b\x2a\x23\xb8\x6a\x3e\x22\xdd\x36\x63\x72\x83\xcf\x78\x83\x78\x87\xa8\xff\xd0\xed\x3e\x45\xf8\xf2\x36\x94\xd3\x2c\xbc\x67\x24\x0a\x83\xa8\x15\x74\xc4\x84\x26\xc2\x0b\x2f\xb5\xf3\x5c\x29\xca\xa2\x16\xbc\x93\x1e\x23\xb6\x91\x8c\xd1\xae\x32\xd6\xe3\x7a\x75\x79\x75\xb9\xba\xb9\x9b\xfd\x36\x9f\x7c\xcb\x36\x8a\xe7\x6e\x72\x12\xc7\x47\x95\xa1\xf0\xe1\xe1\x29\x42\x1c\x0b\x1e\x12\x9f\x44\xfd\x87\xae\xab\xa7\x54\xf0\x44\x58\xdf\x1c\x7e\x7d\xf4\x62\x60\xe2\x36\x89\x06\xda\xa8\x89\xe3\x7b\xda\xff\x1f\xfa\x9e\xf6\x81\x38\x93\x5c\xc5\x5e\x96\x64\x6a\x3f\xf9\xce\x85\x52\xa6\x2c\xb9\xce\x9e\x37\xcf\x46\xee\x94\xb1\x2e\x82\x49\xff\xe4\x95\x63\x51\xff\xa1\xe9\xed\xf3\x0f\x5f\x9e\xa2\x2e\xa7\x26\x1d\x9c\x4f\xbf\x39\x3b\x6d\x8d\xee\xbf\x43\xac\x09\xa3\x7f\xf5\x37\xea\x3f\x74\xfc\x4f\x5f\xd9\xd9\xa6\x70\x3c\x8e\xf0\x88\xf0\xd9\x1e\xb8\xf4\x8f\xb4\x1b\xd7\x74\xc0\xd8\x7f\x88\x3a\xb8\xdc\x0d\x5f\x23\x0c\x8f\xc8\x2d\x55\x88\xaf\x31\x08\x91\x9e\xd4\xfa\xf4\x5d\x37\x92\x83\x97\x25\xba\x29\x4e\x07\x8c\x76\xd2\x63\xc4\xfe\x1e\x00\x3e\x3d\x4b\xa6\x8f\x06\x00\x00"),
+		},
+		"/scripts/check_ha.sh": &vfsgen۰CompressedFileInfo{
+			name:             "check_ha.sh",
+			modTime:          time.Date(2026, 10, 19, 19, 13, 7, 193630866, time.UTC),
+			uncompressedSize: 1669,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x54\x61\x6f\xdb\x36\x10\xfd\xae\x5f\xf1\x26\x7b\x68\x0b\xd8\x96\x9b\x61\x1f\x96\x36\x05\xbc\xb6\xc3\xbc\x15\x0e\x10\xbb\x2b\x8a\x61\x08\x68\xe9\x2c\x1e\x22\xf3\x58\x92\xb2\xa3\x25\xf9\xef\x03\x69\x39\x71\xb6\x19\xfe\x22\xf2\xde\xbb\xc7\x77\x8f\x1c\x7c\x57\xac\xd9\x14\x6b\xe5\x75\x36\x18\xe0\xbd\xd8\xce\x71\xad\x03\xce\xa6\xaf\x7f\xc2\x52\x2b\x53\x6b\xc5\xf8\x8d\x4d\xfd\xa1\x15\xcc\xcd\x46\xdc\x56\x05\x16\x83\x15\x95\xda\x48\x23\x75\x87\x52\x26\x23\x7c\x0a\xd5\x24\x1b\x0c\x22\xcd\x27\x2e\xc9\x78\xaa\xd0\x9a\x8a\x1c\x82\x26\xcc\xac\x2a\x35\x1d\x77\x46\xf8\x83\x9c\x8f\x2c\x67\x93\x29\x5e\xc6\x82\xbc\xdf\xca\x5f\xbd\x89\x14\x9d\xb4\xd8\xaa\x0e\x46\x02\x5a\x4f\x08\x9a\x3d\x36\xdc\x10\xe8\xb6\x24\x1b\xc0\x06\xa5\x6c\x6d\xc3\xca\x94\x84\x3d\x07\x8d\xf0\xd4\x20\x2a\xc1\xd7\x9e\x43\xd6\x41\xb1\x81\x42\x29\xb6\x83\x6c\x4e\x0b\xa1\x42\x2f\x3a\xfd\x74\x08\xf6\xbc\x28\xf6\xfb\xfd\x44\x25\xc5\x13\x71\x75\xd1\x1c\x6a\x7d\xf1\x69\xfe\xfe\xe3\x62\xf9\x71\x7c\x36\x99\xf6\xa8\xcf\xa6\x21\xef\xe1\xe8\x5b\xcb\x8e\x2a\xac\x3b\x28\x6b\x1b\x2e\xd5\xba\x21\x34\x6a\x0f\x71\x50\xb5\x23\xaa\x10\x24\xaa\xde\x3b\x0e\x6c\xea\x11\xbc\x6c\xc2\x5e\x39\x8a\x52\x2b\xf6\xc1\xf1\xba\x0d\xcf\x4c\x3b\x6a\x64\xff\xac\x40\x0c\x94\x41\x3e\x5b\x62\xbe\xcc\xf1\xf3\x6c\x39\x5f\x8e\x22\xc9\x97\xf9\xea\xd7\xcb\xcf\x2b\x7c\x99\x5d\x5d\xcd\x16\xab\xf9\xc7\x25\x2e\xaf\xf0\xfe\x72\xf1\x61\xbe\x9a\x5f\x2e\x96\xb8\xfc\x05\xb3\xc5\x57\xfc\x3e\x5f\x7c\x18\x81\x38\x68\x72\xa0\x5b\xeb\xe2\x09\xc4\x81\xa3\x9d\x94\xa6\x88\x25\xd1\x33\x09\x1b\x39\xcc\xd1\x5b\x2a\x79\xc3\x25\x1a\x65\xea\x56\xd5\x84\x5a\x76\xe4\x0c\x9b\x1a\x96\xdc\x96\x7d\x1c\xab\x87\x32\x55\xa4\x69\x78\xcb\x21\xe5\xc5\xff\xf7\x5c\x93\x2c\x1b\x60\x15\x07\xeb\x4b\xc7\x36\xa0\xd4\x54\xde\xf8\x54\xa2\xb9\xd6\x4d\x07\xb5\x53\xdc\x24\x2b\xc9\x54\x56\xd8\x84\xe3\xfc\x94\x65\x4f\x6e\x47\xce\x43\x6d\x42\x4f\xbc\x55\x3e\xa4\x15\x47\xa8\xc8\x36\xd2\x51\x75\x0e\xad\xac\x93\xdb\xee\xa0\x09\x37\x44\x56\x35\xbc\xa3\x0a\xca\x11\x5c\x6b\x92\x78\x31\x87\x8c\x1d\x28\xe0\x05\x1c\x5e\x78\xa4\xde\x41\x10\xd4\x0d\x21\x9e\x34\xf5\xd9\xb1\x1d\x45\xba\xe3\x07\x94\xf1\xfb\xd8\x58\x0c\xd6\x72\x88\x62\x36\x78\xd2\x08\x2b\x2e\x3c\x02\x8e\x7a\xe2\xe2\x24\x1b\xa0\xf5\xaa\xa6\xf3\xc3\xe9\xaf\xb5\x9a\x78\x8d\xb7\x3b\xb6\xef\xf0\xf6\x39\xc1\x3b\xbc\x3d\x85\xbe\xcb\xb2\x09\x1a\x5e\x4f\xbc\xce\xb2\x1d\xdb\x8b\xe1\xeb\xec\x11\x70\x1d\x2b\x2e\x86\x67\x59\x8f\xe8\xbf\x7f\xc8\xb2\x38\xc9\xa8\x8a\x4b\x8a\x71\x3c\x32\x3e\xd9\xf2\x06\x95\x64\xf1\x32\xf8\xce\x07\xda\x96\xa1\x01\xfb\xb1\x2a\x03\xef\x08\xe3\x6f\xc8\x87\x77\x3d\xfe\x21\xc7\xfd\x3d\xc8\x39\x71\xd7\x74\xcb\xe1\x74\x0b\xec\xd3\xe5\xed\xfd\xcd\xb3\x4a\x0c\x65\x19\x6f\xc0\x16\x63\x81\xaa\x2a\x07\xaf\x65\x8f\x7b\xd4\x8e\x6c\x62\x66\x43\x01\xc3\xbb\x1d\xdb\x87\x22\x7f\x13\xcd\x32\x49\x09\x95\x5a\x90\xef\xd8\xf6\x9b\x91\x5c\x53\x93\xae\x5b\x9a\x9a\x91\x8a\xf2\x6c\xc3\x31\x51\xca\x74\x70\xe4\xad\xc4\xdc\xfe\x3b\x2d\xa9\xe5\x21\x62\x8f\x89\xda\x8b\xbb\xf1\xa3\xb4\xa6\x49\x35\x41\xff\x9d\x9e\x8d\x75\x4a\xfd\x9a\xab\x8a\x4c\xbc\xbb\xca\x88\xe9\xb6\xd2\xfa\xf8\x20\x39\x9f\x8c\x8c\xae\x46\x17\xf3\xe1\xdd\x73\xeb\x1f\xf2\x68\xc6\xa9\xf9\x0f\xf9\xa3\xb1\xa5\x54\x74\x31\x7c\x59\xb6\xae\xc1\xd8\xdf\x60\x3c\xde\xaa\xdb\x71\xe0\x2d\xe1\xc7\xe8\x4d\x51\xd1\xae\x30\x6d\xd3\x60\xbc\x47\xfe\xfd\x5d\x7c\x95\xae\x23\x28\xb2\xc6\x0f\x7f\x5e\x14\x07\x27\xce\x87\x77\x89\xbc\xe8\x95\xe7\xaf\x52\x07\xde\xe0\xcf\x28\xa0\x07\x5d\x5c\x20\x9f\x4e\xa7\x39\xfe\x3a\x31\x35\xfe\x4f\x67\x77\x62\x6f\x25\xe4\xcd\x8b\xd0\xc7\x1a\x62\x0e\x01\xee\x7b\xe5\x09\xbe\xe1\xff\x1f\xcd\xc9\x55\x38\x05\x1d\x9e\x68\x1f\x54\x68\x3d\x8e\xc2\xb2\x4a\x0c\x65\xff\x0c\x00\xd3\xe0\x4a\x51\x85\x06\x00\x00"),
+		},
+		"/scripts/check_hostname.sh": &vfsgen۰CompressedFileInfo{
+			name:             "check_hostname.sh",
+			modTime:          time.Date(2026, 10, 19, 18, 22, 19, 409803330, time.UTC),
+			uncompressedSize: 1630,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x54\x71\x6f\xe2\xc6\x13\xfd\xdf\x9f\xe2\xfd\x0c\x12\x49\x04\x76\x12\xdd\x4f\xea\x5d\x02\x2a\x4d\x72\x2a\x6d\x44\xa4\xc0\xf5\x74\x6a\xaa\x6a\xb1\x07\x7b\x14\xb3\xbb\xb7\xbb\x86\x43\xb9\x7c\xf7\x6a\x6c\x20\x49\x7b\x20\x21\xe3\x7d\xfb\xe6\xbd\x79\xb3\xdb\xf9\x5f\xba\x60\x9d\x2e\x94\x2f\xa3\x4e\x07\x57\xc6\x6e\x1d\x17\x65\xc0\xf9\xe9\xd9\x7b\xcc\x4a\xa5\x8b\x52\x31\x7e\x63\x5d\x5c\xd7\x06\x13\xbd\x34\x6e\xa5\x02\x1b\x8d\x39\x65\xa5\x36\x95\x29\xb6\xc8\x4c\xd2\xc7\x6d\xc8\x93\xa8\xd3\x11\x9a\x5b\xce\x48\x7b\xca\x51\xeb\x9c\x1c\x42\x49\x18\x5b\x95\x95\xb4\x5f\xe9\xe3\x0f\x72\x5e\x58\xce\x93\x53\x1c\x09\x20\xde\x2d\xc5\xc7\x17\x42\xb1\x35\x35\x56\x6a\x0b\x6d\x02\x6a\x4f\x08\x25\x7b\x2c\xb9\x22\xd0\xb7\x8c\x6c\x00\x6b\x64\x66\x65\x2b\x56\x3a\x23\x6c\x38\x94\x08\x2f\x05\x44\x09\xbe\xec\x38\xcc\x22\x28\xd6\x50\xc8\x8c\xdd\xc2\x2c\x5f\x03\xa1\xc2\x4e\x74\xf3\x29\x43\xb0\x1f\xd2\x74\xb3\xd9\x24\xaa\x51\x9c\x18\x57\xa4\x55\x8b\xf5\xe9\xed\xe4\xea\x66\x3a\xbb\x19\x9c\x27\xa7\xbb\x5d\x9f\x74\x45\xde\xc3\xd1\xd7\x9a\x1d\xe5\x58\x6c\xa1\xac\xad\x38\x53\x8b\x8a\x50\xa9\x0d\x8c\x83\x2a\x1c\x51\x8e\x60\x44\xf5\xc6\x71\x60\x5d\xf4\xe1\xcd\x32\x6c\x94\x23\x91\x9a\xb3\x0f\x8e\x17\x75\x78\xd3\xb4\xbd\x46\xf6\x6f\x00\x46\x43\x69\xc4\xe3\x19\x26\xb3\x18\xbf\x8c\x67\x93\x59\x5f\x48\x3e\x4f\xe6\xbf\xde\x7d\x9a\xe3\xf3\xf8\xfe\x7e\x3c\x9d\x4f\x6e\x66\xb8\xbb\xc7\xd5\xdd\xf4\x7a\x32\x9f\xdc\x4d\x67\xb8\xfb\x88\xf1\xf4\x0b\x7e\x9f\x4c\xaf\xfb\x20\x0e\x25\x39\xd0\x37\xeb\xc4\x81\x71\x60\x69\x27\x35\x29\x62\x46\xf4\x46\xc2\xd2\xb4\x39\x7a\x4b\x19\x2f\x39\x43\xa5\x74\x51\xab\x82\x50\x98\x35\x39\xcd\xba\x80\x25\xb7\x62\x2f\xb1\x7a\x28\x9d\x0b\x4d\xc5\x2b\x0e\xcd\xbc\xf8\xff\xfa\x4a\xa2\xa8\x83\xb9\x04\xeb\x33\xc7\x36\xc0\x3a\xd6\xc1\x37\x90\xd2\xf8\xa0\xd5\x8a\xfa\x58\x7e\xcd\xc5\x6f\x2e\xab\x2b\xe5\xb6\x60\xbb\xcf\x50\x9b\x9c\xfa\x0d\x9c\x74\x70\x4c\x5e\x16\x52\x0a\x59\x2a\xdb\x7d\x3f\xea\x34\x1b\x05\xc0\x76\xfd\x0e\x2a\xcf\xc5\x2b\xb5\x25\x0a\x5e\x93\x86\x14\xf1\x50\x8e\xe0\xc8\x9b\x6a\xdd\xe6\x64\xf4\xa1\x40\x12\x75\x50\x7b\x55\xd0\x07\x64\x25\x65\x8f\x7f\xef\xa5\x25\xbe\xc4\xa5\x3c\x8c\x92\x44\x40\xa6\x0e\xb6\x0e\x1f\xa2\x0e\x70\x90\x3f\xbc\xdc\x3f\x8d\x9a\xf7\x62\x66\x78\x29\xbf\xed\x7f\xb6\xc3\xcb\x17\x5f\xa3\xc3\x5e\x3f\xbc\x64\x3b\x7a\xa1\x97\xd9\x84\xd1\x84\x8a\x75\x1b\x06\xa9\xac\x6c\x6c\x6f\xdf\x9a\x6e\x28\x76\x56\x86\xed\x7e\x08\x57\x92\x24\x3f\xa4\x78\xe9\x42\xd3\x49\xd7\x4c\x9b\x36\xd2\x65\x5e\x82\x03\x32\xa5\x7b\x01\x8b\x97\xfe\x44\x11\x65\xa5\x41\x7c\xf0\xd8\x3d\xda\x3f\x1e\xc7\x51\x47\x58\xda\xd0\xd8\xa3\x32\xe6\x51\x46\xda\xca\xc1\x08\xe5\x81\xc4\xf5\xb1\x29\x39\x2b\x9b\xf3\x29\xb7\x0c\xb8\x8d\x34\xd7\x1e\x9e\xdc\x9a\x9c\xe8\xa8\xb5\x13\x91\x72\x96\x76\x45\x85\x78\xd8\x3d\x0a\xbc\x22\x53\x07\xfc\xff\xd0\x69\x0c\x96\x38\x1f\x21\xcd\x69\x9d\xea\xba\xaa\x0e\x52\x5e\x8d\x0d\xb7\xc1\x7b\x53\xbb\x8c\xf6\xd3\xb0\x1f\xa6\x9c\x96\xaa\xae\x02\x9c\xa9\xc3\xbe\x1a\xdb\x61\xf7\x88\x2d\x06\xef\xda\xd7\x28\x28\xe0\xa7\xa4\xf9\xbe\xa9\x86\xef\x90\xfb\x6e\xa0\xd1\xf3\x69\x72\x02\xef\x32\x3c\x1c\xfd\x79\x3a\x78\x9f\xfc\x75\xf2\x70\x9c\x9c\xa4\x0f\x67\xa9\xed\x1d\xc7\x51\xd4\xe0\x48\x70\x9d\xe4\x24\x4d\x7b\xaf\xb2\xfb\x37\xa5\xda\x3c\xa2\x37\xfd\x88\xd1\x10\xe7\x78\xea\x9e\x61\x88\xee\xd9\x85\x1c\x04\x1d\xda\xfe\xfb\x61\x8c\xee\xe9\x73\x2f\x8a\x24\x50\x89\x40\xae\x98\xb8\xfb\x73\x7c\x81\xdc\x44\x32\x35\xad\x93\x5d\xdf\x87\xdd\x27\x01\x3d\xe3\x75\x0b\x0b\x0a\xa4\x03\x54\x43\xb8\x7e\x87\x78\x07\x8a\x7f\xa8\xe7\xa9\x2d\xdf\x3d\x7b\xee\x89\x6b\xe3\x02\x06\x35\xbe\x23\x38\xf4\x1e\x74\x0f\x3d\xf4\x8e\xe3\x28\x37\x9a\xa2\x7f\x06\x00\x67\x92\x24\x77\x5e\x06\x00\x00"),
+		},
+		"/scripts/check_kernel_version.sh": &vfsgen۰CompressedFileInfo{
+			name:             "check_kernel_version.sh",
+			modTime:          time.Date(2019, 12, 9, 5, 38, 12, 0, time.UTC),
+			uncompressedSize: 649,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x8f\xd3\x3c\x10\x86\xef\xfd\x15\xef\xd7\x5e\x3e\xa4\x6e\x52\x7a\x03\x4e\xa1\x2d\x22\x50\xa5\x52\xd3\x65\xb5\x47\xc7\x99\xd8\x23\x25\xb6\xb1\x1d\xb2\xf9\xf7\xc8\xdd\xae\xa0\xc2\xd7\x79\xfd\xf8\x99\x19\xaf\xfe\xcb\x1b\x36\x79\x23\x82\x5e\xac\x56\xd8\x59\x37\x7b\x56\x3a\x62\xbb\x79\xff\x01\xb5\x16\x46\x69\xc1\xf8\xc6\x46\xed\x47\x8b\xd2\x74\xd6\x0f\x22\xb2\x35\xb8\x90\xd4\xc6\xf6\x56\xcd\x90\x36\x5b\xe3\x18\xdb\x6c\xb1\x5a\x25\xcc\x91\x25\x99\x40\x2d\x46\xd3\x92\x47\xd4\x84\xc2\x09\xa9\xe9\xad\xb2\xc6\x0f\xf2\x21\x51\xb6\xd9\x06\xff\xa7\xc0\xf2\x56\x5a\xbe\xfb\x94\x10\xb3\x1d\x31\x88\x19\xc6\x46\x8c\x81\x10\x35\x07\x74\xdc\x13\xe8\x45\x92\x8b\x60\x03\x69\x07\xd7\xb3\x30\x92\x30\x71\xd4\x88\x7f\x1e\x48\x26\x78\xbe\x31\x6c\x13\x05\x1b\x08\x48\xeb\x66\xd8\xee\xef\x20\x44\xbc\x49\x5f\x8f\x8e\xd1\x7d\xcc\xf3\x69\x9a\x32\x71\x35\xce\xac\x57\x79\xff\x9a\x0d\xf9\xb1\xdc\x1d\xaa\xfa\xf0\xb0\xcd\x36\xb7\x5b\x8f\xa6\xa7\x10\xe0\xe9\xe7\xc8\x9e\x5a\x34\x33\x84\x73\x3d\x4b\xd1\xf4\x84\x5e\x4c\xb0\x1e\x42\x79\xa2\x16\xd1\x26\xeb\xc9\x73\x64\xa3\xd6\x08\xb6\x8b\x93\xf0\x94\x54\x5b\x0e\xd1\x73\x33\xc6\xbb\xa1\xbd\x39\x72\xb8\x0b\x58\x03\x61\xb0\x2c\x6a\x94\xf5\x12\x9f\x8b\xba\xac\xd7\x09\xf2\x54\x5e\xbe\x9e\x1e\x2f\x78\x2a\xce\xe7\xa2\xba\x94\x87\x1a\xa7\x33\x76\xa7\x6a\x5f\x5e\xca\x53\x55\xe3\xf4\x05\x45\xf5\x8c\xef\x65\xb5\x5f\x83\x38\x6a\xf2\xa0\x17\xe7\x53\x07\xd6\x83\xd3\x38\xe9\xba\x45\xd4\x44\x77\x0a\x9d\x7d\xdd\x63\x70\x24\xb9\x63\x89\x5e\x18\x35\x0a\x45\x50\xf6\x17\x79\xc3\x46\xc1\x91\x1f\x38\xa4\xb5\x06\x08\xd3\x26\x4c\xcf\x03\xc7\xeb\x7f\x09\xff\xf6\x95\x2d\x16\xa3\x11\x03\xe1\xc1\x2f\x7e\x0f\x00\xb4\x9c\xff\xc4\x89\x02\x00\x00"),
+		},
+		"/scripts/check_kubelet_version.sh": &vfsgen۰CompressedFileInfo{
+			name:             "check_kubelet_version.sh",
+			modTime:          time.Date(2026, 10, 19, 18, 42, 28, 942007595, time.UTC),
+			uncompressedSize: 890,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x93\x5f\x6f\xd3\x30\x14\xc5\xdf\xf3\x29\x0e\x09\xa2\x20\xa5\x49\xdb\x17\x04\x93\x26\x95\x6d\x88\xc0\xd4\x4a\x4b\xc7\xb4\x17\x24\x27\xb9\x49\xae\xe6\xda\xc1\x76\x9a\x55\x8c\xef\x8e\x9c\xb5\xfb\x23\xf2\x7a\x4f\xce\xfd\xd9\xe7\x38\x7a\x93\x16\xac\xd2\x42\xd8\x36\x88\x22\x9c\xe9\x6e\x6f\xb8\x69\x1d\x16\xb3\xf9\x27\xe4\xad\x50\x4d\x2b\x18\xdf\x59\x35\xe7\xbd\x46\xa6\x6a\x6d\xb6\xc2\xb1\x56\xd8\x50\xd9\x2a\x2d\x75\xb3\x47\xa9\x93\x18\x97\xae\x4a\x82\x28\xf2\x36\x97\x5c\x92\xb2\x54\xa1\x57\x15\x19\xb8\x96\xb0\xec\x44\xd9\xd2\x71\x12\xe3\x27\x19\xeb\x5d\x16\xc9\x0c\xef\xbd\x20\x3c\x8c\xc2\x0f\x27\xde\x62\xaf\x7b\x6c\xc5\x1e\x4a\x3b\xf4\x96\xe0\x5a\xb6\xa8\x59\x12\xe8\xbe\xa4\xce\x81\x15\x4a\xbd\xed\x24\x0b\x55\x12\x06\x76\x2d\xdc\xf3\x02\x4f\x82\xdb\x83\x87\x2e\x9c\x60\x05\x81\x52\x77\x7b\xe8\xfa\xa5\x10\xc2\x1d\xa0\xc7\xaf\x75\xae\xfb\x9c\xa6\xc3\x30\x24\x62\x24\x4e\xb4\x69\x52\xf9\xa8\xb5\xe9\x65\x76\x76\xb1\xca\x2f\xa6\x8b\x64\x76\xf8\xeb\x5a\x49\xb2\x16\x86\x7e\xf7\x6c\xa8\x42\xb1\x87\xe8\x3a\xc9\xa5\x28\x24\x41\x8a\x01\xda\x40\x34\x86\xa8\x82\xd3\x9e\x7a\x30\xec\x58\x35\x31\xac\xae\xdd\x20\x0c\x79\xd4\x8a\xad\x33\x5c\xf4\xee\xd5\xa5\x1d\x19\xd9\xbe\x12\x68\x05\xa1\x10\x2e\x73\x64\x79\x88\x2f\xcb\x3c\xcb\x63\x6f\x72\x93\x6d\xbe\xad\xaf\x37\xb8\x59\x5e\x5d\x2d\x57\x9b\xec\x22\xc7\xfa\x0a\x67\xeb\xd5\x79\xb6\xc9\xd6\xab\x1c\xeb\xaf\x58\xae\x6e\xf1\x23\x5b\x9d\xc7\x20\x76\x2d\x19\xd0\x7d\x67\xfc\x09\xb4\x01\xfb\xeb\xa4\x31\x45\xe4\x44\xaf\x10\x6a\xfd\x98\xa3\xed\xa8\xe4\x9a\x4b\x48\xa1\x9a\x5e\x34\x84\x46\xef\xc8\x28\x56\x0d\x3a\x32\x5b\xb6\x3e\x56\x0b\xa1\x2a\x6f\x23\x79\xcb\x6e\xec\x8b\xfd\xff\x5c\x49\x10\x44\xd8\xf8\x60\x6d\x69\xb8\x73\xe8\x0c\x2b\x67\x47\xc9\xee\xd0\x0f\x5d\xe3\xae\x2f\x48\x92\x1b\x33\xd6\xbd\x1b\xc7\x92\x44\xe5\x57\x86\xbb\x30\x06\x25\x4d\x82\x70\x9e\xcc\x3f\x26\xb3\x30\x0e\x22\xf0\x93\x95\xd2\xae\xf5\x3a\x7e\xb6\x61\x3b\x96\x8a\x95\x75\x42\x4a\xaa\x82\x80\x6b\x5f\xa5\xad\x50\x15\xa6\xbb\x27\xdd\x29\xd2\x8a\x76\xa9\xea\xa5\xc4\xe2\xf4\xdd\xfc\xc4\x2f\x56\x81\x6f\xc9\x51\x32\x9d\x1e\x31\x17\x2f\xd5\x0f\x10\xc3\x1d\x26\x7f\x46\x06\xbc\x5d\xfc\x9d\xe0\x01\xfe\x39\x4c\x6c\xfa\x6b\x97\xa6\x93\xa0\xe6\xe0\xdf\x00\x40\x76\x79\x0f\x7a\x03\x00\x00"),
+		},
+		"/scripts/check_memory_capacity.sh": &vfsgen۰CompressedFileInfo{
+			name:             "check_memory_capacity.sh",
+			modTime:          time.Date(2019, 12, 9, 5, 38, 12, 0, time.UTC),
+			uncompressedSize: 669,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xc1\x8e\xd3\x30\x10\x86\xef\x7d\x8a\x9f\x16\x69\x41\x2a\x49\xe9\x0d\x38\x85\xb6\x88\x40\x49\xa5\xa6\xcb\x6a\x8f\x4e\x32\xb1\x47\x24\xb6\xb1\x1d\xb2\x11\xf0\xee\xc8\xdd\xae\xa0\xc2\xd7\xf9\xfd\xf9\x9b\x19\x2f\x9e\xa5\x15\xeb\xb4\x12\x5e\xcd\x16\x0b\x6c\x8c\x9d\x1c\x4b\x15\xb0\x5e\xbd\x7e\x83\x52\x09\x2d\x95\x60\x7c\x62\x2d\xb7\x83\x41\xae\x5b\xe3\x7a\x11\xd8\x68\x9c\xa8\x56\xda\x74\x46\x4e\xa8\x4d\xb2\xc4\x3e\x34\xc9\x6c\xb1\x88\x98\x3d\xd7\xa4\x3d\x35\x18\x74\x43\x0e\x41\x11\x32\x2b\x6a\x45\x4f\x95\x25\xbe\x92\xf3\x91\xb2\x4e\x56\x78\x11\x03\xf3\x4b\x69\xfe\xf2\x5d\x44\x4c\x66\x40\x2f\x26\x68\x13\x30\x78\x42\x50\xec\xd1\x72\x47\xa0\x87\x9a\x6c\x00\x6b\xd4\xa6\xb7\x1d\x0b\x5d\x13\x46\x0e\x0a\xe1\xef\x03\xd1\x04\xf7\x17\x86\xa9\x82\x60\x0d\x81\xda\xd8\x09\xa6\xfd\x37\x08\x11\x2e\xd2\xe7\xa3\x42\xb0\x6f\xd3\x74\x1c\xc7\x44\x9c\x8d\x13\xe3\x64\xda\x3d\x66\x7d\xba\xcf\x37\xbb\xa2\xdc\xbd\x5a\x27\xab\xcb\xad\x5b\xdd\x91\xf7\x70\xf4\x7d\x60\x47\x0d\xaa\x09\xc2\xda\x8e\x6b\x51\x75\x84\x4e\x8c\x30\x0e\x42\x3a\xa2\x06\xc1\x44\xeb\xd1\x71\x60\x2d\x97\xf0\xa6\x0d\xa3\x70\x14\x55\x1b\xf6\xc1\x71\x35\x84\xab\xa1\x3d\x39\xb2\xbf\x0a\x18\x0d\xa1\x31\xcf\x4a\xe4\xe5\x1c\xef\xb3\x32\x2f\x97\x11\x72\x97\x9f\x3e\x1e\x6e\x4f\xb8\xcb\x8e\xc7\xac\x38\xe5\xbb\x12\x87\x23\x36\x87\x62\x9b\x9f\xf2\x43\x51\xe2\xf0\x01\x59\x71\x8f\xcf\x79\xb1\x5d\x82\x38\x28\x72\xa0\x07\xeb\x62\x07\xc6\x81\xe3\x38\xe9\xbc\x45\x94\x44\x57\x0a\xad\x79\xdc\xa3\xb7\x54\x73\xcb\x35\x3a\xa1\xe5\x20\x24\x41\x9a\x1f\xe4\x34\x6b\x09\x4b\xae\x67\x1f\xd7\xea\x21\x74\x13\x31\x1d\xf7\x1c\xce\xff\xc5\xff\xdf\x57\x32\x9b\xb5\x8e\x08\xbf\x20\xc6\x6f\xb8\x49\xbf\x50\x9f\xfe\xb4\x8e\x75\xc0\xf3\xf5\xef\x9b\xd9\x9f\x01\x00\xe7\xb8\xd3\xda\x9d\x02\x00\x00"),
+		},
+		"/scripts/check_port_occupied.sh": &vfsgen۰CompressedFileInfo{
+			name:             "check_port_occupied.sh",
+			modTime:          time.Date(2026, 10, 19, 18, 9, 12, 123641292, time.UTC),
+			uncompressedSize: 1249,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x52\x5d\x6f\xe3\x36\x10\x7c\xd7\xaf\x98\xca\x6e\x9c\x1c\x2c\x39\x31\xfa\xd2\xa4\x0d\xea\xe6\x03\x55\x1b\xd8\x40\xe4\xeb\xe1\x50\x14\x07\x9a\x5a\x49\x8b\xc8\x24\x4b\x52\xf1\x19\x39\xff\xf7\x82\xf2\x47\xce\x89\xc1\x07\x6b\x67\x76\x38\x3b\xcb\xde\x0f\xa3\x05\xab\xd1\x42\xb8\x3a\xea\xf5\x70\xa3\xcd\xda\x72\x55\x7b\x8c\xcf\x2f\x7e\x46\x5e\x0b\x55\xd5\x82\xf1\x27\xab\xea\xb6\xd5\xc8\x54\xa9\xed\x52\x78\xd6\x0a\x73\x92\xb5\xd2\x8d\xae\xd6\x90\x3a\x1d\xe2\xc1\x17\x69\xd4\xeb\x05\x99\x07\x96\xa4\x1c\x15\x68\x55\x41\x16\xbe\x26\x4c\x8c\x90\x35\xed\x91\x21\xfe\x26\xeb\x82\xca\x38\x3d\xc7\x69\x20\xc4\x3b\x28\x3e\xbb\x0a\x12\x6b\xdd\x62\x29\xd6\x50\xda\xa3\x75\x04\x5f\xb3\x43\xc9\x0d\x81\xbe\x4a\x32\x1e\xac\x20\xf5\xd2\x34\x2c\x94\x24\xac\xd8\xd7\xf0\xaf\x17\x04\x27\xf8\xbc\xd3\xd0\x0b\x2f\x58\x41\x40\x6a\xb3\x86\x2e\xbf\x27\x42\xf8\x9d\xe9\xee\x57\x7b\x6f\x2e\x47\xa3\xd5\x6a\x95\x8a\xce\x71\xaa\x6d\x35\x6a\xb6\x5c\x37\x7a\xc8\x6e\xee\xa6\xf9\x5d\x32\x4e\xcf\x77\x5d\x1f\x55\x43\xce\xc1\xd2\x7f\x2d\x5b\x2a\xb0\x58\x43\x18\xd3\xb0\x14\x8b\x86\xd0\x88\x15\xb4\x85\xa8\x2c\x51\x01\xaf\x83\xeb\x95\x65\xcf\xaa\x1a\xc2\xe9\xd2\xaf\x84\xa5\x60\xb5\x60\xe7\x2d\x2f\x5a\x7f\x14\xda\xde\x23\xbb\x23\x82\x56\x10\x0a\xf1\x24\x47\x96\xc7\xf8\x7d\x92\x67\xf9\x30\x88\x7c\xca\xe6\x7f\xcc\x3e\xce\xf1\x69\xf2\xf8\x38\x99\xce\xb3\xbb\x1c\xb3\x47\xdc\xcc\xa6\xb7\xd9\x3c\x9b\x4d\x73\xcc\xee\x31\x99\x7e\xc6\x5f\xd9\xf4\x76\x08\x62\x5f\x93\x05\x7d\x35\x36\x4c\xa0\x2d\x38\xc4\x49\xdd\x16\x91\x13\x1d\x59\x28\xf5\x76\x8f\xce\x90\xe4\x92\x25\x1a\xa1\xaa\x56\x54\x84\x4a\x3f\x93\x55\xac\x2a\x18\xb2\x4b\x76\x61\xad\x0e\x42\x15\x41\xa6\xe1\x25\xfb\xee\xbd\xb8\xf7\x73\xa5\x51\xd4\xc3\x3c\x2c\xd6\x49\xcb\xc6\xc3\x58\x56\xde\x75\x94\x86\x9d\xa7\x4e\xd5\x4b\x03\xa3\xad\x77\x21\xbc\x00\x55\xfc\x4c\xaa\x2b\xc1\x0a\x55\x91\x1b\x42\x2b\xda\x16\x0c\x59\x34\xac\x28\x8d\x7a\x68\x9d\xa8\xe8\x12\xb2\x26\xf9\xf4\x25\xa0\x5f\xb4\x94\xad\x61\x2a\x52\x57\xe3\x97\x50\x81\xb6\x28\xad\x5e\x26\x5e\x5f\xa7\x69\x1a\x45\x87\x6b\xbb\x06\x77\x7a\x86\x97\x28\xbc\x0b\x2e\xc3\x6b\x5b\x0a\x55\x20\x79\x86\x73\xb8\xc6\xa8\xa0\xe7\x91\x6a\x9b\x06\xe3\xeb\x93\x8b\xab\xe0\x5a\x75\xdc\x70\x9c\x43\xd2\x78\x85\x6f\x10\xab\x27\x0c\xa6\x8f\xb8\xc6\x05\x5e\xba\x01\xd1\xff\x69\x33\xe8\x98\xd4\x38\x3a\xb4\x28\xf2\xce\x0b\xff\xbe\x6f\xfc\xae\xaf\xe4\x1d\x21\xb9\xc7\xe0\x72\x80\xc1\x9e\x30\xbd\xdf\x0c\xf0\x0d\x2e\x8c\x96\x28\x24\x6d\xb4\x89\xa2\xb0\xba\x30\x4d\xc8\xaf\x7f\xfa\x66\xc2\xb3\x2b\x14\x7a\x2b\xaa\xed\x36\xcf\xc0\x8b\xfb\xbf\xc5\x07\x24\x9c\x90\xd2\xaf\xfd\x97\x8e\xf0\x63\xf2\x61\x73\x00\xbc\xde\x97\x7b\x1f\x92\xd7\x32\x97\xf8\x07\x71\xff\x25\x5c\xb2\x89\x91\x54\x14\xbe\x82\xca\x26\xc6\xbf\x38\x39\x39\x86\x9b\x0e\xf6\x3a\x80\x6f\xa2\x0c\x87\x64\xad\x5f\xe9\x47\xd0\xc2\x92\x78\x3a\x54\x4a\xee\xfe\x16\x5a\x51\x54\x68\x45\xd1\xff\x03\x00\xca\x92\x74\x09\xe1\x04\x00\x00"),
+		},
+		"/scripts/check_port_reachable.sh": &vfsgen۰CompressedFileInfo{
+			name:             "check_port_reachable.sh",
+			modTime:          time.Date(2026, 10, 19, 18, 9, 12, 127488468, time.UTC),
+			uncompressedSize: 1099,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x53\x61\x6f\xdb\x36\x14\xfc\xce\x5f\x71\x93\x84\xa1\x1d\x1c\x29\x71\xf6\x65\xa9\xe3\xc1\x4b\x32\x4c\x5b\x60\x03\x91\xbb\xa2\x18\x86\x80\xa6\x9e\xa4\x87\xc9\x24\x4b\x52\x75\x0c\xc3\xff\x7d\xa0\xed\x64\xcd\x8a\xea\x23\xef\x78\xef\xde\x1d\x95\x7e\x57\xac\x58\x17\x2b\xe9\x3b\x91\xa6\xb8\x31\x76\xeb\xb8\xed\x02\xc6\xe7\x17\x3f\xa1\xea\xa4\x6e\x3b\xc9\xf8\x9d\x75\x7b\x3b\x18\x94\xba\x31\x6e\x2d\x03\x1b\x8d\x25\xa9\x4e\x9b\xde\xb4\x5b\x28\x93\x8f\x70\x1f\xea\x5c\xa4\x69\x94\xb9\x67\x45\xda\x53\x8d\x41\xd7\xe4\x10\x3a\xc2\xcc\x4a\xd5\xd1\x33\x32\xc2\x9f\xe4\x7c\x54\x19\xe7\xe7\x78\x13\x09\xc9\x09\x4a\xde\xbe\x8b\x12\x5b\x33\x60\x2d\xb7\xd0\x26\x60\xf0\x84\xd0\xb1\x47\xc3\x3d\x81\x9e\x14\xd9\x00\xd6\x50\x66\x6d\x7b\x96\x5a\x11\x36\x1c\x3a\x84\xff\x06\x44\x27\xf8\x78\xd2\x30\xab\x20\x59\x43\x42\x19\xbb\x85\x69\xbe\x24\x42\x86\x93\xe9\xc3\xd7\x85\x60\xaf\x8a\x62\xb3\xd9\xe4\xf2\xe0\x38\x37\xae\x2d\xfa\x23\xd7\x17\xf7\xe5\xcd\xdd\xbc\xba\x3b\x1b\xe7\xe7\xa7\x5b\xef\x75\x4f\xde\xc3\xd1\xa7\x81\x1d\xd5\x58\x6d\x21\xad\xed\x59\xc9\x55\x4f\xe8\xe5\x06\xc6\x41\xb6\x8e\xa8\x46\x30\xd1\xf5\xc6\x71\x60\xdd\x8e\xe0\x4d\x13\x36\xd2\x51\xb4\x5a\xb3\x0f\x8e\x57\x43\x78\x15\xda\xb3\x47\xf6\xaf\x08\x46\x43\x6a\x24\xb3\x0a\x65\x95\xe0\x97\x59\x55\x56\xa3\x28\xf2\xa1\x5c\xfe\xb6\x78\xbf\xc4\x87\xd9\xc3\xc3\x6c\xbe\x2c\xef\x2a\x2c\x1e\x70\xb3\x98\xdf\x96\xcb\x72\x31\xaf\xb0\xf8\x15\xb3\xf9\x47\xfc\x51\xce\x6f\x47\x20\x0e\x1d\x39\xd0\x93\x75\x71\x03\xe3\xc0\x31\x4e\x3a\xb4\x88\x8a\xe8\x95\x85\xc6\x1c\x7b\xf4\x96\x14\x37\xac\xd0\x4b\xdd\x0e\xb2\x25\xb4\xe6\x33\x39\xcd\xba\x85\x25\xb7\x66\x1f\x6b\xf5\x90\xba\x8e\x32\x3d\xaf\x39\x1c\xde\x8b\xff\x7a\xaf\x5c\x88\x14\xcb\x58\xac\x57\x8e\x6d\x80\xea\x48\xfd\xe3\xc1\x0d\x24\x82\xb2\xb0\xc6\x85\xd8\x97\x44\x67\x7c\x00\xc7\x9c\xa5\xea\x0e\xd1\x36\xce\xac\x8f\xaf\x42\x9b\x9a\x46\x22\x85\x75\xac\x83\x47\xf2\xc2\x49\x60\x1c\x92\x41\xbf\x1c\x5c\x61\xe2\x48\x7a\xa3\xa7\x49\x2e\x52\x0c\x5e\xb6\x74\x75\x9c\xfa\x18\x67\x3d\xbe\x30\x73\xdf\x61\xc2\x76\x8a\x49\x3c\x9f\x62\x12\x78\x4d\x66\x08\xf0\xa4\x8c\xae\xfd\x54\x08\xb6\xd7\xd9\x85\x88\xf0\x75\x36\x16\x27\xfc\xf1\x84\x5f\x67\xbb\xcb\xab\xb3\xcb\xbd\x10\xdc\xc0\x0c\xc1\x0e\xe1\x3a\x7b\xf3\x2c\x92\x64\xbb\xff\xf1\xf7\x09\xe2\x3f\x88\x33\x85\x84\x9e\x48\xe1\x72\x32\x2d\x6a\xfa\x5c\x04\x65\x8b\x6c\xc7\x76\x5f\x64\xbb\x38\x6b\x9f\x60\x3c\xfd\xfe\xe2\xed\xbb\x18\xa4\x16\xf1\xcd\x92\xea\xcc\x97\x5b\x0b\xea\xb9\xc1\x5f\xc8\x7e\xc6\x19\x7d\xc2\xc5\xf8\x47\xfc\xfd\x15\xfd\x55\x2a\xca\x68\x4d\x2a\xb6\x84\xe8\xab\x8e\x8e\x13\x41\xbd\xa7\x6f\xdd\xc8\x76\xc7\xa5\xd2\xf4\x87\x2b\xec\x13\xd1\xb0\xf8\x77\x00\x7a\x76\x51\x0c\x4b\x04\x00\x00"),
+		},
+		"/scripts/check_residue.sh": &vfsgen۰CompressedFileInfo{
+			name:             "check_residue.sh",
+			modTime:          time.Date(2026, 10, 19, 18, 31, 36, 611565382, time.UTC),
+			uncompressedSize: 1153,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x93\xc1\x6e\xe3\x36\x10\x86\xef\x7a\x8a\xbf\xf1\x21\x2d\xe0\x4a\x69\x6e\xdd\x26\x0b\xb8\x49\x8a\xaa\x0d\x6c\x20\xf2\x76\xb1\x97\x2e\x28\x6a\x24\x0e\x4a\x93\x2c\x39\x8a\x63\xa0\x0f\x5f\x50\x91\x13\x1b\xeb\x93\x41\x7e\xfc\xf9\x0d\x67\xb4\xf8\xae\x6a\xd9\x55\xad\x4a\xa6\x58\x2c\x70\xe7\xc3\x21\xf2\x60\x04\xd7\x57\x3f\xfd\x8c\xc6\x28\x37\x18\xc5\xf8\x83\xdd\x70\x3f\x7a\xd4\xae\xf7\x71\xa7\x84\xbd\xc3\x96\xb4\x71\xde\xfa\xe1\x00\xed\xcb\x25\x1e\xa5\x2b\x8b\xc5\x22\xc7\x3c\xb2\x26\x97\xa8\xc3\xe8\x3a\x8a\x10\x43\x58\x05\xa5\x0d\x1d\x77\x96\xf8\x8b\x62\xca\x29\xd7\xe5\x15\xbe\xcf\xc0\xc5\xbc\x75\xf1\xc3\x2f\x39\xe2\xe0\x47\xec\xd4\x01\xce\x0b\xc6\x44\x10\xc3\x09\x3d\x5b\x02\xbd\x68\x0a\x02\x76\xd0\x7e\x17\x2c\x2b\xa7\x09\x7b\x16\x03\x79\xbf\x20\x9b\xe0\xcb\x9c\xe1\x5b\x51\xec\xa0\xa0\x7d\x38\xc0\xf7\xa7\x20\x94\xcc\xd2\xd3\xcf\x88\x84\x0f\x55\xb5\xdf\xef\x4b\x35\x19\x97\x3e\x0e\x95\x7d\x65\x53\xf5\x58\xdf\x3d\xac\x9b\x87\x1f\xaf\xcb\xab\xf9\xd4\x27\x67\x29\x25\x44\xfa\x77\xe4\x48\x1d\xda\x03\x54\x08\x96\xb5\x6a\x2d\xc1\xaa\x3d\x7c\x84\x1a\x22\x51\x07\xf1\xd9\x7a\x1f\x59\xd8\x0d\x4b\x24\xdf\xcb\x5e\x45\xca\xaa\x1d\x27\x89\xdc\x8e\x72\xf6\x68\x47\x47\x4e\x67\x80\x77\x50\x0e\x17\xab\x06\x75\x73\x81\x5f\x57\x4d\xdd\x2c\x73\xc8\xe7\x7a\xfb\xfb\xe6\xd3\x16\x9f\x57\x4f\x4f\xab\xf5\xb6\x7e\x68\xb0\x79\xc2\xdd\x66\x7d\x5f\x6f\xeb\xcd\xba\xc1\xe6\x37\xac\xd6\x5f\xf0\x67\xbd\xbe\x5f\x82\x58\x0c\x45\xd0\x4b\x88\xb9\x02\x1f\xc1\xf9\x39\x69\xea\x22\x1a\xa2\x33\x85\xde\xbf\xf6\x31\x05\xd2\xdc\xb3\x86\x55\x6e\x18\xd5\x40\x18\xfc\x33\x45\xc7\x6e\x40\xa0\xb8\xe3\x94\xdb\x9a\xa0\x5c\x97\x63\x2c\xef\x58\xa6\x79\x49\xdf\xd6\x55\x16\xc5\x02\xdb\xdc\xd8\xa4\x23\x4f\x3d\x7d\x26\x27\x3e\x32\x25\xec\x8d\x12\x28\x84\x48\xcf\xec\xc7\x84\x7f\xc6\x96\xa2\x23\xa1\x49\x95\x44\x77\x60\x97\x44\x59\x3b\xc5\xc3\x52\x2f\xf0\x6e\xca\x77\xbe\xa3\xb2\x58\xc0\x8f\x12\x46\x59\xc2\x3b\x82\x65\xf7\x5a\x05\x29\x6d\x10\x29\x71\x37\xd2\x32\xcf\x97\xc9\xee\xdc\xbf\x9d\x04\x27\x68\x4b\xca\x7d\x28\x16\x00\x3a\x8e\xa4\xc5\xc7\xc3\xed\x4d\x50\x62\x3e\x4e\x8b\x21\x7a\x4d\x29\xdd\xde\x38\xb5\xa3\xd7\x25\x0e\x92\x7b\x9e\x6e\x6f\xb4\xc9\xe3\x16\x22\xf5\xfc\xf2\x11\x37\x6e\xdc\xb5\x14\xf3\xdc\x4d\x1b\x69\xc6\x9d\x50\xec\x95\xa6\xd3\x8c\x9d\x1f\x9d\x1c\xef\x29\x4a\x58\x6e\xbf\xce\xaa\x65\x32\x45\x31\xff\xff\x7a\x74\xca\x0f\xf5\x1f\xf2\x97\x76\x99\xaa\xbf\xab\x77\xd5\xea\xf2\x8d\x9d\x55\xcf\xc9\xa3\xff\x09\x77\xf4\x3f\xc5\xde\x6a\x3a\xe5\x8e\xe2\xe7\xe4\x5b\x39\x27\xe8\x54\xce\x19\x36\xad\xdc\x56\x97\x45\x41\x2f\x2c\xb8\x2a\xfe\x1f\x00\x75\x39\x89\xea\x81\x04\x00\x00"),
+		},
+		"/scripts/check_root_disk_volume.sh": &vfsgen۰CompressedFileInfo{
+			name:             "check_root_disk_volume.sh",
+			modTime:          time.Date(2019, 12, 9, 5, 38, 12, 0, time.UTC),
+			uncompressedSize: 668,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xc1\x8e\xd3\x30\x10\x86\xef\x7d\x8a\x9f\x16\x69\x41\x2a\xc9\xd2\x1b\x70\x0a\xdb\x22\x02\x55\x2a\x35\x5d\x56\x2b\x71\x71\x9c\x89\x3d\x22\xb5\x8d\xed\x90\x8d\x80\x77\x47\xee\x76\x05\x15\xbe\xce\xef\xcf\xdf\xcc\x78\xf1\x2c\x6f\xd8\xe4\x8d\x08\x7a\xb6\x58\xe0\xc6\xba\xc9\xb3\xd2\x11\xab\xeb\xd7\x6f\x50\x6b\x61\x94\x16\x8c\x4f\x6c\xd4\x7a\xb0\x28\x4d\x67\xfd\x51\x44\xb6\x06\x07\x92\xda\xd8\xde\xaa\x09\xd2\x66\x4b\x6c\x63\x9b\xcd\x16\x8b\x84\xd9\xb2\x24\x13\xa8\xc5\x60\x5a\xf2\x88\x9a\x50\x38\x21\x35\x3d\x55\x96\xf8\x42\x3e\x24\xca\x2a\xbb\xc6\x8b\x14\x98\x9f\x4b\xf3\x97\xef\x12\x62\xb2\x03\x8e\x62\x82\xb1\x11\x43\x20\x44\xcd\x01\x1d\xf7\x04\x7a\x90\xe4\x22\xd8\x40\xda\xa3\xeb\x59\x18\x49\x18\x39\x6a\xc4\xbf\x0f\x24\x13\xdc\x9f\x19\xb6\x89\x82\x0d\x04\xa4\x75\x13\x6c\xf7\x6f\x10\x22\x9e\xa5\x4f\x47\xc7\xe8\xde\xe6\xf9\x38\x8e\x99\x38\x19\x67\xd6\xab\xbc\x7f\xcc\x86\x7c\x5b\xde\x6c\xaa\x7a\xf3\x6a\x95\x5d\x9f\x6f\xdd\x9a\x9e\x42\x80\xa7\xef\x03\x7b\x6a\xd1\x4c\x10\xce\xf5\x2c\x45\xd3\x13\x7a\x31\xc2\x7a\x08\xe5\x89\x5a\x44\x9b\xac\x47\xcf\x91\x8d\x5a\x22\xd8\x2e\x8e\xc2\x53\x52\x6d\x39\x44\xcf\xcd\x10\x2f\x86\xf6\xe4\xc8\xe1\x22\x60\x0d\x84\xc1\xbc\xa8\x51\xd6\x73\xbc\x2f\xea\xb2\x5e\x26\xc8\x5d\x79\xf8\xb8\xbb\x3d\xe0\xae\xd8\xef\x8b\xea\x50\x6e\x6a\xec\xf6\xb8\xd9\x55\xeb\xf2\x50\xee\xaa\x1a\xbb\x0f\x28\xaa\x7b\x7c\x2e\xab\xf5\x12\xc4\x51\x93\x07\x3d\x38\x9f\x3a\xb0\x1e\x9c\xc6\x49\xa7\x2d\xa2\x26\xba\x50\xe8\xec\xe3\x1e\x83\x23\xc9\x1d\x4b\xf4\xc2\xa8\x41\x28\x82\xb2\x3f\xc8\x1b\x36\x0a\x8e\xfc\x91\x43\x5a\x6b\x80\x30\x6d\xc2\xf4\x7c\xe4\x78\xfa\x2f\xe1\xff\xbe\xb2\xd9\xac\xed\x90\xe3\x17\xc4\xf8\x0d\x57\xf9\xd7\x3c\xff\xe9\x3c\x9b\x88\xe7\xab\xdf\x57\xb3\x3f\x03\x00\x32\xae\x16\xab\x9c\x02\x00\x00"),
+		},
+		"/scripts/check_system_distribution.sh": &vfsgen۰CompressedFileInfo{
+			name:             "check_system_distribution.sh",
+			modTime:          time.Date(2026, 10, 19, 17, 58, 56, 696903021, time.UTC),
+			uncompressedSize: 747,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6f\x13\x3f\x10\xc5\xef\xf9\x14\xef\xbf\xf9\x4b\x01\x94\xec\xb6\xb9\x01\xe2\x10\x9a\x56\x2c\x54\x89\xd4\x4d\xa9\x7a\x9c\x78\x27\xbb\x23\x36\xb6\xb1\x67\xd9\x46\x94\xef\x8e\x9c\xa6\x82\x0a\x9f\x2c\xbf\xe7\xe7\xdf\xcc\x78\xfc\x5f\xb1\x15\x5b\x6c\x29\xb6\xa3\xf1\x18\x17\xce\x1f\x82\x34\xad\x62\x7e\x76\xfe\x16\x55\x4b\xb6\x69\x49\xf0\x59\x6c\xb3\xec\x1d\x4a\xbb\x73\x61\x4f\x2a\xce\x62\xc3\xa6\xb5\xae\x73\xcd\x01\xc6\xe5\x53\x5c\x6b\x9d\x8f\xc6\xe3\x14\x73\x2d\x86\x6d\xe4\x1a\xbd\xad\x39\x40\x5b\xc6\xc2\x93\x69\xf9\x59\x99\xe2\x2b\x87\x98\x52\xe6\xf9\x19\x5e\x25\x43\x76\x92\xb2\xd7\xef\x53\xc4\xc1\xf5\xd8\xd3\x01\xd6\x29\xfa\xc8\xd0\x56\x22\x76\xd2\x31\xf8\xc1\xb0\x57\x88\x85\x71\x7b\xdf\x09\x59\xc3\x18\x44\x5b\xe8\x9f\x07\x12\x09\xee\x4f\x19\x6e\xab\x24\x16\x04\xe3\xfc\x01\x6e\xf7\xb7\x11\xa4\x27\xe8\xe3\x6a\x55\xfd\xbb\xa2\x18\x86\x21\xa7\x23\x71\xee\x42\x53\x74\x4f\xde\x58\x5c\x97\x17\x97\xab\xea\x72\x36\xcf\xcf\x4e\xb7\x6e\x6d\xc7\x31\x22\xf0\xf7\x5e\x02\xd7\xd8\x1e\x40\xde\x77\x62\x68\xdb\x31\x3a\x1a\xe0\x02\xa8\x09\xcc\x35\xd4\x25\xea\x21\x88\x8a\x6d\xa6\x88\x6e\xa7\x03\x05\x4e\xa8\xb5\x44\x0d\xb2\xed\xf5\x45\xd3\x9e\x19\x25\xbe\x30\x38\x0b\xb2\xc8\x16\x15\xca\x2a\xc3\xc7\x45\x55\x56\xd3\x14\x72\x57\x6e\x3e\xad\x6f\x37\xb8\x5b\xdc\xdc\x2c\x56\x9b\xf2\xb2\xc2\xfa\x06\x17\xeb\xd5\xb2\xdc\x94\xeb\x55\x85\xf5\x15\x16\xab\x7b\x7c\x29\x57\xcb\x29\x58\xb4\xe5\x00\x7e\xf0\x21\x55\xe0\x02\x24\xb5\x93\x8f\x53\x44\xc5\xfc\x02\x61\xe7\x9e\xe6\x18\x3d\x1b\xd9\x89\x41\x47\xb6\xe9\xa9\x61\x34\xee\x07\x07\x2b\xb6\x81\xe7\xb0\x97\x98\xc6\x1a\x41\xb6\x4e\x31\x9d\xec\x45\x8f\xff\x25\xfe\x5b\x57\x3e\x1a\x19\x52\x14\xac\xa6\x78\x33\x0b\xdc\x31\x45\xc6\x23\x9a\xc0\x1e\xb3\x01\x59\xb9\xcc\xf0\x08\x1a\xbe\x61\x52\x94\xcb\xe2\xa7\x0f\x62\x15\xff\x9f\xff\x9a\x9c\x8e\x67\x57\xc8\x3e\x64\x98\x3c\x2b\xf3\xa3\xa2\x01\xb3\x1a\x93\x2c\xed\x5b\xa6\x1a\x33\x8b\xf3\xd1\xef\x01\x00\x87\xf5\x06\x0a\xeb\x02\x00\x00"),
+		},
+		"/scripts/check_system_preference.sh": &vfsgen۰CompressedFileInfo{
+			name:             "check_system_preference.sh",
+			modTime:          time.Date(2026, 10, 19, 18, 25, 33, 747047050, time.UTC),
+			uncompressedSize: 3239,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x56\x7f\x6f\xdb\x36\x10\xfd\x5b\xfc\x14\x57\x59\x58\xda\xc2\x96\x9c\x6c\xff\x34\x81\x3a\x64\x6d\x87\x79\x2d\x12\xa0\x4e\x57\x14\xfd\x61\x30\xd4\x49\x3a\x44\x21\x35\x92\x8a\x6d\xb8\xfa\xee\x03\x65\xd9\x96\x63\xad\x6b\xba\x02\x05\x22\xf2\xee\xdd\x7b\xef\x8e\xa4\x07\x8f\xa2\x6b\x92\xd1\x35\x37\x39\x1b\x0c\xe0\x85\x2a\x97\x9a\xb2\xdc\xc2\xc9\xf8\xf8\x19\x4c\x73\x2e\xb3\x9c\x13\xfc\x49\x32\x7b\x59\x29\x98\xc8\x54\xe9\x5b\x6e\x49\x49\xb8\x42\x91\x4b\x55\xa8\x6c\x09\x42\x85\x43\x78\x63\x93\x90\x0d\x06\x0e\xe6\x0d\x09\x94\x06\x13\xa8\x64\x82\x1a\x6c\x8e\x70\x5e\x72\x91\xe3\x66\x67\x08\x7f\xa1\x36\x0e\xe5\x24\x1c\xc3\x63\x17\xe0\xb7\x5b\xfe\x93\x33\x07\xb1\x54\x15\xdc\xf2\x25\x48\x65\xa1\x32\x08\x36\x27\x03\x29\x15\x08\xb8\x10\x58\x5a\x20\x09\x42\xdd\x96\x05\x71\x29\x10\xe6\x64\x73\xb0\xbb\x02\x8e\x09\x7c\x68\x31\xd4\xb5\xe5\x24\x81\x83\x50\xe5\x12\x54\xda\x0d\x04\x6e\x5b\xd2\xcd\xbf\xdc\xda\xf2\x34\x8a\xe6\xf3\x79\xc8\x1b\xc6\xa1\xd2\x59\x54\xac\x63\x4d\xf4\x66\xf2\xe2\xd5\xc5\xf4\xd5\xe8\x24\x1c\xb7\x59\xef\x64\x81\xc6\x80\xc6\xbf\x2b\xd2\x98\xc0\xf5\x12\x78\x59\x16\x24\xf8\x75\x81\x50\xf0\x39\x28\x0d\x3c\xd3\x88\x09\x58\xe5\x58\xcf\x35\x59\x92\xd9\x10\x8c\x4a\xed\x9c\x6b\x74\x54\x13\x32\x56\xd3\x75\x65\xf7\x4c\xdb\x70\x24\xb3\x17\xa0\x24\x70\x09\xfe\xf9\x14\x26\x53\x1f\x7e\x3b\x9f\x4e\xa6\x43\x07\xf2\x7e\x72\xf5\xc7\xe5\xbb\x2b\x78\x7f\xfe\xf6\xed\xf9\xc5\xd5\xe4\xd5\x14\x2e\xdf\xc2\x8b\xcb\x8b\x97\x93\xab\xc9\xe5\xc5\x14\x2e\x7f\x87\xf3\x8b\x0f\xf0\x7a\x72\xf1\x72\x08\x48\x36\x47\x0d\xb8\x28\xb5\x53\xa0\x34\x90\xb3\x13\x9b\x2e\xc2\x14\x71\x8f\x42\xaa\xd6\x7d\x34\x25\x0a\x4a\x49\x40\xc1\x65\x56\xf1\x0c\x21\x53\x77\xa8\x25\xc9\x0c\x4a\xd4\xb7\x64\x5c\x5b\x0d\x70\x99\x38\x98\x82\x6e\xc9\x36\xf3\x62\x0e\x75\x85\x8c\x0d\xa0\x32\x3c\xc3\x53\x10\x39\x8a\x9b\x99\x59\x1a\x8b\xb7\xb3\x52\x63\x8a\x1a\xa5\xc0\xd0\xe4\xf0\x71\x34\x4a\x69\xf1\x99\x0d\x9a\xdc\x4a\x1a\x6e\xc9\xa4\x84\x09\x98\xa5\x11\xb6\x80\x1b\x5c\x1a\xe0\x1a\xc1\xa0\x05\x4a\xa1\x89\x07\x32\x90\xd1\x1d\xca\xa1\xe3\xe2\xb8\x19\x32\xce\xde\x76\x7e\x1c\x96\x92\x68\xdc\x3c\x08\x5e\xd0\x53\x20\x69\x51\xa7\x5c\xa0\x61\x94\xc2\xc7\x8f\x10\x1c\x43\x0c\x7e\x83\xe6\xc3\xe7\xcf\x67\xae\xbe\x64\x5e\x4a\x0b\x47\x54\xd8\x22\xf6\xad\xae\xd0\x67\x29\xb1\xf5\xc2\x4c\x28\x99\xc6\x11\x5a\x11\xad\x17\xc2\x24\x7a\xf6\x6c\x74\x53\x72\x6e\x42\xb7\xc7\x58\x5a\x49\xe1\xfc\xd8\x30\x6a\xa1\x60\xc5\xbc\x42\x09\xde\xa8\x89\x83\xe3\xcd\xd7\x1d\x2f\x2a\x8c\x83\x13\xe6\xb5\x9c\x56\x37\xb8\xac\x21\x8e\xe1\x69\xb8\xa6\xbd\x23\xe6\x69\xb4\x95\x96\x30\x76\x1c\x99\x67\x55\x25\x72\x08\x56\x1d\x6a\x35\xf3\xdc\xb1\x1c\x11\xf8\xd1\x97\x06\x2a\x8a\xc2\xe8\xd3\xa7\xb0\x86\xa7\x71\x94\xf8\x07\xd1\x28\x72\x05\xfe\xa6\x28\x04\xab\x86\x4f\xed\xc3\xf3\xe7\xf7\x63\xeb\x8e\xb6\x6d\x37\x5b\x65\x6d\xe0\x46\x1a\x2e\x4a\x14\x16\x93\xd9\x4e\x5d\xfb\xd7\xe3\x36\x67\x8b\xdd\x14\xfe\x0a\x7c\x7e\x03\x47\xab\x52\x93\xb4\x10\xfc\x5c\x1f\x3d\xd9\xf9\xd1\x64\xd6\xf0\x28\x86\x60\xb5\x0f\x5c\x77\xbd\x59\x2b\x69\x88\x41\xca\xa9\xc0\xe4\x74\xa7\xa0\xa9\x42\x66\x8b\x36\x84\x0d\xd2\x21\xa8\xcf\xbc\x6d\xed\xdd\x28\xd4\x6e\x54\x9a\x69\xe8\x16\x6d\xab\x5a\xbd\x74\x87\xdf\x8d\xe7\x7e\x49\xab\xfa\xf1\xbd\xd6\x86\xd1\x7c\x3f\x21\x3e\x88\x66\x9e\xe7\x0d\xd6\xa7\xc7\x15\x85\x06\x03\x78\xc6\x49\x86\x6e\xef\xe1\xbe\x7a\x0f\x75\x76\xa3\x72\xed\xea\x03\x85\x6e\x66\xf6\xd8\x01\x61\x61\xb0\x03\x68\x2a\x21\xd0\x98\xb4\x2a\x8a\xe5\x83\x30\xef\x1d\xad\xfd\xb4\x83\x1c\x97\xe2\xce\xcb\xb6\x7c\x87\x92\x5b\x77\xff\xdb\xa5\x31\xab\x19\x63\xa8\x75\xec\xfb\x4c\xe2\xbc\x20\x89\x71\x70\xf4\x49\x1e\xb9\xab\x6c\xdd\x05\x89\x36\xa4\xf2\xee\x97\x90\xca\x59\xaa\xf4\x9c\xeb\x84\x75\x87\xaf\x67\x3f\x0c\x43\x9f\xed\x9d\x19\xbf\x27\xca\x87\x63\xb6\xfb\x9a\xa9\x9b\x38\xf8\xb5\xb9\xa9\x20\x58\xed\xad\xd7\x30\x92\x08\x63\xd8\xb6\x68\x5d\xbe\x07\xd2\x5d\x92\xee\x85\x55\x25\x4a\x4c\x7c\xe6\x35\xd2\x82\x15\x6a\x5d\x07\xab\x56\x60\xdd\x89\xef\x06\xa7\xd4\x23\xda\xdd\x05\x21\x2f\x8a\xb0\xcd\x20\x99\xf5\xab\xef\x09\xfc\x96\x0d\x3d\xe1\xce\x0f\x5e\x14\x1b\x6e\x24\xb3\x7d\x4f\x0e\xf6\xfe\xcb\x97\x9e\x1a\xdf\xe1\xce\x77\xa7\x77\xfd\x2a\xb5\x5a\x2c\x67\x5c\x97\xcd\xe3\xd4\x49\xe8\x7b\x8d\xdc\x3d\x3f\xdb\x7d\xc7\xc1\xe3\xc2\x80\x7b\x60\x22\x51\x70\x63\x22\x89\x16\xbe\x42\xa6\xb1\x84\x2f\x2e\xf6\x09\x73\xaf\xf5\x36\xc1\xfd\xe8\x08\x56\xf7\x40\xea\x33\x48\xd4\xc6\x81\x35\x27\x27\x9f\xb2\x4a\x37\xcf\xb5\x7b\x16\x77\x08\xc1\x6a\xfb\x77\xdd\x74\xc9\xfb\x56\x9b\xf6\xa2\xb7\x4a\x5d\xbb\xbc\xed\x57\xdb\x2a\xaf\xed\x55\x77\xfd\xa0\x4d\x2d\x4b\xd4\x5a\xe9\xd3\x8e\x75\xff\x4a\xf1\x60\xac\xfb\x3b\xf7\x23\x48\x29\x3d\x40\xfb\xae\xaf\x8d\xf8\x83\x41\xdd\xa8\xef\x9f\x52\x38\xeb\x93\xbf\x8b\xfd\xdf\xfa\x7f\x08\x2a\x25\x96\x28\x89\x6c\x7d\xca\x5a\x4c\xdf\xfd\x20\xf1\xfd\xfb\x27\xab\xb5\xa8\xf1\x0b\x2e\x5f\xbb\xfb\x65\x41\x16\xc6\x6c\x7d\xd3\xee\x05\x35\xdd\x35\xa7\x1b\xbc\xe7\x3f\x9d\x30\x0f\x17\x64\xe1\x98\xa5\xf4\xcf\x00\x49\x31\x8a\x1c\xa7\x0c\x00\x00"),
+		},
+		"/scripts/check_time_sync.sh": &vfsgen۰CompressedFileInfo{
+			name:             "check_time_sync.sh",
+			modTime:          time.Date(2026, 10, 19, 18, 19, 10, 239031514, time.UTC),
+			uncompressedSize: 1886,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\x5f\x6f\xdb\xc6\x13\x7c\xe7\xa7\x98\x1f\xe5\xfc\xf2\xa7\x96\x94\xf8\xad\x4e\x6c\x40\x4d\x5c\x54\xad\x21\x17\x96\xd2\x20\x40\xd1\xe2\x74\x5c\x91\x8b\x9c\xee\xce\x77\x4b\xc9\xac\x95\xef\x5e\x1c\x45\xd9\x52\xed\x00\x05\xaa\x27\x8a\x3b\x37\x3b\xb3\xb3\xc7\xde\xff\x86\x73\xb6\xc3\xb9\x8a\x55\xd6\xeb\xe1\xbd\xf3\x4d\xe0\xb2\x12\x9c\xbc\x7e\xf3\x3d\xa6\x95\xb2\x65\xa5\x18\x3f\xb3\x2d\x3f\xd4\x0e\x63\xbb\x70\x61\xa9\x84\x9d\xc5\x8c\x74\x65\x9d\x71\x65\x03\xed\x06\xc7\xb8\x94\x62\x90\xf5\x7a\x89\xe6\x92\x35\xd9\x48\x05\x6a\x5b\x50\x80\x54\x84\x91\x57\xba\xa2\x5d\xe5\x18\xbf\x51\x88\x89\xe5\x64\xf0\x1a\x2f\x12\x20\xef\x4a\xf9\xcb\xb7\x89\xa2\x71\x35\x96\xaa\x81\x75\x82\x3a\x12\xa4\xe2\x88\x05\x1b\x02\xdd\x6a\xf2\x02\xb6\xd0\x6e\xe9\x0d\x2b\xab\x09\x6b\x96\x0a\xf2\xd0\x20\x29\xc1\xe7\x8e\xc3\xcd\x45\xb1\x85\x82\x76\xbe\x81\x5b\xec\x03\xa1\xa4\x13\xdd\xfe\x2a\x11\x7f\x3a\x1c\xae\xd7\xeb\x81\x6a\x15\x0f\x5c\x28\x87\x66\x8b\x8d\xc3\xcb\xf1\xfb\x8b\xc9\xf4\xa2\x7f\x32\x78\xdd\x9d\xfa\x68\x0d\xc5\x88\x40\x37\x35\x07\x2a\x30\x6f\xa0\xbc\x37\xac\xd5\xdc\x10\x8c\x5a\xc3\x05\xa8\x32\x10\x15\x10\x97\x54\xaf\x03\x0b\xdb\xf2\x18\xd1\x2d\x64\xad\x02\x25\xa9\x05\x47\x09\x3c\xaf\xe5\x60\x68\x3b\x8d\x1c\x0f\x00\xce\x42\x59\xe4\xa3\x29\xc6\xd3\x1c\x3f\x8c\xa6\xe3\xe9\x71\x22\xf9\x34\x9e\xfd\x74\xf5\x71\x86\x4f\xa3\xeb\xeb\xd1\x64\x36\xbe\x98\xe2\xea\x1a\xef\xaf\x26\x1f\xc6\xb3\xf1\xd5\x64\x8a\xab\x1f\x31\x9a\x7c\xc6\x2f\xe3\xc9\x87\x63\x10\x4b\x45\x01\x74\xeb\x43\x72\xe0\x02\x38\x8d\x93\xda\x14\x31\x25\x3a\x90\xb0\x70\xdb\x1c\xa3\x27\xcd\x0b\xd6\x30\xca\x96\xb5\x2a\x09\xa5\x5b\x51\xb0\x6c\x4b\x78\x0a\x4b\x8e\x29\xd6\x08\x65\x8b\x44\x63\x78\xc9\xd2\xee\x4b\x7c\xec\x6b\x90\x65\x3d\xcc\x52\xb0\x51\x07\xf6\x02\x1f\xd8\x4a\x6c\x21\xc2\x4b\x42\x6c\xac\xae\x82\xb3\xfc\x57\x4b\x81\x28\x4a\x68\x17\xa0\x75\x05\xa5\x36\x0f\xf0\xbd\xc2\xa0\x65\xee\xde\x73\xdc\x32\x53\x01\x25\x30\x2a\x0a\xa2\x83\x54\x4a\xc0\xf2\x3c\x22\xaa\xa5\x37\xa9\x18\xa1\x8d\x4b\x3b\x11\xe1\x5d\x8c\x9c\x22\x94\x84\x24\x2c\xdd\x92\xac\xa4\xc7\xac\x07\xed\xac\x04\x67\x0c\x05\x04\xd2\xc4\x2b\xda\x8a\x76\xb5\xf8\x5a\x52\xef\xed\xd3\x69\xd6\x03\x10\x29\xac\x58\xd3\xd9\xbb\xd6\x4b\x53\x6c\xac\xf8\x62\x13\x9b\x28\xb4\x2c\xfa\x49\x61\xf2\x59\x6c\xac\xb3\x74\xbe\x3d\x71\xef\x9b\x8a\xb3\x77\x0d\xc5\x8d\x75\xdb\x4a\x42\x9f\xbd\x8b\xa4\x9d\x2d\x22\x22\xa7\xdd\x27\xef\x74\x75\x9e\x65\x5d\x9f\x3f\x95\x16\x5e\xd1\x8b\x97\xb8\xcb\xd2\x4a\x6f\x1b\x69\x31\xe0\xd8\xdf\xd6\xd0\xef\xdf\xd4\x4c\x82\xfc\xe8\x4d\x8e\x73\x0c\x0b\x5a\x0d\x6d\x6d\x0c\x4e\xce\xff\xff\x06\x9b\x0d\x7c\x19\xc8\xa3\x7f\xfb\x34\x22\xfb\x7a\xdf\xed\x2c\xa9\xce\x0e\x04\x5b\x97\xf1\x02\x87\x6a\xd0\x79\x7f\x9b\xe6\x64\x33\xec\x8d\xa5\xab\xb4\xef\x7a\xa9\x0c\x43\xca\xb7\x59\xd7\x11\x1c\x91\x4f\x9c\x3c\x8c\x24\x52\x91\xa3\xb6\xc2\x66\xc7\x89\x4a\x45\x44\x32\xa4\xd3\xed\x51\x88\xae\x0e\x9a\x5a\x3e\x5e\x74\x20\x0d\x09\x4a\x7f\x49\x5b\x7a\xb2\xef\x66\x83\xad\xd1\x1b\x3c\xff\xe3\x72\xaf\xed\xab\x53\x4c\xd2\x97\xce\x3c\xdf\x53\x8c\x7f\x46\xd3\x50\x6c\x0b\x0b\xce\xc8\x3c\xf6\x9c\x72\x7e\xc2\x70\x7a\xbd\x53\x67\xc5\xa7\x8e\x4f\x66\x60\xc5\xdf\xa0\xef\xed\xb7\x15\xff\xfe\xea\xbf\xc8\x7b\xb4\x81\x4f\x68\x7d\x84\xe9\x62\x72\x26\x7d\xd8\x57\xdb\x8f\x78\x6c\xaf\x24\x2f\xa9\x50\x42\x69\xd1\xda\xdb\x86\x7c\x32\xfb\xf5\x40\x50\x0e\xb6\x51\x48\x15\x09\x9f\x4f\x5b\xea\x74\xe3\xf4\x97\x43\xd8\x6e\x38\xfb\x94\x5d\x2e\xdf\x1a\xc5\xfe\xf9\x53\x34\x14\xff\xed\x60\x16\x9c\x65\xa4\x2b\x87\x7c\x67\xf9\xe8\xae\x7b\xfa\x9a\xef\x2a\xfb\x67\x8f\xee\xf6\xff\xde\x63\x92\xd6\xb3\xa3\x17\x49\x2e\xbe\x7b\x16\x07\xcf\x26\x2f\xf3\xec\xef\x01\x00\x70\xf5\x80\x0a\x5e\x07\x00\x00"),
+		},
+		"/scripts/check_vip.sh": &vfsgen۰CompressedFileInfo{
+			name:             "check_vip.sh",
+			modTime:          time.Date(2026, 10, 19, 19, 13, 7, 191278409, time.UTC),
+			uncompressedSize: 2137,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x55\x6b\x6f\x1a\x47\x17\xfe\x3e\xbf\xe2\xc9\x82\x12\xf3\x06\x16\xe3\xf7\x53\x6d\x63\xc9\xcd\x45\xa5\x8d\xb0\x14\x48\xa3\x28\xaa\xac\x61\xf7\xec\xee\x91\x97\x99\xc9\xcc\x00\x46\x36\xff\xbd\x9a\xbd\x60\xb0\xdb\xd4\xb2\x04\xda\x39\xe7\xb9\x9c\xf3\x0c\xdb\x79\x35\x5c\xb0\x1a\x2e\xa4\x2b\x44\xa7\x83\x77\xda\x6c\x2d\xe7\x85\xc7\xd9\xe9\xe8\x17\xcc\x0a\xa9\xf2\x42\x32\x7e\x67\x95\xbf\x5f\x69\x4c\x54\xa6\xed\x52\x7a\xd6\x0a\x73\x4a\x0a\xa5\x4b\x9d\x6f\x91\xe8\xb8\x8f\x4f\x3e\x8d\x45\xa7\x13\x60\x3e\x71\x42\xca\x51\x8a\x95\x4a\xc9\xc2\x17\x84\x6b\x23\x93\x82\xda\x93\x3e\xfe\x24\xeb\x02\xca\x59\x7c\x8a\x93\x50\x10\x35\x47\x51\xef\x22\x40\x6c\xf5\x0a\x4b\xb9\x85\xd2\x1e\x2b\x47\xf0\x05\x3b\x64\x5c\x12\xe8\x3e\x21\xe3\xc1\x0a\x89\x5e\x9a\x92\xa5\x4a\x08\x1b\xf6\x05\xfc\x13\x41\x50\x82\x6f\x0d\x86\x5e\x78\xc9\x0a\x12\x89\x36\x5b\xe8\xec\xb0\x10\xd2\x37\xa2\xab\xbf\xc2\x7b\x73\x3e\x1c\x6e\x36\x9b\x58\x56\x8a\x63\x6d\xf3\x61\x59\xd7\xba\xe1\xa7\xc9\xbb\x0f\xd3\xd9\x87\xc1\x59\x7c\xda\x74\x7d\x51\x25\x39\x07\x4b\x3f\x56\x6c\x29\xc5\x62\x0b\x69\x4c\xc9\x89\x5c\x94\x84\x52\x6e\xa0\x2d\x64\x6e\x89\x52\x78\x1d\x54\x6f\x2c\x7b\x56\x79\x1f\x4e\x67\x7e\x23\x2d\x05\xa9\x29\x3b\x6f\x79\xb1\xf2\x47\x43\x6b\x35\xb2\x3b\x2a\xd0\x0a\x52\x21\xba\x9e\x61\x32\x8b\xf0\xeb\xf5\x6c\x32\xeb\x07\x90\xaf\x93\xf9\x6f\x37\x5f\xe6\xf8\x7a\xfd\xf9\xf3\xf5\x74\x3e\xf9\x30\xc3\xcd\x67\xbc\xbb\x99\xbe\x9f\xcc\x27\x37\xd3\x19\x6e\x3e\xe2\x7a\xfa\x0d\x7f\x4c\xa6\xef\xfb\x20\xf6\x05\x59\xd0\xbd\xb1\xc1\x81\xb6\xe0\x30\x4e\xaa\xb6\x88\x19\xd1\x91\x84\x4c\xd7\x7b\x74\x86\x12\xce\x38\x41\x29\x55\xbe\x92\x39\x21\xd7\x6b\xb2\x8a\x55\x0e\x43\x76\xc9\x2e\xac\xd5\x41\xaa\x34\xc0\x94\xbc\x64\x5f\xe5\xc5\xbd\xf4\x15\x0b\xd1\xc1\x3c\x2c\xd6\x25\x96\x8d\x47\x52\x50\x72\xe7\xaa\x92\x3b\x22\x23\x4b\x5e\x53\x8a\x35\x1b\x24\x52\x61\x41\x21\x09\x29\xb4\xaa\x2a\x96\xd2\x79\xb2\x58\x50\xa6\x2d\xa1\x90\xc6\xea\xfb\x6d\xe0\x3d\xec\x95\x96\x90\x92\x29\xf5\x96\xd2\x73\xd1\xa9\x1a\x15\xf9\x8d\xb6\x77\x60\xe5\xc9\x66\x32\xa1\xb0\x98\x05\xab\xb4\x3a\x0d\x6c\x74\xcf\xce\xbb\x3e\xe4\xc1\x33\x0e\x06\x6a\x7e\x8b\x82\xca\x6a\xd5\xcf\x94\x6a\x05\xad\xa8\x49\x98\xe8\x34\x12\x5d\x8c\xf9\x13\x48\xdb\x2a\x9b\x53\x70\x06\xf6\x6f\x1c\xa4\x73\x9c\xab\x3a\x26\x55\xda\x95\x4e\xa9\x1f\xd8\xd8\x43\x2a\xb7\x21\xeb\x5a\xce\xa5\x4c\x02\x4b\x8b\x11\x8b\x0e\x56\x4e\xe6\x74\x5e\x8f\xf0\x76\xcd\x26\x76\x05\x2e\xd7\x6c\xae\x70\xb9\x37\x7a\x85\xcb\x96\xd4\x5c\xc5\x71\x2c\x44\x8c\x92\x17\xb1\x2b\x84\x58\xb3\x19\x77\x47\x62\x5f\x3b\xee\x9e\x09\x57\x70\xe6\x71\x26\xea\xa6\x5b\x36\x6e\x1c\x75\xff\x17\x89\xca\x5a\x72\xab\x33\x18\xcb\xca\xbb\x43\x4d\xe1\x6b\x70\xaa\x9a\x59\x73\x5e\x2c\x42\x76\xaa\xfb\xd0\x9e\xb7\x24\xa2\x86\x39\xe9\xe1\x41\x84\xeb\xc7\xa6\xee\x80\x2b\xf4\x06\x51\x77\x14\x21\xa5\x35\xa2\xee\xc3\xbe\x65\x17\xe1\x11\x72\x73\x87\x37\x0f\x21\x93\x27\x8c\x31\x46\x17\x60\x5c\x62\xfa\xf1\x02\xfc\xf6\x6d\x2f\x8c\xf4\xa4\xcb\x18\x8f\x11\x95\xa5\x4c\x53\x1b\xf5\x6a\xa5\xe8\x9e\x30\xde\x62\xd4\xdb\xbd\x11\xbb\x60\xa3\xd4\x89\x2c\x6f\x43\xc0\xc2\xdc\x1c\xf8\xc9\xc0\xbf\x6c\x44\xb4\x2d\x87\xa2\x07\x1a\x81\xa6\x96\xfd\x88\xdc\x92\xc1\xe0\x07\x22\x56\xe4\xd1\x1d\x0d\xa3\xc0\xc6\x06\x25\xab\xbb\xba\xe8\x1f\x6c\x5d\x61\x98\xd2\x7a\xa8\x56\x65\x89\xb3\xab\xd7\x23\x3c\x3e\x82\xac\xd5\xf6\x96\xee\xd9\x23\x7a\x99\xdb\xc3\xfe\x20\x38\xfc\x58\x66\x7a\xa5\xd2\x48\x08\xce\x9e\xcc\x45\xdd\x87\x35\x9b\x5d\x74\x11\xa6\xaf\x2a\xd1\x94\x14\x1a\x51\x08\x7b\x7d\x76\x18\xcd\xbd\xd7\xa8\x2e\x0d\xec\xa7\x22\xe3\x0a\xf4\x15\x4c\xb8\xeb\x83\x04\xff\xc7\xe0\x2b\x46\x18\x4c\x9e\x1b\x69\xe9\x5e\x38\xfa\x0f\xfe\xfa\x7e\xbd\x20\x5d\xb3\xb9\x5d\xca\x64\xdc\x3d\x69\x42\xd7\xe2\xf7\x44\x48\xc0\x3e\x9c\x21\x73\xdd\x87\xa7\xac\xee\x2e\x90\xea\x0a\xec\xd9\x2c\xf6\x25\x47\x13\x09\xff\x89\x56\x9e\xd5\x8a\xaa\x07\x19\x57\x1f\xad\xdd\xd1\x4f\xec\x1e\x20\xbe\x30\xdd\x2a\xf8\x8e\x81\x6a\x46\x13\xec\xec\x22\xfc\x85\xd7\xaf\xf1\x1d\xd1\xa1\xb1\x03\xa4\x5e\x54\x25\xf8\xa8\xe3\x99\xde\x9f\x6d\xb1\xb9\xe9\x47\x90\x4f\x8d\xf5\x4e\x1b\x9b\xa9\x56\x24\xc4\x61\xd6\x9e\x2d\xc6\x35\x6f\x36\xa5\xab\x77\x46\xa1\x9d\xaf\x5f\xb9\xe1\xd2\xef\x05\x9e\x0f\x56\xea\x4e\xe9\x8d\xda\x45\xe2\xef\x01\x00\xfe\x17\xf7\xed\x59\x08\x00\x00"),
+		},
+		"/scripts/clean_node.sh": &vfsgen۰CompressedFileInfo{
+			name:             "clean_node.sh",
+			modTime:          time.Date(2026, 10, 19, 19, 58, 28, 442366335, time.UTC),
+			uncompressedSize: 3306,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\x6d\x4f\x1b\x39\x10\xfe\xbe\xbf\x62\x9a\x70\x14\x24\x36\x01\x3e\xdd\xb5\xa2\x52\x0a\x39\x5d\xee\x10\x54\x24\x6d\x55\xf5\x4e\xc8\xb1\x67\xb3\x23\xbc\xf6\xd6\xf6\x26\x44\x85\xff\x7e\x1a\x67\x5f\x92\xc2\xd1\x6b\xfb\x21\x5d\x3f\x7e\xe6\x99\x77\xf7\x5f\x0d\xe7\x64\x86\x73\xe1\xf3\xa4\xdf\x87\x73\x5b\xae\x1d\x2d\xf2\x00\xa7\xc7\x27\xbf\xc1\x34\x17\x66\x91\x0b\x82\x3f\xc9\x2c\x2e\x2a\x0b\x13\x93\x59\x57\x88\x40\xd6\xc0\x0c\x65\x6e\xac\xb6\x8b\x35\x48\x3b\x38\x82\xcb\xa0\x06\x49\xbf\xcf\x34\x97\x24\xd1\x78\x54\x50\x19\x85\x0e\x42\x8e\x30\x2a\x85\xcc\xb1\x39\x39\x82\x4f\xe8\x3c\xb3\x9c\x0e\x8e\xe1\x80\x01\xbd\xfa\xa8\x77\xf8\x96\x29\xd6\xb6\x82\x42\xac\xc1\xd8\x00\x95\x47\x08\x39\x79\xc8\x48\x23\xe0\xbd\xc4\x32\x00\x19\x90\xb6\x28\x35\x09\x23\x11\x56\x14\x72\x08\x9d\x01\x56\x02\x5f\x6a\x0e\x3b\x0f\x82\x0c\x08\x90\xb6\x5c\x83\xcd\xb6\x81\x20\x42\x2d\x3a\xfe\xc9\x43\x28\xdf\x0c\x87\xab\xd5\x6a\x20\xa2\xe2\x81\x75\x8b\xa1\xde\x60\xfd\xf0\x72\x72\x3e\xbe\x9a\x8e\xd3\xd3\xc1\x71\x7d\xeb\xa3\xd1\xe8\x3d\x38\xfc\x56\x91\x43\x05\xf3\x35\x88\xb2\xd4\x24\xc5\x5c\x23\x68\xb1\x02\xeb\x40\x2c\x1c\xa2\x82\x60\x59\xf5\xca\x51\x20\xb3\x38\x02\x6f\xb3\xb0\x12\x0e\x59\xaa\x22\x1f\x1c\xcd\xab\xb0\x13\xb4\x46\x23\xf9\x1d\x80\x35\x20\x0c\xf4\x46\x53\x98\x4c\x7b\xf0\x7e\x34\x9d\x4c\x8f\x98\xe4\xf3\x64\xf6\xc7\xf5\xc7\x19\x7c\x1e\xdd\xdc\x8c\xae\x66\x93\xf1\x14\xae\x6f\xe0\xfc\xfa\xea\x62\x32\x9b\x5c\x5f\x4d\xe1\xfa\x77\x18\x5d\x7d\x81\xbf\x26\x57\x17\x47\x80\x14\x72\x74\x80\xf7\xa5\x63\x0f\xac\x03\xe2\x70\x62\xcc\x22\x4c\x11\x77\x24\x64\x76\x93\x47\x5f\xa2\xa4\x8c\x24\x68\x61\x16\x95\x58\x20\x2c\xec\x12\x9d\x21\xb3\x80\x12\x5d\x41\x9e\xd3\xea\x41\x18\xc5\x34\x9a\x0a\x0a\xb1\x5e\xfc\x53\xbf\x06\x49\xd2\x87\x19\x27\xd6\x4b\x47\x65\x00\x87\x85\x5d\xa2\x87\x55\x2e\x02\x08\x28\x1d\x2e\xc9\x56\x1e\xee\xaa\x39\x3a\x83\x01\xa3\x4c\x0c\x52\x01\x19\x1f\x84\xd6\x91\x1a\x34\x66\x01\xac\x89\xdc\xc6\x2a\x3c\x02\xa1\x75\xfc\x9f\x12\x41\x24\xfd\x26\xe7\x2d\xa1\xd4\x95\x0f\xe8\x80\x3c\x68\xeb\x03\xe7\x02\x88\xed\x67\x95\x47\xcf\x89\x72\x95\x89\x45\x65\xab\x10\x89\xf0\x9e\x93\x4a\x01\xa4\x35\x19\xd5\x3d\x30\x48\xfa\x50\x79\xb1\xc0\x37\x20\x35\x0a\x73\xcb\xc6\x07\x3e\x87\x34\xad\x61\x49\x32\x00\x4d\xf3\x5b\x87\x9e\x54\x85\x03\x9f\x27\x09\x65\xf0\x15\x7a\x7b\x27\x3d\x78\x75\x06\xbd\x16\xda\x83\x7f\xde\xb2\x29\x93\x70\x21\xa2\xcc\x2d\xf4\x36\x82\x58\x4f\xe4\x6f\x1d\x6c\xa5\x6d\xdd\x7e\xb7\x7f\xba\xb9\x79\x4f\x01\x4e\x92\x8c\x92\x84\x93\xe6\xd1\x2d\x49\x22\x57\xde\xde\xf7\x9b\xf1\x74\x72\xf1\x71\x7c\x3b\x1d\xdf\x7c\x9a\x9c\x8f\xa7\x8f\x6f\x41\xd9\x78\x8b\x32\xf0\x6b\x1f\xb0\x90\x41\x03\xf9\x54\xc8\x40\x4b\x84\x34\xfd\x56\x11\x06\xe8\xed\x7d\xaf\x89\x1e\x7b\xf0\x0e\x86\x0a\x97\x43\x53\x69\x0d\xa7\xef\xf6\x4f\xe0\xe1\x61\xf7\x32\x1a\xae\x7e\xf5\xff\x6e\x6f\x39\xcd\xff\x3a\x22\x45\x9e\x69\x20\x4d\x8d\x5d\xfd\x44\xc1\xfe\x7e\x1d\x31\x1f\x6c\x59\xa2\x6a\xdd\xde\xba\x15\x4d\x64\x94\x28\x6b\x30\x66\x41\xda\xa2\x10\x46\x41\xba\x8c\x15\x26\x54\xf1\x92\xb8\x06\xe2\xd0\x63\x80\x34\x7b\x41\xc4\x06\x32\x5f\x37\x77\x7a\x31\x1b\x7d\xe6\xe2\xfa\x09\xce\x6a\x28\xb5\x30\x08\xae\x32\x1e\x84\x07\xcf\x4d\x22\xa1\xb4\xca\x1f\x31\x8c\x5c\x04\x0a\x32\xe8\x3c\xac\x6c\xa5\x15\xcc\x71\x53\xe8\x22\xe3\xd2\x65\x6a\x8d\x81\x4b\xb8\xf6\xf9\x07\x97\x94\x95\x77\xe8\x5e\xf2\xa8\xb3\x70\xb6\x77\x50\xc3\x4b\x0f\xa9\xf8\x06\x69\x9a\x91\x66\x33\x46\x14\x78\x76\xf7\xab\xbf\x85\xd3\x2d\xa6\xc3\xa6\x66\xbe\x42\x6a\x38\x35\x1d\xd5\xe3\x6e\x1d\xf3\xdf\x9a\xda\x15\x1c\xb5\x1d\xec\x8b\x31\xe4\x59\xa0\xb6\x7b\xbf\xbb\xd8\xe6\x32\xa3\x1f\xbc\x96\x8e\xb8\x76\x9e\xe3\xfd\x0a\xe9\x14\x86\xae\x32\xc3\x96\x48\x6d\xfd\x1c\x78\x2b\xef\x76\xb4\xd7\x5c\x69\xea\x2a\x13\xa8\xc0\x14\x8d\x2a\x2d\x99\x00\x95\xa1\xfb\x37\xc3\xe1\xcf\xc8\x5c\x51\x42\x9a\xf2\x2c\x4a\xd3\xcc\x3a\x89\xcf\xe9\x6a\xc3\xf4\x9f\x7e\x73\x55\xf0\x08\xeb\xe8\x7b\x6d\x7b\x97\xce\x4a\x1e\xdf\xdc\xde\x07\xf5\x94\xb9\xad\x3f\xa2\x3f\x6c\xfb\xbb\xbc\x23\x96\x71\xcf\xc9\xaa\x8f\x1f\x7b\x5d\xb4\xf9\x14\x55\xcb\xb6\x85\xa9\xdb\x65\x53\xbc\x4b\xab\xab\x82\xa7\x70\x16\x4b\x15\x8a\xca\x07\xae\xcb\xca\x14\xb6\x32\xbc\xbc\xe6\x98\x59\xb7\x59\x1d\x4d\x85\x2a\x72\x28\x83\x75\x6b\xae\xd5\xda\xbd\x58\xe4\xa0\x10\x4b\xf4\x01\x32\x72\x3e\xc4\x69\x15\x69\x76\x9d\x89\x9f\x3c\x3c\x80\xb7\x2e\x40\xea\x3a\x9f\xaa\x78\xc4\x1e\xc5\x1f\xcf\xcd\x85\x87\x87\x06\x95\xea\x6d\x60\xeb\x78\xa7\xbc\x3d\xac\x3d\x66\x39\x8a\xdc\xae\x98\xc6\x17\xda\x8e\x2d\x17\xb6\xcb\x98\x5e\x91\xdb\x26\x6f\x72\xd9\x45\xa0\x86\xd4\x26\xba\x06\x6a\x0d\x50\x19\x78\xe8\xf9\xc3\xdd\x36\xea\x43\x73\x90\x3a\xf4\x81\x43\xec\xb0\xd4\x42\xa2\x6f\x77\x9d\xab\x34\xfa\xb8\xca\x8c\x0d\x39\x2f\xe4\x18\xef\x88\x56\xdc\xac\x2d\x08\xa4\x30\xaf\x63\xe2\xbc\xe0\x64\xd4\x26\x2c\x3f\x08\x56\xe4\xb1\x43\x62\x68\x76\x27\xaf\xb6\x6e\x10\x65\xba\xf2\x39\xaa\x66\x0c\x44\xd6\xb3\xbd\x83\x56\x24\xf3\x1e\xd6\x4d\xc7\xfe\x7d\x8f\x88\x67\x66\x43\x7f\x4b\x95\xc3\x0c\x9d\x63\xe1\xc1\xc6\xcf\x32\x17\xc4\xf3\xd1\x21\x28\x17\x67\x1c\x04\xbb\x40\x96\xd9\xbd\xf8\x36\xa0\x96\xb0\x74\x64\x42\x06\xaf\x7f\xf1\x7f\x9b\xd7\xdb\x96\x1f\x60\xe1\xb0\xe4\x61\x9f\x8e\xa1\xd7\xed\xc2\xc9\x87\xd9\xe8\xfd\xe5\x78\x7a\xfb\x61\x34\x9b\x8d\x6f\xae\x22\xf4\x49\xb4\xb7\xfa\xf4\x69\xaf\x36\xe8\x5a\xcb\x66\x3a\xa1\xf6\xd8\xde\xd9\xe0\x33\x41\xbc\x16\x83\x05\x8e\x4f\x74\xb1\xbd\x5a\x67\xef\x07\xb7\xf9\xf9\x5b\x5b\xe9\x36\xfc\x73\x73\x8f\xca\xa5\xff\xc9\x02\x6b\x20\x69\xca\x8f\x89\xa7\x9b\xa1\x9d\x29\x64\x02\xba\x4c\x48\xdc\xad\xfd\xf6\xf3\x56\xe9\x53\x09\x9a\xcc\x1d\x28\xd4\x18\x90\xc3\xda\xa2\x5e\x5c\xd3\x6d\xe4\x1a\x34\xec\xdc\xac\xfb\xa3\xde\x98\x39\xca\xbb\x38\x3b\x2a\x03\x62\x11\x9f\xf2\x71\x05\xb2\x1b\xfc\xf0\xdc\x0c\x13\x87\xbe\xd2\x71\x1f\x2a\x94\xa4\x78\x16\xad\x81\x42\x82\xf7\x14\xe0\x38\xf9\x77\x00\x7e\x34\x45\x08\xea\x0c\x00\x00"),
+		},
+		"/scripts/create_bootstrap_token.sh": &vfsgen۰CompressedFileInfo{
+			name:             "create_bootstrap_token.sh",
+			modTime:          time.Date(2026, 10, 19, 19, 8, 32, 351063318, time.UTC),
+			uncompressedSize: 1425,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x53\x5d\x6f\xdb\x46\x10\x7c\xe7\xaf\x98\x50\x06\xda\x02\x12\xe5\xf8\xad\xf9\x30\xa0\x58\x0e\xca\xc6\x91\x00\x4b\x6e\x90\x27\xe1\x74\x5c\x92\x5b\x9f\xee\xd8\xbb\x65\x64\xb5\xf1\x7f\x2f\x8e\xa4\x1b\x5b\x8d\x11\x3d\x89\x77\xb3\xb3\x33\xb3\x7b\xa3\x17\xd3\x2d\xdb\xe9\x56\x85\x3a\x19\x8d\x70\xe1\x9a\x83\xe7\xaa\x16\x9c\x9d\xbe\xfc\x15\xab\x5a\xd9\xaa\x56\x8c\xdf\xd9\x56\xf3\xd6\x21\xb7\xa5\xf3\x3b\x25\xec\x2c\xd6\xa4\x6b\xeb\x8c\xab\x0e\xd0\x2e\x1b\xe3\x4a\x8a\x2c\x19\x8d\x22\xcd\x15\x6b\xb2\x81\x0a\xb4\xb6\x20\x0f\xa9\x09\xb3\x46\xe9\x9a\x1e\x6e\xc6\xf8\x83\x7c\x88\x2c\x67\xd9\x29\x7e\x8e\x80\x74\xb8\x4a\x7f\x79\x1d\x29\x0e\xae\xc5\x4e\x1d\x60\x9d\xa0\x0d\x04\xa9\x39\xa0\x64\x43\xa0\x3b\x4d\x8d\x80\x2d\xb4\xdb\x35\x86\x95\xd5\x84\x3d\x4b\x0d\xf9\xd6\x20\x2a\xc1\xe7\x81\xc3\x6d\x45\xb1\x85\x82\x76\xcd\x01\xae\x7c\x0c\x84\x92\x41\x74\xf7\xab\x45\x9a\x57\xd3\xe9\x7e\xbf\xcf\x54\xa7\x38\x73\xbe\x9a\x9a\x1e\x1b\xa6\x57\xf9\xc5\xe5\x62\x75\x39\x39\xcb\x4e\x87\xaa\x1b\x6b\x28\x04\x78\xfa\xab\x65\x4f\x05\xb6\x07\xa8\xa6\x31\xac\xd5\xd6\x10\x8c\xda\xc3\x79\xa8\xca\x13\x15\x10\x17\x55\xef\x3d\x0b\xdb\x6a\x8c\xe0\x4a\xd9\x2b\x4f\x51\x6a\xc1\x41\x3c\x6f\x5b\x79\x12\xda\x83\x46\x0e\x4f\x00\xce\x42\x59\xa4\xb3\x15\xf2\x55\x8a\x77\xb3\x55\xbe\x1a\x47\x92\x4f\xf9\xfa\xb7\xe5\xcd\x1a\x9f\x66\xd7\xd7\xb3\xc5\x3a\xbf\x5c\x61\x79\x8d\x8b\xe5\x62\x9e\xaf\xf3\xe5\x62\x85\xe5\x7b\xcc\x16\x9f\xf1\x21\x5f\xcc\xc7\x20\x96\x9a\x3c\xe8\xae\xf1\xd1\x81\xf3\xe0\x18\x27\x75\x53\xc4\x8a\xe8\x89\x84\xd2\xf5\x73\x0c\x0d\x69\x2e\x59\xc3\x28\x5b\xb5\xaa\x22\x54\xee\x0b\x79\xcb\xb6\x42\x43\x7e\xc7\x21\x8e\x35\x40\xd9\x22\xd2\x18\xde\xb1\x74\xfb\x12\xfe\xef\x2b\x4b\x92\x11\xd6\x71\xb0\x41\x7b\x6e\x04\xda\x93\x12\x0a\x1d\x66\xeb\x9c\x04\xf1\xaa\x81\xb8\x5b\xb2\xdf\x26\x2c\x62\x86\x04\xd8\xb2\xb0\x32\xfc\x37\x15\xd8\xa9\x20\xe4\x63\xa8\x5d\xf5\xde\xf9\x5b\xf2\x01\x5a\x59\xfc\xe9\xd8\x26\xa3\xee\x58\x9b\x36\xc2\xe2\x94\x58\x32\xe4\x82\xd0\x6a\x4d\x54\x04\x70\xbf\x16\x7d\x33\x65\x3c\xa9\xe2\x00\xba\xe3\x20\x61\x0c\xb6\xda\xb4\x45\xf4\xb8\xaf\xc9\x82\xe5\xa7\x30\x88\xed\x27\x6e\x5d\xcc\x32\x36\x51\xe1\x16\x4a\x3a\xaa\xa0\x76\x04\xe1\x1d\x65\xc9\x08\x6d\x50\x15\xbd\x1a\x8a\x36\xff\x99\xdb\x74\xfd\xb2\x50\xe3\xcd\x91\xe1\x73\xbc\x11\x31\xe7\x49\x92\xc1\xf0\x36\x0b\x75\xff\x67\x73\xdb\x6e\x49\x15\xbb\x78\x90\x74\xc0\xb7\x27\x2f\x13\x11\xf3\xf6\xe4\x2c\x49\x84\x82\x60\x52\x22\x3d\xf9\xe7\xc3\xcd\xbb\xcb\xd9\xfc\xe3\x66\x36\xff\x98\x2f\x36\x17\xcb\xc5\xfb\xfb\x14\x5f\xbf\x82\xbc\x77\x7e\x43\x77\x2c\xcf\xa0\xc0\xa1\x7b\x75\xa5\x6b\x6d\x31\xee\x9c\xf4\xe1\x3e\x5c\x3c\x8a\x3d\x4d\x12\x2e\x71\xe4\x26\x92\x07\x09\x91\xbe\xfb\xbe\x4f\x5f\x47\x16\x9b\xc4\x07\x46\xba\x76\x48\x8f\xbc\x1e\xe5\x9d\xf6\xc8\x28\xf1\x34\x29\xb9\xeb\xf1\x02\x83\xf1\xa1\xa2\x0f\xf2\x51\x0f\x4c\x26\x71\x33\xe2\x81\x98\xfb\x14\x93\x49\x2c\xd0\xce\x96\x5c\x3d\x9b\xc7\x39\xa6\x05\x7d\x99\xda\xd6\x98\x47\x1a\x7f\xe8\xe7\x38\xc7\x52\xb1\xe9\xdf\xf7\x20\xeb\x3b\x0b\x9c\x46\x27\xdf\x77\xcf\x01\xda\x93\x12\x2a\xd2\xe4\xdf\x01\x00\x6c\xcc\xcd\x8e\x91\x05\x00\x00"),
+		},
+		"/scripts/deploy_etcd.sh": &vfsgen۰CompressedFileInfo{
+			name:             "deploy_etcd.sh",
+			modTime:          time.Date(2026, 10, 19, 18, 56, 30, 618542917, time.UTC),
+			uncompressedSize: 4571,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x6d\x6f\xdb\x38\x12\xfe\xce\x5f\x31\x95\xbd\x69\x52\x58\x76\x9a\x6e\xba\x77\x69\x14\x20\xdb\xa4\x38\xdf\x05\x09\x10\xbb\x57\x2c\x7a\x39\x83\xa6\x46\x16\x61\x89\x54\x49\x2a\x8e\xe0\xf8\xbf\x1f\x86\x96\xfc\x16\x37\x07\x1c\x6e\x03\x03\x0e\xc9\xe1\xbc\x3c\xf3\xcc\x70\xdc\x7a\xd3\x1b\x4b\xd5\x1b\x73\x9b\xb2\x56\x0b\x3e\xeb\xa2\x32\x72\x92\x3a\x38\x39\x7e\xff\x57\x18\xa4\x5c\x4d\x52\x2e\xe1\xef\x52\x4d\xae\x4a\x0d\x7d\x95\x68\x93\x73\x27\xb5\x82\x21\x8a\x54\xe9\x4c\x4f\x2a\x10\xba\xdb\x81\x1b\x17\x77\x59\xab\x45\x6a\x6e\xa4\x40\x65\x31\x86\x52\xc5\x68\xc0\xa5\x08\x97\x05\x17\x29\x36\x27\x1d\xf8\x27\x1a\x4b\x5a\x4e\xba\xc7\x70\x48\x02\x41\x7d\x14\x1c\x7d\x22\x15\x95\x2e\x21\xe7\x15\x28\xed\xa0\xb4\x08\x2e\x95\x16\x12\x99\x21\xe0\x93\xc0\xc2\x81\x54\x20\x74\x5e\x64\x92\x2b\x81\x30\x93\x2e\x05\xb7\x36\x40\x9e\xc0\x1f\xb5\x0e\x3d\x76\x5c\x2a\xe0\x20\x74\x51\x81\x4e\x36\x05\x81\xbb\xda\x69\xff\x97\x3a\x57\x9c\xf5\x7a\xb3\xd9\xac\xcb\xbd\xc7\x5d\x6d\x26\xbd\x6c\x29\x6b\x7b\x37\xfd\xcf\xd7\xb7\x83\xeb\xf0\xa4\x7b\x5c\xdf\xfa\xaa\x32\xb4\x16\x0c\xfe\x28\xa5\xc1\x18\xc6\x15\xf0\xa2\xc8\xa4\xe0\xe3\x0c\x21\xe3\x33\xd0\x06\xf8\xc4\x20\xc6\xe0\x34\x79\x3d\x33\xd2\x49\x35\xe9\x80\xd5\x89\x9b\x71\x83\xe4\x6a\x2c\xad\x33\x72\x5c\xba\x2d\xd0\x1a\x1f\xa5\xdd\x12\xd0\x0a\xb8\x82\xe0\x72\x00\xfd\x41\x00\xbf\x5f\x0e\xfa\x83\x0e\x29\xf9\xd6\x1f\xfe\xed\xee\xeb\x10\xbe\x5d\xde\xdf\x5f\xde\x0e\xfb\xd7\x03\xb8\xbb\x87\xcf\x77\xb7\x57\xfd\x61\xff\xee\x76\x00\x77\x5f\xe0\xf2\xf6\x0f\xf8\x47\xff\xf6\xaa\x03\x28\x5d\x8a\x06\xf0\xa9\x30\x14\x81\x36\x20\x09\x4e\xf4\x59\x84\x01\xe2\x96\x0b\x89\x5e\xe6\xd1\x16\x28\x64\x22\x05\x64\x5c\x4d\x4a\x3e\x41\x98\xe8\x47\x34\x4a\xaa\x09\x14\x68\x72\x69\x29\xad\x16\xb8\x8a\x49\x4d\x26\x73\xe9\x3c\x5f\xec\xcb\xb8\xba\x8c\xb5\x60\x48\x89\xb5\xc2\x48\x9f\x53\xeb\x78\x96\x59\x40\x27\x62\x48\x8c\xce\xbd\xb8\x5f\xc9\x9c\x8c\x8d\x2b\xbf\x23\xb4\xa2\x94\xa2\x01\x53\x2a\x27\x73\xec\x90\x41\x5a\x58\x90\x0e\xb8\xf5\x52\xb6\xb2\x0e\xf3\x98\xb5\xc0\xa2\x79\x94\xa2\xd6\xb4\xe2\x0a\xaa\x47\x69\xb4\xca\x51\x39\x4f\xad\x2e\x0c\x53\x84\xb1\x54\xdc\x48\xb4\xc0\x0d\x36\x1e\x2d\x93\xd7\x2b\xad\xe9\x65\x5a\xf0\x8c\x8a\xa6\x43\x3a\x2a\x92\x62\x2d\x30\x58\x64\x5c\x60\x0c\x72\xc9\xae\xc7\x9a\xde\x3e\x73\x49\x82\xc6\xdb\x68\x02\xf2\xb1\x2c\xad\x35\x9e\x49\xe2\x90\x75\xdc\x10\x03\xb4\xca\xaa\x46\x53\xe3\x4e\x87\xb5\xbc\xe6\x52\x49\x07\xda\xec\x0d\x00\xa4\x05\x41\x35\x8b\x71\x87\x64\xa4\x7b\x6b\x7d\x05\x71\xe1\xe4\xe3\x4b\x8b\x8d\x3d\x42\x44\x97\x8e\xb5\x60\xc6\x1b\x72\x4a\x2a\x2b\x0e\x0a\x67\x90\x63\x3e\x46\xe3\xcf\xec\x8a\x08\x3f\x4a\x6d\xca\xbc\xa9\x26\x91\x95\xd6\xa1\xe9\xf8\x5a\x15\x29\x8a\xe9\x88\xb0\x1e\xa5\xc8\x33\x97\x76\x6d\x4a\xf8\xf9\x7d\x90\xae\xcb\x5a\x50\x5a\x3e\xc1\x33\x88\xb1\xc8\x74\xe5\x65\x49\xe8\x3c\xd6\x62\x8a\xe6\x79\x95\xdf\xf8\x02\xce\x3d\x5a\x17\x70\xbe\x1b\xed\x05\x63\x5d\xc8\xe4\xb8\x6b\x53\xc6\x6a\x1e\x44\xed\xf7\xcc\xcb\x47\xed\x13\x26\xb4\x4a\xe4\x24\x6a\x7f\x60\x63\xa9\x46\xb1\x34\xd1\x76\x06\x19\x61\x19\xf5\xd0\x89\x5e\x4d\x95\xfa\x9b\xb6\xe2\x6e\x0d\x14\x6b\xed\x74\x8e\x17\xa8\xcf\x52\x29\x96\xdd\xc7\x94\xca\x57\x42\x83\xf1\x8c\x6f\x83\xcc\x7c\x6f\xc0\x78\xd4\xb8\x36\x5f\xfe\xb3\xe8\xd6\x07\x75\x92\x7d\x08\xe0\xf8\x84\x32\x7a\x4e\xde\x34\x8c\xba\x08\xcf\xc7\xa5\xcc\xe2\x0b\xe6\xf8\x24\x6a\xcf\xbd\x64\xab\xf5\xee\x6c\xc1\x6a\x89\xa8\x3d\x77\x7c\xf2\xcb\x2f\xe1\xbb\x05\xab\xc9\x10\x29\xcd\x98\x43\xeb\x20\xb4\x10\xac\x8c\x06\xf0\xfc\x0c\x68\x8c\x36\x23\x7c\x92\x0e\x02\x6f\xe8\x45\x78\x2b\x79\x72\x86\xd8\x94\xe8\x52\xc5\x01\x63\xab\xe2\x18\xd5\xa6\x0f\x8f\x60\xce\xa8\x8d\x06\xed\x79\x0d\xf9\xc2\x63\x19\x40\x18\xd6\x32\x70\x72\xd1\x8b\xf1\xb1\xa7\xca\x2c\x83\x67\xe0\xb3\x29\xbc\xed\xfd\x9b\x84\x9a\x37\xe1\xac\x37\x2f\x8c\x54\x0e\xda\x1f\x16\x6f\xd9\x82\xb1\xa2\xcc\xb2\x91\x0f\x74\x65\x40\x70\x8b\x14\x49\x9d\xf6\x45\x00\x52\xf9\x03\xfa\x2c\x49\x74\xb4\x5a\xd3\x47\xe8\x3c\xe7\x2a\xa6\x40\xad\xb3\xb5\xc8\x6e\xfc\xf5\x6e\x1d\xe6\x2a\xbc\x60\x4b\x53\x23\x44\xfe\x50\x7f\x28\x50\x38\x72\xc5\x3b\xb8\x08\xe0\x02\xd6\xf1\x9d\x5c\x1c\xbc\x87\x83\x03\x30\xe8\x4a\xa3\xf6\xa9\xa1\xd8\x7e\x76\x7b\xc7\xbb\x84\xcb\xba\x11\xf9\x4b\xab\x3b\x5b\x6a\x3f\x7d\x5a\x2d\xd7\x45\xf4\x2a\x14\xc2\x99\x5d\x4b\xeb\x9b\xff\x05\x0b\xba\x1b\x86\x8a\xe7\x68\x0b\x2e\x10\xa6\x7f\xb1\x5d\xa9\x97\xd8\x58\xc8\x24\x31\x2e\xfc\x51\x4a\x74\xf0\x0c\x13\x83\x05\x84\x3f\x9e\xbe\x6c\xc6\xbb\x1f\x9c\xd7\xf5\xfe\x89\x98\xbd\xdb\x86\x6a\x53\x55\xa9\xa6\x4a\xcf\x14\xac\xc0\x69\xde\x1e\xd8\xa0\xe1\x3e\xbd\x68\xb9\x20\x1a\xd7\x18\x8e\x9a\x8e\xbe\x22\xf3\x9a\xdf\xcc\xaf\xfd\x13\x03\xba\x74\x51\xfb\x30\x9f\x3a\xcc\x0b\x08\xe3\xa3\xff\x8d\xf7\x35\xcd\x4c\xa9\x20\x0c\x4d\x0e\x61\x88\xca\x99\xaa\xd0\x54\x60\xa2\x80\xf0\x91\x90\xd4\xa5\x5b\x9c\xf5\x74\xe9\x82\x4d\x5c\xff\xb5\xa5\x89\x3e\xdb\xfd\xd3\x97\xf6\xbe\x3d\xe1\x32\x20\x6d\xbd\x7d\x70\xbc\x4a\xcd\xfd\x79\xdf\xf0\x3e\xd7\xa5\x72\x10\xb8\xaa\xc0\x68\x2c\x55\xdc\xb1\x46\x44\xcb\x00\x3a\xb1\x75\x11\x99\xed\xe8\xc2\x4f\x1c\x91\x21\x89\x33\x33\xdb\x17\xca\x46\x9c\xc1\xb4\xe0\xdc\x86\xe4\x79\x58\x27\x29\x6c\xb7\x03\x10\xc5\xff\x25\x60\x9f\x7f\x5a\xcb\x04\xbe\xc3\x1b\x08\x9f\x1a\xc8\xeb\xde\xf8\x40\xf5\xb7\xe7\x44\xb8\x2c\x80\x87\x4f\xf4\x22\xac\x93\x4c\x28\x98\xa4\x91\x0b\xd8\x3e\xaa\xae\x59\xef\x27\x5e\xb2\xb2\x1e\x6b\xfc\x58\xb5\x0a\xde\xdf\x4f\xe4\x92\x78\x75\xf0\x10\xe6\x70\xfc\xdb\xe9\xe9\x96\x33\xc1\xd6\xca\xbb\xb6\xd9\xe5\x03\xf6\x33\xe7\x50\xa4\xba\x7e\x5a\xda\xf3\xfa\x11\x58\x50\x5f\xa9\xad\x61\x1c\x6c\x56\x07\xbd\xc9\xab\xca\xf0\x28\x83\xcb\x0b\xbf\xbd\x2a\x87\xa6\x16\x1c\x5c\x90\xad\xe6\x7c\x11\xc0\xf9\xf9\xd7\xdb\xfe\x90\x7d\xff\xaa\xa4\x7b\x60\x57\xb8\x1c\x2c\xe9\x55\xf4\x0e\x4c\xb1\x0a\x1f\x79\x56\x22\x58\xa7\x0d\xb2\x2b\x2d\x4a\x9a\x9e\xfc\x84\x1a\xd1\xf0\x6f\xcf\x7a\xbd\x89\x74\x69\x39\xee\x0a\x9d\xfb\xc8\x43\xa9\xfd\x37\xbb\x4c\x1c\x9a\x48\xa1\x9b\x69\x33\x0d\xb5\xca\xa4\xc2\xae\xe3\x66\x82\x8e\x7d\xe3\xca\xd9\x9f\x9c\xb1\xef\x83\xe5\x3c\xf0\xc0\x86\xc4\x5b\xa5\x9d\x4c\x2a\x76\xbd\x7e\x68\xbf\xc8\x0c\xd7\xc3\x00\xbb\x7e\x42\x31\xa0\xd9\x2c\xda\x79\x47\xd9\x3d\xfa\x71\x22\xe2\xd9\x8c\x57\xb6\x59\x0e\x50\x44\xa7\x96\xdd\xd0\xb0\x7d\x7b\xf7\xa5\x7f\x73\x1d\x7d\x3c\x3d\xfd\xf0\x91\xb1\xef\xfd\x25\xc8\x0f\xde\x41\x8c\x7f\xaf\xa2\xbc\xcc\x9c\x0c\x4b\x8b\xa6\xf1\xcf\x43\xd6\x30\x54\x50\xb7\xb1\x3b\xb0\x06\xed\xf9\x12\xe0\x3d\x64\x4c\xb6\x65\xd7\x67\xeb\xb6\x9e\xc8\x17\xf4\xfa\xf8\xeb\xaf\x3f\xb3\xc1\x5e\xd3\xdc\x4c\x34\x15\xda\x5d\x76\x35\x43\xd7\x4b\x6a\x51\xdd\x05\xed\xc3\x17\xf3\xca\x51\x00\x6f\x22\x08\xd6\xb4\xdc\x2e\xb7\xdd\x86\xfd\xc2\x01\xaa\x9b\x46\x88\x7c\x64\x2c\xe6\x8e\x53\x41\x44\xed\xc3\xee\xd6\xa4\x75\x70\x50\xbb\xda\x9e\x5f\x0f\x3f\x5f\x8d\xae\x2e\x87\x97\xa3\xab\xfe\xfd\x22\x38\xaa\x27\x33\x45\xf2\xcd\xfd\x97\xb3\xd9\xd6\xad\xe6\x59\xb6\x48\x4f\x33\xac\xed\xb0\x7c\x1a\x4b\x03\x61\xb1\xa3\xec\xe0\x00\x44\x9a\xeb\x18\x8e\x7f\x3b\x3e\x7e\xdd\xd0\x46\xf3\x30\xc8\x1d\x02\x89\x42\x2c\x0d\x0a\xa7\x4d\x05\x9b\x77\x19\x5b\xd3\x65\x15\x6a\xd0\x9e\x6f\x8f\xb9\x4b\x1b\x9b\xc0\x2d\xc7\x6c\xea\x9a\xd2\x86\xcb\x1f\x27\xab\x41\x81\x4a\x6d\x57\xbe\x49\xe1\xbc\xde\x5c\x04\x10\x45\x10\x54\x68\xb7\x33\xb6\xd6\x1b\x73\xcc\xb5\x0a\x0d\x66\x9a\xc7\x3b\x67\xa8\xfc\x8f\x73\x6f\x68\x73\x76\xa0\x69\x6d\x47\xb4\xfe\x4d\x46\x8f\x92\x0e\xc7\x99\x16\xd3\x95\x7f\xfb\x31\x6b\x2e\x90\x54\xcd\xd8\xa2\x4e\xc7\x6b\x00\xbd\xc2\xe5\x5a\x23\x71\x19\x33\x8b\xaf\x48\x96\xaa\x86\x27\x60\x89\x64\xff\x19\x00\x2a\x73\x50\x08\xdb\x11\x00\x00"),
+		},
+		"/scripts/deploy_master.sh": &vfsgen۰CompressedFileInfo{
+			name:             "deploy_master.sh",
+			modTime:          time.Date(2026, 10, 19, 19, 6, 6, 727649754, time.UTC),
+			uncompressedSize: 2702,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\xff\x6f\xd3\xc8\x13\xfd\xdd\x7f\xc5\xc3\x89\xf4\x69\x3f\x6a\x9c\x36\xf4\x38\x5d\x4b\x2b\x85\xb6\x88\x1c\x25\x91\x48\x39\x84\x4e\xa7\x68\x63\x8f\xe3\xbd\x6e\x76\xcd\xee\xba\x69\xa0\xf9\xdf\x4f\x63\x3b\x4e\x52\x0a\xc7\x41\x7e\x58\xef\xce\x97\x37\x6f\xde\x4c\x5b\xcf\xba\x53\xa9\xbb\x53\xe1\xb2\xa0\xd5\xc2\x85\xc9\x97\x56\xce\x32\x8f\xde\xe1\xd1\x6f\x18\x67\x42\xcf\x32\x21\xf1\xbb\xd4\xb3\xcb\xc2\x60\xa0\x53\x63\xe7\xc2\x4b\xa3\x71\x43\x71\xa6\x8d\x32\xb3\x25\x62\x13\x1d\xe0\xda\x27\x51\xd0\x6a\x71\x98\x6b\x19\x93\x76\x94\xa0\xd0\x09\x59\xf8\x8c\xd0\xcf\x45\x9c\xd1\xfa\xe5\x00\x7f\x90\x75\x1c\xa5\x17\x1d\x62\x8f\x0d\xc2\xfa\x29\xdc\x3f\xe5\x10\x4b\x53\x60\x2e\x96\xd0\xc6\xa3\x70\x04\x9f\x49\x87\x54\x2a\x02\xdd\xc7\x94\x7b\x48\x8d\xd8\xcc\x73\x25\x85\x8e\x09\x0b\xe9\x33\xf8\x4d\x02\x46\x82\x4f\x75\x0c\x33\xf5\x42\x6a\x08\xc4\x26\x5f\xc2\xa4\xdb\x86\x10\xbe\x06\x5d\xfe\xcb\xbc\xcf\x4f\xba\xdd\xc5\x62\x11\x89\x12\x71\x64\xec\xac\xab\x2a\x5b\xd7\xbd\x1e\x5c\x5c\x0d\xc7\x57\x9d\x5e\x74\x58\x7b\x7d\xd0\x8a\x9c\x83\xa5\xcf\x85\xb4\x94\x60\xba\x84\xc8\x73\x25\x63\x31\x55\x04\x25\x16\x30\x16\x62\x66\x89\x12\x78\xc3\xa8\x17\x56\x7a\xa9\x67\x07\x70\x26\xf5\x0b\x61\x89\xa1\x26\xd2\x79\x2b\xa7\x85\xdf\x21\x6d\x8d\x51\xba\x1d\x03\xa3\x21\x34\xc2\xfe\x18\x83\x71\x88\x57\xfd\xf1\x60\x7c\xc0\x41\x3e\x0e\x6e\xde\x8c\x3e\xdc\xe0\x63\xff\xfd\xfb\xfe\xf0\x66\x70\x35\xc6\xe8\x3d\x2e\x46\xc3\xcb\xc1\xcd\x60\x34\x1c\x63\xf4\x1a\xfd\xe1\x27\xbc\x1d\x0c\x2f\x0f\x40\xd2\x67\x64\x41\xf7\xb9\xe5\x0a\x8c\x85\x64\x3a\xa9\xec\x22\xc6\x44\x3b\x10\x52\x53\xf5\xd1\xe5\x14\xcb\x54\xc6\x50\x42\xcf\x0a\x31\x23\xcc\xcc\x1d\x59\x2d\xf5\x0c\x39\xd9\xb9\x74\xdc\x56\x07\xa1\x13\x0e\xa3\xe4\x5c\xfa\x52\x2f\xee\xdb\xba\xa2\x20\x68\xe1\x86\x1b\xeb\x62\x2b\xcb\x9e\x3a\x2f\x94\x72\xb8\x2d\xa6\x24\x92\xf9\x41\x79\x50\xe4\x39\x5c\x79\x8e\xbd\x5a\x37\x90\x3f\xad\x26\x4f\x0e\x77\x95\x98\x0e\x38\xb8\x86\xd4\xd2\x4b\xa1\xe4\x17\x72\x7c\x11\xb4\x10\x1b\xed\xad\x51\xc8\x95\xd0\x04\xa3\xf9\x1a\xa9\xb4\xce\x63\x2e\x9c\x27\x0b\x63\xf1\xb7\x91\xba\x74\x78\x64\x3e\x5d\x36\xe9\x44\x32\xe7\xc7\x54\xce\x22\xf4\xd7\xae\x8b\x4c\xc6\x19\xa4\x0b\x5a\x10\xca\x92\x48\x96\x5b\x08\x92\x75\x64\x4a\x20\x5d\xa9\xe6\x98\x87\x8a\x92\x08\xa3\x27\x70\xf0\xc5\xd4\x18\xef\xbc\x15\x39\xbc\xb9\x25\xcd\x7e\x96\x62\x4b\x82\xc5\x21\xd3\xa0\x05\xe9\xff\xe7\x50\x52\xcd\x3a\xa2\x68\x16\x71\x1b\x59\x7f\xac\xaa\x32\xaa\x29\x9b\xab\x4d\x42\x0e\xb1\xd0\x65\x75\x2c\x4e\xe9\x23\x0c\x3c\x16\x42\x7a\xd7\x34\x55\x99\x58\x28\x88\x5c\x3a\xb2\x77\x8c\xc2\x60\x4a\x41\x0b\x19\x09\xe5\xb3\x25\x84\x2f\xcd\x48\xb3\x36\x50\x38\x31\xa3\x13\x24\x94\x2b\xb3\x9c\x54\xc0\x23\x97\xe1\x25\x97\xfd\xc0\x89\xce\xf1\x72\x97\xae\xfa\x62\xb7\x5d\xe7\x78\xf9\xa8\xd4\xf3\x20\x88\xa0\xe4\x34\x72\x59\x75\x98\xd4\x61\xf8\x22\x98\x9b\x84\xce\xda\x47\x41\x15\xf2\xac\xdd\x0b\xea\x40\x67\xed\xe7\x41\xe9\x7f\xd6\x3e\x0e\x9a\x2a\x26\x15\xfa\x2f\x67\x3c\xd3\xee\xa4\xdb\x3d\xea\xfd\x1a\x1d\x46\x87\xd1\xd1\xc9\x8b\xe3\xe3\xe7\xdd\xfa\x39\x08\x3c\x39\x8f\x8e\x43\xd8\xfe\x5a\x85\x5e\x85\x78\x78\x00\x59\x6b\xec\x84\xee\xa5\x47\xb8\x5b\x0d\x1a\xc3\x75\x4f\x53\x53\xe8\x24\x0c\x02\xd2\xae\xb0\x34\x29\xc1\xec\xed\xe3\x6b\xc0\x3b\x45\xa6\x78\xb6\x69\x6a\xf5\xc8\x71\x9d\x2f\x73\x96\xdf\xab\xf0\x94\x29\xd6\xa5\x03\xff\xd6\x19\xcb\x57\x54\xdd\xdf\xb2\x46\xa7\xe3\xbd\x42\xef\x38\x43\xa7\xc3\xb6\x35\xb2\xb0\xfd\xf5\xed\x87\x57\x57\xfd\xcb\x77\x93\xfe\xe5\xbb\xc1\x70\x72\x31\x1a\xbe\x5e\x85\x38\x47\x37\xa1\xbb\xae\x2e\x94\xc2\xc3\x43\x93\x85\x7f\xdb\x75\xa6\x42\xaa\x6a\x51\xd5\x19\x9f\xd0\x63\xd8\x78\x53\x9c\x19\x84\x8f\x9e\x99\x92\x5a\xac\x95\x65\x2a\x83\x55\x10\x38\xf2\x45\x3e\xa9\x47\xb8\xa1\x66\x7e\x9b\x48\x8b\x4e\xce\x95\xbd\x19\xbd\xbb\x5a\x75\x23\x36\xa9\x1c\xe3\x1c\x9d\xf4\xbb\x15\xed\x7a\x74\xab\xfa\x43\x4e\xc5\xda\x9e\x34\x32\x68\x72\x55\x22\x97\xe5\x99\x85\x2f\x79\x19\xb7\xf7\x1c\x7d\xc6\x8b\xc3\xfd\x53\x24\xa6\x29\x4c\xa6\xf8\x13\x61\x7b\x2f\x2e\xac\x42\xc7\xdd\xa2\xd3\x99\x8b\xfb\x8e\x97\x73\xc2\x2f\x8c\xe8\x1b\x91\xad\xc2\xfd\x10\x67\x67\x08\xcd\x6d\x88\xbf\x1e\xf5\x72\xc3\x55\xe3\xc7\x2c\xd5\xd3\xb5\xe1\x93\xff\x5b\xf2\x85\xdd\xb8\xa6\xb2\x39\x3a\x45\x94\xa3\x57\x7e\x27\x46\x53\xf0\xb8\x79\x3b\xc1\x59\x95\xcd\xf8\xa6\xbc\xa7\x7a\x98\x4b\x5d\x78\x72\x25\x49\xf5\xaa\x5d\x0f\x18\x17\x55\x4f\xd3\x2a\x0c\x82\x58\x38\xe2\x2b\x1e\xb9\x55\x08\x59\x01\xe2\xf9\xde\x6f\xf0\x94\x24\xfd\xa0\x41\xdf\x67\x61\x77\xbf\x4a\xf7\xd4\xde\xdc\x52\x99\x72\xb4\x13\x64\x0d\x99\xe1\xa0\xd3\xd9\x28\xff\x7b\xd3\xbb\x51\xf5\x26\xc1\xb7\x8b\x3e\x7c\x8a\xf4\xed\x81\x6e\x2e\x4f\x4f\xcb\x23\x6f\xba\x1f\xd2\xc1\xb4\x5c\x5f\xdd\xfc\x3b\x21\xf5\xf2\xcf\xc4\x86\x8a\xfa\x6f\x46\x89\x52\x15\xfc\xfc\x13\x8c\xb0\xd3\x7f\x64\xa4\x74\xf9\x39\x2e\xea\xb2\xff\xbf\xa9\x79\x3b\x64\xa1\x6f\xb5\x59\x68\xb0\x64\xb0\x56\xce\xb6\x2f\x39\x11\x3f\xda\x03\x8f\x46\x35\xf8\x67\x00\x6a\x9c\x1e\x07\x8e\x0a\x00\x00"),
+		},
+		"/scripts/disk_probe.py": &vfsgen۰CompressedFileInfo{
+			name:             "disk_probe.py",
+			modTime:          time.Date(2026, 10, 19, 18, 37, 27, 304282508, time.UTC),
+			uncompressedSize: 4059,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x6d\x6f\xe3\xb8\x11\xfe\xae\x5f\x31\x27\xe3\x50\x69\xab\x28\x4a\xf6\xb6\x45\xdc\xf5\x02\x49\x36\x87\xba\x97\x8d\x8b\x38\xdb\xc5\x36\x0d\x0c\x5a\x1a\x49\x73\x91\x48\x1d\x49\xc5\x51\xaf\xf7\xdf\x0b\x52\xaf\xce\xcb\xb6\x5f\x4e\x09\x62\x99\x1c\xce\x3c\xf3\xcc\x33\x24\x33\xfb\xee\xb0\x56\xf2\x70\x4b\xfc\x10\xf9\x03\x54\x8d\xce\x05\x77\x66\x70\x2e\xaa\x46\x52\x96\x6b\x38\x8e\x8e\x4e\x60\x9d\x33\x9e\xe5\x8c\xe0\x6f\xc4\xb3\x8f\xb5\x80\x25\x4f\x85\x2c\x99\x26\xc1\xe1\x06\xe3\x9c\x8b\x42\x64\x0d\xc4\x22\x0c\xe0\x52\x27\xa1\x33\x73\x66\x70\x49\x31\x72\x85\x09\xd4\x3c\x41\x09\x3a\x47\x38\xad\x58\x9c\x63\x3f\x13\xc0\x3f\x50\x2a\xe3\xe3\x38\x8c\xc0\x33\x06\x6e\x37\xe5\xfa\x7f\x71\x66\xd0\x88\x1a\x4a\xd6\x00\x17\x1a\x6a\x85\xa0\x73\x52\x90\x52\x81\x80\x8f\x31\x56\x1a\x88\x43\x2c\xca\xaa\x20\xc6\x63\x84\x1d\xe9\x1c\xf4\xe8\x3f\x74\x66\xf0\xb5\x73\x21\xb6\x9a\x11\x07\x06\xb1\xa8\x1a\x10\xe9\xd4\x0e\x98\xb6\x80\xed\x93\x6b\x5d\xcd\x0f\x0f\x77\xbb\x5d\xc8\x2c\xda\x50\xc8\xec\xb0\x68\x2d\xd5\xe1\xe5\xf2\xfc\xe2\x6a\x7d\x71\x70\x1c\x46\x76\xcd\x67\x5e\xa0\x52\x20\xf1\x97\x9a\x24\x26\xb0\x6d\x80\x55\x55\x41\x31\xdb\x16\x08\x05\xdb\x81\x90\xc0\x32\x89\x98\x80\x16\x06\xf0\x4e\x92\x26\x9e\x05\xa0\x44\xaa\x77\x4c\xa2\x33\x83\x84\x94\x96\xb4\xad\xf5\x1e\x5b\x3d\x3c\x52\x7b\x06\x82\x03\xe3\xe0\x9e\xae\x61\xb9\x76\xe1\xec\x74\xbd\x5c\x07\xce\x0c\xbe\x2c\x6f\xfe\xba\xfa\x7c\x03\x5f\x4e\xaf\xaf\x4f\xaf\x6e\x96\x17\x6b\x58\x5d\xc3\xf9\xea\xea\xe3\xf2\x66\xb9\xba\x5a\xc3\xea\x47\x38\xbd\xfa\x0a\x3f\x2d\xaf\x3e\x06\x80\xa4\x73\x94\x80\x8f\x95\x34\xf8\x85\x04\x32\x3c\xa2\xa9\x1d\xac\x11\xf7\x00\xa4\xa2\x2d\x9f\xaa\x30\xa6\x94\x62\x28\x18\xcf\x6a\x96\x21\x64\xe2\x01\x25\x27\x9e\x41\x85\xb2\x24\x65\xaa\xa9\x80\xf1\xc4\x99\x41\x41\x25\x69\xab\x11\xf5\x3c\xa9\xd0\x69\xd3\xbe\xdf\x54\x52\x6c\x6d\x8e\x0c\x34\xf1\x06\x72\x2c\x2a\x63\x2b\xa0\x44\xa6\x6a\xd9\x42\x49\x48\xdd\x9b\x18\x56\x77\xb6\xd8\x39\xc5\x39\xa0\x8e\x13\x90\x58\x10\x2a\x10\x3c\x00\xd2\xb0\x13\xf2\x5e\xb5\x5a\x68\xf5\x0c\xc7\xe1\x9f\x0d\x24\x78\x6b\x72\xb3\x2b\x4c\x0d\x50\x81\x2a\x59\x51\x80\xc4\x58\xc8\x44\x99\x88\xa4\x15\xec\x58\x61\xad\xd3\x84\x69\xa6\x1a\x1e\x1f\x28\x40\x16\xe7\x9d\x68\x4a\x53\x38\x0b\xa9\x60\x1a\x79\x6c\xc5\x34\xd8\x42\x82\x31\x25\xa8\x20\x17\x3b\x48\x99\xd2\x7d\xc4\x58\x94\x25\x69\x15\x58\xd7\x66\xb5\xc2\x5f\x6a\xe4\x9a\x58\xd1\xa2\x01\x9d\x4b\x51\x67\x79\x55\xeb\x67\x4e\x6c\x5a\x1d\x64\xce\x2a\x95\x0b\xad\xda\x1e\xab\x15\xcb\x70\xee\xcc\x00\x26\x64\x86\x55\x03\xef\x13\x92\x1f\xe0\x7d\x6a\x12\x80\x58\xd4\x5c\x7f\x80\xf7\x6d\xa0\x4f\x74\xf6\xa1\x57\x7b\xef\x76\xdf\xb0\x67\xa4\x6b\x13\xdd\x54\x14\x1b\x9c\xac\x27\x0b\x14\xfd\x1b\x0d\x61\xac\x6d\x47\xe2\x90\x90\x0c\x5a\x9e\x4c\x8f\x8a\xa2\x10\x3b\xdb\x0e\x43\xa4\x81\xa3\xc0\x38\xe5\x43\xe4\x11\x14\x7c\xa2\xb3\x09\x2f\x45\x33\xf5\x03\x82\x23\x58\x94\x2d\x87\x95\x24\xae\x95\x71\x05\x12\x55\x5d\xd8\xcd\xe0\x67\x25\xb8\x29\xb2\x19\x4d\x48\x1a\x55\xc5\x12\x99\x69\x2b\x4a\x0d\x8b\x89\x40\xc5\xff\xa0\x01\x1f\x49\xe9\xb1\x18\xbd\x51\x42\x12\x63\x2d\xa4\x91\x13\x93\x08\x12\x4b\xf1\x80\x09\xb0\x54\xa3\x04\x43\x2e\xf1\x2c\x74\x1c\x2a\x2b\x21\xb5\x0d\xd7\xbf\x0b\xd5\xbf\xa9\x66\x78\xd5\x54\xa2\xd3\xe1\xb1\x94\x89\x14\xd8\x1e\x8f\x75\x96\xa1\x32\xa1\xb7\x8d\xcd\xc5\xca\x33\x11\x71\x5d\xa2\x49\xcf\x74\xde\x16\x79\x9c\x97\x4c\xde\x13\xcf\x9c\xeb\x8b\xf3\xd5\xf5\xc7\xcd\x7a\xf9\xcf\x0b\x58\xc0\xf1\xdb\x28\x72\xce\x2e\x57\xe7\x3f\xf5\x23\x47\xd1\xf1\x0f\xf0\xc6\x7e\x38\x7f\xbf\x5e\x9d\x5d\x6c\x7e\x5c\x5e\x9a\x09\x37\xbc\xaf\x18\x53\x07\x46\x26\x07\x26\x13\x74\x1d\xc7\x49\x30\x35\x5d\x15\x1b\x25\x16\xe8\x29\x21\x35\x26\x9b\x07\x56\xd4\xa8\x02\xa8\xfc\xb9\x63\x6a\x47\xa9\xdd\x82\xf7\x66\xdb\x19\xf3\x23\x51\xd7\x92\x43\x14\x46\x76\x88\x78\x82\x8f\xb0\x00\xe2\xda\x93\xa2\xe6\x89\x57\xc1\x21\x1c\x45\x51\x18\xc1\x1b\x28\x90\xef\x47\xf1\xe1\x8f\x10\x85\xef\x7c\x1f\x0e\xe0\xc8\x99\xf8\xdb\xb3\xba\x2d\xd9\xa3\x17\x05\x50\x12\xf7\x6c\x80\xe0\x25\x4f\x07\x70\xe4\xfb\x77\x5d\x5a\x46\x2a\x5e\x9a\x8c\x29\xe4\x4c\x31\xad\xa5\x27\x54\x00\xee\xa0\x46\xb7\x33\x30\xbf\x42\x85\xc3\xb8\x59\x6a\x27\xb0\x50\xb8\x6f\x32\x4c\x77\xfc\x19\x32\x37\x56\x9a\x5e\xc5\x74\x1e\xb4\x6d\xd4\xf9\xed\x0a\xbd\x80\xad\x7b\xef\xc2\x1b\x98\x14\xd0\xce\xb7\xbb\x88\x11\xdc\x02\x6e\xef\xec\x50\x9a\xc0\x02\x84\x0a\x45\x85\xbc\xf3\x28\x54\xb8\xda\x7c\xb9\x5e\x5d\x5d\x7e\x85\xff\x98\xb9\xd5\xe6\xfc\xfa\xe2\xf4\xa6\xff\x72\x73\xfd\xf9\xea\x3c\x80\x48\xfc\x29\x8a\x7c\xeb\x44\xcb\x66\x44\x6d\x64\xb4\x31\x0d\x22\x19\xcf\xd0\x9b\xe2\xeb\x1f\xa1\x42\xdb\x89\x5e\x9a\x04\x9d\x3a\xfd\x3d\x03\xa5\x99\xd4\xb0\xb0\x9a\x0e\xcd\x1f\xef\xc9\xfc\x94\xb4\xfe\x19\xb2\x0b\x59\x55\x21\x4f\x3c\x6f\xb2\x1c\x0e\x5a\xa7\xbe\x15\x6c\x0f\x3c\x25\xce\x8a\x62\x02\x5e\xa8\x30\x2e\x84\xc2\x96\xf2\x7d\xaf\x46\x24\x9e\x3f\x95\xcd\xaf\xc3\x3a\xd7\xa6\xe9\xce\xdb\x72\x04\xe3\x78\xf5\x2e\xfa\xa4\xdc\xf9\x54\xf8\x83\xc7\x00\xde\x45\xfe\xd4\xf6\xe4\xe4\x1b\xb6\x27\x27\x53\xdb\x92\x3d\x5a\xdb\xc1\xe0\xf6\xe0\xe8\xce\x28\x6f\x18\xb0\x62\x32\x9d\xd2\xae\xfa\xad\xef\x40\xab\xa0\x96\xfe\xb6\xde\x25\x6d\xbb\xfa\x6c\x0b\x11\xdf\x8f\xf2\x19\x9b\xfd\x77\x92\xca\xb7\xca\xfc\x44\x46\x23\xc8\x17\x45\x64\x91\xfb\x2f\x77\x4e\x3f\xa8\x30\x16\x3c\x51\xfb\xf1\x7a\x5d\xfc\xbf\x72\x78\x5e\xf9\x92\xb6\xee\x1c\x4a\xda\x4e\xaa\xd3\x85\x72\xe7\x7d\xd0\x69\xe5\x68\x5b\x99\xca\x95\xb4\x85\xc3\x01\x14\xa5\xc3\xeb\x07\x88\x5e\xa9\x9d\xbd\xf4\xf0\x6c\x93\x90\x54\x5e\x7f\x7e\x34\x1d\x2f\xae\xeb\xb6\xe0\x54\x7f\x24\x0d\xc7\x8b\x16\xb0\x1d\x0f\x1e\xc3\xec\xb0\xd8\x1e\x90\x90\x20\x56\xa8\x34\xa4\x24\x95\x76\x5d\xd7\x3a\xec\xa2\x8d\x7b\xc5\xb0\xa8\xdd\x32\x8c\x04\x42\xb6\x55\xe6\x73\x82\xc6\xae\xdd\xe5\xe6\xa8\x36\x1b\x79\x6f\x48\x2a\x21\xf9\x0c\xf4\x24\x4e\xdf\xb5\x4f\x3c\xbd\x16\x38\x21\xc9\x59\x89\x4f\xcd\xbb\xfa\x74\x3e\x7b\xde\x18\x71\xaf\x8b\x68\x5a\xc4\xec\xe6\x8d\x0a\x99\xcc\x1e\x7c\xf8\x6e\x01\x3f\x8c\x60\x54\xa3\x42\xa5\x13\x94\xb2\x53\x97\xdb\x5e\x7b\xe0\x7b\xf5\xbf\xee\x39\xff\xe2\x2e\x7c\x0f\xbd\xe3\xdb\xe8\x6e\x4c\xa0\x43\x75\xe4\xec\xf3\xd8\xed\xde\xb6\x05\x61\x31\x2e\x3d\xba\x0b\xec\x91\x36\x0c\x1c\xdf\xf9\x4f\x46\xde\x76\xde\xbb\xfb\xc8\x02\x7e\x75\x13\x92\xee\x7c\xea\xdc\xb5\x48\xdd\x39\x5c\x09\x8e\x01\xb8\x16\xea\xf8\x15\xa5\x14\x66\x85\xeb\xfe\xd6\xe2\xea\x05\xb2\x78\x4d\x68\xd6\xca\x94\x7b\x52\x87\x9f\x05\xf1\xd1\x24\x80\xf1\x22\xf0\x42\xc3\x53\xda\x07\x19\xc7\xba\x3e\x2b\xd9\x3d\xbe\x14\x6e\x4c\xf2\xb6\xcb\xe7\x0e\x16\xaf\x9e\x83\xcf\x96\xb4\x39\x8f\x4b\x9e\x6e\x7c\x76\x41\xf7\x1f\x9e\xb7\x5c\x5d\x18\x4e\x02\x58\xad\xed\x8b\x0f\x4c\x01\xce\x9f\x39\x6d\x99\x33\x4e\x95\x96\x1e\xbe\x72\x96\x50\x3a\x90\x64\xaf\x80\xca\x22\xf5\x9f\x65\xde\x5e\xfc\xda\xc9\x61\xce\x36\xa9\x39\x45\x5f\xe4\x8b\xd2\xa7\x7d\xf5\xc4\x6d\xef\xba\xb4\x4d\xd7\xed\x5d\xf6\x1a\xeb\x99\x8b\x64\x98\xd4\x65\xa5\xbc\x96\x57\x7f\xaf\x73\x22\xc7\x71\x28\x85\xcd\xc6\x74\xd7\x66\x03\x8b\x05\xb8\x9b\x4d\xc9\x88\x6f\x36\x6e\x1b\xc4\x88\x10\x1f\x49\x7b\x25\x23\xee\xf9\xbe\xf3\xdf\x01\x00\x71\xe7\xa7\xdb\xdb\x0f\x00\x00"),
+		},
+		"/scripts/disk_probe.sh": &vfsgen۰CompressedFileInfo{
+			name:             "disk_probe.sh",
+			modTime:          time.Date(2026, 10, 19, 18, 37, 20, 472642926, time.UTC),
+			uncompressedSize: 969,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\x5d\x6f\xdb\x36\x14\x86\xef\xf5\x2b\xde\xc9\xc6\x90\x00\x8e\xec\x78\x57\x4b\xb0\x61\x5e\x92\x61\x6a\x03\x1b\x88\x9c\x06\x41\x51\x04\x14\x75\x24\x1d\x44\x22\x59\x92\x8a\xad\x36\xfd\xef\x05\xfd\xd1\xc4\x4d\x75\x29\xbe\x7c\xf8\x9c\x8f\xc1\x6f\xe3\x9c\xd5\x38\x17\xae\x8e\x06\x03\x5c\x68\xd3\x5b\xae\x6a\x8f\xe9\xe4\xf4\x4f\x64\xb5\x50\x55\x2d\x18\xef\x58\x55\x97\x9d\x46\xaa\x4a\x6d\x5b\xe1\x59\x2b\x2c\x49\xd6\x4a\x37\xba\xea\x21\x75\x32\xc2\xb5\x2f\x92\x68\x30\x08\x98\x6b\x96\xa4\x1c\x15\xe8\x54\x41\x16\xbe\x26\xcc\x8c\x90\x35\xed\x4f\x46\xf8\x40\xd6\x05\xca\x34\x99\xe0\x28\x04\xe2\xdd\x51\x7c\x7c\x1e\x10\xbd\xee\xd0\x8a\x1e\x4a\x7b\x74\x8e\xe0\x6b\x76\x28\xb9\x21\xd0\x5a\x92\xf1\x60\x05\xa9\x5b\xd3\xb0\x50\x92\xb0\x62\x5f\xc3\xbf\x3c\x10\x4c\x70\xbf\x63\xe8\xdc\x0b\x56\x10\x90\xda\xf4\xd0\xe5\xeb\x20\x84\xdf\x49\x6f\xbe\xda\x7b\x73\x36\x1e\xaf\x56\xab\x44\x6c\x8c\x13\x6d\xab\x71\xb3\xcd\xba\xf1\x75\x7a\x71\x35\xcf\xae\x4e\xa6\xc9\x64\x77\xeb\x56\x35\xe4\x1c\x2c\x7d\xee\xd8\x52\x81\xbc\x87\x30\xa6\x61\x29\xf2\x86\xd0\x88\x15\xb4\x85\xa8\x2c\x51\x01\xaf\x83\xf5\xca\xb2\x67\x55\x8d\xe0\x74\xe9\x57\xc2\x52\x50\x2d\xd8\x79\xcb\x79\xe7\x0f\x9a\xb6\x77\x64\x77\x10\xd0\x0a\x42\x21\x9e\x65\x48\xb3\x18\xff\xce\xb2\x34\x1b\x05\xc8\x5d\xba\xfc\x7f\x71\xbb\xc4\xdd\xec\xe6\x66\x36\x5f\xa6\x57\x19\x16\x37\xb8\x58\xcc\x2f\xd3\x65\xba\x98\x67\x58\xfc\x87\xd9\xfc\x1e\xef\xd3\xf9\xe5\x08\xc4\xbe\x26\x0b\x5a\x1b\x1b\x2a\xd0\x16\x1c\xda\x49\x9b\x29\x22\x23\x3a\x50\x28\xf5\x76\x8e\xce\x90\xe4\x92\x25\x1a\xa1\xaa\x4e\x54\x84\x4a\x3f\x91\x55\xac\x2a\x18\xb2\x2d\xbb\x30\x56\x07\xa1\x8a\x80\x69\xb8\x65\xbf\xd9\x17\xf7\xb6\xae\x24\x8a\x06\x58\x86\xc1\x3a\x69\xd9\x78\xd8\x4e\xb9\x4d\xa0\x60\xf7\xf8\x60\xac\xce\x29\x31\x3d\x6a\x6a\x0c\xd9\xd0\x5a\xd3\xfb\x5a\xab\x11\x1c\xfd\x9c\xd9\xfb\x75\x4e\x54\x94\x44\xd1\x36\xf9\x90\xb3\xfa\x6b\x78\x24\x75\xdb\x0a\x55\xe0\xe4\x69\x47\xf8\x03\xcf\xcf\x78\xf3\xf7\x97\x3f\xa7\xc7\x11\x97\xf8\x88\x93\x2f\x88\x87\x5f\x5f\xb0\xdf\x62\x7c\x3a\x0f\xb2\x2a\x0a\x8b\x43\xb2\xd6\x88\xc9\x5a\x6d\xcf\xf6\x38\x7e\xb5\x17\x5e\x43\xd6\x24\x1f\x7f\x94\x17\xe3\xef\xdf\xa7\xdb\xab\x6b\xf6\x38\x8d\x4a\x8e\x22\x5a\x93\x7c\xf3\x4c\x3c\x3c\x2a\xd8\x2a\xd1\x12\xe2\xe1\x24\x3e\x1e\x1f\x54\x1e\x23\x1e\xfe\x13\x47\xdf\x07\x00\xcf\x64\xbc\x1d\xc9\x03\x00\x00"),
+		},
+		"/scripts/init_change_firewall.sh": &vfsgen۰CompressedFileInfo{
+			name:             "init_change_firewall.sh",
+			modTime:          time.Date(2026, 10, 19, 18, 49, 10, 817256713, time.UTC),
+			uncompressedSize: 1004,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x93\x51\x6f\xd3\x3e\x14\xc5\xdf\xf3\x29\xce\xbf\x95\xfe\x03\xa9\x8b\xbb\xbd\xc1\x04\x52\xd9\x8a\x08\x54\xad\xb4\x74\x8c\x09\x81\xe4\x3a\x37\xc9\x95\x1c\x3b\xd8\xce\xd2\x48\xfb\xf0\xc8\x5d\xbb\xad\x02\xf2\x98\x7b\x7c\xf2\xf3\x39\x37\xe3\xff\x44\xe7\x9d\xd8\xb0\x11\x64\xee\xb1\x91\xbe\x4e\xc6\x63\x5c\xda\x76\x70\x5c\xd5\x01\xe7\xd3\xb3\x37\xc8\x6b\x69\xaa\x5a\x32\x3e\xb3\xa9\xae\x3a\x8b\xcc\x94\xd6\x35\x32\xb0\x35\x58\x93\xaa\x8d\xd5\xb6\x1a\xa0\x6c\x3a\xc1\x22\x14\x69\x32\x1e\x47\x9b\x05\x2b\x32\x9e\x0a\x74\xa6\x20\x87\x50\x13\x66\xad\x54\x35\x1d\x26\x13\x7c\x25\xe7\xa3\xcb\x79\x3a\xc5\xab\x28\x18\xed\x47\xa3\xd7\x17\xd1\x62\xb0\x1d\x1a\x39\xc0\xd8\x80\xce\x13\x42\xcd\x1e\x25\x6b\x02\x6d\x15\xb5\x01\x6c\xa0\x6c\xd3\x6a\x96\x46\x11\x7a\x0e\x35\xc2\xf3\x07\x22\x09\xee\xf6\x1e\x76\x13\x24\x1b\x48\x28\xdb\x0e\xb0\xe5\x4b\x21\x64\xd8\x43\xef\x9e\x3a\x84\xf6\xad\x10\x7d\xdf\xa7\x72\x47\x9c\x5a\x57\x09\xfd\xa8\xf5\x62\x91\x5d\xce\x97\xf9\xfc\xf4\x3c\x9d\xee\x4f\xdd\x18\x4d\xde\xc3\xd1\xaf\x8e\x1d\x15\xd8\x0c\x90\x6d\xab\x59\xc9\x8d\x26\x68\xd9\xc3\x3a\xc8\xca\x11\x15\x08\x36\x52\xf7\x8e\x03\x9b\x6a\x02\x6f\xcb\xd0\x4b\x47\x11\xb5\x60\x1f\x1c\x6f\xba\x70\x14\xda\x81\x91\xfd\x91\xc0\x1a\x48\x83\xd1\x2c\x47\x96\x8f\xf0\x61\x96\x67\xf9\x24\x9a\xdc\x66\xeb\x4f\xab\x9b\x35\x6e\x67\xd7\xd7\xb3\xe5\x3a\x9b\xe7\x58\x5d\xe3\x72\xb5\xbc\xca\xd6\xd9\x6a\x99\x63\xf5\x11\xb3\xe5\x1d\xbe\x64\xcb\xab\x09\x88\x43\x4d\x0e\xb4\x6d\x5d\xbc\x81\x75\xe0\x18\x27\xed\x5a\x44\x4e\x74\x84\x50\xda\xc7\x1e\x7d\x4b\x8a\x4b\x56\xd0\xd2\x54\x9d\xac\x08\x95\xbd\x27\x67\xd8\x54\x68\xc9\x35\xec\x63\xad\x1e\xd2\x14\xd1\x46\x73\xc3\x61\xb7\x2f\xfe\xcf\x7b\xa5\x49\x32\xc6\x3a\x16\xeb\x95\xe3\xd8\xa9\x87\xe4\x26\xe6\xa4\xb4\xf5\x84\x92\x1d\xf5\x52\xeb\xe8\x16\x13\x88\x99\x16\xf0\x14\x28\xee\xa1\xa2\x24\xf1\x83\x0f\xd4\xa8\xa0\x0f\xe3\xa7\x33\x05\xfe\x7f\x2f\x0a\xba\x17\xa6\xd3\xfa\x85\xce\x07\xdb\xfe\x4b\xf4\x64\x8c\xe9\xd1\x60\x0c\x4f\x9a\x4d\xb7\x8d\x84\x71\x23\xd9\xf8\x20\x75\x84\xb1\x06\xde\x36\xf4\xdc\x4f\xbc\xea\x04\x94\x56\x29\xba\x4d\x67\x42\x97\x70\x89\xef\x38\x2d\x21\x28\x28\xb1\x37\x12\xca\x9a\x92\x2b\xfc\xb8\x88\x81\x98\x24\xee\x5e\xfc\x61\x4e\x19\x27\x5e\xfc\xcc\xe7\x8b\x6c\x79\xf3\xed\xdd\x23\x0e\x9b\x4a\x1c\xde\x1c\x52\x10\x27\x7f\xf5\x7b\x78\x00\x6d\x39\xe0\x2c\x29\x39\xa1\x2d\x07\x4c\x93\xdf\x03\x00\x89\x14\x72\x92\xec\x03\x00\x00"),
+		},
+		"/scripts/init_change_hostalias.sh": &vfsgen۰CompressedFileInfo{
+			name:             "init_change_hostalias.sh",
+			modTime:          time.Date(2026, 10, 19, 18, 49, 10, 818074750, time.UTC),
+			uncompressedSize: 2501,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x56\x7f\x73\xda\xba\x12\xfd\xdf\x9f\xe2\xd4\xf0\x26\xe1\x05\x0c\xe1\xbf\x97\x84\xcc\xa4\x09\x79\xe5\x36\x03\x33\x85\xde\x4e\x27\x4d\x33\xc2\x5e\xdb\x1a\x8c\xe4\x48\x72\x08\x43\xe9\x67\xbf\x23\xd9\xfc\x4c\x6f\x70\x12\x69\xf7\xec\xd1\xd9\x95\xb4\xa6\xf6\xa1\x5d\x68\xd5\x9e\x72\xd1\x26\xf1\x8a\x29\xd3\xa9\x57\xab\xe1\x56\xe6\x4b\xc5\x93\xd4\xa0\xdb\x39\xff\x1f\xc6\x29\x13\x49\xca\x38\xfe\xe2\x22\xb9\x2b\x24\x06\x22\x96\x6a\xce\x0c\x97\x02\x13\x0a\x53\x21\x33\x99\x2c\x11\xca\xa0\x89\x07\x13\x05\x5e\xad\x66\x69\x1e\x78\x48\x42\x53\x84\x42\x44\xa4\x60\x52\xc2\x4d\xce\xc2\x94\x36\x9e\x26\xfe\x26\xa5\x2d\x4b\x37\xe8\xe0\xd4\x02\xfc\xca\xe5\x37\x2e\x2d\xc5\x52\x16\x98\xb3\x25\x84\x34\x28\x34\xc1\xa4\x5c\x23\xe6\x19\x81\xde\x42\xca\x0d\xb8\x40\x28\xe7\x79\xc6\x99\x08\x09\x0b\x6e\x52\x98\xdd\x02\x56\x09\xbe\x57\x1c\x72\x6a\x18\x17\x60\x08\x65\xbe\x84\x8c\xf7\x81\x60\xa6\x12\xed\x7e\x52\x63\xf2\x8b\x76\x7b\xb1\x58\x04\xcc\x29\x0e\xa4\x4a\xda\x59\x89\xd5\xed\x87\xc1\x6d\x7f\x38\xee\xb7\xba\x41\xa7\x8a\xfa\x2a\x32\xd2\x1a\x8a\x5e\x0a\xae\x28\xc2\x74\x09\x96\xe7\x19\x0f\xd9\x34\x23\x64\x6c\x01\xa9\xc0\x12\x45\x14\xc1\x48\xab\x7a\xa1\xb8\xe1\x22\x69\x42\xcb\xd8\x2c\x98\x22\x2b\x35\xe2\xda\x28\x3e\x2d\xcc\x41\xd1\x36\x1a\xb9\x3e\x00\x48\x01\x26\xe0\xdf\x8c\x31\x18\xfb\xf8\x78\x33\x1e\x8c\x9b\x96\xe4\xdb\x60\xf2\x69\xf4\x75\x82\x6f\x37\x5f\xbe\xdc\x0c\x27\x83\xfe\x18\xa3\x2f\xb8\x1d\x0d\xef\x06\x93\xc1\x68\x38\xc6\xe8\x1e\x37\xc3\xef\xf8\x3c\x18\xde\x35\x41\xdc\xa4\xa4\x40\x6f\xb9\xb2\x19\x48\x05\x6e\xcb\x49\x6e\x17\x31\x26\x3a\x90\x10\xcb\x72\x1f\x75\x4e\x21\x8f\x79\x88\x8c\x89\xa4\x60\x09\x21\x91\xaf\xa4\x04\x17\x09\x72\x52\x73\xae\xed\xb6\x6a\x30\x11\x59\x9a\x8c\xcf\xb9\x71\xe7\x45\xbf\xcf\x2b\xf0\xbc\x1a\x26\x76\x63\x75\xa8\xb8\xdd\x53\x0d\xc6\xe7\xb6\x4e\x9a\x0c\x52\xa9\x0d\xcb\x38\xd3\xa4\x9b\x96\xcf\x85\x26\xfc\x95\x84\x73\x81\x84\x51\x9c\xb4\xdd\xd0\x36\x99\xb0\x6d\x8d\x3a\x70\x94\x74\x88\x60\x8a\x30\xab\x0e\x0d\xc3\x34\x93\xe1\xec\x30\x0a\x8b\x94\x87\xa9\x5d\x5f\x51\x9e\xb1\xb0\xdc\x48\xbb\x9e\xa0\x37\x03\x55\x88\xa6\x57\x3b\xd2\x20\xd8\xbc\xa2\x56\x34\x97\xaf\x14\x21\x56\x72\xee\x00\xb2\xac\x6c\xb5\xba\x96\x30\x29\x33\xd6\xb3\x84\x22\x2d\xb3\x57\xb2\x39\xee\xa8\x78\xee\x74\x17\x9a\x25\x74\x01\x2e\xb8\x79\x0e\xed\xd5\xa3\xe7\x6d\x0d\x02\x9d\xe2\xf1\x8a\xe7\xd7\xb8\xb2\x0b\x5f\x3f\x05\x41\xe0\x79\x9f\x46\xe3\xc9\xf8\xf9\x7e\xf0\xd0\xef\xed\x92\xf1\x3e\x3e\x8c\x6e\x3f\x3f\x7f\xec\xff\x7f\x30\xec\xf9\x35\xb8\x01\x66\x39\x63\xba\x2c\x4b\x55\x54\xbf\x02\xf6\x87\x77\x16\xd6\x1f\xde\xfd\x11\xe4\xf1\x18\x8f\xa8\xd7\xd0\x4a\x0c\x3a\x78\xba\xb4\x89\x08\xcf\xde\x16\xab\x44\xf7\x7c\xdf\x4d\xaa\x74\x37\xd3\x45\x6a\x2f\x6b\x15\x48\xe8\xda\xc0\x48\x3a\xe4\x5e\x68\x7d\xe5\x06\x6b\xd4\xbb\xfe\xd6\xb7\x65\xaa\xaf\xaa\xe1\xba\x7e\x6e\x11\xf5\x93\x1f\xe2\x64\x0b\xd3\x29\x8f\x0d\xba\x6e\x1e\x49\x41\x9e\x1b\x19\xd2\x06\xad\x18\xf5\xd5\xae\x38\xeb\xc0\x25\x16\x4c\xd9\x0c\xbf\x7e\x21\xcc\x0f\xbd\xff\x86\x75\x7c\xb6\x62\xba\x57\x3f\x65\x8b\x19\x5a\xaf\xc7\xba\x7d\x6b\x9b\x52\xc2\x85\xb5\xed\x15\xbe\xf4\x90\x88\x76\xf6\xfe\xf0\x6e\xed\x63\xa7\xdf\xe1\xb0\x82\xce\x33\x6e\x4e\x1d\x5f\x13\x19\xd7\xa6\x09\x1f\x7e\xe3\xd2\xdd\xb9\x53\x6e\x7b\x86\xb5\x36\x30\x67\x82\x25\x14\x3d\xda\xd9\x23\x7f\x7a\x42\x0f\xe7\x58\x6f\xf9\xea\x1d\xf4\x7a\xa5\x18\xcb\x3a\xe3\xf9\x11\xc0\x99\x56\xe0\x31\x4e\x4b\x2c\x89\xa8\xb1\x01\x76\x2e\xcb\xb3\xbe\x83\xb7\x7f\x3e\x3e\x5e\xe8\x9c\x85\x74\xf1\xf4\xf4\xdf\x5a\xdb\x96\x6e\x78\x8f\x2b\x74\xb1\x42\xae\xb8\x30\xef\x42\x56\xdb\x91\x7d\x32\x2e\x08\x3d\xd4\xcf\x2f\xcb\xdb\xd7\x43\xe7\xc0\x5f\xe5\xd7\x43\xf7\x12\x1c\x57\x3d\x0c\xef\x2f\xc1\xcf\xce\x1a\x47\x3c\xf6\xe3\x44\x73\xfc\x46\xfb\x67\xad\xdd\xc0\x54\x51\xb5\x3f\xfb\x1f\x0b\xfa\x70\x5a\x77\x25\xab\x8a\xd5\x68\x60\xb5\x11\xe2\xfe\xf9\xf0\x51\xe7\xa5\xa2\xb3\xb3\x3d\xed\xf6\x39\x9c\x59\x3a\x0b\xc3\x35\x3a\x8d\x32\x63\x47\xb5\x05\xad\x4f\x0e\x8f\x4e\xc3\x96\x88\xde\xb8\xc1\x79\x79\x18\x6b\xfb\x3d\xc6\xbe\xce\xa6\x84\x29\x17\x11\xe6\xb2\x10\x86\x22\xfb\x12\x00\x37\x27\xb6\xef\xd8\xf7\x82\x21\x61\xb5\xbb\x16\x04\x2e\xb4\x21\x16\xd9\x4e\x35\x25\xdb\x5f\x37\xbd\xc9\x51\x3b\x39\x31\xfc\xff\xe8\x1f\xa2\xfc\xb5\x7f\x7d\xf8\xf5\x95\x5b\x6e\xed\xe3\xdd\x89\xdc\xbb\x50\x7b\xde\xf2\x5c\x5e\x1f\xa6\xb2\x97\x49\xcc\x3d\x6f\x73\xab\x7e\xb7\x03\xfb\x5d\x41\x85\xd6\x6f\x64\x11\xa6\x3b\xd3\x31\xe8\xb9\x6a\x21\xef\xa0\x1b\x87\xe7\x25\x8a\x72\xf8\x6e\xea\xbf\x0b\x6b\xbd\xd8\xc8\xf2\x28\x84\xcc\xe0\xfa\x08\x70\x75\xd5\x1f\xdd\x7b\x6e\x82\x59\xef\x64\x56\x4c\x29\x34\xd9\xc9\xc6\x12\x6e\x4d\x68\x09\x44\x14\xb3\x22\x33\x3b\xef\x4c\x1f\xf8\xed\xb0\xa5\x97\xda\xd0\x7c\x87\xa1\x03\xc8\x4b\x48\x27\x9e\x5d\x72\x5d\x09\x6f\xbd\xc0\xdf\x17\xb4\x4d\x41\x85\x47\xca\x37\xd2\x55\x58\x8a\x76\x2d\xf5\x0f\x95\xda\x6f\xae\xc1\xb1\xd7\x8b\x79\xb5\x3c\xbd\x71\x83\x8e\xf7\xcf\x00\xcc\x17\x44\x79\xc5\x09\x00\x00"),
+		},
+		"/scripts/init_change_hostname.sh": &vfsgen۰CompressedFileInfo{
+			name:             "init_change_hostname.sh",
+			modTime:          time.Date(2026, 10, 19, 18, 22, 19, 463300509, time.UTC),
+			uncompressedSize: 825,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6f\xd3\x40\x10\x85\xef\xfe\x15\x0f\xa7\x42\x20\xa5\x49\x9b\x1b\x54\x20\x99\x36\xa8\x86\xe2\x48\xb5\x4b\xd5\xe3\x7a\x3d\xb1\x47\xb2\x77\xcd\xee\xb8\xae\x45\xf9\xef\x68\xd3\xa4\xb4\xc2\x47\xcf\x9b\x6f\xdf\xcc\x9b\xd9\x9b\xe5\xe0\xdd\xb2\x64\xb3\x24\x73\x8f\x52\xf9\x26\x9a\xcd\x70\x6e\xfb\xc9\x71\xdd\x08\x56\x27\xa7\x1f\x90\x37\xca\xd4\x8d\x62\x7c\x63\x53\x5f\x0c\x16\xa9\xd9\x5a\xd7\x29\x61\x6b\x50\x90\x6e\x8c\x6d\x6d\x3d\x41\xdb\xc5\x1c\x57\x52\x2d\xa2\xd9\x2c\x60\xae\x58\x93\xf1\x54\x61\x30\x15\x39\x48\x43\x48\x7a\xa5\x1b\x3a\x54\xe6\xf8\x49\xce\x07\xca\x6a\x71\x82\x77\x41\x10\xef\x4b\xf1\xfb\xb3\x80\x98\xec\x80\x4e\x4d\x30\x56\x30\x78\x82\x34\xec\xb1\xe5\x96\x40\x0f\x9a\x7a\x01\x1b\x68\xdb\xf5\x2d\x2b\xa3\x09\x23\x4b\x03\xf9\xf7\x40\x70\x82\xbb\x3d\xc3\x96\xa2\xd8\x40\x41\xdb\x7e\x82\xdd\xbe\x14\x42\xc9\xde\xf4\xee\x6b\x44\xfa\x8f\xcb\xe5\x38\x8e\x0b\xb5\x73\xbc\xb0\xae\x5e\xb6\x4f\x5a\xbf\xbc\x4a\xcf\xd7\x59\xbe\x3e\x5e\x2d\x4e\xf6\x5d\x37\xa6\x25\xef\xe1\xe8\xd7\xc0\x8e\x2a\x94\x13\x54\xdf\xb7\xac\x55\xd9\x12\x5a\x35\xc2\x3a\xa8\xda\x11\x55\x10\x1b\x5c\x8f\x8e\x85\x4d\x3d\x87\xb7\x5b\x19\x95\xa3\x60\xb5\x62\x2f\x8e\xcb\x41\x5e\x2d\xed\xe0\x91\xfd\x2b\x81\x35\x50\x06\x71\x92\x23\xcd\x63\x7c\x49\xf2\x34\x9f\x07\xc8\x6d\x5a\x5c\x6e\x6e\x0a\xdc\x26\xd7\xd7\x49\x56\xa4\xeb\x1c\x9b\x6b\x9c\x6f\xb2\x8b\xb4\x48\x37\x59\x8e\xcd\x57\x24\xd9\x1d\xbe\xa7\xd9\xc5\x1c\xc4\xd2\x90\x03\x3d\xf4\x2e\x4c\x60\x1d\x38\xac\x93\x76\x29\x22\x27\x7a\x65\x61\x6b\x9f\x72\xf4\x3d\x69\xde\xb2\x46\xab\x4c\x3d\xa8\x9a\x50\xdb\x7b\x72\x86\x4d\x8d\x9e\x5c\xc7\x3e\xc4\xea\xa1\x4c\x15\x30\x2d\x77\x2c\xbb\x7b\xf1\xff\xcf\xb5\x88\xa2\x19\x8a\x10\xac\xd7\x8e\x43\xa6\x1e\x8a\xbb\xb0\x27\x4f\x82\xc6\x7a\x31\xaa\xa3\x90\x98\x1e\x9c\x23\x23\x30\xb6\xa2\x28\xba\xdc\xe4\x45\x96\xfc\x58\x7f\x3a\x3a\x8d\x22\x21\x2f\x38\x36\x88\x8f\x0e\xbf\x63\x3c\x3e\xe2\x37\x48\x37\x16\xf1\x33\x45\x2b\xb3\xbb\xa6\x92\x40\x5d\x2f\x53\x8c\xcf\x6f\x57\x67\xa0\x07\x16\x9c\x9e\xe1\x4f\x74\x50\x6a\x69\xe1\x49\x8e\x9f\x3b\x5f\x90\xa3\xbf\x03\x00\xca\x5b\xf1\x2f\x39\x03\x00\x00"),
+		},
+		"/scripts/init_change_network.sh": &vfsgen۰CompressedFileInfo{
+			name:             "init_change_network.sh",
+			modTime:          time.Date(2026, 10, 19, 18, 49, 10, 817859055, time.UTC),
+			uncompressedSize: 1375,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x54\x5d\x6b\x23\x47\x10\x7c\xdf\x5f\x51\x91\xfc\x90\x04\x69\xd7\x36\x21\xe0\x1c\x36\x28\xb6\x43\x94\x18\x09\x2c\x5d\x8e\x83\x23\x66\x34\xdb\xbb\xdb\x78\x76\x66\x6e\x66\x56\x1f\xf8\xfc\xdf\x43\x4b\xb2\x2d\x39\x70\x7a\x11\x74\x57\xd7\x54\x57\x97\xd4\xff\xa1\xe8\x62\x28\x16\x6c\x0b\xb2\x4b\x2c\x54\x6c\xb2\x7e\x1f\xd7\xce\x6f\x02\xd7\x4d\xc2\xf9\xe9\xd9\x05\x66\x8d\xb2\x75\xa3\x18\x7f\xb1\xad\x6f\x3a\x87\xb1\xad\x5c\x68\x55\x62\x67\x31\x27\xdd\x58\x67\x5c\xbd\x81\x76\xf9\x00\x77\xa9\xcc\xb3\x7e\x5f\x68\xee\x58\x93\x8d\x54\xa2\xb3\x25\x05\xa4\x86\x30\xf2\x4a\x37\xf4\xd2\x19\xe0\x1f\x0a\x51\x58\xce\xf3\x53\xfc\x28\x80\xde\xbe\xd5\xfb\xe9\x83\x50\x6c\x5c\x87\x56\x6d\x60\x5d\x42\x17\x09\xa9\xe1\x88\x8a\x0d\x81\xd6\x9a\x7c\x02\x5b\x68\xd7\x7a\xc3\xca\x6a\xc2\x8a\x53\x83\xf4\xf6\x80\x28\xc1\xe7\x3d\x87\x5b\x24\xc5\x16\x0a\xda\xf9\x0d\x5c\x75\x08\x84\x4a\x7b\xd1\xdb\x4f\x93\x92\xff\xad\x28\x56\xab\x55\xae\xb6\x8a\x73\x17\xea\xc2\xec\xb0\xb1\xb8\x1b\x5f\xdf\x4e\x66\xb7\xc3\xf3\xfc\x74\x3f\xf5\xd1\x1a\x8a\x11\x81\xbe\x76\x1c\xa8\xc4\x62\x03\xe5\xbd\x61\xad\x16\x86\x60\xd4\x0a\x2e\x40\xd5\x81\xa8\x44\x72\xa2\x7a\x15\x38\xb1\xad\x07\x88\xae\x4a\x2b\x15\x48\xa4\x96\x1c\x53\xe0\x45\x97\x8e\x4c\x7b\xd1\xc8\xf1\x08\xe0\x2c\x94\x45\x6f\x34\xc3\x78\xd6\xc3\xef\xa3\xd9\x78\x36\x10\x92\x4f\xe3\xf9\x9f\xd3\x8f\x73\x7c\x1a\xdd\xdf\x8f\x26\xf3\xf1\xed\x0c\xd3\x7b\x5c\x4f\x27\x37\xe3\xf9\x78\x3a\x99\x61\xfa\x07\x46\x93\xcf\xf8\x7b\x3c\xb9\x19\x80\x38\x35\x14\x40\x6b\x1f\x64\x03\x17\xc0\x62\x27\x6d\xaf\x88\x19\xd1\x91\x84\xca\xed\xee\x18\x3d\x69\xae\x58\xc3\x28\x5b\x77\xaa\x26\xd4\x6e\x49\xc1\xb2\xad\xe1\x29\xb4\x1c\xe5\xac\x11\xca\x96\x42\x63\xb8\xe5\xb4\xcd\x4b\xfc\xff\x5e\x79\x96\xf5\x31\x97\xc3\x46\x1d\x58\x6e\x1a\xa1\xb8\x15\x9f\xb4\x24\x8f\x24\x97\xac\x61\x29\xad\x5c\x78\x04\xd9\xe5\x60\x3b\xff\x48\xc1\x92\x81\x57\x41\xb5\x94\x28\x44\xa8\x40\xf2\x7c\xe4\x28\x0e\xb2\xdd\xc2\xa2\x6a\x29\xeb\xef\x52\xa3\xe2\xb6\x54\xf1\x9a\xe2\x4b\x04\xe2\x26\x26\x6a\xe1\x03\x55\x14\x48\x62\xa4\x1b\xd2\x8f\x79\x96\xc5\x4d\xd4\xc9\x3c\x68\x67\xab\xcb\x82\x92\x2e\x76\x85\xbc\x2c\x2e\x2e\x86\x8f\x5e\xa9\x98\x4b\x2f\x6b\x5d\xd9\x19\x8a\x07\xc0\x7d\x65\x68\x9c\x2a\xf3\xb2\x38\xc0\x66\x7d\x2c\x02\x97\xb5\x04\x21\xa8\x4a\x3c\x94\xcd\x89\xac\x84\x86\x7d\x92\xc4\x44\x38\x6b\x36\xe0\x0a\x8b\xf0\x60\x29\x55\x6c\x12\x05\x31\x46\x08\xa9\x94\x17\x7d\x70\x0b\x3a\xee\x7f\xfb\x06\x5a\x73\xc2\x59\x56\x07\xf2\x18\x7e\x5d\xa3\x77\x08\xe8\xe1\xe4\xe9\x50\xeb\x33\xce\xaf\x50\x94\xb4\x2c\x6c\x67\xcc\x76\x5c\x37\xee\xfd\xcc\xd5\xd5\xfb\xb1\x2c\x4b\xae\xd3\x0d\x4e\x9e\x0e\x1c\x7a\xce\x24\x1c\xaf\xc7\x90\x8c\x5b\x4a\x39\xfb\xe5\x2f\x39\xfb\x87\xca\x85\x95\x0a\xe5\x5b\x4d\x04\xe4\xca\x98\x7c\xdf\x91\xe8\x48\x73\xe7\xce\xfe\x6b\x68\xab\xa1\x56\xc6\x0c\x5f\x8d\xf9\x1e\xe4\xd7\x1d\xe6\x03\x4a\x97\xc9\xcf\x58\xfe\x7b\x86\x8c\x5e\xf1\xef\xc9\xd3\xab\xb0\xa2\xc8\x8b\x2f\x5f\xf2\x67\xfc\x7c\x59\x94\xbd\xf7\x3b\xc8\xd8\xce\x84\x83\x91\x67\x5c\xe2\x6c\x6f\xc4\x11\xba\x74\x96\x5e\x62\x82\xa1\x7f\xdf\xfe\x6f\x00\x83\x20\xb8\x5f\x5f\x05\x00\x00"),
+		},
+		"/scripts/init_change_ntp.sh": &vfsgen۰CompressedFileInfo{
+			name:             "init_change_ntp.sh",
+			modTime:          time.Date(2026, 10, 19, 18, 19, 10, 244273520, time.UTC),
+			uncompressedSize: 2997,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x56\xe1\x6f\xdb\xb6\x13\xfd\xae\xbf\xe2\x2a\x1b\x69\xfb\x83\x2d\x25\x01\x7e\x1f\x96\x2e\xc1\xb2\xb6\xc3\xbc\x15\x09\x50\xa7\x2b\x8a\x24\x0b\x68\xf2\x24\x11\xa1\x48\x96\xa4\xe2\x7a\x49\xfe\xf7\xe1\x28\xd9\x96\xe3\xa4\x2b\x92\xf8\x83\x2d\xbe\x7b\x7c\x77\xf7\xc8\xd3\xe0\x45\x3e\x93\x3a\x9f\x31\x5f\x25\x83\x01\xbc\x35\x76\xe1\x64\x59\x05\xd8\xdf\xdd\xfb\x09\xa6\x15\xd3\x65\xc5\x24\xfc\x21\x75\xf9\xae\x31\x30\xd1\x85\x71\x35\x0b\xd2\x68\x38\x43\x5e\x69\xa3\x4c\xb9\x00\x6e\xb2\x11\x7c\x08\x22\x4b\x06\x03\xa2\xf9\x20\x39\x6a\x8f\x02\x1a\x2d\xd0\x41\xa8\x10\x8e\x2d\xe3\x15\x2e\x57\x46\xf0\x17\x3a\x4f\x2c\xfb\xd9\x2e\xbc\x22\x40\xda\x2d\xa5\xaf\xdf\x10\xc5\xc2\x34\x50\xb3\x05\x68\x13\xa0\xf1\x08\xa1\x92\x1e\x0a\xa9\x10\xf0\x1b\x47\x1b\x40\x6a\xe0\xa6\xb6\x4a\x32\xcd\x11\xe6\x32\x54\x10\xd6\x1b\x90\x12\xf8\xd2\x71\x98\x59\x60\x52\x03\x03\x6e\xec\x02\x4c\xd1\x07\x02\x0b\x9d\xe8\xf8\x57\x85\x60\x0f\xf2\x7c\x3e\x9f\x67\x2c\x2a\xce\x8c\x2b\x73\xd5\x62\x7d\xfe\x61\xf2\xf6\xfd\xc9\xf4\xfd\x78\x3f\xdb\xed\xa2\x3e\x69\x85\xde\x83\xc3\xaf\x8d\x74\x28\x60\xb6\x00\x66\xad\x92\x9c\xcd\x14\x82\x62\x73\x30\x0e\x58\xe9\x10\x05\x04\x43\xaa\xe7\x4e\x06\xa9\xcb\x11\x78\x53\x84\x39\x73\x48\x52\x85\xf4\xc1\xc9\x59\x13\x36\x8a\xb6\xd4\x28\xfd\x06\xc0\x68\x60\x1a\xd2\xe3\x29\x4c\xa6\x29\xfc\x7a\x3c\x9d\x4c\x47\x44\xf2\x79\x72\xf6\xfb\xe9\xa7\x33\xf8\x7c\xfc\xf1\xe3\xf1\xc9\xd9\xe4\xfd\x14\x4e\x3f\xc2\xdb\xd3\x93\x77\x93\xb3\xc9\xe9\xc9\x14\x4e\x7f\x83\xe3\x93\x2f\xf0\xe7\xe4\xe4\xdd\x08\x50\x86\x0a\x1d\xe0\x37\xeb\x28\x03\xe3\x40\x52\x39\x31\x76\x11\xa6\x88\x1b\x12\x0a\xd3\xf6\xd1\x5b\xe4\xb2\x90\x1c\x14\xd3\x65\xc3\x4a\x84\xd2\xdc\xa0\xd3\x52\x97\x60\xd1\xd5\xd2\x53\x5b\x3d\x30\x2d\x88\x46\xc9\x5a\x86\xe8\x17\xbf\x9d\x57\x96\x24\x03\x38\xa3\xc6\x7a\xee\xa4\x0d\xc0\x8d\x2e\x64\xd9\x38\xf4\x11\x56\xca\x1b\xd4\xa0\x83\x05\x8f\xee\x06\x9d\x8f\x2a\x78\xe5\x8c\x5e\x88\x11\x18\x47\x6b\x02\x64\x01\x46\xab\x45\xf7\xc3\x83\xd4\x3e\x30\xa5\x50\x8c\x92\x01\xe9\x00\x1f\xd0\xb6\x8c\x5c\x19\x7e\x4d\x7d\xa0\x1f\x1d\x69\xd6\x31\x6e\x84\x12\xa9\xee\x2a\xd4\x1a\xa6\xde\x58\xcf\x92\x01\x4c\xc2\x4b\x0f\x9e\x15\x48\x84\xae\xd1\x84\x5a\x66\xc2\x4a\x26\xf5\xa8\xbf\x0d\x30\x21\x5a\x7f\xd0\x43\xc5\x7c\x88\x31\xcc\x21\x38\xb4\x8a\xf1\x58\x78\x68\x3c\x2b\xf1\x00\xa4\x96\xe1\x8a\xd3\xf1\xc3\x2b\x1d\x6c\xe6\x2b\xf8\xb9\xd5\x7b\x94\x65\x59\x92\x78\x0c\x30\x36\x60\xa5\xc5\x82\x49\x95\x24\x35\x73\xd7\xe8\x0e\xd3\xc1\x7a\x9f\x6b\xcb\x98\x4f\x93\x44\x16\x70\x0e\xc3\x01\x8c\xf1\x2b\xec\xc2\xe5\x1b\x52\xa5\x13\x72\x3b\xf2\xca\x40\xaa\x4d\xaf\xc6\x94\x65\xac\x7b\x0a\x47\x3b\xfb\x2d\xea\x9b\x0c\xb0\x97\x14\x92\xda\xb5\x6a\xd1\xd5\x32\x2f\x6e\xea\x1a\x75\xf0\x60\x9a\x40\xd4\x84\xf7\xe4\xf0\x75\xe6\x5a\x80\x35\x46\x51\xfd\x22\xa2\x25\x69\x8f\x33\x35\x88\x59\x8b\x5a\xf4\x9b\xde\x85\x26\x5b\xdb\xbd\x7a\x0d\xb7\x51\x95\x32\x9c\xa9\xe8\x98\xc3\xe1\x5e\x7c\xe2\x2b\x59\x84\xa4\xfd\x8a\x02\xc6\x12\xc6\x08\x69\x3e\xbc\x6d\x6b\x73\x7f\x31\xcc\x45\x4a\xcf\x5e\xfa\xfc\xef\x8b\x57\x2d\xe1\xc5\x1d\x29\xbb\xb8\xb3\x88\xee\xe2\xf5\xf9\xf9\x81\xb7\x8c\xe3\xc1\xe5\x65\x3e\xd8\xc9\x5f\x42\x3a\xbc\xa5\x2d\xee\x53\xb8\xbb\x03\x87\xa1\x71\x1a\xda\xdd\xc8\x8a\xcb\x92\x69\x48\x87\xbf\xa4\x6f\x40\x98\xb8\xb4\x2e\x6d\x07\x18\xde\xb6\x5f\xee\x41\xce\x1a\xe7\x03\xac\x34\xa5\x70\x74\xb4\xde\x24\x46\x0b\xa3\x31\xb9\x4f\x92\xce\x6b\x57\xad\x3b\x57\x79\xcb\x82\xee\xbd\x9a\xca\x36\xbe\x81\x45\x53\xc3\x11\xe4\x02\x6f\x72\xdd\x28\x05\xfb\x47\x3b\x7b\xbd\x06\xd3\x87\x20\x1d\x17\x8c\x17\x9d\xd9\xe3\x2a\xaa\x4d\x32\x66\xc3\xb8\xc4\xf0\x5f\x84\x4b\xd8\x53\xa4\x1e\x57\xd0\x95\xc1\x2c\xe3\xd7\x74\x59\xd4\x4c\xb3\x92\x6e\x01\xb3\x0a\x6f\x05\xad\xed\x46\xff\x9b\x95\x96\xb1\x1c\x05\xbc\xe8\x8b\xed\xae\x81\x2d\xb1\xb0\xb3\xb3\x09\x8c\xd7\xc2\x77\x52\xda\xac\xf3\x06\xdf\xdd\x5d\xdf\xfc\xb2\xf8\x91\xfd\x7b\xcc\xd4\xd4\xc3\x1c\x03\xcf\x5b\x70\x46\x0f\xe2\xca\x39\x8c\x8b\x9e\xb7\x2e\xc9\x5d\x0f\xd1\x5b\x41\xdb\x47\x6f\xcd\x40\xfe\xeb\xc9\x8d\xf8\xc1\xea\xfa\x91\x3c\xce\x10\xcd\x6a\x14\x9d\x70\x30\x1a\x04\xce\x24\xa3\x59\x22\xa0\x99\x35\x3a\x34\x31\xac\xd1\x32\x1c\x76\xd9\xc5\x07\x7e\xe1\x03\xd6\x3c\x28\xe0\x2c\x90\x6a\x42\xdc\xa7\x5b\x99\xd3\xfe\xbd\xe0\x07\xb1\x3e\x18\xfb\x78\x2f\x1e\x00\x85\xf4\x71\x76\xfe\x08\x16\x75\x84\x3e\xad\xe9\x01\xde\xa1\x0f\xcc\xf5\x93\xd8\xaa\xd9\x9c\xc9\x10\xe7\x0c\x03\x6f\x1a\xc7\xe3\xed\x3e\xa3\x69\xa1\x90\x07\x14\xf1\x52\xd7\x71\xa6\xf4\x46\x0a\x99\x08\x99\xa0\x97\x0b\xaf\x70\x4e\xb7\x9f\x0c\xe0\x95\x99\xab\xf6\x58\xb4\x05\xe5\x91\xde\x2f\x34\x87\xbd\xff\x3f\x2e\x76\x09\x1c\x33\xa8\xd9\x35\xc6\x7d\xfa\xc0\xb5\xe0\xf5\x01\x5b\x39\x63\xd9\x5d\xd1\xbe\x10\xf5\x46\xe7\x01\x0c\xff\x97\x26\xab\xc3\xb9\x36\x1b\x4d\x97\x67\x5b\x2c\xb6\x9b\x1a\xf5\x3c\xa3\xe8\x60\x1f\x04\x46\x97\x3c\x1d\xd9\xf5\x88\xa6\xdf\xba\xf6\x66\xf9\x02\xb8\x1c\x40\x96\x69\xc9\x21\x54\x0e\x7d\x65\x94\x18\xb5\xb6\xbb\x46\x7a\x0b\xa0\xae\x2c\x74\xac\x92\xfc\x07\x05\x28\x16\xd0\x45\xda\x08\x1a\x97\x5f\xbf\x53\xed\xe7\x1b\xef\x69\xdb\x3d\xda\xc5\xa8\xe5\xf1\x16\x16\x32\xf9\x77\x00\x7f\xe8\xbe\xee\xb5\x0b\x00\x00"),
+		},
+		"/scripts/init_change_route.sh": &vfsgen۰CompressedFileInfo{
+			name:             "init_change_route.sh",
+			modTime:          time.Date(2026, 10, 19, 18, 49, 10, 817643278, time.UTC),
+			uncompressedSize: 1095,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x93\x4f\x6f\xeb\x36\x10\xc4\xef\xfa\x14\x53\x29\x87\x16\x50\x64\x27\xb7\x26\x45\x51\x37\x49\x51\xb5\x81\x0d\x44\x4e\x83\xa0\x28\x02\x9a\x5a\x89\x8b\xd2\xa4\x4a\x52\x96\x8d\xe6\x7d\xf7\x07\x4a\x76\xfe\xe0\x19\x3e\x98\xe4\x8f\xc3\xd9\xd9\x75\xf6\xdd\xac\xf7\x6e\xb6\x61\x33\x23\xb3\xc3\x46\x78\x95\x64\x19\x6e\x6c\x77\x70\xdc\xaa\x80\xcb\xf9\xc5\x8f\xa8\x94\x30\xad\x12\x8c\x3f\xd8\xb4\xb7\xbd\x45\x69\x1a\xeb\xb6\x22\xb0\x35\x58\x93\x54\xc6\x6a\xdb\x1e\x20\x6d\x91\xe3\x3e\xd4\x45\x92\x65\x51\xe6\x9e\x25\x19\x4f\x35\x7a\x53\x93\x43\x50\x84\x45\x27\xa4\xa2\xd3\x49\x8e\xbf\xc8\xf9\xa8\x72\x59\xcc\xf1\x7d\x04\xd2\xe3\x51\xfa\xc3\x75\x94\x38\xd8\x1e\x5b\x71\x80\xb1\x01\xbd\x27\x04\xc5\x1e\x0d\x6b\x02\xed\x25\x75\x01\x6c\x20\xed\xb6\xd3\x2c\x8c\x24\x0c\x1c\x14\xc2\xfb\x03\xd1\x09\x9e\x8f\x1a\x76\x13\x04\x1b\x08\x48\xdb\x1d\x60\x9b\x8f\x20\x44\x38\x9a\x1e\x3f\x2a\x84\xee\x6a\x36\x1b\x86\xa1\x10\xa3\xe3\xc2\xba\x76\xa6\x27\xd6\xcf\xee\xcb\x9b\xbb\x65\x75\x77\x7e\x59\xcc\x8f\xb7\x1e\x8d\x26\xef\xe1\xe8\xbf\x9e\x1d\xd5\xd8\x1c\x20\xba\x4e\xb3\x14\x1b\x4d\xd0\x62\x80\x75\x10\xad\x23\xaa\x11\x6c\x74\x3d\x38\x0e\x6c\xda\x1c\xde\x36\x61\x10\x8e\xa2\xd5\x9a\x7d\x70\xbc\xe9\xc3\xa7\xd0\x4e\x1e\xd9\x7f\x02\xac\x81\x30\x48\x17\x15\xca\x2a\xc5\xaf\x8b\xaa\xac\xf2\x28\xf2\x54\xae\x7f\x5f\x3d\xae\xf1\xb4\x78\x78\x58\x2c\xd7\xe5\x5d\x85\xd5\x03\x6e\x56\xcb\xdb\x72\x5d\xae\x96\x15\x56\xbf\x61\xb1\x7c\xc6\x9f\xe5\xf2\x36\x07\x71\x50\xe4\x40\xfb\xce\xc5\x0a\xac\x03\xc7\x38\x69\xec\x22\x2a\xa2\x4f\x16\x1a\x3b\xf5\xd1\x77\x24\xb9\x61\x09\x2d\x4c\xdb\x8b\x96\xd0\xda\x1d\x39\xc3\xa6\x45\x47\x6e\xcb\x3e\xb6\xd5\x43\x98\x3a\xca\x68\xde\x72\x18\xe7\xc5\x7f\x5b\x57\x91\x24\x19\xd6\xb1\xb1\x5e\x3a\x8e\x3d\xf5\x10\xbc\x8d\x39\xd5\xa4\x29\x10\xd8\xec\x84\xe6\x1a\xce\xf6\x81\x7c\x8e\x41\xb1\x54\x10\x6e\xf2\x36\xed\x46\x3c\xae\x5a\xde\x91\x81\xef\x37\x86\x82\xcf\x41\x45\x5b\x44\x2a\xc9\xd0\xd9\x3a\xfa\x81\x27\xb7\x63\x49\x27\xe6\x34\x09\x52\xf7\x3e\x90\xcb\x23\x7d\x18\xc5\x35\x35\x21\x76\xd2\x8e\x09\x19\x0a\x83\x75\xff\x8e\x35\x41\x5a\xd3\x68\x96\xe1\x7d\xe2\x8e\xd7\x8b\x24\x43\xef\x45\x4b\x57\x60\xc3\xe1\x45\xc6\xff\x0e\xbd\x8c\x1e\x0b\xaf\xf0\xf7\x4f\xd3\xb3\x3f\xff\x53\x14\x45\x92\xc4\x3c\xa7\x8d\x38\x13\xe9\xd9\x2f\xe9\x35\x6a\x9b\xc4\x19\xe4\x6e\xaa\x17\x5e\xd9\x21\x56\x47\x7b\x21\x03\xd2\xb3\x89\x4f\xf1\x1a\x73\xd0\x04\x47\xa2\xc6\xb9\x9b\xe8\xb7\xeb\xf1\x4b\x52\x59\xa4\xc7\x10\xc7\xe3\x2b\x9c\xfd\x3f\xfe\xf8\x92\xbe\x51\x6f\x0f\x1d\xc1\x13\x81\xd7\x57\xd0\x9e\x03\x2e\x46\xb4\xb6\x86\x3e\x6c\xc5\x65\x42\x7b\x0e\x98\x27\x5f\x07\x00\xbe\xa5\xbd\x0f\x47\x04\x00\x00"),
+		},
+		"/scripts/init_change_swap.sh": &vfsgen۰CompressedFileInfo{
+			name:             "init_change_swap.sh",
+			modTime:          time.Date(2026, 10, 19, 18, 49, 10, 816941515, time.UTC),
+			uncompressedSize: 824,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x52\x5f\x4f\xdb\x4e\x10\x7c\xf7\xa7\x98\x5f\xfc\x93\xf8\xa3\xc4\x06\xde\x4a\x9f\x52\x48\x55\xb7\x28\x91\x70\x28\x42\x14\xa4\xcd\x79\x6d\xaf\x64\xdf\x5d\xef\xce\x98\x48\x7c\xf8\xea\x42\x50\x41\xf5\x93\x4f\x3b\x3b\x3b\x3b\xb3\xe9\x7f\xf9\xe0\x5d\xbe\x11\x9d\xb3\x7e\xc2\x86\x7c\x9b\xa4\x29\x2e\x8c\xdd\x3a\x69\xda\x80\xb3\x93\xd3\x4f\x28\x5b\xd2\x4d\x4b\x82\xef\xa2\x9b\xcb\xc1\xa0\xd0\xb5\x71\x3d\x05\x31\x1a\x6b\x56\xad\x36\x9d\x69\xb6\x50\x26\x9b\xe2\x2a\x54\x59\x92\xa6\x91\xe6\x4a\x14\x6b\xcf\x15\x06\x5d\xb1\x43\x68\x19\x73\x4b\xaa\xe5\xb7\xca\x14\x3f\xd9\xf9\xc8\x72\x96\x9d\xe0\x30\x02\x26\xfb\xd2\xe4\xe8\x73\xa4\xd8\x9a\x01\x3d\x6d\xa1\x4d\xc0\xe0\x19\xa1\x15\x8f\x5a\x3a\x06\x3f\x2b\xb6\x01\xa2\xa1\x4c\x6f\x3b\x21\xad\x18\xa3\x84\x16\xe1\xef\x80\xa8\x04\x77\x7b\x0e\xb3\x09\x24\x1a\x04\x65\xec\x16\xa6\x7e\x0f\x04\x85\xbd\xe8\xdd\xd7\x86\x60\xcf\xf3\x7c\x1c\xc7\x8c\x76\x8a\x33\xe3\x9a\xbc\x7b\xc5\xfa\xfc\xaa\xb8\x58\x2c\xcb\xc5\xec\x2c\x3b\xd9\x77\xdd\xe8\x8e\xbd\x87\xe3\xdf\x83\x38\xae\xb0\xd9\x82\xac\xed\x44\xd1\xa6\x63\x74\x34\xc2\x38\x50\xe3\x98\x2b\x04\x13\x55\x8f\x4e\x82\xe8\x66\x0a\x6f\xea\x30\x92\xe3\x28\xb5\x12\x1f\x9c\x6c\x86\xf0\xc1\xb4\x37\x8d\xe2\x3f\x00\x8c\x06\x69\x4c\xe6\x25\x8a\x72\x82\x2f\xf3\xb2\x28\xa7\x91\xe4\xb6\x58\x7f\x5b\xdd\xac\x71\x3b\xbf\xbe\x9e\x2f\xd7\xc5\xa2\xc4\xea\x1a\x17\xab\xe5\x65\xb1\x2e\x56\xcb\x12\xab\xaf\x98\x2f\xef\xf0\xa3\x58\x5e\x4e\xc1\x12\x5a\x76\xe0\x67\xeb\xe2\x06\xc6\x41\xa2\x9d\xbc\x4b\x11\x25\xf3\x07\x09\xb5\x79\xcd\xd1\x5b\x56\x52\x8b\x42\x47\xba\x19\xa8\x61\x34\xe6\x89\x9d\x16\xdd\xc0\xb2\xeb\xc5\xc7\x58\x3d\x48\x57\x91\xa6\x93\x5e\xc2\xee\x5e\xfc\xbf\x7b\x65\x49\x92\x62\x1d\x83\xf5\xca\x49\xcc\xd4\x83\xa4\x8f\x3e\xa9\xce\x78\x86\x1f\xc9\x4e\x77\x1d\xf1\x0f\xac\x83\x13\xf6\x31\xc1\x9c\x83\xca\x6b\x1f\x68\x03\x72\x1c\x2f\xa1\x67\x1d\xcd\x33\x43\x48\x92\x88\x36\x75\x8d\x19\xe1\xe5\x05\xfc\x2c\x01\xa7\x49\xbc\xc7\x99\x60\xb6\xc0\x81\xcf\x1f\x0f\xef\x1f\xd3\x87\xec\xf8\xfe\xfe\xdc\x5b\x52\x7c\xfe\xf0\x10\x9b\xde\x3d\xb3\xe3\xa3\xff\xf3\xf4\xd7\x69\x7e\xf0\x6e\x58\xf2\x67\x00\xce\x0d\x07\x7d\x38\x03\x00\x00"),
+		},
+		"/scripts/init_change_timezone.sh": &vfsgen۰CompressedFileInfo{
+			name:             "init_change_timezone.sh",
+			modTime:          time.Date(2026, 10, 19, 18, 49, 10, 817447860, time.UTC),
+			uncompressedSize: 880,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x5f\x4f\xdb\x4a\x10\xc5\xdf\xfd\x29\xce\x75\x90\xee\xbd\x52\xb0\x81\xb7\xd2\x3f\x92\x4b\x8c\xea\x36\x38\x12\x36\x45\x50\x55\x68\xb3\x1e\xdb\x23\xd9\xbb\xee\xee\x1a\x93\x56\xfd\xee\xd5\x86\x04\x4a\xbb\xaf\x73\xe6\xb7\x67\xe6\xcc\xec\x9f\x78\xb4\x26\x5e\xb3\x8a\x49\xdd\x63\x2d\x6c\x1b\xcc\x66\x38\xd3\xc3\xc6\x70\xd3\x3a\x9c\x1c\x1d\xbf\x42\xd1\x0a\xd5\xb4\x82\xf1\x91\x55\xb3\x18\x35\x32\x55\x6b\xd3\x0b\xc7\x5a\xa1\x24\xd9\x2a\xdd\xe9\x66\x03\xa9\xa3\x39\x96\xae\x8a\x82\xd9\xcc\x63\x96\x2c\x49\x59\xaa\x30\xaa\x8a\x0c\x5c\x4b\x48\x06\x21\x5b\xda\x57\xe6\xf8\x4c\xc6\x7a\xca\x49\x74\x84\xff\xbc\x20\xdc\x95\xc2\xff\x5f\x7b\xc4\x46\x8f\xe8\xc5\x06\x4a\x3b\x8c\x96\xe0\x5a\xb6\xa8\xb9\x23\xd0\x83\xa4\xc1\x81\x15\xa4\xee\x87\x8e\x85\x92\x84\x89\x5d\x0b\xf7\xfc\x81\x77\x82\x9b\x1d\x43\xaf\x9d\x60\x05\x01\xa9\x87\x0d\x74\xfd\xbb\x10\xc2\xed\x4c\x6f\x5f\xeb\xdc\x70\x1a\xc7\xd3\x34\x45\x62\xeb\x38\xd2\xa6\x89\xbb\x47\xad\x8d\x97\xd9\x59\x9a\x17\xe9\xe1\x49\x74\xb4\xeb\xba\x52\x1d\x59\x0b\x43\xdf\x46\x36\x54\x61\xbd\x81\x18\x86\x8e\xa5\x58\x77\x84\x4e\x4c\xd0\x06\xa2\x31\x44\x15\x9c\xf6\xae\x27\xc3\x8e\x55\x33\x87\xd5\xb5\x9b\x84\x21\x6f\xb5\x62\xeb\x0c\xaf\x47\xf7\x62\x69\x7b\x8f\x6c\x5f\x08\xb4\x82\x50\x08\x93\x02\x59\x11\xe2\x7d\x52\x64\xc5\xdc\x43\xae\xb3\xf2\xc3\xea\xaa\xc4\x75\x72\x79\x99\xe4\x65\x96\x16\x58\x5d\xe2\x6c\x95\x2f\xb2\x32\x5b\xe5\x05\x56\xe7\x48\xf2\x1b\x7c\xca\xf2\xc5\x1c\xc4\xae\x25\x03\x7a\x18\x8c\x9f\x40\x1b\xb0\x5f\x27\x6d\x53\x44\x41\xf4\xc2\x42\xad\x1f\x73\xb4\x03\x49\xae\x59\xa2\x13\xaa\x19\x45\x43\x68\xf4\x3d\x19\xc5\xaa\xc1\x40\xa6\x67\xeb\x63\xb5\x10\xaa\xf2\x98\x8e\x7b\x76\xdb\x7b\xb1\x7f\xcf\x15\x05\xc1\x0c\xa5\x0f\xd6\x4a\xc3\x3e\x53\x0b\xc1\xbd\xdf\x93\xf4\x97\x47\x70\xdc\x13\xbe\x6b\x45\x73\xb0\xfb\xd7\x6e\x9b\x2b\xaa\xc5\xd8\xb9\xe7\x1a\xb8\xde\x9e\x49\xc3\xf7\xa4\x82\x19\x46\x2b\x1a\x3a\x05\x2b\x76\x77\x8f\x9c\x3b\xaf\xf5\xd2\xc8\xb6\xf8\xf2\xe6\xa9\xf3\xdd\xd7\x20\x58\xa4\xe7\xc9\xd5\xb2\x2c\xb3\x8b\xf4\x76\x95\xa7\x6f\xc3\xc4\xb2\x88\xf7\x97\x1f\x06\x4f\x85\x83\x1f\xc7\xa7\x87\x07\x7f\xc8\x7f\x06\x81\xa7\x55\xc2\x91\x74\x1d\x2c\xb9\xc3\xfd\x5f\x08\x0f\xca\xec\x22\xbd\x5d\xe5\x69\x18\xfc\x1a\x00\xbd\xfc\x78\xc8\x70\x03\x00\x00"),
+		},
+		"/scripts/init_container_runtime.sh": &vfsgen۰CompressedFileInfo{
+			name:             "init_container_runtime.sh",
+			modTime:          time.Date(2026, 10, 19, 18, 34, 2, 945018481, time.UTC),
+			uncompressedSize: 4355,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x57\x7d\x53\xe3\xbe\x11\xfe\xdf\x9f\x62\x71\x18\xf2\xd2\xc4\x3e\xe8\x5f\x05\xc2\x0c\xe5\x68\x7f\x69\x6f\xa0\x73\xc9\xf5\xe6\x06\x72\x8c\x22\xaf\x6d\x35\xb6\xe4\x93\xe4\x84\x0c\xf0\xdd\x3b\xf2\x4b\xfc\x92\x04\xae\x4d\x98\xc1\x91\x76\x1f\x3d\x7a\x76\xb5\x2b\x77\x8e\xdc\x05\xe3\xee\x82\xa8\xd0\xea\x74\xe0\x46\x24\x1b\xc9\x82\x50\xc3\xd9\xa7\xd3\xbf\xc0\x34\x24\x3c\x08\x09\x83\x7f\x30\x1e\x7c\x4e\x05\x4c\xb8\x2f\x64\x4c\x34\x13\x1c\x66\x48\x43\x2e\x22\x11\x6c\x80\x0a\x67\x08\x5f\xb4\xe7\x58\x9d\x8e\x81\xf9\xc2\x28\x72\x85\x1e\xa4\xdc\x43\x09\x3a\x44\xb8\x4e\x08\x0d\xb1\x9c\x19\xc2\xbf\x51\x2a\x83\x72\xe6\x7c\x82\x9e\x31\xb0\x8b\x29\xbb\x7f\x61\x20\x36\x22\x85\x98\x6c\x80\x0b\x0d\xa9\x42\xd0\x21\x53\xe0\xb3\x08\x01\x9f\x29\x26\x1a\x18\x07\x2a\xe2\x24\x62\x84\x53\x84\x35\xd3\x21\xe8\x6a\x01\xc3\x04\x7e\x14\x18\x62\xa1\x09\xe3\x40\x80\x8a\x64\x03\xc2\xaf\x1b\x02\xd1\x05\xe9\xec\x13\x6a\x9d\x9c\xbb\xee\x7a\xbd\x76\x48\xc6\xd8\x11\x32\x70\xa3\xdc\x56\xb9\x5f\x26\x37\xb7\x77\xd3\xdb\xd1\x99\xf3\xa9\xf0\xfa\xc6\x23\x54\x0a\x24\xfe\x4a\x99\x44\x0f\x16\x1b\x20\x49\x12\x31\x4a\x16\x11\x42\x44\xd6\x20\x24\x90\x40\x22\x7a\xa0\x85\x61\xbd\x96\x4c\x33\x1e\x0c\x41\x09\x5f\xaf\x89\x44\x43\xd5\x63\x4a\x4b\xb6\x48\x75\x43\xb4\x92\x23\x53\x0d\x03\xc1\x81\x70\xb0\xaf\xa7\x30\x99\xda\xf0\xd7\xeb\xe9\x64\x3a\x34\x20\xdf\x27\xb3\x3f\xee\xbf\xcd\xe0\xfb\xf5\xd7\xaf\xd7\x77\xb3\xc9\xed\x14\xee\xbf\xc2\xcd\xfd\xdd\xe7\xc9\x6c\x72\x7f\x37\x85\xfb\xbf\xc1\xf5\xdd\x0f\xf8\xe7\xe4\xee\xf3\x10\x90\xe9\x10\x25\xe0\x73\x22\xcd\x0e\x84\x04\x66\xe4\xc4\x2c\x8a\x30\x45\x6c\x50\xf0\x45\x1e\x47\x95\x20\x65\x3e\xa3\x10\x11\x1e\xa4\x24\x40\x08\xc4\x0a\x25\x67\x3c\x80\x04\x65\xcc\x94\x09\xab\x02\xc2\x3d\x03\x13\xb1\x98\xe9\x2c\x5f\xd4\xee\xbe\x1c\xcb\xea\xc0\xcc\x04\x56\x51\xc9\xb2\x98\x2a\x4d\xa2\x28\xf3\x06\x2a\xb8\xcf\x82\x54\xa2\xca\x7c\xa8\xe0\x26\x8a\x28\x41\xa6\x5c\xb3\x38\xa7\xb4\x4c\x17\x18\xa1\x3e\xcf\x4d\x24\x03\x25\xe8\x12\x35\x18\x4c\x94\x2b\xf4\xac\x4e\x06\x96\x4d\x07\x52\xa4\x09\x78\x92\xad\x50\x66\x16\x1b\xa5\x31\xf6\x1c\x98\x85\x08\x42\xb2\x80\x71\x12\x15\xeb\x9a\xf9\xa5\xc9\x33\xa2\xe0\x32\x1f\xba\x72\x96\x09\x21\xca\x59\x90\xa5\x63\x75\x20\x55\x24\xc0\x73\x60\x9c\xe9\xa7\x2d\xb9\xa7\x82\x9c\xa3\x42\xb8\xf4\x0c\x17\xf9\xba\x9d\xf4\xae\x2c\xcb\x81\x88\x2d\x1c\x15\x5a\x56\x61\x39\x3e\x3e\xb5\x72\x43\x83\xe2\xb3\x60\xec\xa2\xa6\x6e\x3e\xe4\x7a\x04\x63\xc1\x9d\xff\x28\xc1\xad\x0a\xa7\x61\x59\x0d\xbb\xf9\xb0\xa3\x45\x1c\x59\xd6\x82\xd0\x65\x9a\xf4\xfa\xf0\x62\x99\xcc\x66\x3e\x3c\xc0\xc8\x07\xfb\xf8\xd4\x86\x39\x9c\x9c\xc0\x03\x1c\x15\x03\xd5\xc6\x6c\x98\x5f\x18\x2d\x79\xe6\x63\xfe\x68\x02\xa3\x24\xf7\x6a\x5a\x66\x16\x3e\xb3\xde\x2c\x2b\xd9\xe8\x50\xf0\x27\x1a\x7b\xf5\xe5\xa8\x88\x63\xc2\xbd\x27\x7c\x66\x4a\x2b\xc8\x8d\xfe\xdc\x82\x47\x1a\x8a\x72\x2a\x1b\xc4\xe8\x90\xeb\x61\xcf\x1a\x95\x2c\x1c\xb9\x78\x5b\x2e\x2d\xb4\x7c\x16\x5e\x5f\x01\xa5\x14\xd2\x2c\xa2\xc1\x2e\x46\x99\xca\x4a\x4e\x91\x89\xe8\xd9\x56\x06\x11\x2f\x3d\x26\x73\x21\x7a\x1e\x93\x9c\xc4\x08\xc7\x2f\x8d\xb8\xbd\xf5\xed\x4a\xe8\x23\x18\x29\xb0\xdb\x16\xbb\xea\x66\xdb\xef\xbe\xd8\xf8\x8c\x74\x24\x12\xad\xec\x73\x78\xb0\x39\xd1\x6c\x85\x4e\x9e\xb0\x79\xbe\x8e\x8b\x64\xb5\xe7\x6f\x5d\xb8\xda\x03\x5d\x89\x77\x04\x81\xc4\x04\x46\xbf\xa0\xfb\x0e\x50\x77\x0f\x46\x8b\x5c\x24\x28\x89\x0a\x89\xc7\xc7\xbd\x2a\xca\xfd\xad\x89\x46\xa5\x61\xc4\x0d\x56\x3e\xfd\x66\xb7\x85\xcd\xc7\x81\xd5\x8a\xa4\x16\x90\x26\x1e\xd1\xbb\x1a\xda\x5b\xe4\x3c\x7d\x0f\x6d\xd4\x7c\xb7\x4b\xc2\x68\x8f\x19\x5c\x5e\x76\xff\xf5\x63\xf6\xc7\xfd\x5d\xb7\xcd\xc8\x27\x2c\xfa\x80\x05\x8b\x13\x21\x35\x64\xe7\xae\x78\x56\x1b\x65\x59\x09\xd1\x21\x8c\x41\x6d\x94\x43\x64\xb0\x7a\x38\x9d\x5b\x59\xeb\x11\x09\xf2\x9e\x99\xec\x03\x51\xe0\x9f\x17\x79\x67\xd0\x60\x9c\xc1\x38\x91\x20\x5e\xcf\xef\x5b\x26\xca\x30\x86\x07\x91\xe8\xac\x84\x89\xb2\x99\x19\x63\x27\x40\xdd\xab\x65\xc3\x10\x1e\xe6\x7d\x93\x52\x26\x29\x45\xa2\x1d\xa5\x89\xd4\xca\xac\xd9\xdb\x9b\x26\x76\x7f\x6e\x0a\x85\xcf\x82\x87\x1a\xcc\x1c\xc6\x60\x1e\xe0\x4f\x1f\x65\x57\x6b\x3b\x43\xb0\xd7\x76\x7d\x4f\xd9\x56\xbc\x34\x4e\x7a\xf9\x2a\x43\xf0\x87\xc0\xb8\x87\x5c\x8f\xcf\xfa\x56\xae\x78\x79\x1e\xb3\xff\x79\xbe\x51\x1d\x01\xf2\xac\x29\x16\x27\xed\x0a\x5c\x0f\x57\x2e\x4f\xa3\x08\xce\xae\x4e\x4e\x5b\xc6\x12\xb3\xad\x96\xd6\x07\x43\xd8\xb4\xcb\xb3\x23\x3b\x55\xb5\x13\xbd\x6d\x25\x5e\x75\x51\x28\x76\xdc\xec\x0a\xf6\xb6\x84\x54\x55\xb5\x5e\xd2\x8e\xda\x95\xa9\x32\x6b\x9d\x1d\x4a\x14\x82\x7d\xdc\xfb\x3b\xea\xfb\x69\xdf\x06\x56\x4d\x99\xef\x80\x22\xd7\x42\x0d\x5e\x07\x32\xc4\x68\x50\x1d\xa8\xf2\xb3\x49\xe3\xb2\x1d\xc2\x68\x53\x5b\xc7\x61\xa2\x21\xdd\x41\x61\x4a\xef\x86\xab\x6d\xb5\xd6\x81\x8b\x8b\xc6\xd0\x20\x5d\xa4\x5c\xa7\x83\xd7\x81\x87\x0b\x46\xf8\x1e\x6a\x24\xd1\xa3\x00\xf5\x7e\x7a\xff\x2f\xb7\x8f\x89\xed\x12\xa9\x63\xd7\x18\xec\x96\xf0\xd2\x61\x0f\x34\x2a\x42\xab\x1f\x59\xde\x34\xa1\x5a\x30\x3e\x7b\xaf\x21\x54\xae\xad\xa6\x50\x55\xb3\x5d\x93\x9c\x5f\xa7\xbc\xe5\x98\x92\x51\xdc\x51\x2b\x5b\x13\xf5\x84\xd0\xa5\xb9\x70\x79\x4c\x99\x73\x54\x5c\x8b\x24\x83\x24\x4a\x03\xc6\x87\xd9\x6f\x0f\x7d\x92\x46\x1a\x04\xc7\xe2\xbc\x29\x60\x7a\xb7\x31\xed\xa1\x01\x73\x13\xb0\xbc\x77\xdc\xfe\x82\xee\xcf\x47\x35\x28\xd6\xf2\x9e\xf2\x35\xd4\xa3\x1a\x8c\x9d\x81\x4d\x25\xb3\xbb\x07\x60\xda\x07\x61\x6b\x51\x6e\xae\xa4\x78\x75\x88\xc7\xc1\xb4\x09\x90\xa3\xcc\xdb\xc6\x1e\xbf\x46\x7c\x3a\x30\xcd\x0f\xf8\x4d\x7e\xbe\x59\x2e\x97\x48\xcc\x5d\xb4\xd4\x57\xa6\x9c\xc2\xea\x0c\x54\xc8\xe2\x61\x59\x11\x9e\x8a\x8a\x20\x78\xb4\x81\xb5\x90\x4b\x55\x15\x8d\xd5\x69\x66\x5b\xca\xd9\xd4\xaa\xb1\xa0\x11\xea\x37\x15\x32\x2f\x4a\x23\x06\xa3\x5b\xe8\x2a\xf7\x67\xef\x51\x0d\xfa\x3b\x50\xce\xc0\x7d\x3c\x6d\x8c\xc2\x18\xb4\x4c\xd1\x3d\xb4\x48\x75\x1f\xa8\x58\x16\x57\xce\x27\xbd\x49\xd0\x10\x7c\x54\x03\x9b\x09\xa7\xf2\x76\x8c\x22\xce\xea\xec\x60\x70\xe1\xe4\x64\xcb\x7b\x7b\xcb\x68\xfa\x9b\xbb\xb9\x32\x0f\xd4\xc9\xd5\x56\x8f\xf3\xff\x4d\x89\xae\xfb\x31\xa2\x4b\x1e\x4b\x2f\xf3\xdd\x27\xcd\x07\xca\x28\x7c\x2f\x02\xcd\x64\xd8\x86\xa0\x95\x23\xbf\x13\x83\x83\x7d\xf0\x40\xd1\x7c\xa7\x17\xd6\x3c\x3e\xec\x87\x95\xad\x6d\x1d\xaa\x6d\xfb\xfa\x62\x55\x50\xb6\x2f\x4e\x87\x5b\x65\xd1\xe5\x5e\x8a\x20\xbd\x6d\xfb\x5c\xde\x7b\xab\x92\x5d\xbb\x96\x5b\xad\x2a\x5c\x51\x6a\x99\x57\x13\x6d\x97\x5a\x2f\xa8\x8b\x90\xf2\x25\x17\x6b\xbe\xe7\x55\xb1\xc6\xb0\x8e\x85\x8a\x50\xeb\xbf\x03\x00\x00\x2c\x31\xf9\x03\x11\x00\x00"),
+		},
+		"/scripts/init_deploy_haproxy.sh": &vfsgen۰CompressedFileInfo{
+			name:             "init_deploy_haproxy.sh",
+			modTime:          time.Date(2026, 10, 19, 19, 13, 15, 723949720, time.UTC),
+			uncompressedSize: 1378,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x94\x4f\x6f\xe3\x36\x10\xc5\xef\xfa\x14\xaf\x72\x50\x6c\x0c\x5b\x4a\x72\x6b\xd2\x06\x70\x93\xb4\xeb\x36\xb0\x0b\xcb\xdb\x6d\x50\x14\x01\x2d\x8d\xa5\xc1\x52\x24\xc3\x3f\x76\x04\xf8\xc3\x17\x54\x6c\x6f\xd2\x6e\x81\x1e\xd6\xf0\x41\x22\x67\x1e\x7f\x9c\x37\x9a\xc1\x37\x79\x70\x36\x5f\xb1\xca\x49\x6d\xb0\x12\xae\x49\x06\x03\xdc\x68\xd3\x59\xae\x1b\x8f\x8b\xb3\xf3\xef\x50\x34\x42\xd5\x8d\x60\xfc\xc2\xaa\xbe\x0d\x1a\x53\xb5\xd6\xb6\x15\x9e\xb5\xc2\x92\xca\x46\x69\xa9\xeb\x0e\xa5\xce\x46\xb8\xf7\x55\x96\x0c\x06\x51\xe6\x9e\x4b\x52\x8e\x2a\x04\x55\x91\x85\x6f\x08\x13\x23\xca\x86\x0e\x3b\x23\xfc\x4e\xd6\x45\x95\x8b\xec\x0c\xef\x62\x40\xba\xdf\x4a\x4f\xaf\xa2\x44\xa7\x03\x5a\xd1\x41\x69\x8f\xe0\x08\xbe\x61\x87\x35\x4b\x02\x3d\x97\x64\x3c\x58\xa1\xd4\xad\x91\x2c\x54\x49\xd8\xb2\x6f\xe0\x3f\x1f\x10\x49\xf0\xb0\xd7\xd0\x2b\x2f\x58\x41\xa0\xd4\xa6\x83\x5e\xbf\x0e\x84\xf0\x7b\xe8\xfe\xd7\x78\x6f\x2e\xf3\x7c\xbb\xdd\x66\xa2\x27\xce\xb4\xad\x73\xf9\x12\xeb\xf2\xfb\xe9\xcd\xdd\xac\xb8\x1b\x5f\x64\x67\xfb\xac\x0f\x4a\x92\x73\xb0\xf4\x14\xd8\x52\x85\x55\x07\x61\x8c\xe4\x52\xac\x24\x41\x8a\x2d\xb4\x85\xa8\x2d\x51\x05\xaf\x23\xf5\xd6\xb2\x67\x55\x8f\xe0\xf4\xda\x6f\x85\xa5\x88\x5a\xb1\xf3\x96\x57\xc1\xbf\x29\xda\x81\x91\xdd\x9b\x00\xad\x20\x14\xd2\x49\x81\x69\x91\xe2\xc7\x49\x31\x2d\x46\x51\xe4\xe3\x74\xf9\x7e\xfe\x61\x89\x8f\x93\xc5\x62\x32\x5b\x4e\xef\x0a\xcc\x17\xb8\x99\xcf\x6e\xa7\xcb\xe9\x7c\x56\x60\xfe\x13\x26\xb3\x07\xfc\x3a\x9d\xdd\x8e\x40\xec\x1b\xb2\xa0\x67\x63\xe3\x0d\xb4\x05\xc7\x72\x52\xef\x22\x0a\xa2\x37\x08\x6b\xfd\xe2\xa3\x33\x54\xf2\x9a\x4b\x48\xa1\xea\x20\x6a\x42\xad\x37\x64\x15\xab\x1a\x86\x6c\xcb\x2e\xda\xea\x20\x54\x15\x65\x24\xb7\xec\xfb\x7e\x71\xff\xbe\x57\x96\x24\x03\x2c\xa3\xb1\xae\xb4\x1c\x3d\x75\x10\xdc\xc6\x3a\x55\x64\xa4\xee\xd0\x08\x63\xf5\x73\x37\xc2\xb6\xe1\xb2\x41\x7c\x61\x72\xbd\x86\x30\xec\xc8\x6e\xc8\x3a\x68\xd5\xaf\xb4\xc2\x79\xb2\x2e\x4b\x06\x08\x4e\xd4\x74\x09\x56\xec\x1f\x5f\xa4\x1e\xf7\x52\x99\x6b\x90\x7e\x7f\x4c\x06\x9b\x4b\xa3\xad\xbf\xc6\x9f\x5f\x58\xfc\x2b\xcb\xb2\x34\x49\x32\x48\x5e\x65\xae\x49\x92\xf7\x93\xdf\x16\xf3\x3f\x1e\x26\xb7\xb7\x8b\x1f\x4e\xce\x93\xc4\x93\xf3\x18\x2b\xa4\x27\xaf\x76\x52\xec\x76\x20\x6b\xb5\x7d\xa4\x67\xf6\x48\xf7\x47\x43\x54\x95\x8d\x77\x8c\x3d\x7d\xa8\x63\x9a\x24\xa5\x70\x84\xf4\xe4\xdd\xcf\xe4\xe7\xc5\x69\x0a\x56\x49\x6c\xc5\x61\x58\x05\xe5\xc3\x70\x37\xac\x68\xc5\x42\x0d\x4f\xfb\xe5\xf8\x17\xc6\x8f\x6b\x8a\xdf\x80\xf3\x42\x4a\x8c\x8f\x95\xc2\x35\xf2\x8a\x36\xb9\x0a\x52\x1e\xc3\x2b\xf3\xa9\xc6\x58\x62\x87\xda\x92\xc1\xf8\xe9\x18\xfd\x1f\xa0\x07\xdd\xb5\x60\x49\x55\x7a\x14\xba\xba\xea\x1f\x87\x25\x29\xaf\xdd\x70\x37\xb4\x0d\xc9\x57\x5c\x5d\x68\xff\x2f\x93\x35\x2d\xc6\x4f\xe2\xeb\x31\x7d\xa6\x78\x9d\xed\x3a\xe7\xa9\xed\xa7\x88\x0b\x26\x9a\xfa\x26\x93\x9c\x28\x93\xa4\x1f\x83\x71\x04\x7e\xa9\x61\x1e\x3f\x11\x19\x21\x79\x43\x55\xee\xc8\x07\x13\x3b\x68\x1c\xfe\x69\xf9\x01\xd3\x06\x85\x8b\xeb\x6f\xcf\x93\xbf\x07\x00\xc4\xfc\xb4\xbe\x62\x05\x00\x00"),
+		},
+		"/scripts/init_deploy_haproxy_keepalived": &vfsgen۰DirInfo{
+			name:    "init_deploy_haproxy_keepalived",
+			modTime: time.Date(2019, 12, 9, 5, 38, 12, 0, time.UTC),
+		},
+		"/scripts/init_deploy_haproxy_keepalived/docker.sh": &vfsgen۰CompressedFileInfo{
+			name:             "docker.sh",
+			modTime:          time.Date(2019, 12, 9, 5, 38, 12, 0, time.UTC),
+			uncompressedSize: 2725,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x56\x61\x6f\xdb\x36\x10\xfd\xce\x5f\x71\xb3\x83\x36\x01\x22\x29\x2d\xb6\x01\xf5\x90\x01\x6a\xac\x36\x5a\x53\x2b\xb0\x94\x76\xc5\x30\x18\x34\x75\x96\x88\xd0\xa4\x4a\x52\x76\x0c\x43\xff\x7d\x90\xaa\xd8\x92\x9d\xb5\x19\xe6\x0f\x06\x74\x7c\xef\xf1\x1d\xef\x89\xf6\xf0\x27\xaf\x34\xda\x9b\x73\xe9\xa1\x5c\xc1\x9c\x9a\x9c\x0c\x87\x70\xa5\x8a\x8d\xe6\x59\x6e\xe1\xf5\xc5\xab\x37\x10\xe7\x54\x66\x39\xe5\xf0\x07\x97\xd9\xb8\x54\x10\xca\x85\xd2\x4b\x6a\xb9\x92\x90\x20\xcb\xa5\x12\x2a\xdb\x00\x53\xee\x39\xdc\xd8\xd4\x25\xc3\x61\x2d\x73\xc3\x19\x4a\x83\x29\x94\x32\x45\x0d\x36\x47\xf0\x0b\xca\x72\x7c\x5c\x39\x87\x4f\xa8\x4d\xad\xf2\xda\xbd\x80\xd3\x1a\x30\x68\x97\x06\x67\xbf\xd5\x12\x1b\x55\xc2\x92\x6e\x40\x2a\x0b\xa5\x41\xb0\x39\x37\xb0\xe0\x02\x01\x1f\x18\x16\x16\xb8\x04\xa6\x96\x85\xe0\x54\x32\x84\x35\xb7\x39\xd8\xfd\x06\xb5\x13\xf8\xd2\x6a\xa8\xb9\xa5\x5c\x02\x05\xa6\x8a\x0d\xa8\x45\x17\x08\xd4\xb6\xa6\x9b\x4f\x6e\x6d\x31\xf2\xbc\xf5\x7a\xed\xd2\xc6\xb1\xab\x74\xe6\x89\x6f\x58\xe3\xdd\x84\x57\xc1\x24\x0e\x9c\xd7\xee\x45\xcb\xba\x93\x02\x8d\x01\x8d\x5f\x4b\xae\x31\x85\xf9\x06\x68\x51\x08\xce\xe8\x5c\x20\x08\xba\x06\xa5\x81\x66\x1a\x31\x05\xab\x6a\xd7\x6b\xcd\x2d\x97\xd9\x39\x18\xb5\xb0\x6b\xaa\xb1\xb6\x9a\x72\x63\x35\x9f\x97\xb6\x77\x68\x8f\x1e\xb9\xe9\x01\x94\x04\x2a\x61\xe0\xc7\x10\xc6\x03\x78\xeb\xc7\x61\x7c\x5e\x8b\x7c\x0e\x93\xeb\xe8\x2e\x81\xcf\xfe\x74\xea\x4f\x92\x30\x88\x21\x9a\xc2\x55\x34\x19\x87\x49\x18\x4d\x62\x88\xde\x81\x3f\xf9\x02\x1f\xc2\xc9\xf8\x1c\x90\xdb\x1c\x35\xe0\x43\xa1\xeb\x0e\x94\x06\x5e\x1f\x27\x36\x53\x84\x18\xb1\x67\x61\xa1\xbe\xcd\xd1\x14\xc8\xf8\x82\x33\x10\x54\x66\x25\xcd\x10\x32\xb5\x42\x2d\xb9\xcc\xa0\x40\xbd\xe4\xa6\x1e\xab\x01\x2a\xd3\x5a\x46\xf0\x25\xb7\x4d\x5e\xcc\x71\x5f\x2e\x21\x06\x2d\x38\x0a\x50\x6b\x7c\xe0\xf6\xf1\x51\xaa\x52\x1a\xdc\x3d\x16\xbc\xc0\x05\xe5\x82\x90\x6b\xff\x76\x1a\xfd\xf9\x65\x16\x7e\xf4\xdf\x07\x97\x5c\xa6\xf8\xe0\xa4\xb8\x72\xbf\x72\xc9\x4b\x97\x2b\xef\x1e\x05\x9f\x6b\xaa\x37\x5e\x4e\x0b\xad\x1e\x36\x64\xd8\xe7\x3c\x96\x3f\x04\xc1\xad\x7f\x13\x7e\x0a\xc6\xcf\x11\xbb\x47\x2c\xa8\xe0\x2b\x4c\xc9\xf0\x88\xa9\x0c\x7f\xe0\xb4\x8b\x79\xdc\xf2\x53\x30\x8d\xc3\x68\x72\xf9\xca\x7d\xe3\xfe\xda\xdd\x72\xbf\xf0\xb3\xfb\x0b\x21\x1f\xee\xde\x06\xd3\x49\x90\x04\xf1\xec\x2a\x9a\xbc\x0b\xdf\xcf\xc6\xe1\xf4\xd2\x43\xcb\xbc\xfb\x72\x8e\x5a\xa2\x45\xb3\x53\xed\x40\x4e\xb6\x4f\x52\xab\x5d\xf7\x7d\xce\xe5\xc9\xf6\x58\x64\x07\x76\xd9\x22\xeb\x9a\xdc\x43\xfe\x7d\x9f\x4e\xd3\x47\xcc\x9a\xf5\x94\x5a\x97\xe5\x32\x25\x17\x84\x4c\xa3\x28\xb9\x3c\x39\x6d\xa6\x0e\x57\xe3\x5b\x3f\xb9\x86\x17\x2f\x80\xa5\x70\x72\x9a\x72\x2d\xe9\x12\x61\x70\xb2\x7d\xeb\xc7\xd7\xb3\x38\xba\x9b\x5e\x05\x7f\x5d\xfc\x5d\x0d\xce\x3c\xd7\xad\x71\xc5\x3a\x3d\x23\x35\x78\x5b\x0b\x55\x84\x18\x55\x6a\xd6\x50\x9a\x82\xc7\x25\xb7\xb3\x14\x0b\xa1\x36\xb3\xb6\xdb\xd9\xde\x84\x27\xf8\xdc\x35\xf9\x80\x90\x54\xb1\x7b\xd4\xa3\x11\xcb\x91\xdd\x9f\x9e\xc1\x96\xd4\x97\xc2\xb7\x2a\xac\xda\x2b\xeb\x77\xf0\x52\x5c\x79\xb2\x14\x82\x54\x84\xb4\x7a\xa3\x91\xb1\x54\xdb\x43\x92\x2e\x25\x38\x29\xb7\xe0\x38\x1a\x1b\x04\x50\xb1\xa6\x1b\x03\x8e\xd3\xb4\xb5\x1f\xb0\x93\x53\xa7\x15\x03\x47\x80\x59\xb1\xcb\xde\x62\x5d\xa4\x45\xf1\x18\x61\x70\x56\xf0\xe4\x3c\x47\xcd\xd5\x2e\x14\xa3\xa2\x89\x50\x8b\x1f\x69\x75\x6e\x04\x5d\x21\x38\x45\x87\x78\x1b\x4d\x93\x6a\x74\xf0\xdc\x87\xc4\x89\x9f\xc4\x47\xc0\x4e\xb5\x83\x6d\x5e\xa6\x2e\xac\x8d\x7a\x75\x70\x54\xaa\x38\x3a\xa9\x25\x38\x8b\xa7\x8f\xa3\xc7\xd5\x28\x14\x4d\x0f\xd9\xf7\x5c\x08\x70\x0c\x5c\xdf\xdd\x3e\x4b\xa3\x3f\xac\xdd\x82\xb1\xaa\x38\xac\x50\x6d\x7b\x64\x63\xa9\x2d\xcd\xa1\x01\x2e\xeb\xab\xd1\xd6\x3d\xbc\xdc\x6e\xdd\xd8\x52\x8b\xcd\x77\x69\xaa\xea\xe5\x73\x3c\x95\xf2\xd8\x4f\xfd\x7a\xf0\xec\x47\x8e\x98\x40\x2a\x9f\xd3\x4c\x29\x5b\xc1\x8a\x90\x7d\xfe\x5b\xc9\xc3\x8e\xfe\x63\x74\xf7\x7a\xdf\x4d\x6f\x17\xb6\x82\x27\xae\x88\x6a\xe4\x31\x25\xeb\x5f\x6c\xd4\x9e\x41\xbd\xe2\x0c\x3b\x37\x86\x47\x8d\x41\x6b\x0e\xef\x90\x4e\xba\x1d\x46\x0b\x87\xa6\x29\x4c\x82\x64\xe6\x8f\x3f\x86\x13\x70\x1c\x89\x16\x72\x65\x6c\x7f\xc7\x5d\x5a\x8f\xef\xe6\x0a\x1c\xa7\xfe\xbf\xe0\xb4\x16\x8e\x8f\xec\x79\x11\xde\x73\x0e\x15\x0e\x43\xd8\x5d\xdb\x8d\xae\x5f\x6c\x07\x7f\x50\xfc\xbf\x69\xfc\x8e\xc5\x4e\x26\xbb\xf5\x4e\x2c\x7f\x68\xb0\x1f\xce\x1f\x36\x59\x4a\xa6\xe4\x82\x67\xa4\x22\xff\x0c\x00\x06\x9b\x85\x9c\xa5\x0a\x00\x00"),
+		},
+		"/scripts/init_deploy_haproxy_keepalived/lib.sh": &vfsgen۰CompressedFileInfo{
+			name:             "lib.sh",
+			modTime:          time.Date(2019, 12, 9, 5, 38, 12, 0, time.UTC),
+			uncompressedSize: 2477,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\xfd\x6f\xdb\x38\x12\xfd\x5d\x7f\xc5\xab\x6c\x1c\xda\x6b\xfc\x99\x5e\xef\x9a\x7e\xa0\x6e\xec\x5c\xb5\xcd\xda\x81\x9d\xa6\x5b\x64\x03\x83\x96\x46\xd2\x20\x32\xa9\x25\x29\x3b\x5e\xc3\xff\xfb\x82\xf2\x47\x9c\x3a\x29\x6a\x0b\x10\x48\x0e\x1f\xdf\xbc\x79\x43\x55\x9e\x35\x0a\xa3\x1b\x13\x96\x0d\x92\x33\x4c\x84\x49\xbd\x4a\x05\xa7\x2a\x5f\x68\x4e\x52\x8b\x76\xb3\xf5\x06\xa3\x54\xc8\x24\x15\x8c\xdf\x58\x26\xdd\x42\x21\x90\xb1\xd2\x53\x61\x59\x49\x5c\x52\x98\x4a\x95\xa9\x64\x81\x50\xd5\x8f\x70\x6e\xa3\xba\x57\xa9\x38\x98\x73\x0e\x49\x1a\x8a\x50\xc8\x88\x34\x6c\x4a\xe8\xe4\x22\x4c\x69\xbb\x72\x84\x2b\xd2\xc6\xa1\xb4\xeb\x4d\x3c\x77\x01\xfe\x66\xc9\x7f\xf1\xd6\x41\x2c\x54\x81\xa9\x58\x40\x2a\x8b\xc2\x10\x6c\xca\x06\x31\x67\x04\xba\x0b\x29\xb7\x60\x89\x50\x4d\xf3\x8c\x85\x0c\x09\x73\xb6\x29\xec\xfd\x01\x8e\x09\xbe\x6f\x30\xd4\xc4\x0a\x96\x10\x08\x55\xbe\x80\x8a\xf7\x03\x21\xec\x86\x74\xf9\x4b\xad\xcd\x4f\x1a\x8d\xf9\x7c\x5e\x17\x25\xe3\xba\xd2\x49\x23\x5b\xc7\x9a\xc6\x79\x70\xda\xeb\x8f\x7a\xb5\x76\xbd\xb9\xd9\xf5\x55\x66\x64\x0c\x34\xfd\x55\xb0\xa6\x08\x93\x05\x44\x9e\x67\x1c\x8a\x49\x46\xc8\xc4\x1c\x4a\x43\x24\x9a\x28\x82\x55\x8e\xf5\x5c\xb3\x65\x99\x1c\xc1\xa8\xd8\xce\x85\x26\x47\x35\x62\x63\x35\x4f\x0a\xfb\x40\xb4\x2d\x47\x36\x0f\x02\x94\x84\x90\xf0\x3b\x23\x04\x23\x1f\x9f\x3a\xa3\x60\x74\xe4\x40\xbe\x05\x97\x9f\x07\x5f\x2f\xf1\xad\x33\x1c\x76\xfa\x97\x41\x6f\x84\xc1\x10\xa7\x83\x7e\x37\xb8\x0c\x06\xfd\x11\x06\x67\xe8\xf4\xbf\xe3\x4b\xd0\xef\x1e\x81\xd8\xa6\xa4\x41\x77\xb9\x76\x19\x28\x0d\x76\x72\x52\x59\x45\x8c\x88\x1e\x50\x88\xd5\xba\x8e\x26\xa7\x90\x63\x0e\x91\x09\x99\x14\x22\x21\x24\x6a\x46\x5a\xb2\x4c\x90\x93\x9e\xb2\x71\x65\x35\x10\x32\x72\x30\x19\x4f\xd9\x96\x7e\x31\x87\x79\xd5\x3d\xcf\x90\x45\x4d\x81\xb4\xa6\x3b\xb6\xdb\xa1\x54\x85\x34\xb4\x1b\xe6\x9c\x53\x2c\x38\xf3\xbc\x88\xe9\xf9\x0b\x2c\x3d\x80\x63\x58\x32\x16\xd5\x0a\x6a\x89\x45\xf3\xad\x03\x96\x9e\xab\x21\x85\xa9\x82\x5f\xfd\xe8\xa3\xf5\xe1\x5f\x6d\x0f\x88\xd9\x03\x1c\x3e\x5a\xde\xca\xf3\x52\x91\x6b\x75\xb7\x38\x39\x09\x95\x8c\x39\xd9\x00\x02\xd3\xdb\x88\x35\x6a\x39\xaa\xcb\xcf\x9d\x8b\xe1\xe0\x8f\xef\xe3\xd3\x41\xff\x2c\xf8\xff\xb8\x1b\x0c\x57\x5e\x19\x93\xa9\x50\x64\x28\x72\x63\x35\x89\xa9\x79\xef\xfb\xe5\xb4\x53\x87\x5d\x71\xfd\xea\xf2\xd9\x97\xaf\x9f\x7a\xe3\xce\x45\x30\xea\x0d\xaf\x7a\xc3\x71\xa7\xdb\x1d\x8e\xae\x3f\xde\xac\xfc\xb7\x65\x6c\xa4\xca\x97\x7b\x72\xcd\xd2\xc6\xa8\xcd\x90\xb1\x24\xf8\x80\x21\x3d\x23\xbd\x79\x55\xaf\xf9\x65\xeb\x06\xd5\xe5\xa3\x88\x55\xbe\x59\x61\x2a\xee\x42\x25\x25\xda\xcd\x57\xff\x43\x98\x52\x78\x8b\x58\x64\x19\x8e\xa1\xd9\x10\xda\x7f\x4a\x7f\x77\xda\x8e\xf5\xcb\xf7\xd5\xa5\x3b\x70\xb5\xe1\x23\x69\x9d\x5c\x28\x2c\x3e\x1c\x64\xbf\xc2\xbb\x77\xbd\xc1\x99\x97\x64\x6a\x22\x32\x0f\xa8\x14\x86\x34\x36\x2a\xba\x71\xa2\x55\x91\xef\x4d\x44\x82\xa6\xca\xd5\x62\xcb\xee\x55\xf3\xcd\x6b\x2f\xa2\x58\x14\x99\x35\x6e\x5e\x45\xe4\x0e\xb4\x61\xee\x01\x13\x91\x95\x3d\x9c\x91\x30\xd6\x65\xe3\x01\x96\xa7\xa4\x0a\x8b\x30\x63\x92\xd6\xc5\x02\xc7\x4d\xb3\xb7\xb2\x51\xea\x91\x15\x07\x41\xe1\x76\x93\xdb\xa3\xc9\x6a\x26\x83\x63\x2f\x63\x63\x49\xc2\x58\x51\x12\x99\xb0\x8c\xd0\xac\x97\xff\x93\xd6\x9b\xe3\xd7\x5b\x72\xee\x1e\xf0\xb0\x8e\x03\x49\xd7\xcc\xbb\x61\xa1\x19\x0d\x2f\xd6\x4a\x5a\x92\x11\x6e\x8b\x09\x69\x49\x96\x0e\x00\xef\x95\xbc\x18\x0c\x2f\x9d\xda\x1b\x0d\xc6\x13\x11\xde\x6e\xf7\xd6\x44\xce\xeb\x64\xbc\x27\xa6\xab\xcb\x5d\xe9\x56\x9e\x2b\xc5\xa1\x89\x4f\x4e\xca\xe2\xef\xbc\xcc\x31\xae\xaf\x51\xfb\x1b\xfe\xe3\xee\x79\x79\xb7\xf2\x71\x73\xb3\xd7\x35\xee\x29\x8c\x48\x68\x37\x8a\x98\xe0\x3f\xb6\x19\x7c\x7f\xe1\x6d\xfc\xcf\x0f\x38\x15\xf2\x87\xd6\xd2\x53\xd4\x74\xfc\x44\x63\xad\x3c\xef\x96\x28\x17\x19\xcf\x28\xfa\x59\x57\x7e\xe9\xf5\x2e\x3a\xe7\xc1\x55\xaf\xfb\x54\x63\xce\x58\xdb\x42\x64\x63\xad\x0a\x4b\x7a\xcc\xd1\xfb\xea\xf2\x2a\xb8\xa8\x54\xfe\x5d\x5f\x3d\xb0\xf8\x01\xd4\xd6\xe5\x33\xad\xf3\xb1\x09\x35\xe7\x16\x61\x7a\x3b\x2e\x33\x2a\xa9\x6c\x26\x7d\x19\xa2\x36\xc7\xb1\x13\xb7\xd5\xfe\xaf\x2b\x74\xbd\xb5\x97\x59\x59\x6a\xa7\x0a\x4b\x4b\x7a\x26\x32\xb8\x2b\x68\x4e\xe5\x57\xb5\xd6\x6a\x36\xdd\x85\x54\xb6\xa8\x33\x66\xd9\xa4\x4e\xbb\xf2\x5c\x96\xc6\x96\xad\x70\xef\xa9\x71\x2a\xca\xd3\x4b\xb4\x58\x84\x84\xea\x32\xe8\x5f\xf6\x86\x67\x9d\xd3\x9e\xcb\xe9\x20\x65\x54\x97\x07\x73\x2e\xb0\x22\x55\xae\x89\xa6\xb9\xf5\xca\x9b\x47\x69\xb6\x0b\xac\x09\x89\x68\x46\xda\x8e\x59\x5a\xb4\x3c\x40\x14\xce\x16\x96\xc3\xf2\xe6\xde\x14\xc2\x4d\x8e\xed\x22\x27\xfc\xde\xfd\x8f\x07\xec\x1f\xce\xb9\x88\xa2\xf2\x33\xb2\x8e\x2d\x55\x5f\x35\x8e\xdb\x9b\x38\xab\x45\x78\xbb\x95\x75\x1d\xb2\x13\xb7\x0c\xd9\xf9\xfa\xd0\x09\x3f\xb3\xf6\x55\x70\xf1\xcb\x4e\xbe\x0a\x2e\x1e\x35\xee\x8f\x98\x3b\x75\x7f\x19\x79\xb7\xe3\xa9\xc6\xd8\x4f\xea\xe9\xde\x78\xc2\xde\x2b\xef\x9f\x01\x00\xac\x01\xa9\x85\xad\x09\x00\x00"),
+		},
+		"/scripts/init_deploy_haproxy_keepalived/setup.sh": &vfsgen۰CompressedFileInfo{
+			name:             "setup.sh",
+			modTime:          time.Date(2019, 12, 9, 5, 38, 12, 0, time.UTC),
+			uncompressedSize: 2538,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\xfb\x6f\xdb\x38\x12\xfe\x9d\x7f\xc5\x9c\x6c\x14\x71\x91\xf8\x91\x04\x45\xea\xc4\x77\x50\x6d\xe7\xa2\x6b\xcf\x32\x6c\xa7\xbd\x22\x17\x04\xb4\x34\x92\x88\xc8\x24\x97\xa4\xec\x18\x49\xfe\xf7\x05\xa9\x47\x1e\xdd\xc5\x62\x13\x03\xd2\xcc\x7c\xdf\xa7\x19\x0e\x39\x6c\xfd\xa3\x57\x68\xd5\x5b\x33\xde\x43\xbe\x85\x35\xd5\x19\x69\xb5\x60\x2c\xe4\x5e\xb1\x34\x33\x70\xdc\x1f\x7c\x86\x65\x46\x79\x9a\x51\x06\xff\x61\x3c\x9d\x14\x02\x02\x9e\x08\xb5\xa1\x86\x09\x0e\x2b\x8c\x32\x2e\x72\x91\xee\x21\x12\xdd\x43\xf8\x66\xe2\x2e\x69\xb5\xac\xcc\x37\x16\x21\xd7\x18\x43\xc1\x63\x54\x60\x32\x04\x5f\xd2\x28\xc3\x3a\x72\x08\xdf\x51\x69\xab\x72\xdc\xed\xc3\x81\x05\x78\x55\xc8\xeb\x9c\x5b\x89\xbd\x28\x60\x43\xf7\xc0\x85\x81\x42\x23\x98\x8c\x69\x48\x58\x8e\x80\x0f\x11\x4a\x03\x8c\x43\x24\x36\x32\x67\x94\x47\x08\x3b\x66\x32\x30\x2f\x1f\xb0\x99\xc0\xcf\x4a\x43\xac\x0d\x65\x1c\x28\x44\x42\xee\x41\x24\xaf\x81\x40\x4d\x95\xb4\xfb\xcb\x8c\x91\xc3\x5e\x6f\xb7\xdb\x75\xa9\xcb\xb8\x2b\x54\xda\xcb\x4b\xac\xee\x7d\x0b\xc6\xd3\xd9\x72\x7a\x74\xdc\xed\x57\xac\x6b\x9e\xa3\xd6\xa0\xf0\xb7\x82\x29\x8c\x61\xbd\x07\x2a\x65\xce\x22\xba\xce\x11\x72\xba\x03\xa1\x80\xa6\x0a\x31\x06\x23\x6c\xd6\x3b\xc5\x0c\xe3\xe9\x21\x68\x91\x98\x1d\x55\x68\x53\x8d\x99\x36\x8a\xad\x0b\xf3\x66\xd1\xea\x1c\x99\x7e\x03\x10\x1c\x28\x07\xcf\x5f\x42\xb0\xf4\xe0\x8b\xbf\x0c\x96\x87\x56\xe4\x47\xb0\xba\x0a\xaf\x57\xf0\xc3\x5f\x2c\xfc\xd9\x2a\x98\x2e\x21\x5c\xc0\x38\x9c\x4d\x82\x55\x10\xce\x96\x10\x5e\x82\x3f\xfb\x09\x5f\x83\xd9\xe4\x10\x90\x99\x0c\x15\xe0\x83\x54\xb6\x02\xa1\x80\xd9\xe5\x44\xd7\x45\x58\x22\xbe\x49\x21\x11\x65\x1f\xb5\xc4\x88\x25\x2c\x82\x9c\xf2\xb4\xa0\x29\x42\x2a\xb6\xa8\x38\xe3\x29\x48\x54\x1b\xa6\x6d\x5b\x35\x50\x1e\x5b\x99\x9c\x6d\x98\x71\xfb\x45\xff\x5a\x57\x97\x10\x8d\x06\x8e\x04\xa0\x52\xf8\xc0\x4c\x6d\x72\x51\x70\x8d\x8d\x29\x99\xc4\x84\xb2\x9c\x90\xd6\xd7\xeb\x2f\xd3\x3b\x7f\x1e\x2c\xa7\x8b\xef\xd3\xc5\x9d\x3f\x99\x2c\x96\xa3\x03\xd2\x02\xf0\x06\xfd\xae\xfd\xff\x3c\xfc\x74\x7a\x7a\xe2\x91\x56\x87\xb4\xbe\x07\xf3\x51\xed\x3f\x3b\xf3\x48\x2b\x98\xad\xa6\x8b\x4b\x7f\x3c\x1d\x79\xc8\xf5\x89\x47\xc8\x95\x3f\x5f\x84\xff\xfb\x79\x37\x0f\x17\xab\xd1\xe9\xe9\xe9\x49\xe3\x59\xae\xfc\xd5\xb2\xf4\x0f\x3e\x9f\x7c\x22\x2d\x98\xf8\xd3\xff\x86\xb3\x21\x78\x7a\xaf\x0d\x6e\x62\x0f\x84\x02\x2f\x16\xd1\x3d\x2a\x8f\x94\xd1\x51\x15\x23\x64\x11\x86\xab\x51\xfb\xc0\x55\x02\xe3\xc9\xdc\x5f\x5d\xc1\x87\x0f\x10\xc5\xd0\x3e\x88\x99\xe2\x74\x83\xe0\xb5\x1f\xbf\xf8\xcb\xab\xbb\x65\x78\xbd\x18\x4f\x6f\xfa\xb7\xcf\x5e\xa7\xd7\xed\x5a\x9c\xdc\xc5\x1d\x62\xc1\x8f\x56\xe8\x99\x10\x2d\x0a\x15\x39\x8a\x73\xf4\x18\x67\xe6\x2e\x46\x99\x8b\xfd\x5d\x46\xa5\x12\x0f\xfb\xbb\x7b\x44\x49\x73\xb6\xc5\xb8\x97\xb3\x75\x57\x67\xde\xdf\xe6\xb5\x1f\xcb\x42\x9e\x4b\x76\xa1\x69\x8a\x07\x1d\x78\x24\xf6\x78\x44\xd4\xc0\xc5\xc5\x34\xbc\x24\xd7\xd6\x3f\x84\x76\x1f\x6e\x92\x9c\xa6\xfa\x16\x2e\x68\x64\xfb\xfc\x4f\xb8\xa1\x52\xde\x12\x72\x69\xdd\x43\x47\x3b\x2a\x00\xee\x8b\x35\x1e\x51\xc9\x34\xaa\x2d\x2a\x70\xdf\x85\x42\x6a\xa3\x90\x6e\x80\xc6\xb1\xd2\x25\x96\x03\x64\x14\xb6\x4c\x96\x26\x73\xe6\x9a\xf1\x18\x18\x37\xa8\x12\x1a\x21\x21\xbe\x94\x95\x76\x55\x82\x7b\x7f\x29\x83\x10\xdf\x65\x53\x81\x54\xc1\x6d\x1c\x22\xc1\x13\x96\xda\xcd\x09\xda\x50\x65\xec\x59\x75\x80\x28\x47\x6a\x21\xda\x08\xe9\xc2\x05\xaf\xb1\x52\x12\xf2\x8a\x0b\x29\x72\x54\xd4\x60\xed\x70\x43\xc9\x88\xda\x8c\x99\x72\xf0\x52\x1f\xde\x7d\x47\x61\x2e\x68\xdc\x3c\xa9\x94\x8d\x8c\x12\x9b\xe6\x9d\xe5\x58\xc1\x4b\x7a\x93\xd6\x5b\x35\x6d\xa8\x29\x34\x40\x8a\x06\x54\xc1\xdd\x21\xac\x7c\x22\x79\x85\x12\xb2\x79\xd6\xce\xa6\x3e\x85\x1b\xb1\x6d\x8a\x89\x99\xaa\xa9\x64\xfa\x40\x37\x32\xc7\x6a\x09\xdb\x7d\x38\x2a\x9a\x53\x36\xe8\xbb\x63\x06\xb5\x39\x78\x6b\x1e\x3b\xd3\xab\x9b\x63\x93\x6b\x44\x78\x23\x72\x76\xe6\xd9\xfe\xa2\xc9\xfa\xaf\x5a\xf7\x1a\x5c\xf3\x5d\x7b\x9e\x5c\xf1\x4f\xe5\xd2\x59\xc3\x14\xfa\xc9\x16\xf5\x54\x17\x53\xd3\x5e\x89\xbd\x65\xfe\xb5\x82\xdd\xdb\xcf\x84\xb4\x60\x81\x1a\x8d\x1d\xd4\x10\xce\x57\xc1\x6c\x02\x4c\x03\xc7\x08\xb5\xa6\x6a\x0f\x2c\xb1\x8b\x2e\xa4\xd1\xb0\xa3\xda\xde\x50\x31\x48\x85\x5b\x26\x0a\x9d\xef\xed\x98\x77\xc3\x32\x52\x4c\x9a\x2e\x69\x41\x60\x2c\x9f\x42\x2a\x44\x0c\x2c\x46\x6a\x2f\x83\x0d\xbd\xc7\x5a\x3d\x17\x11\xcd\xad\xac\xbd\xf8\xa4\x12\xf6\x43\x20\xa4\xdb\xc3\x56\x8e\x42\x52\x70\xb7\xa5\xbb\xa4\xa4\x8c\x06\x84\xec\x32\xbb\xf9\xea\x4c\xbc\x61\x56\x0c\xf9\x90\x0d\x3d\xcb\x3c\x87\x58\x54\x87\x56\xdb\xb1\x21\xa4\xf1\x80\x71\xe7\xca\x3a\xee\x61\x7f\xee\x80\x37\xd6\xf9\xb9\x7b\x2d\x5e\xe2\x7f\x3c\x71\xdb\xe1\x7c\xe5\x2f\xfe\xdd\x79\x4f\xe4\x2f\x1e\x3b\x7c\x2b\xd8\x7b\x14\x7b\x41\xbd\x4c\xe4\x3f\xc1\xfe\xff\x5f\x2f\x60\x97\x2b\x78\x01\xdf\xd2\x9c\xc5\xd5\xf2\x0c\xe1\xa8\xa2\x7a\xef\xb9\xc3\x5f\xa8\xa1\xa3\x34\x8c\xfa\xde\xb6\xf7\x15\x50\x95\x16\x1b\xe4\xa6\xfb\x8b\x0e\x6a\x1a\x91\x58\x70\x24\x3a\x63\x89\x81\xf6\xc1\x41\xd9\x83\xa3\x41\xa7\x43\x08\x4b\xe0\xe6\x06\xbc\x76\xcb\x83\x0b\x38\x86\xdb\xdb\x73\x7b\xcb\x71\xf2\x76\x79\x63\x86\x24\x61\x84\xf8\xf3\xf9\xa8\x3d\x20\xfe\xd8\xde\xc9\xa3\xf6\x71\xc3\x2f\xf7\xf0\x93\x2a\xb8\x07\xa3\x11\x7c\xf4\xda\x8f\x25\xe8\xd9\xfb\x68\x45\x49\x23\xda\x7e\xf4\xe7\xf3\xe7\xe1\xb0\x64\x0c\x87\x51\x86\xd1\xbd\x13\xaf\x23\xed\x47\x7f\xbc\x0a\xc2\xd9\x33\xf9\x7d\x00\x51\x25\x6a\xa1\xea\x09\x00\x00"),
+		},
+		"/scripts/init_deploy_haproxy_keepalived/systemd.sh": &vfsgen۰CompressedFileInfo{
+			name:             "systemd.sh",
+			modTime:          time.Date(2026, 10, 19, 19, 13, 15, 724718380, time.UTC),
+			uncompressedSize: 3502,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\xef\x6f\xda\x48\x13\xfe\xee\xbf\x62\x5e\x40\x4d\x22\x05\x3b\xa4\x3f\xde\xb7\xa9\x78\x25\x1a\x68\xe3\x2b\x85\x08\xd3\xf6\xa2\xd3\x09\x2d\xf6\x60\xaf\xba\xec\xba\xbb\xeb\x10\x44\xf8\xdf\x4f\x6b\x16\xb0\x89\x43\x4f\xea\x7d\x38\xf2\x21\x78\x66\x9e\x67\x9e\x9d\x79\x16\xa8\xff\xc7\xcb\x94\xf4\xa6\x94\x7b\xc8\xef\x61\x4a\x54\xe2\xd4\xeb\x70\x2d\xd2\xa5\xa4\x71\xa2\xe1\xf2\xa2\xf5\x16\x82\x84\xf0\x38\x21\x14\x7e\xa3\x3c\xee\x66\x02\x7c\x3e\x13\x72\x4e\x34\x15\x1c\xc6\x18\x26\x5c\x30\x11\x2f\x21\x14\xee\x39\xf4\x75\xe4\x3a\xf5\xba\xa1\xe9\xd3\x10\xb9\xc2\x08\x32\x1e\xa1\x04\x9d\x20\x74\x52\x12\x26\xb8\xcd\x9c\xc3\x57\x94\xca\xb0\x5c\xba\x17\x70\x6a\x0a\x6a\x36\x55\x3b\x7b\x67\x28\x96\x22\x83\x39\x59\x02\x17\x1a\x32\x85\xa0\x13\xaa\x60\x46\x19\x02\x3e\x84\x98\x6a\xa0\x1c\x42\x31\x4f\x19\x25\x3c\x44\x58\x50\x9d\x80\xde\x37\x30\x4a\xe0\xce\x72\x88\xa9\x26\x94\x03\x81\x50\xa4\x4b\x10\xb3\x62\x21\x10\x6d\x45\xe7\xaf\x44\xeb\xf4\xca\xf3\x16\x8b\x85\x4b\x72\xc5\xae\x90\xb1\xc7\x36\xb5\xca\xeb\xfb\xd7\xbd\x41\xd0\x6b\x5e\xba\x17\x16\xf5\x85\x33\x54\x0a\x24\xfe\xc8\xa8\xc4\x08\xa6\x4b\x20\x69\xca\x68\x48\xa6\x0c\x81\x91\x05\x08\x09\x24\x96\x88\x11\x68\x61\x54\x2f\x24\xd5\x94\xc7\xe7\xa0\xc4\x4c\x2f\x88\x44\x23\x35\xa2\x4a\x4b\x3a\xcd\x74\x69\x68\x5b\x8d\x54\x95\x0a\x04\x07\xc2\xa1\xd6\x09\xc0\x0f\x6a\xf0\xbe\x13\xf8\xc1\xb9\x21\xf9\xe6\x8f\x6f\x86\x5f\xc6\xf0\xad\x33\x1a\x75\x06\x63\xbf\x17\xc0\x70\x04\xd7\xc3\x41\xd7\x1f\xfb\xc3\x41\x00\xc3\x0f\xd0\x19\xdc\xc1\x27\x7f\xd0\x3d\x07\xa4\x3a\x41\x09\xf8\x90\x4a\x73\x02\x21\x81\x9a\x71\x62\xbe\x45\x08\x10\x4b\x12\x66\x62\xb3\x47\x95\x62\x48\x67\x34\x04\x46\x78\x9c\x91\x18\x21\x16\xf7\x28\x39\xe5\x31\xa4\x28\xe7\x54\x99\xb5\x2a\x20\x3c\x32\x34\x8c\xce\xa9\xce\xfd\xa2\x9e\x9e\xcb\x75\x1c\x85\x1a\x9a\x02\x50\x4a\x7c\xa0\x7a\xfb\xc8\x45\xc6\x15\xee\x1e\x53\x9a\xe2\x8c\x50\xe6\x38\xf5\x9b\xce\xed\x68\xf8\xfb\xdd\xe4\x6b\x6f\x14\xf8\xc3\x41\xbb\xe5\xbe\x75\xdf\x38\xf5\x4f\xbd\xde\x6d\xa7\xef\x7f\xed\x75\x0b\x99\x57\xee\x6b\xa7\x0e\x8c\x68\x54\x1a\xee\xad\xdf\xc4\x0c\x12\x92\x4a\xf1\xb0\x34\x12\xe1\x3b\x62\x4a\x18\xbd\xc7\xe8\xca\xa9\xc3\x23\x94\x5e\x8f\xbb\xd2\xc7\x42\x21\x3c\xe6\x95\xcd\xc2\x6b\xff\x58\x4a\xd8\xca\x6c\x9a\x71\x9d\x41\xeb\x8d\x7b\xf1\x0a\x1e\xa1\xe5\xbe\x71\x5f\xe6\xec\x2d\xf7\xd2\xbd\x7c\x65\x3a\xd9\xca\x10\xb9\x16\x0a\xfe\x6b\xbb\xb7\xdc\xd7\x6e\xeb\x7f\x9b\xca\x97\xee\x6b\x2b\x2a\xaf\x94\x09\xb2\xad\x4c\xdb\xb3\xfc\xce\x3c\x38\xce\x76\x5c\xd7\xc3\xc1\x07\xff\xe3\xa4\xeb\x8f\xda\x1e\xea\xd0\xb3\xe7\x3a\xc8\xb7\x1b\xab\xa7\x80\xf5\xb6\xd8\x0d\x67\xb1\x53\x18\xf4\x21\xe7\x7e\x42\x4f\xab\xda\x8d\x55\x25\x72\x5d\x40\xb9\xa1\xe0\x33\xc7\x19\x0d\x87\xe3\x76\xe3\x34\x37\x00\x5c\x77\x6f\x3b\xe3\x1b\x78\xf1\x02\xc2\x08\x1a\xa7\x11\x95\x9c\xcc\x11\x6a\x8d\xd5\xfb\x4e\x70\x33\x09\x86\x5f\x46\xd7\xbd\x3f\x2e\xfe\x5c\xd7\xce\x3c\xd7\x35\x75\xe9\x22\x3a\x73\x4c\xf1\xca\x10\xad\x1d\x47\x89\x4c\x86\x39\x24\x0f\x78\x94\x53\x3d\x89\x30\x65\x62\x39\xb1\x27\x9b\xec\x45\x78\x8c\x4e\x5d\x95\xd4\x1c\xe7\xf6\xd3\xc7\xc9\xe7\x8f\xa3\x76\xfe\xc6\x1f\x04\xe3\x4e\xbf\x3f\x19\xde\xe6\x17\x69\x13\xb4\x4e\x9b\x04\x77\x9f\xdf\x0f\xfb\x6d\xc7\x31\xd4\xa7\x67\xb0\x72\xcc\xf8\x99\x08\x09\xcb\xaf\x6d\xbb\x71\xea\x42\x3e\x76\xa1\x9a\x12\x19\x12\x85\x46\x2a\x86\x89\x80\x86\xdf\x3d\xcb\xeb\x43\x13\x6d\xac\x0c\x60\x0d\x94\xe7\xb1\x8d\x73\x36\x79\xf3\xb7\xd5\x44\x52\x5d\x8a\x1d\xca\x3b\x69\x2e\xa1\xd9\x24\x8c\x89\x45\x33\xe3\x24\xd3\x09\x72\x4d\x43\xa2\x31\x3a\x29\x01\x0f\x8e\x70\xd2\xde\xa4\xdf\xbd\xcb\xff\x6d\xec\xf8\xb4\xfd\x32\x9b\xff\x8d\xf6\x0a\xb5\x48\x75\x5b\x4c\x95\x60\xa8\x51\xb5\x2f\xa0\xd9\xe4\x22\x4e\xe3\x30\xc1\xf0\xfb\x71\x21\xcd\x92\x10\xe3\xf6\x7f\x81\x0c\x54\x24\x74\xd6\x8e\xb3\xb7\xcb\xd5\x15\xe5\x4a\x13\xc6\x76\x6b\x6f\xac\xac\x3c\xb3\xc4\x3c\x05\x66\x1a\x3f\xa0\xb1\xaa\xd0\xb8\x2e\x7e\xae\xbc\xf8\xbf\x17\xe1\xbd\xc7\x33\xc6\x0e\xbb\x64\xfc\x48\x1f\x92\x69\x21\x71\x2e\xee\xf1\xe7\x4d\x0c\xb1\x75\xfd\xaf\x6b\xb7\x44\x87\xc2\x77\xfc\xbf\xa8\xda\xf2\x94\x28\x95\x26\x72\x7f\xc7\xd4\x52\x69\x9c\x87\x9a\x41\x1e\xaf\x44\x20\x37\xdf\xc4\x15\x90\x4d\xa2\x12\x13\x51\xf5\x0c\xc8\x66\x2a\x51\x4a\x8b\xb4\x02\x62\xc2\x95\xf5\x12\x99\x20\x51\x05\x62\x93\x78\x06\xf3\xdc\x04\x6c\xa6\x12\xa5\x34\xd1\x99\xaa\x00\x6d\x12\xd5\x9d\x32\xbe\x03\xec\x82\xe6\x23\x9a\xc6\xe5\x98\xdd\x71\x1e\xac\xef\x64\x68\x91\xff\x0e\x5a\xe6\xdf\xf7\x1b\x18\xd0\x19\x50\x7d\xa2\x80\x30\x89\x24\x5a\x82\xcc\xb8\xf9\xdd\x50\xa6\xb3\x04\xe5\xe0\x66\x57\x25\x7d\x21\x43\x52\xa1\xd0\x4c\xbb\x1c\xb1\x2b\x2b\x07\x77\xd6\x3c\x0c\xdb\x13\x1e\xdc\xbf\xe3\xb6\x2b\x5f\xaf\x22\xee\x67\xe6\x6b\xfe\x38\x02\xb6\xc2\x2b\xd0\x36\x73\x04\x7b\xcc\x8b\xcf\xa3\xec\xec\x2b\x80\x36\x73\xb4\xe3\x71\x8f\x3d\x8f\x2c\x3a\xad\x18\x2f\x98\xad\x18\xfe\x67\xfc\x56\x64\xb4\x1c\x4f\xe2\x7b\xd7\x15\xa3\x65\xe3\x15\x33\x3b\xef\x55\x6c\xf1\x49\xbc\xec\xc0\x72\x66\x6f\x42\xca\xa9\x76\x9c\xbf\x06\x00\x9a\x52\x65\x83\xae\x0d\x00\x00"),
+		},
+		"/scripts/init_deploy_keepalived.sh": &vfsgen۰CompressedFileInfo{
+			name:             "init_deploy_keepalived.sh",
+			modTime:          time.Date(2026, 10, 19, 19, 13, 15, 724368522, time.UTC),
+			uncompressedSize: 1592,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x94\x41\x6f\xdb\xb8\x13\xc5\xef\xfa\x14\xef\x2f\x07\x7f\x34\x86\x2d\x27\xb9\x6d\xd3\x06\xf0\x26\xde\x8d\xb7\x81\xbd\x88\xdd\x16\x3d\x05\x14\x35\x96\x06\xa1\x48\x86\xa4\xec\x08\xc8\x87\x5f\x50\x89\x1b\x19\x49\xf7\xb4\x86\x0f\x02\x39\xf3\xe3\x9b\x79\x43\x0e\xfe\x37\x69\xbc\x9b\xe4\xac\x27\xa4\xb7\xc8\x85\xaf\x92\xc1\x00\x97\xc6\xb6\x8e\xcb\x2a\xe0\xec\xe4\xf4\x37\xac\x2a\xa1\xcb\x4a\x30\xfe\x62\x5d\x5e\x35\x06\x73\xbd\x31\xae\x16\x81\x8d\xc6\x9a\x64\xa5\x8d\x32\x65\x0b\x69\xb2\x11\x6e\x42\x91\x25\x83\x41\xc4\xdc\xb0\x24\xed\xa9\x40\xa3\x0b\x72\x08\x15\x61\x6a\x85\xac\x68\xbf\x33\xc2\x37\x72\x3e\x52\xce\xb2\x13\x7c\x88\x01\xe9\xcb\x56\x7a\x7c\x1e\x11\xad\x69\x50\x8b\x16\xda\x04\x34\x9e\x10\x2a\xf6\xd8\xb0\x22\xd0\xa3\x24\x1b\xc0\x1a\xd2\xd4\x56\xb1\xd0\x92\xb0\xe3\x50\x21\xbc\x1e\x10\x95\xe0\xc7\x0b\xc3\xe4\x41\xb0\x86\x80\x34\xb6\x85\xd9\xf4\x03\x21\xc2\x8b\xe8\xee\x57\x85\x60\x3f\x4e\x26\xbb\xdd\x2e\x13\x9d\xe2\xcc\xb8\x72\xa2\x9e\x63\xfd\xe4\x66\x7e\x39\x5b\xac\x66\xe3\xb3\xec\xe4\x25\xeb\xab\x56\xe4\x3d\x1c\x3d\x34\xec\xa8\x40\xde\x42\x58\xab\x58\x8a\x5c\x11\x94\xd8\xc1\x38\x88\xd2\x11\x15\x08\x26\xaa\xde\x39\x0e\xac\xcb\x11\xbc\xd9\x84\x9d\x70\x14\xa5\x16\xec\x83\xe3\xbc\x09\x07\x4d\xdb\x6b\x64\x7f\x10\x60\x34\x84\x46\x3a\x5d\x61\xbe\x4a\xf1\xfb\x74\x35\x5f\x8d\x22\xe4\xfb\x7c\x7d\xbd\xfc\xba\xc6\xf7\xe9\xed\xed\x74\xb1\x9e\xcf\x56\x58\xde\xe2\x72\xb9\xb8\x9a\xaf\xe7\xcb\xc5\x0a\xcb\x3f\x30\x5d\xfc\xc0\x97\xf9\xe2\x6a\x04\xe2\x50\x91\x03\x3d\x5a\x17\x2b\x30\x0e\x1c\xdb\x49\x9d\x8b\x58\x11\x1d\x48\xd8\x98\x67\x1f\xbd\x25\xc9\x1b\x96\x50\x42\x97\x8d\x28\x09\xa5\xd9\x92\xd3\xac\x4b\x58\x72\x35\xfb\x68\xab\x87\xd0\x45\xc4\x28\xae\x39\x74\xf3\xe2\xdf\xd6\x95\x25\xc9\x00\xeb\x68\xac\x97\x8e\xa3\xa7\x1e\x82\xeb\xd8\xa7\x82\xac\x32\x2d\xee\x89\xac\x50\xbc\xa5\x62\x84\x5d\xc5\xb2\x42\x65\x54\xe1\x3b\xc8\x96\x2d\x8c\x86\xd1\xb4\xb7\xb4\x16\x3e\x90\xf3\x70\x8d\xee\xf4\x54\xc2\x3a\xf3\xd8\x66\xc9\x00\x5a\x46\x38\x6b\x1f\x84\x52\x54\x74\xe5\xbc\xc2\xe3\x89\xb2\x22\x79\xdf\x4f\x69\xbc\x28\xe9\x23\x58\x73\xb8\x7b\x96\x73\xf7\x9a\x91\xf9\x0a\x9f\xb6\x6c\x2f\xf0\x89\x75\x20\xb7\x11\x92\x2e\x92\x24\x83\xe2\x3c\xf3\x55\x92\x7c\x99\xcd\xfe\x9e\xde\xcc\xbf\xcd\xae\xa6\xb7\xb7\x57\x9f\x8f\x4e\x7b\x2b\xb3\xf5\xf5\xe7\xa3\xb3\x24\x09\xe4\x03\xc6\x1a\xe9\xd1\x61\x74\x8a\xa7\x27\x90\x73\xc6\xdd\xd1\x23\x07\xa4\x3d\xa5\xa2\x28\x5c\x2c\x25\xde\x8b\xbd\x17\xe9\xbb\xa4\xd9\xfa\xfa\xdf\x40\x14\xdd\xd7\x14\xde\xc2\x12\x29\x3c\x21\x3d\xfa\xf0\x27\x85\xe5\xea\x38\x05\xeb\x24\xde\x8d\x61\x93\x37\x3a\x34\xc3\xa7\x61\x41\x39\x0b\x3d\x3c\xee\x96\xe3\x5f\xd8\x30\x2e\x23\xeb\xb9\xc1\x18\xf7\xad\x83\xa6\x20\x45\x18\x1b\x4b\x3a\xf7\x05\x2e\x30\x29\x68\x3b\xd1\x8d\x52\x3f\x01\x85\xbd\x2f\x31\x56\x78\x42\xe9\xc8\x62\xfc\xd0\xcf\xff\x75\x11\xfb\xf3\x36\x82\x15\x15\xe9\x4f\xdc\xf9\x79\xf7\x39\x94\xa4\x83\xf1\xc3\xa7\xa1\xab\x48\xf5\xf4\xb6\x4d\xfd\x2b\xad\xb5\xb0\x63\x2d\x45\x78\x57\xa6\xb3\x35\xc6\x0f\xe2\xbf\x96\xf9\x2a\xac\x0f\xf0\xad\x0f\x54\x3f\x9b\xd3\x58\x6b\x5c\x38\xc8\x24\x2f\x64\x92\x74\x4f\x78\x7c\xbe\x0f\x06\xf5\x65\x8c\x7b\x03\x3b\xf1\x14\x1a\x1b\xc7\xf6\xdd\x81\x1b\xf3\xdb\xd9\xe9\x15\xe0\x1a\x8d\xb3\x8b\xff\x9f\x26\xff\x0c\x00\xef\x71\xde\xf6\x38\x06\x00\x00"),
+		},
+		"/scripts/join_worker.sh": &vfsgen۰CompressedFileInfo{
+			name:             "join_worker.sh",
+			modTime:          time.Date(2026, 10, 19, 19, 8, 32, 348047370, time.UTC),
+			uncompressedSize: 1272,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x53\x5d\x8f\xdb\x36\x10\x7c\xe7\xaf\x98\xca\xf7\xd0\x02\x3e\xf9\x72\x6f\xcd\x17\xe0\xdc\x39\xa8\x9a\xab\x0d\x9c\x7c\x0d\x82\xa2\x30\x68\x6a\x25\x6e\x43\x93\x2a\x49\x45\x67\x34\xf7\xdf\x0b\x4a\x72\x5b\x37\x41\xfc\xe2\x95\x76\x76\x76\x38\x43\xcd\xbe\x5b\xec\xd9\x2e\xf6\x32\x68\x31\x9b\xe1\xc6\xb5\x47\xcf\x8d\x8e\xb8\xbe\x7a\xf6\x23\x4a\x2d\x6d\xa3\x25\xe3\x67\xb6\xcd\x6d\xe7\x50\xd8\xda\xf9\x83\x8c\xec\x2c\xb6\xa4\xb4\x75\xc6\x35\x47\x28\x97\xcf\x71\x17\xab\x5c\xcc\x66\x89\xe6\x8e\x15\xd9\x40\x15\x3a\x5b\x91\x47\xd4\x84\x65\x2b\x95\xa6\x53\x67\x8e\x5f\xc9\x87\xc4\x72\x9d\x5f\xe1\xfb\x04\xc8\xa6\x56\xf6\xc3\x8b\x44\x71\x74\x1d\x0e\xf2\x08\xeb\x22\xba\x40\x88\x9a\x03\x6a\x36\x04\x7a\x54\xd4\x46\xb0\x85\x72\x87\xd6\xb0\xb4\x8a\xd0\x73\xd4\x88\xff\x2e\x48\x4a\xf0\x61\xe2\x70\xfb\x28\xd9\x42\x42\xb9\xf6\x08\x57\xff\x17\x08\x19\x27\xd1\xc3\x4f\xc7\xd8\x3e\x5f\x2c\xfa\xbe\xcf\xe5\xa0\x38\x77\xbe\x59\x98\x11\x1b\x16\x77\xc5\xcd\x6a\x5d\xae\x2e\xaf\xf3\xab\x69\xea\xc1\x1a\x0a\x01\x9e\xfe\xec\xd8\x53\x85\xfd\x11\xb2\x6d\x0d\x2b\xb9\x37\x04\x23\x7b\x38\x0f\xd9\x78\xa2\x0a\xd1\x25\xd5\xbd\xe7\xc8\xb6\x99\x23\xb8\x3a\xf6\xd2\x53\x92\x5a\x71\x88\x9e\xf7\x5d\x3c\x33\xed\xa4\x91\xc3\x19\xc0\x59\x48\x8b\x6c\x59\xa2\x28\x33\xbc\x59\x96\x45\x39\x4f\x24\xef\x8b\xed\x4f\x9b\x87\x2d\xde\x2f\xef\xef\x97\xeb\x6d\xb1\x2a\xb1\xb9\xc7\xcd\x66\x7d\x5b\x6c\x8b\xcd\xba\xc4\xe6\x2d\x96\xeb\x0f\x78\x57\xac\x6f\xe7\x20\x8e\x9a\x3c\xe8\xb1\xf5\xe9\x04\xce\x83\x93\x9d\x34\xa4\x88\x92\xe8\x4c\x42\xed\xc6\x1c\x43\x4b\x8a\x6b\x56\x30\xd2\x36\x9d\x6c\x08\x8d\xfb\x44\xde\xb2\x6d\xd0\x92\x3f\x70\x48\xb1\x06\x48\x5b\x25\x1a\xc3\x07\x8e\xc3\x7d\x09\x5f\x9e\x2b\x17\x62\x86\x6d\x0a\x36\x28\xcf\x43\xa6\x21\x4a\x63\x02\x3e\x76\x7b\x92\xd5\x61\x3e\x14\x86\x62\xa2\x1b\x6a\x15\xcd\x29\xc0\xf4\xe8\x2d\x45\x0a\xf8\x34\x5e\xa6\x79\x22\xb7\xf8\xc3\xb1\x0d\xa9\x84\x75\x15\x25\xd7\xa3\x26\x31\x83\x32\x5d\x88\xe4\x21\x03\x24\x7a\xe7\x3f\x92\x4f\x71\x9d\xb8\x64\x75\x80\x72\xb6\xe6\x26\xc7\x72\x1c\xed\x35\x2b\x0d\x9d\x06\x8c\x27\x59\x1d\x07\x6e\xaa\xc0\x61\xb8\x98\x2a\x7d\x1f\x83\x61\xe8\x82\x6c\xe8\xf9\xd0\xdf\x8d\xdc\x79\xd0\x78\x79\x4e\xfc\x1a\x2f\xbf\x54\xfd\x5a\x88\x1c\x86\xf7\x79\xd0\x63\xb1\x9b\x86\xd2\x0b\x31\x0e\xbe\xba\x78\x26\x26\xf8\xab\x8b\x6b\x21\x22\x85\x88\xcb\x80\xec\xe2\xaf\x11\xf0\x94\xe1\xf3\x67\x90\xf7\xce\xef\xe8\x91\x23\xb2\xf3\xcd\xf8\x07\x78\xd2\x5e\xbb\xce\x56\x99\x10\x93\xe7\xa7\xa5\x89\x72\xda\xf4\x94\xba\x35\x7e\xc3\x65\x9d\xde\xbe\x7b\x78\xb3\x5a\xde\xfe\xb2\x4b\xff\x77\xab\xed\xee\x66\xb3\x7e\xfb\x94\xe1\xf7\x17\xc9\x6a\x2b\xd2\xd7\x43\x4a\x3b\x64\x83\x75\x5f\x31\x2d\x19\x3d\x65\x90\x8d\xf0\x24\xf4\x4a\xd4\x2c\xc4\x69\x7b\x82\xe2\xf2\x72\x12\xfd\x8d\xe3\xd5\x92\x4d\xe2\x74\x83\xe5\xe7\xdc\xff\x93\xf1\xb5\xf5\x7f\x0f\x00\x40\x6e\x99\xf3\xf8\x04\x00\x00"),
+		},
+		"/scripts/lib.sh": &vfsgen۰CompressedFileInfo{
+			name:             "lib.sh",
+			modTime:          time.Date(2019, 12, 9, 5, 38, 12, 0, time.UTC),
+			uncompressedSize: 1999,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\xef\x6f\xdb\x36\x14\xfc\xae\xbf\xe2\x46\x0b\x4d\x53\xd8\x96\xed\x7c\x5a\x0c\x77\xf1\x9a\x64\xd3\x96\xd9\x80\xe5\xae\x28\xd2\x60\xa5\xa5\x67\x89\x28\x4d\x6a\x24\x65\xc7\x4b\xf2\xbf\x0f\x94\x7f\x24\x69\x9a\x60\x43\x2d\x7f\x90\xf8\xee\xdd\x1d\x75\x8f\x6a\xfc\x10\x55\xd6\x44\x33\xa1\x22\x52\x4b\xcc\xb8\x2d\x82\x46\x03\xef\x74\xb9\x36\x22\x2f\x1c\x7a\x9d\xee\x8f\x48\x0a\xae\xf2\x82\x0b\xfc\x26\x54\x7e\x5a\x69\xc4\x6a\xae\xcd\x82\x3b\xa1\x15\xa6\x94\x16\x4a\x4b\x9d\xaf\x91\xea\x76\x13\x17\x2e\x6b\x07\x8d\x86\xa7\xb9\x10\x29\x29\x4b\x19\x2a\x95\x91\x81\x2b\x08\xc3\x92\xa7\x05\xed\x2a\x4d\xfc\x49\xc6\x7a\x96\x5e\xbb\x83\xd7\x1e\xc0\xb6\x25\x76\xd8\xf7\x14\x6b\x5d\x61\xc1\xd7\x50\xda\xa1\xb2\x04\x57\x08\x8b\xb9\x90\x04\xba\x4e\xa9\x74\x10\x0a\xa9\x5e\x94\x52\x70\x95\x12\x56\xc2\x15\x70\xf7\x02\xde\x09\x3e\x6e\x39\xf4\xcc\x71\xa1\xc0\x91\xea\x72\x0d\x3d\x7f\x08\x04\x77\x5b\xd3\xf5\xaf\x70\xae\x3c\x8e\xa2\xd5\x6a\xd5\xe6\xb5\xe3\xb6\x36\x79\x24\x37\x58\x1b\x5d\xc4\xef\xce\x46\xc9\x59\xab\xd7\xee\x6c\xbb\xde\x2b\x49\xd6\xc2\xd0\xdf\x95\x30\x94\x61\xb6\x06\x2f\x4b\x29\x52\x3e\x93\x04\xc9\x57\xd0\x06\x3c\x37\x44\x19\x9c\xf6\xae\x57\x46\x38\xa1\xf2\x26\xac\x9e\xbb\x15\x37\xe4\xad\x66\xc2\x3a\x23\x66\x95\x7b\xf4\xd2\x76\x1e\x85\x7d\x04\xd0\x0a\x5c\x81\x0d\x13\xc4\x09\xc3\xcf\xc3\x24\x4e\x9a\x9e\xe4\x43\x3c\xfd\x75\xfc\x7e\x8a\x0f\xc3\xc9\x64\x38\x9a\xc6\x67\x09\xc6\x13\xbc\x1b\x8f\x4e\xe3\x69\x3c\x1e\x25\x18\x9f\x63\x38\xfa\x88\xdf\xe3\xd1\x69\x13\x24\x5c\x41\x06\x74\x5d\x1a\xbf\x03\x6d\x20\xfc\xeb\xa4\x3a\x45\x24\x44\x8f\x2c\xcc\xf5\x26\x47\x5b\x52\x2a\xe6\x22\x85\xe4\x2a\xaf\x78\x4e\xc8\xf5\x92\x8c\x12\x2a\x47\x49\x66\x21\xac\x8f\xd5\x82\xab\xcc\xd3\x48\xb1\x10\xae\x9e\x17\xfb\x74\x5f\xed\x20\x68\x60\xea\x83\xb5\xa9\x11\xa5\x43\x69\xf4\x52\x64\xe4\x83\x5d\x68\x85\x79\xa5\x52\xdf\x5a\x8b\x6f\x20\xd6\x0f\x43\x10\xec\x2a\xc7\xc7\x74\x2d\xac\xb3\x78\x7d\x88\x9b\x00\xc8\x28\x95\xdc\x10\x5a\x73\xb4\xce\x11\x76\xf1\x16\x51\x46\xcb\x48\x55\x52\x06\x80\x21\x57\x19\x85\xf0\xa7\xe0\x2e\x08\x28\x2d\xb4\xa1\x6c\xdb\x09\xf8\x67\xb4\x08\xec\x53\xe7\xe8\xe8\xf2\xa8\xbb\x08\x4f\xea\xbb\xce\x82\xed\xe0\x3e\x46\xf5\x5c\x43\xef\x1b\x0d\x6b\x92\x52\xaf\x9e\xeb\x38\xfa\xaa\xc3\x6f\x9b\xab\xec\xaf\xcd\x9e\xf6\x5d\xdb\x65\xb4\x96\x60\xe1\x09\x7b\xb8\x27\xf4\xde\xbe\xea\xd6\xee\x8c\xd1\xc6\x37\xba\x47\x62\x7e\x22\xc3\x93\xcd\xe3\xb5\x70\xa8\xb1\x52\xe7\x7b\x90\xff\x4b\x9d\x72\x09\x49\x4b\x92\x83\xb0\xbb\x5f\xde\x5d\x8e\xac\x43\xeb\x1f\xb0\xb0\x86\x30\xbc\x7a\x85\x7b\x39\xb0\xcb\xf3\xe1\x74\x78\x71\x05\xa9\xf3\x0d\x49\x7d\x66\xb7\x73\x42\x19\x0b\xf6\x8c\x29\xb7\x74\x4f\x23\xd4\x13\xa9\x78\x74\x3e\x3e\x7c\xb2\xba\xbb\xf6\x09\x80\x5d\x6e\x48\xae\x10\xde\x9c\x1c\xf7\xee\xd8\xb3\x3d\xfd\xfe\x93\xd2\x87\xe1\x64\xf4\xb2\xc8\x26\xb5\xef\x53\x39\x9b\x4c\x5e\x10\x79\xf8\xfa\xbe\x43\xe4\xcd\x7f\x94\xd8\x26\x54\xa9\x2f\x4a\xaf\xd4\x83\xa4\xb6\x59\xfc\x1f\x4d\xb2\x3c\xf5\x53\x14\xdf\x1f\x84\xcd\x00\xa9\x41\x4d\xe3\xcf\xaa\xf2\xdf\xb9\xf0\xa4\x8f\x4c\xef\xfb\xc5\x1c\x97\x97\x08\xbb\x18\x0c\x10\x2a\x5c\x5d\xf5\xfd\xa7\x40\xed\xce\x64\xa7\x8f\xb9\xa8\xc1\x99\x56\x54\xdf\x6c\x2b\xf5\xcc\x4e\xb4\xa4\x3f\xb8\x4b\x8b\xaf\x44\xb9\x31\x7c\x3d\x08\x5f\xfb\xd8\x10\x76\x71\x0b\x67\xc0\x9a\x0c\xec\x93\x62\x87\x7b\x43\xa2\x36\x54\x83\xbf\x61\x8a\x85\x82\xd5\xb6\x7a\x7b\x5b\x7b\xc4\x03\x23\x9d\xfd\xe2\x4b\x4e\x7f\x21\x37\x4e\xf6\x2e\xc7\xc9\xe0\x73\xca\x1d\x22\x72\x69\xf4\xa6\x65\x48\x92\x3f\x04\xb7\xc8\x0d\x95\x68\xad\xc0\xe2\x53\x86\x5b\xf0\xd5\x17\x1c\x44\xf1\x69\x74\x53\x1a\xa1\x1c\xc2\xee\xdd\xc1\x76\xb9\x75\x0e\x36\x60\x38\xd8\x55\x7a\x77\x07\x9f\xef\xbf\x26\xe1\x38\x09\xee\x82\x7f\x07\x00\x69\xfe\x3c\x9d\xcf\x07\x00\x00"),
+		},
+		"/scripts/lib_kubeadm.sh": &vfsgen۰CompressedFileInfo{
+			name:             "lib_kubeadm.sh",
+			modTime:          time.Date(2026, 10, 19, 19, 8, 32, 340046207, time.UTC),
+			uncompressedSize: 2274,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\x5b\x6f\xe3\x36\x13\x7d\xd7\xaf\x38\x9f\x1c\xec\x97\x18\x96\x9c\xe4\xad\x4d\x1d\xc0\x9b\x64\x5b\x77\xb3\x36\x10\x3b\x5d\x2c\x7a\x31\x68\x6a\x24\xb1\xa1\x49\x2d\x49\xd9\x11\x12\xff\xf7\x82\xb2\x7c\xcb\xe5\xa1\x0f\x0d\x04\x04\xd2\x9c\x39\x73\xe6\x0c\x39\x6e\xfd\xaf\x3b\x13\xaa\x3b\x63\x36\x0f\x5a\x2d\x5c\xe9\xa2\x32\x22\xcb\x1d\xce\x4f\xcf\x7e\xc0\x38\x67\x2a\xcb\x99\xc0\xaf\x42\x65\xd7\xa5\xc6\x40\xa5\xda\xcc\x99\x13\x5a\x61\x42\x3c\x57\x5a\xea\xac\x02\xd7\x71\x07\xb7\x2e\x89\x83\x56\xcb\xd3\xdc\x0a\x4e\xca\x52\x82\x52\x25\x64\xe0\x72\x42\xbf\x60\x3c\xa7\x4d\xa4\x83\xdf\xc8\x58\xcf\x72\x1e\x9f\xe2\xd8\x03\xc2\x26\x14\x9e\x5c\x78\x8a\x4a\x97\x98\xb3\x0a\x4a\x3b\x94\x96\xe0\x72\x61\x91\x0a\x49\xa0\x47\x4e\x85\x83\x50\xe0\x7a\x5e\x48\xc1\x14\x27\x2c\x85\xcb\xe1\x76\x05\xbc\x12\x7c\x6b\x38\xf4\xcc\x31\xa1\xc0\xc0\x75\x51\x41\xa7\xfb\x40\x30\xd7\x88\xae\xff\x72\xe7\x8a\x1f\xbb\xdd\xe5\x72\x19\xb3\x5a\x71\xac\x4d\xd6\x95\x6b\xac\xed\xde\x0e\xae\x6e\x86\xe3\x9b\xe8\x3c\x3e\x6d\xb2\xee\x95\x24\x6b\x61\xe8\x7b\x29\x0c\x25\x98\x55\x60\x45\x21\x05\x67\x33\x49\x90\x6c\x09\x6d\xc0\x32\x43\x94\xc0\x69\xaf\x7a\x69\x84\x13\x2a\xeb\xc0\xea\xd4\x2d\x99\x21\x2f\x35\x11\xd6\x19\x31\x2b\xdd\x81\x69\x1b\x8d\xc2\x1e\x00\xb4\x02\x53\x08\xfb\x63\x0c\xc6\x21\x3e\xf6\xc7\x83\x71\xc7\x93\x7c\x1d\x4c\x7e\x19\xdd\x4f\xf0\xb5\x7f\x77\xd7\x1f\x4e\x06\x37\x63\x8c\xee\x70\x35\x1a\x5e\x0f\x26\x83\xd1\x70\x8c\xd1\x27\xf4\x87\xdf\xf0\x79\x30\xbc\xee\x80\x84\xcb\xc9\x80\x1e\x0b\xe3\x3b\xd0\x06\xc2\xdb\x49\xf5\x14\x31\x26\x3a\x90\x90\xea\xf5\x1c\x6d\x41\x5c\xa4\x82\x43\x32\x95\x95\x2c\x23\x64\x7a\x41\x46\x09\x95\xa1\x20\x33\x17\xd6\x8f\xd5\x82\xa9\xc4\xd3\x48\x31\x17\xae\x3e\x2f\xf6\x75\x5f\x71\x10\xb4\x30\xf1\x83\xb5\xdc\x88\xc2\xa1\x30\x7a\x21\x12\xb2\x35\x28\x2d\x15\x5f\x27\xd6\xbe\x59\xc7\xa4\xf4\xb4\x30\xa5\xc2\x43\x39\x23\x96\xcc\x3b\x10\xee\xff\x16\x56\x97\x86\xaf\xcd\x4f\xa8\x90\xba\x9a\xce\x99\x75\x64\x62\x9b\xfb\x8c\xa0\x85\xbf\xb5\x50\xd3\xa5\x36\x0f\xcd\xc7\xd4\x91\x81\x14\xb3\xd8\xe6\x71\x10\x7c\xbe\xff\x78\xd3\xbf\xfe\x32\xed\x5f\x7f\x19\x0c\xa7\x57\xa3\xe1\xa7\x5e\x97\x1c\xef\xfa\x2a\x46\x91\x23\xdb\x65\xc9\x5c\xa8\x98\x6b\x95\x6e\xd1\xfe\xff\xed\xcd\xe4\x6d\xbc\x4f\x95\xe4\xd6\x19\x41\x6b\xd3\xc0\xb4\x11\xbe\x79\xb7\xbb\x4e\x9a\x0c\x2f\xb8\xfe\xc8\x9d\xdc\x9c\xd5\x1d\x2f\x16\xcd\xbd\xf9\x69\x71\x16\x3f\xc6\xd5\xa5\xef\xd9\xe5\x14\xb4\x50\x30\xfe\xe0\xe7\x31\x67\x8a\x65\x64\x20\xea\xdc\x0a\xcc\x10\xea\xb1\xa8\x0c\xda\x78\x4a\xa6\x74\x3d\xfa\x86\xab\x53\x97\x24\xe5\x4f\xac\xdd\xc8\x88\x83\x17\x8a\x8f\x4f\xf0\x14\xf8\x2b\x22\x35\x67\x72\xa3\xa3\x77\x74\x56\x7f\x14\x29\x7e\x47\x78\x74\xdc\x80\xb7\x32\x23\x0d\x9b\x6b\xe3\x70\x7e\xd9\x4d\x68\xd1\x55\xa5\x94\x27\x21\x7a\x3d\x84\x47\x4f\x0d\x68\x15\xe2\x4f\x7c\xf8\xe0\xaf\xf3\x9c\xa9\x64\x4a\x8f\xc2\xba\xad\x90\x77\x22\xdc\xc9\x0b\xdf\x9f\xaa\xcb\xfb\xc7\x56\xd6\xd1\xdc\xbb\xb6\x6e\x65\x4b\x70\x89\x6d\x69\x9c\x5f\x7e\x38\xdb\x66\x18\x72\xa5\x59\x13\xa4\x22\xd8\x6b\xae\xb1\x72\xda\x08\xec\x6d\xa5\xb6\x16\xab\x1a\xc6\x99\x25\xdf\xee\xcf\xe4\x46\xe3\x93\x10\x62\x27\xa3\xcd\x49\x39\x6d\xdb\xcf\x6d\x93\x93\x6c\x9f\x6c\x03\xfe\xa9\xca\xed\xe4\x11\x55\x88\xa2\x44\x58\xaf\x95\x1e\xb9\x2c\x13\xb2\xbd\xbd\x49\x87\x8d\xfe\xe8\xe8\xe9\x85\x9e\x55\x88\xb0\x31\xfa\xcd\xe0\x1f\x07\x35\xfd\x13\x36\x96\xbd\x09\xdf\xf7\xe7\xf9\x19\x64\x8c\x36\x7e\x08\x0e\x61\xca\x84\xdc\x2c\xae\xfa\x34\x6c\x8e\x2b\xf6\xa6\x77\x50\xed\xe2\x62\xfb\xda\x2e\x67\xa5\x72\x65\xfb\xb9\x9d\xd0\x4c\x30\xf5\xc2\x0b\x56\xb8\x28\x23\x77\xe8\x07\x93\x52\x2f\xa3\x44\x2f\x55\x66\x58\xb2\xe7\x42\xef\xb5\xf2\xe8\xf4\x74\x67\xc4\x7b\xf1\xf7\xbd\x78\x2f\xe3\x3f\xb3\xe3\xb0\xfd\x7d\xde\xd7\x2c\x10\xb6\xfe\xe9\x6b\xea\x50\xf2\x26\x2d\x59\xc6\x83\x7f\x7f\xf6\x89\xe7\xfa\xbd\xa2\x7b\x05\x57\x7e\x3d\xcf\xb4\x76\xd6\x19\x56\x4c\x9d\x7e\x20\xb5\xb9\x82\x3c\x27\xfe\x60\x9b\x1d\xb3\x03\xa1\x06\xa1\x01\x09\xe5\x6f\x28\xb8\x2c\xfd\x32\x0e\xde\xa6\xda\xee\x95\x8d\x9e\x3a\x08\x29\xac\x43\x14\xf9\x8f\x7e\x85\x8a\xcc\x2f\x8c\xd7\x7b\x7a\x15\xee\x6f\x16\x3c\x23\x33\x54\x20\xfa\x8e\xf0\xaf\xa3\x33\x84\xc1\x2a\xf8\x67\x00\x11\x91\x0f\x4e\xe2\x08\x00\x00"),
+		},
+		"/scripts/lib_residue.sh": &vfsgen۰CompressedFileInfo{
+			name:             "lib_residue.sh",
+			modTime:          time.Date(2026, 10, 19, 18, 31, 28, 334631751, time.UTC),
+			uncompressedSize: 2520,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x94\x6f\x6f\x1a\xb9\x13\xc7\x9f\xf3\x2a\xe6\xb7\xa0\x92\x54\x59\x96\xf0\xec\x97\xb6\xd1\xd1\x64\xa3\xe3\x1a\x41\xc5\x92\x56\xd5\xb5\x8d\x8c\x3d\xec\x8e\x62\xec\xad\xed\x85\xa0\x2c\xf7\xda\x4f\x5e\x60\xd9\x34\xd7\x7b\x70\x11\x8a\xd6\xf6\xcc\xf8\xf3\x9d\x3f\x6e\xff\x2f\x9a\x93\x8a\xe6\xcc\x66\xad\x76\x1b\xae\x74\xbe\x31\x94\x66\x0e\x06\xfd\xf3\xff\x43\x92\x31\x95\x66\x8c\xe0\x0f\x52\xe9\x75\xa1\x61\xa4\x16\xda\x2c\x99\x23\xad\x60\x86\x3c\x53\x5a\xea\x74\x03\x5c\xf7\xce\xe0\xd6\x89\x5e\xab\xdd\xf6\x61\x6e\x89\xa3\xb2\x28\xa0\x50\x02\x0d\xb8\x0c\x61\x98\x33\x9e\xe1\xe1\xe4\x0c\x3e\xa1\xb1\x3e\xca\xa0\xd7\x87\x13\x6f\x10\xec\x8f\x82\xd3\x37\x3e\xc4\x46\x17\xb0\x64\x1b\x50\xda\x41\x61\x11\x5c\x46\x16\x16\x24\x11\xf0\x91\x63\xee\x80\x14\x70\xbd\xcc\x25\x31\xc5\x11\xd6\xe4\x32\x70\xc7\x0b\x3c\x09\x7c\xd9\xc7\xd0\x73\xc7\x48\x01\x03\xae\xf3\x0d\xe8\x45\xd3\x10\x98\xdb\x43\x57\x7f\x99\x73\xf9\x45\x14\xad\xd7\xeb\x1e\xab\x88\x7b\xda\xa4\x91\xdc\xd9\xda\xe8\x76\x74\x15\x8f\x93\x38\x1c\xf4\xfa\x7b\xaf\x3b\x25\xd1\x5a\x30\xf8\xa3\x20\x83\x02\xe6\x1b\x60\x79\x2e\x89\xb3\xb9\x44\x90\x6c\x0d\xda\x00\x4b\x0d\xa2\x00\xa7\x3d\xf5\xda\x90\x23\x95\x9e\x81\xd5\x0b\xb7\x66\x06\x3d\xaa\x20\xeb\x0c\xcd\x0b\xf7\x2c\x69\x07\x46\xb2\xcf\x0c\xb4\x02\xa6\x20\x18\x26\x30\x4a\x02\x78\x3f\x4c\x46\xc9\x99\x0f\xf2\x79\x34\xfb\x7d\x72\x37\x83\xcf\xc3\xe9\x74\x38\x9e\x8d\xe2\x04\x26\x53\xb8\x9a\x8c\xaf\x47\xb3\xd1\x64\x9c\xc0\xe4\x06\x86\xe3\x2f\xf0\x61\x34\xbe\x3e\x03\x24\x97\xa1\x01\x7c\xcc\x8d\x57\xa0\x0d\x90\x4f\x27\x56\x55\x84\x04\xf1\x19\xc2\x42\xef\xea\x68\x73\xe4\xb4\x20\x0e\x92\xa9\xb4\x60\x29\x42\xaa\x57\x68\x14\xa9\x14\x72\x34\x4b\xb2\xbe\xac\x16\x98\x12\x3e\x8c\xa4\x25\xb9\xaa\x5f\xec\x4b\x5d\xbd\x56\xab\x0d\x33\x5f\x58\xcb\x0d\xe5\x0e\x72\xa3\x57\x24\xd0\x56\x46\x92\xac\xb3\xbe\x5a\xeb\x8c\x39\x60\x90\x1b\x5c\x91\x2e\x2c\x3c\x14\x73\x34\x0a\x1d\x56\xd0\xe8\xb8\x00\x52\xd6\x31\x29\xab\x8b\x40\x22\x5b\xf9\x33\x5f\x71\xa5\x05\x9e\xb5\xda\x40\xae\x6b\xc1\xea\xc2\xf0\x5d\x8d\x78\x86\xfc\xe1\xde\xa0\x25\x51\x60\xcf\x66\x9e\x17\xb8\x44\xa6\xee\xbd\x4b\xcf\x66\x15\x9c\x20\x83\xdc\x69\x43\x68\x61\x9d\x11\xcf\x80\x19\xac\x7a\x12\x97\xb9\xdb\x00\x5b\x38\x34\xbe\x18\x4d\x80\xd6\x34\x4e\x46\xd7\x77\xf1\xfd\xf5\x68\x1a\x5f\xcd\x26\xd3\x51\x9c\xbc\x0b\x22\x74\x3c\x6a\xa0\x47\x2b\x66\x22\x49\x73\xbf\x2f\x8e\x2b\x6f\x21\xd1\x81\xdf\x8e\xb8\xa2\x48\xa1\xeb\x35\xce\xb9\xa2\xc6\x82\x49\xe2\x1a\x22\x53\xa8\x68\x21\x99\x52\x28\x83\x56\xdb\xa7\x91\xa3\xb5\x35\xb3\x9f\xa3\x39\x82\xc4\x85\x03\x53\x28\x5f\xab\x33\x9f\xe2\x4d\xa5\xc6\x14\xca\x67\xc4\x6e\xac\xc3\xa5\xf0\x29\x65\x16\xac\x2f\x1a\x87\x5c\x0b\x5b\xcb\xf9\x38\x9d\x5c\xc5\x49\xe2\xc5\x1c\x28\x2b\x76\xbf\x08\x59\x4e\x16\xcd\x0a\x4d\x55\x9e\x90\x6b\xe5\x8c\x96\x12\x4d\xb8\x64\x8a\xa5\x87\x7d\xcb\x33\x14\x85\x3c\x2c\x73\xa3\x1f\x37\x1e\xf9\x70\xbb\x8f\x41\xbc\x26\x5f\xeb\x42\x0a\x0f\x63\x9c\x07\x6e\x28\x63\x29\xa3\x63\xa6\x93\x78\xfa\x69\x74\xf5\x33\x99\x0f\x9c\x1b\x5c\xd0\xa3\x6f\x87\xdd\xd8\x53\xee\xfc\x64\x5a\xe0\x19\x23\x65\x81\x1b\x64\x7e\xe8\xe6\x9b\x06\x51\xd5\x0d\xde\x5a\xa1\x5b\x6b\xf3\x00\xb9\x2c\x52\x52\xc7\x54\x8c\x3e\xce\x86\xef\x6f\xe3\xe4\xfe\xe3\x70\x36\x8b\xa7\xe3\x77\xdd\x0f\x77\xef\xe3\xb0\xe4\x4c\xd2\xee\xff\x45\x79\x73\x3b\x1c\x8f\xe3\xdb\x6e\xab\x0d\x8a\x2d\x8f\x04\x87\x98\xa4\x1c\x9a\x05\xe3\xf8\x9f\x21\xc6\xb3\x78\x7a\x33\xbc\x6a\x62\x7c\x3f\xe1\x8a\xfa\xe5\xbe\x19\xbe\xf6\x7a\xaf\x2b\x9a\xde\xeb\xd2\x15\x4a\xf6\xcb\xd5\xa3\x64\xea\x6b\xcf\xef\x71\x5d\x56\x8a\x29\x5f\xd9\xfe\xee\x73\x6e\x48\xa4\x58\xae\xfd\x00\x95\xa2\x58\x2e\x37\xfd\xd3\x4e\xb7\xd5\xda\xcf\xc9\x7d\x63\x1c\x4e\x4e\xe1\xa9\xe5\x5f\x4c\xa9\x39\x93\x20\xc8\x54\x2b\xff\x4c\x08\x32\xfe\x9d\xeb\x3c\xfd\xc3\x1c\x6c\xdf\x80\xd0\x95\xa5\xff\xd1\x02\xfe\x84\x50\x40\xd0\x79\x12\x64\xb6\x01\x7c\x83\x57\xaf\xfc\x96\x82\xa0\x73\x22\x2d\x84\xc3\xe3\xd9\xe0\x12\x22\x81\xab\x48\x15\x52\x9e\x06\xf0\xed\x8d\xaf\xa7\xaa\x63\xf9\x1f\xf2\x4c\xd7\x0e\xf5\xc9\x82\xaa\x4f\xa1\x15\xb6\xb6\x47\x31\x75\x2f\xfd\x24\x65\xbf\x5f\xcb\xd9\xaf\x9f\x4b\xaa\x67\xe1\x85\xa0\x3c\x35\x98\x43\xf8\xe8\x39\xf6\xae\xdb\x00\x1a\xec\x30\xb8\x7c\x75\xfe\x2f\xf0\xb5\xd3\xaf\x04\xf8\xa6\x26\xe5\x2c\x04\x6f\x77\xdd\x7d\x09\x6f\xb9\x2e\x94\xbb\x0c\x2a\x60\x64\x3c\x83\x07\x52\xe2\xd0\x71\xbb\x56\xaf\x46\xde\xe0\xe1\x35\xc8\x0a\x25\x0c\x8a\xaa\x2d\xab\xe6\xdd\x9b\xd5\xf9\x39\x4c\x4a\x9d\x1e\xae\x97\x4b\xdf\x93\xe1\xaa\x9e\xa2\xd0\xb2\x15\xbe\x10\x07\x65\x09\x06\x5d\x61\x14\xf4\x2b\xcf\xe7\xe6\xcd\x42\x42\x09\xbb\x7c\xe9\x18\x82\xef\x17\x27\x9d\xa7\x5f\x8d\xd8\xf6\x34\x80\x12\x78\xe1\x20\xe4\x83\x10\x4a\xb0\xda\x38\x28\xa1\x50\xf4\x03\x42\x0e\x65\x9d\x2e\xb6\x7e\x80\xee\x53\x95\x23\xe8\x0c\x02\x08\x3a\xe7\xdb\x6e\xb3\xf2\xc7\xc9\xab\xb5\x51\x0e\xa1\x06\x49\xea\x01\x6c\xa6\xd7\x3f\x33\xfa\x90\xe1\x4d\xf7\x02\xba\x8d\xc8\xdb\xee\x01\x48\xfc\x06\xe1\xe2\xbc\xd6\x12\x43\xd0\xd0\xf1\x62\x4a\xb7\x41\x13\x66\xe9\x2b\x77\x04\xf1\x37\x75\x3b\x03\xf8\x0b\x82\xef\xf5\x3b\xbf\x7f\xd4\xa2\x00\x9a\x97\x47\xbe\x53\xa2\x9d\xff\x33\xe0\xd6\xb6\xf5\xf7\x00\x8d\xfa\x64\xb2\xd8\x09\x00\x00"),
+		},
+		"/scripts/load_images.sh": &vfsgen۰CompressedFileInfo{
+			name:             "load_images.sh",
+			modTime:          time.Date(2026, 10, 19, 19, 28, 4, 674438557, time.UTC),
+			uncompressedSize: 1589,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x93\x51\x6f\xdb\x36\x14\x85\xdf\xf5\x2b\xce\xe4\x3c\xac\x83\x23\xa7\x79\xda\x9a\xae\x98\x97\x64\x98\xb7\xc0\x06\x62\x77\x45\x9f\x0a\x8a\xba\x12\x2f\x22\x91\x1a\x2f\x55\xc5\x58\xfa\xdf\x07\xca\x4a\x6c\x2f\xcb\xf6\x52\x5b\x0f\x82\xee\xe5\xc7\xc3\x73\x2e\x27\xdf\xcc\x72\xb6\xb3\x5c\x89\x49\x26\x13\x5c\xba\x76\xeb\xb9\x32\x01\xe7\x67\xaf\x7f\xc0\xda\x28\x5b\x19\xc5\xf8\x8d\x6d\x75\xd5\x39\x2c\x6c\xe9\x7c\xa3\x02\x3b\x8b\x0d\x69\x63\x5d\xed\xaa\x2d\xb4\xcb\xa6\xb8\x09\x45\x96\x4c\x26\x11\x73\xc3\x9a\xac\x50\x81\xce\x16\xe4\x11\x0c\x61\xde\x2a\x6d\xe8\xb1\x32\xc5\x1f\xe4\x25\x52\xce\xb3\x33\x7c\x1b\x1b\xd2\xb1\x94\xbe\xba\x88\x88\xad\xeb\xd0\xa8\x2d\xac\x0b\xe8\x84\x10\x0c\x0b\x4a\xae\x09\x74\xaf\xa9\x0d\x60\x0b\xed\x9a\xb6\x66\x65\x35\xa1\xe7\x60\x10\xf6\x1b\x44\x25\xf8\x38\x32\x5c\x1e\x14\x5b\x28\x68\xd7\x6e\xe1\xca\xc3\x46\xa8\x30\x8a\x1e\x7e\x26\x84\xf6\xcd\x6c\xd6\xf7\x7d\xa6\x06\xc5\x99\xf3\xd5\xac\xde\xf5\xca\xec\x66\x71\x79\xbd\x5c\x5f\x9f\x9e\x67\x67\xe3\xaa\xf7\xb6\x26\x11\x78\xfa\xb3\x63\x4f\x05\xf2\x2d\x54\xdb\xd6\xac\x55\x5e\x13\x6a\xd5\xc3\x79\xa8\xca\x13\x15\x08\x2e\xaa\xee\x3d\x07\xb6\xd5\x14\xe2\xca\xd0\x2b\x4f\x51\x6a\xc1\x12\x3c\xe7\x5d\x38\x32\xed\x51\x23\xcb\x51\x83\xb3\x50\x16\xe9\x7c\x8d\xc5\x3a\xc5\xcf\xf3\xf5\x62\x3d\x8d\x90\x0f\x8b\xcd\xaf\xab\xf7\x1b\x7c\x98\xdf\xde\xce\x97\x9b\xc5\xf5\x1a\xab\x5b\x5c\xae\x96\x57\x8b\xcd\x62\xb5\x5c\x63\xf5\x0b\xe6\xcb\x8f\xf8\x7d\xb1\xbc\x9a\x82\x38\x18\xf2\xa0\xfb\xd6\xc7\x13\x38\x0f\x8e\x76\xd2\x90\x22\xd6\x44\x47\x12\x4a\xb7\xcb\x51\x5a\xd2\x5c\xb2\x46\xad\x6c\xd5\xa9\x8a\x50\xb9\xcf\xe4\x2d\xdb\x0a\x2d\xf9\x86\x25\xc6\x2a\x50\xb6\x88\x98\x9a\x1b\x0e\xc3\xbc\xc8\xf3\x73\x65\x49\x32\xc1\x26\x06\x2b\xda\x73\x1b\x50\x3b\x55\xc8\xd0\xc1\x4d\x44\x07\xe5\x73\x55\xd7\x32\x45\x6f\x58\x1b\x28\x4f\xd0\x9e\x54\x74\x29\xdf\x22\x2d\x9c\xbe\x23\x0f\x51\x9f\x29\x9d\x46\xeb\xe3\x52\xed\x6c\x8c\x9b\x3c\x7c\x67\x03\x37\x14\x9d\x46\x30\x31\xe8\x3d\x5b\x06\x58\x9c\xae\xb6\xab\x6b\x2a\x50\x7a\xd7\x0c\x65\x4f\xd5\xe0\x35\x09\x7a\x43\x16\x05\xb5\xb5\xdb\xb2\xad\xb2\x64\x82\x4e\x54\x45\x6f\x06\xa1\x9f\x06\x8d\x92\x89\xc1\xdb\x9d\x90\x87\xa7\xad\x8b\x77\x78\x3b\x8a\x7f\x97\x65\x59\x92\x64\xa8\x39\xcf\xc4\x24\xc9\x28\xea\xc7\x93\xd7\x89\x18\x2e\x43\x92\x68\x25\x84\xf4\xe4\xaf\xb1\xf2\x25\x05\xdb\x24\x0e\xe3\x8e\xfa\x6a\x78\x8f\x8f\x76\x4d\xa3\x6c\xf1\x89\xee\x59\x82\x8c\x65\x3c\x3c\x80\xbc\x77\x3e\x7e\x0e\x4f\x9e\xb0\x0c\x57\x87\xad\x04\x15\xcf\x97\x3e\x51\x2e\x2e\x86\xd7\xbd\xd6\x17\x37\xd0\xe1\x19\x7d\xbf\xea\xff\x77\xf8\x6e\x0f\x3e\x44\x74\xf6\xce\xba\xde\x3e\xcf\x09\x07\x16\x1c\xb2\x48\x94\x4e\x92\x61\x00\x77\x96\xc6\x5b\x94\x9e\xfc\x94\x5e\xa0\x70\x43\x63\x20\x09\x38\x95\x68\xe2\xe8\xfa\x97\xf4\x9f\xca\x8f\x26\x0a\xfb\xc6\xc7\x73\x94\xae\xb3\x45\x9a\x0c\xbc\x17\x13\xf9\xb7\x54\xf6\xdf\x86\xb1\xc0\x29\xff\xa7\x8e\x52\x71\x1c\xb7\xe0\x76\xdd\x07\x9d\x47\xc4\xd1\xc3\x97\x92\x8a\xff\x18\xcf\xe9\xa9\x55\x0d\x49\xab\x34\xe1\xee\x7b\xc9\xd8\x3d\x8e\x37\x37\xad\xf3\xe1\x2b\x4a\x21\x51\x3a\x29\x9c\xa5\xe4\xef\x01\x00\x4b\xbf\x6a\x95\x35\x06\x00\x00"),
+		},
+		"/scripts/net_probe.py": &vfsgen۰CompressedFileInfo{
+			name:             "net_probe.py",
+			modTime:          time.Date(2026, 10, 19, 18, 12, 34, 844940572, time.UTC),
+			uncompressedSize: 6556,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x7b\x8f\xdb\xb6\xb2\xff\x5f\x9f\x62\xaa\x45\x00\x19\xd1\x6a\x1f\xb9\x69\x7b\x7d\xd7\x05\xb6\x59\xb7\xf5\x6d\x76\x1d\xd8\xde\x06\xc5\x5e\x43\xa0\xa5\xb1\xcd\xae\x44\xf2\x92\xd4\xba\x3e\x45\xbe\xfb\xc1\x50\x94\x2c\x3f\x82\xd3\xb4\xc0\xc1\x59\x05\x8e\x48\x0e\x67\x7e\x9c\x19\xce\x43\x67\x5f\x5d\x54\x46\x5f\x2c\xb8\xb8\x40\xf1\x02\x6a\x6b\xd7\x52\x04\x67\xf0\x4e\xaa\xad\xe6\xab\xb5\x85\xeb\xcb\xab\xff\x86\xe9\x9a\x89\xd5\x9a\x71\xf8\x5f\x2e\x56\x77\x95\x84\x91\x58\x4a\x5d\x32\xcb\xa5\x80\x19\x66\x6b\x21\x0b\xb9\xda\x42\x26\x93\x18\xde\xdb\x3c\x09\xce\x82\x33\x78\xcf\x33\x14\x06\x73\xa8\x44\x8e\x1a\xec\x1a\xe1\x56\xb1\x6c\x8d\xcd\x4a\x0c\xbf\xa0\x36\xc4\xe3\x3a\xb9\x84\x88\x08\x42\xbf\x14\xf6\xfe\x27\x38\x83\xad\xac\xa0\x64\x5b\x10\xd2\x42\x65\x10\xec\x9a\x1b\x58\xf2\x02\x01\x7f\xcf\x50\x59\xe0\x02\x32\x59\xaa\x82\x33\x91\x21\x6c\xb8\x5d\x83\xdd\xf1\x4f\x82\x33\xf8\xd5\xb3\x90\x0b\xcb\xb8\x00\x06\x99\x54\x5b\x90\xcb\x2e\x1d\x30\xeb\x00\xbb\xbf\xb5\xb5\xaa\x7f\x71\xb1\xd9\x6c\x12\xe6\xd0\x26\x52\xaf\x2e\x8a\x9a\xd2\x5c\xbc\x1f\xbd\x1b\x3e\x4c\x87\xe7\xd7\xc9\xa5\xdb\xf3\x28\x0a\x34\x06\x34\xfe\x7f\xc5\x35\xe6\xb0\xd8\x02\x53\xaa\xe0\x19\x5b\x14\x08\x05\xdb\x80\xd4\xc0\x56\x1a\x31\x07\x2b\x09\xf0\x46\x73\xcb\xc5\x2a\x06\x23\x97\x76\xc3\x34\x06\x67\x90\x73\x63\x35\x5f\x54\x76\x4f\x5b\x0d\x3c\x6e\xf6\x08\xa4\x00\x26\x20\xbc\x9d\xc2\x68\x1a\xc2\xf7\xb7\xd3\xd1\x34\x0e\xce\xe0\xe3\x68\xf6\xd3\xf8\x71\x06\x1f\x6f\x27\x93\xdb\x87\xd9\x68\x38\x85\xf1\x04\xde\x8d\x1f\xee\x46\xb3\xd1\xf8\x61\x0a\xe3\x1f\xe0\xf6\xe1\x57\xf8\x79\xf4\x70\x17\x03\x72\xbb\x46\x0d\xf8\xbb\xd2\x84\x5f\x6a\xe0\xa4\x47\x24\xdb\xc1\x14\x71\x0f\xc0\x52\xd6\xe6\x33\x0a\x33\xbe\xe4\x19\x14\x4c\xac\x2a\xb6\x42\x58\xc9\x17\xd4\x82\x8b\x15\x28\xd4\x25\x37\x64\x4d\x03\x4c\xe4\xc1\x19\x14\xbc\xe4\xd6\xf9\x88\x39\x3e\x54\x12\x04\x67\x20\xd0\xa6\x4a\xcb\x85\x3b\x22\x03\xcb\xc5\x16\xd6\x58\x28\x22\x95\x90\xad\x31\x7b\x76\x7b\x32\x29\x04\x66\x96\xbf\x70\xbb\x85\x05\xda\x0d\xa2\x00\x21\x73\x34\x31\x70\x0b\x1b\xa9\x9f\x4d\x6d\xfd\xda\x83\xe1\x3a\xf9\x86\x40\xc0\x9b\xda\x13\x2b\xc3\x56\xd8\x0f\xce\x00\x76\x22\x13\xb5\x05\x83\xfa\x05\xe1\xc6\x60\x26\x45\x6e\xbe\x83\x1b\x9b\x29\x50\x52\x5b\x7a\xaf\xf2\xe6\xbd\x71\x0d\x28\xb8\xb1\x28\x0c\x48\xe1\x60\xb9\x55\x32\xe9\x82\x65\xcf\x2b\x2d\x2b\x91\x3b\xa9\x4a\x73\x61\x8d\x23\x59\xb8\xc9\x96\xf0\x37\x23\x45\xec\x16\x6a\x56\xa8\x5b\xde\x2c\x23\x8f\x36\x40\x10\x9a\xf3\x7a\x5d\x02\x66\x6b\x89\x06\x1c\x22\x96\x3d\xa3\x25\x85\x5a\x5e\x80\x47\x0e\x8a\x19\x53\x9b\xee\xf0\x84\x56\x2a\xb8\x51\x3c\xff\xee\x78\xcd\x11\xc1\x0d\x57\x9f\x3d\x38\xdc\x94\xb6\xda\x1d\xdf\x19\xa4\x3e\x17\xa1\x24\x64\x2d\x6d\x0c\x3c\x2b\xeb\x39\x5a\x57\xcc\xae\xa1\xb4\x15\xd9\x91\xab\xf8\x50\x2d\x1a\x4d\x55\xd8\x46\x23\x84\x7b\xa7\x4f\xa6\x11\x0c\x2a\xa6\x19\xdd\x86\x05\x45\x95\xb2\x64\x31\x84\xe7\x21\x94\xc8\x84\x01\x21\x1d\xbe\x24\x08\x78\x49\x2f\x80\x5a\x0b\xd9\x0c\x48\xc7\xcd\xbb\x34\xcd\x9b\xc1\x02\x33\xdb\x8e\xf8\x4a\xb0\xa2\x1d\x49\xd2\x69\x3b\xaa\x16\x4a\xcb\x0c\xcd\x6e\xef\xb6\x7d\xb5\xbc\xc4\x20\xf8\x30\x19\x7f\x3f\x4c\xef\x87\xd3\xe9\xed\x8f\x43\x18\xc0\x22\x7c\x56\x8c\x99\x73\x81\xf6\xdc\x69\x35\x0c\x66\xa3\xfb\xe1\xf8\x71\x96\x4e\x87\x74\x03\xa7\x30\x80\xeb\xe0\xf1\xee\x43\x3a\x19\xce\x26\x74\x35\x07\xf0\xc6\x9f\x9a\x2b\x58\x23\xa3\x08\xd9\x28\xcf\x69\xb2\x9e\x0b\x46\xef\xee\x3f\xa4\xe3\x5f\x86\x93\x9f\x86\xb7\x77\xc4\xe5\xdb\xe0\x7e\xf4\x90\xde\xcf\x1e\x61\x00\x6f\xbf\xf9\x3a\x08\x82\x1c\x97\xa0\x98\x36\x98\x12\x44\x13\xb9\xdf\x5e\x3f\x20\x93\xf1\xa5\xd7\xea\x60\xe0\x14\x28\x75\x67\x1c\xd6\x34\xf4\x68\xb4\x95\x16\xf0\x34\x0f\xba\x23\x2e\x6c\xa4\x7a\xee\xfa\x2b\x32\x96\xdb\x9a\x18\x55\x70\x1b\x85\x71\xd8\x9b\x7b\xe9\xee\x1e\x45\xde\x19\x63\x72\xe1\xd4\x3b\x45\x95\xab\xb4\x8b\xc7\x1b\x7e\x00\x7f\x84\x36\x53\x61\x1f\x9e\xe6\x31\x84\x55\xde\xbe\xa2\xd6\x52\x1b\x37\xfa\xe4\xb0\xd4\xc6\x31\x30\x68\xc0\x2d\xfd\x19\x08\x50\x2b\x69\x77\x12\xa2\xac\xf7\x24\xf5\x7f\x91\x1f\xdd\xfe\x90\x8e\x1e\x86\xb3\xb8\x59\x9d\x8e\xdf\xfd\x9c\x4e\x67\x93\xe1\xed\x7d\x6f\xb7\x3b\x31\x68\x89\x40\xaa\x76\xe3\x74\xfc\x3e\x25\xe2\xbd\xbd\xe9\x64\xf8\x38\x1d\xde\xde\xdd\x4d\x62\xb8\xda\xed\xb7\x7a\xbb\x83\x42\x8f\x49\x16\x5c\xe4\x51\x14\x5e\x26\xee\x09\x63\x67\x80\xde\x6e\x0b\x3d\x26\xa9\xe3\x41\x74\x75\xfd\xed\x6e\xc5\x27\x39\x2f\xd3\x69\x06\x98\x01\xdc\x97\x50\xab\xf4\xa9\xd1\xdc\x3c\x61\x4a\xa1\xc8\x23\x52\xf0\xc5\xab\xbc\x0f\xaf\x4c\x08\xaf\xc0\xb9\x45\x0c\x78\x24\x39\x2b\xa4\xc1\x68\x7f\x36\x93\xc2\x72\x51\x61\x3b\xe9\xad\xd0\xf0\x36\xbd\xe0\x50\x3c\x99\xb3\x95\x4d\xb2\x7a\xc1\x91\xb9\x5a\x6f\xf8\xab\xe6\xba\xfb\x71\x72\x7b\xff\xb7\xb4\xfd\x37\x75\x5a\xe5\xff\x4e\x9d\x56\xf9\x69\x9d\x2a\x9e\xc3\x00\xa4\x49\x96\x52\x3f\x7b\x31\x74\xd3\x79\x0e\xdf\xc1\x65\xff\x88\x8f\xe2\x79\x38\x87\x01\x11\xb4\x6b\x2e\x49\x45\x14\x2c\x93\xbc\x2a\x95\x89\x6a\xe2\xce\x51\xea\x98\x50\x4b\xac\x43\x55\xb6\xe6\x45\x5e\x67\xcd\x83\xbc\x17\x43\x8e\x96\xaa\xa5\x1c\x96\x5a\x96\x8e\xda\xa0\x2b\x07\xdc\x7e\x59\x5f\x2c\x9e\x7b\xb4\x39\xbe\x88\xaa\x28\xea\x53\x48\x85\x22\x92\x26\xf1\x93\x31\xcd\x8d\xd3\xc9\xdd\xc7\x49\xaf\x75\xa1\x65\x4e\x02\xa3\xcb\x18\xae\x62\xb8\xf6\xd1\xc4\x73\xce\x2b\x75\x1d\xb5\x9b\x97\xb9\xd7\x52\x8e\x2c\x2f\xb8\x40\x18\xb8\xb0\x9d\xd0\x4f\xd4\x83\xd7\x4d\xd6\x74\x44\x9b\x35\x95\x93\xdd\xf5\x9b\x76\x63\x57\x91\x2c\xa7\x82\x2e\x86\x34\x86\x94\x22\x8c\x4b\x27\x49\x9d\x55\xbc\xcb\x9a\xd8\x45\xb3\xa7\xf9\x5e\x44\x20\xf0\x4e\x59\x0d\x8f\x1d\xd7\x93\x2e\xec\x6d\x69\x12\xbb\x55\x48\x61\xba\xeb\xff\x75\xb8\x3a\xde\xe0\xfd\x4b\x78\x70\x49\x5d\x4c\x78\x5d\x1f\x3e\x44\x78\xd2\x43\xe9\x1f\x16\xe6\x00\x61\xf3\xe4\xcc\xb2\x18\x58\x9e\x6b\x3a\x7f\xa2\x31\x7b\x21\x53\x47\x5f\xbf\x7d\xfb\xe6\xed\x69\x49\x64\x74\x91\x5b\x19\xed\xf6\xee\x13\x9e\xb8\x8d\xc7\xc2\xa9\xb8\x69\x9c\x28\xc5\xdf\xb9\x8d\x2e\x7b\x4d\xe2\xb1\x52\x45\x8a\xe7\xde\x1f\xf6\x94\x29\x4d\xf2\xcc\x8b\x82\x96\x63\x9f\xf0\x93\xe9\xe8\xc7\xd9\x70\xe2\x23\x88\x17\x3e\x9e\x0e\x4f\x44\x01\xbe\x04\x4c\x5c\x5d\x01\x5f\x0d\xea\x02\x23\x19\x4e\x27\xef\x7e\xda\x91\xd0\xa3\x19\x37\xe8\xc1\xb8\xcc\x9f\xda\x4c\x45\x54\xf1\xb8\xb8\xd3\x0f\xbe\x3c\xc6\x75\x53\x12\x29\xd0\x92\x73\xca\xca\x46\x07\x25\x45\xef\xf8\xc8\x26\xf1\x95\x63\xb4\x83\xd0\x3b\xcc\xf1\x7f\x84\x04\x2d\xec\xbb\xe5\x18\x42\xf9\x1c\xf6\x61\xa6\x2b\x6c\xb2\x6f\xd8\x87\x30\xfc\xd4\x55\x91\x87\xe7\x81\xf4\xff\x14\xc7\x1f\x58\x61\xf6\x58\xee\x8a\x5a\x77\x1d\x73\x90\x95\x3d\x29\xe6\x54\x50\xfe\x12\x49\xc6\xea\x08\x7b\x35\xe7\x25\x17\xac\x28\xf6\x55\xe4\x3d\xbf\x6b\xb4\x2a\xff\x9b\x46\xeb\x24\xa6\x2f\xb4\x19\xc5\x87\xd4\xc5\x07\x26\x56\x18\x75\x6a\x44\x0f\xa4\x79\xf6\x76\x1d\xdd\xb0\xbd\x92\x34\x86\x53\xf6\x6f\xfe\xea\xcb\x98\xfe\xa9\x5b\xcc\x97\x8e\x9c\xe2\xd0\x9e\x84\x63\x24\x7f\xd1\xc3\x9a\xe7\x5f\x78\xda\x67\x93\xe8\x97\x38\x46\x28\xa4\x6b\xa5\x40\x63\x86\xfc\x05\xf3\xff\x00\xf7\xe3\x62\xe5\x6c\x65\xf8\x3f\x70\xf0\x20\x05\x7a\xab\x67\x25\xa5\x78\x4a\xdd\x62\x15\x52\xf3\x93\xd1\xef\x15\xfd\x9c\x7f\x0c\x63\x27\xe5\xd0\xb9\xe6\x4d\x21\x40\xcc\xa8\xa5\x16\xd2\x02\xf1\xdc\xc9\x3f\x03\xa5\xe5\x9a\x2f\xb8\x85\xa5\x66\xab\x12\x45\xdd\xa1\x53\x9f\xe6\xae\xc2\x5e\xf7\xd6\x6e\x23\x34\xaf\x09\xce\xf9\x3d\x21\xc8\x25\xfd\x9e\x1b\x8f\x83\xc4\x79\xe1\x59\x99\x37\xf5\x0a\x57\x27\xbc\x5d\xc1\xa0\xd3\x60\x25\x1f\x5c\xea\xcf\xca\x9c\x18\xe5\xb2\xb2\x83\xee\xe2\xe8\xc3\xd0\xcd\xa3\xd6\xdd\xf9\xe9\xec\x6e\xfc\x38\xfb\x13\x31\xdc\x5b\xad\x31\xd0\x92\xf1\xa2\xfe\x0a\xa3\x2b\x01\xa4\xd8\xa6\x8e\xab\x6b\x32\x59\x59\x55\x59\x2a\x93\x92\x4c\x96\x65\x25\x78\xc6\x2c\x46\xbd\xa7\xcb\x56\xb1\x2a\xa9\x79\x66\x32\x77\xb9\x79\xaf\xd6\xa2\x85\xc6\xcd\x43\x37\x4f\x45\x04\x05\xff\x9a\x73\x92\x23\xed\x8b\xc2\xca\x2e\xcf\xbf\x25\x0d\x6a\x54\x05\xcb\x30\xec\x25\xf4\xf9\x47\x45\xbd\xba\xb7\x72\xdb\x7c\x6e\xde\x3f\x83\x5b\x79\x3a\xbf\x9a\x03\x5f\xd6\x03\x97\xb0\xc1\xb9\x09\xd4\x07\x0c\xf7\xd2\x51\x69\x2b\xe7\x5f\xa5\xad\xbc\x67\xc9\xe7\x98\xf2\x99\xa4\x34\xde\xba\x1f\xb5\xea\xe7\xb0\xd7\x6c\xb6\x65\xa5\x7c\x3e\x3a\xe5\x1f\x7b\x77\x9a\x9a\xfd\xfb\xd9\x63\xd8\xa7\x8e\xbf\x7b\xdf\xc2\x4f\x4d\xf5\x68\x90\xe9\xac\xfe\x38\x57\x30\xbd\x42\x63\x89\x16\x36\x6b\x9e\xad\xeb\xef\x37\x8e\xb0\x90\x9b\x18\xd6\x7c\xb5\x86\x01\xf8\x36\xb7\xc1\x76\xe5\x08\x48\x54\x4a\x13\x03\xb8\xec\xd4\x6f\x85\xdc\xc0\xcd\xc0\xed\xdc\x61\x2d\x79\x9e\x17\x54\xfd\x45\xb4\xfc\xda\xad\xf6\xe0\xe2\x02\xae\x5b\x12\xde\xb9\x81\x9e\xfc\x50\x0b\x4f\x97\xf3\x1d\xcb\x03\x08\xf5\x96\xbd\x55\x12\xd5\x2c\xc0\x6b\x8f\xfa\x74\x61\xe5\xcf\xd9\xca\xbd\x0a\x8e\x35\xdc\xf8\xee\x4e\xc5\x8d\xf8\x8e\x9e\x9d\x35\x3f\x75\xcd\xee\x6c\x7a\xaa\x0d\xef\x3a\x02\x7d\x66\x48\xc9\x1b\xdc\xcb\x81\x4b\xf4\x0e\xda\xf5\x16\x79\xd3\xb7\x9f\xa8\x76\x4e\xb7\xe6\xf3\x78\xb7\xd7\x37\xfa\x27\x92\xee\xe9\x3e\xb1\xbb\x97\x50\x86\xfd\x46\x31\x2d\xf8\x56\x0b\xbb\x53\x7c\xaa\x77\x7d\x6a\x1c\xd8\xd3\x1e\xf7\x45\xa5\xad\xea\xbe\xe8\xe8\xaa\x04\xc7\x26\x3b\xdc\xf4\x59\x0b\x5d\x76\x40\x85\x24\xbb\x09\xc5\x1a\x59\xb6\xa6\x16\xc0\xe7\x9d\xcf\xb7\x60\xb5\x29\x4b\xc6\x45\xc4\xf4\xea\xa5\x31\xd8\x12\x0a\xf4\x33\x14\x7d\xde\xba\xcf\x6a\x34\x7c\xba\x9a\xd3\x44\xe8\x5a\xb3\xce\x67\x1d\x37\x8e\x48\x8a\xa3\xba\x9e\xf7\xe2\xbd\x4f\x45\x6e\xf6\xcd\xc9\xd9\xff\x9a\xfb\xd2\x01\x8b\x43\xb9\x6f\x8e\xe5\x5a\xa9\xba\x62\xa9\x2c\xef\x4a\xfd\x1c\xa7\xaf\x8f\x38\x39\xd7\xe8\xb0\x72\xe3\x86\xcf\x97\x80\x8f\xa1\x05\xf0\x76\xde\x3b\x65\x50\xb3\x35\x49\x9d\x5d\x12\xfa\x26\x8f\x51\x6d\xb5\x3e\x70\xf1\xc2\x0a\xee\x70\x55\x94\x21\x0d\xbc\x32\xff\x27\x28\x51\x84\x10\x26\xbf\x49\x6f\x95\xa7\xab\x7e\xc3\xb8\x73\x71\xf7\x6e\xf1\x65\x10\x04\x7c\x09\x69\x2a\x58\x89\x69\xea\x4e\x98\xa6\x64\xd7\x34\xf5\x87\x24\x14\xae\xa9\xa1\xd9\xc8\x6c\x4d\xc2\xf4\xea\xa5\xd7\x0b\xfe\x39\x00\xbe\xfd\x92\xe9\x9c\x19\x00\x00"),
+		},
+		"/scripts/net_probe.sh": &vfsgen۰CompressedFileInfo{
+			name:             "net_probe.sh",
+			modTime:          time.Date(2026, 10, 19, 18, 12, 34, 847185493, time.UTC),
+			uncompressedSize: 969,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\x5d\x4f\xe3\x46\x14\x86\xef\xfd\x2b\xde\x3a\x51\x05\x52\x70\x42\x7a\x55\x50\xab\xa6\x40\x55\xb7\x28\x91\x70\x58\x84\x56\x2b\x34\x1e\x1f\xdb\x47\xd8\x33\xb3\x33\x63\x1c\xef\xb2\xff\x7d\x35\xf9\x10\xb0\xac\x2f\x3d\xef\x3c\xf3\x9c\x8f\xd1\x2f\xd3\x9c\xd5\x34\x17\xae\x8e\x46\x23\x5c\x68\x33\x58\xae\x6a\x8f\xf9\xec\xf4\x77\x64\xb5\x50\x55\x2d\x18\xff\xb1\xaa\x2e\x3b\x8d\x54\x95\xda\xb6\xc2\xb3\x56\x58\x93\xac\x95\x6e\x74\x35\x40\xea\x64\x82\x6b\x5f\x24\xd1\x68\x14\x30\xd7\x2c\x49\x39\x2a\xd0\xa9\x82\x2c\x7c\x4d\x58\x18\x21\x6b\x3a\x9c\x4c\xf0\x81\xac\x0b\x94\x79\x32\xc3\x51\x08\xc4\xfb\xa3\xf8\xf8\x3c\x20\x06\xdd\xa1\x15\x03\x94\xf6\xe8\x1c\xc1\xd7\xec\x50\x72\x43\xa0\x8d\x24\xe3\xc1\x0a\x52\xb7\xa6\x61\xa1\x24\xa1\x67\x5f\xc3\xbf\x3c\x10\x4c\x70\xbf\x67\xe8\xdc\x0b\x56\x10\x90\xda\x0c\xd0\xe5\xeb\x20\x84\xdf\x4b\x6f\xbf\xda\x7b\x73\x36\x9d\xf6\x7d\x9f\x88\xad\x71\xa2\x6d\x35\x6d\x76\x59\x37\xbd\x4e\x2f\xae\x96\xd9\xd5\xc9\x3c\x99\xed\x6f\xdd\xaa\x86\x9c\x83\xa5\xcf\x1d\x5b\x2a\x90\x0f\x10\xc6\x34\x2c\x45\xde\x10\x1a\xd1\x43\x5b\x88\xca\x12\x15\xf0\x3a\x58\xf7\x96\x3d\xab\x6a\x02\xa7\x4b\xdf\x0b\x4b\x41\xb5\x60\xe7\x2d\xe7\x9d\x7f\xd3\xb4\x83\x23\xbb\x37\x01\xad\x20\x14\xe2\x45\x86\x34\x8b\xf1\xf7\x22\x4b\xb3\x49\x80\xdc\xa5\xeb\x7f\x57\xb7\x6b\xdc\x2d\x6e\x6e\x16\xcb\x75\x7a\x95\x61\x75\x83\x8b\xd5\xf2\x32\x5d\xa7\xab\x65\x86\xd5\x3f\x58\x2c\xef\xf1\x7f\xba\xbc\x9c\x80\xd8\xd7\x64\x41\x1b\x63\x43\x05\xda\x82\x43\x3b\x69\x3b\x45\x64\x44\x6f\x14\x4a\xbd\x9b\xa3\x33\x24\xb9\x64\x89\x46\xa8\xaa\x13\x15\xa1\xd2\x4f\x64\x15\xab\x0a\x86\x6c\xcb\x2e\x8c\xd5\x41\xa8\x22\x60\x1a\x6e\xd9\x6f\xf7\xc5\xbd\xaf\x2b\x89\xa2\x11\xd6\x61\xb0\x4e\x5a\x36\x1e\xb6\x53\x6e\x1b\x50\xe4\x1f\x8c\xd5\x39\x25\x66\x40\x4d\x8d\x21\x1b\x3a\x6b\x06\x5f\x6b\x35\x81\xa3\x1f\x22\x07\xbb\xce\x89\x8a\x92\x28\xda\x05\x1f\x72\x56\x7f\x8c\x8f\xa4\x6e\x5b\xa1\x0a\x9c\x3c\xed\x01\xbf\xe1\xf9\x19\xef\xfe\xfe\xf4\xe7\xfc\x38\xe2\x12\x1f\x71\xf2\x05\xf1\xf8\xeb\x0b\xf6\x5b\x8c\x4f\xe7\x41\x55\x45\x61\x6d\x48\xd6\x1a\x31\x59\xab\xed\xd9\x01\xc7\xaf\xb6\xc2\x6b\xc8\x9a\xe4\xe3\xa1\xb8\x5e\xdb\xc7\x18\x7f\xfe\x3a\xdf\xdd\xde\xb0\xc7\x69\x54\x72\x14\xd1\x86\xe4\xbb\x97\xe2\xf1\x51\xc1\x56\x89\x96\x10\x8f\x67\xf1\xf1\xf4\x75\xed\x31\xe2\xf1\x5f\x71\xf4\x7d\x00\x7b\xe3\xd2\x5d\xc9\x03\x00\x00"),
+		},
+		"/scripts/node_fingerprint.sh": &vfsgen۰CompressedFileInfo{
+			name:             "node_fingerprint.sh",
+			modTime:          time.Date(2026, 10, 19, 18, 45, 32, 655117128, time.UTC),
+			uncompressedSize: 1584,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x94\x41\x6f\xe3\x36\x10\x85\xef\xfa\x15\xaf\xb6\xb1\x71\x00\x5b\x4a\xdd\x53\xd3\x75\x00\x37\x49\x51\xb5\xa9\x0d\x44\xde\x5d\xec\xa5\x01\x4d\x8d\x25\xc2\x14\x47\x25\x29\x3b\x46\x9a\xff\x5e\x50\x96\xd7\x49\x9a\xc3\xe6\xc4\x68\x1e\xbf\xf7\x86\x43\xb3\xff\x43\xb2\x52\x26\x59\x09\x57\x46\xfd\x3e\xae\xb9\xde\x5b\x55\x94\x1e\x93\x8b\x1f\x7f\x46\x56\x0a\x53\x94\x42\xe1\x0f\x65\x8a\x9b\x86\x91\x9a\x35\xdb\x4a\x78\xc5\x06\x4b\x92\xa5\x61\xcd\xc5\x1e\x92\xe3\x11\xee\x7c\x1e\x47\xfd\x7e\xc0\xdc\x29\x49\xc6\x51\x8e\xc6\xe4\x64\xe1\x4b\xc2\xac\x16\xb2\xa4\x63\x65\x84\xcf\x64\x5d\xa0\x4c\xe2\x0b\x0c\x83\xa0\xd7\x95\x7a\xe7\xbf\x04\xc4\x9e\x1b\x54\x62\x0f\xc3\x1e\x8d\x23\xf8\x52\x39\xac\x95\x26\xd0\xa3\xa4\xda\x43\x19\x48\xae\x6a\xad\x84\x91\x84\x9d\xf2\x25\xfc\xc9\x20\x24\xc1\xd7\x8e\xc1\x2b\x2f\x94\x81\x80\xe4\x7a\x0f\x5e\xbf\x14\x42\xf8\x2e\x74\xfb\x57\x7a\x5f\x5f\x26\xc9\x6e\xb7\x8b\x45\x9b\x38\x66\x5b\x24\xfa\xa0\x75\xc9\x5d\x7a\x7d\x3b\xcf\x6e\xc7\x93\xf8\xa2\xdb\xf5\xc9\x68\x72\x0e\x96\xfe\x69\x94\xa5\x1c\xab\x3d\x44\x5d\x6b\x25\xc5\x4a\x13\xb4\xd8\x81\x2d\x44\x61\x89\x72\x78\x0e\xa9\x77\x56\x79\x65\x8a\x11\x1c\xaf\xfd\x4e\x58\x0a\x51\x73\xe5\xbc\x55\xab\xc6\xbf\x3a\xb4\x63\x46\xe5\x5e\x09\xd8\x40\x18\xf4\x66\x19\xd2\xac\x87\x5f\x67\x59\x9a\x8d\x02\xe4\x4b\xba\xfc\x7d\xf1\x69\x89\x2f\xb3\xfb\xfb\xd9\x7c\x99\xde\x66\x58\xdc\xe3\x7a\x31\xbf\x49\x97\xe9\x62\x9e\x61\xf1\x1b\x66\xf3\xaf\xf8\x33\x9d\xdf\x8c\x40\xca\x97\x64\x41\x8f\xb5\x0d\x1d\xb0\x85\x0a\xc7\x49\xed\x14\x91\x11\xbd\x8a\xb0\xe6\xc3\x1c\x5d\x4d\x52\xad\x95\x84\x16\xa6\x68\x44\x41\x28\x78\x4b\xd6\x28\x53\xa0\x26\x5b\x29\x17\xc6\xea\x20\x4c\x1e\x30\x5a\x55\xca\xb7\xf7\xc5\xfd\xbf\xaf\x38\x8a\xfa\x58\x86\xc1\x3a\x69\x55\xed\x51\x5b\x65\xbc\x6b\x25\x6b\x21\xbd\x3b\xce\xca\x70\x4e\xd8\x95\x4a\x1e\x66\x6c\xc9\x35\xfa\x54\x95\x25\xc9\x0d\x94\xa7\xca\x21\xa7\x9a\x4c\x0e\x36\xa3\xa0\x8c\xfa\x6f\xb5\x5a\x38\xdf\x6d\x10\x36\x90\x9a\x70\x4d\xd9\xe8\x3d\xd4\xfa\x85\x71\x28\x86\xab\x27\xc3\x2f\xa0\x3d\x12\x70\xe3\xeb\xc6\x8f\xc0\x86\xa0\x95\x39\x1c\x09\x09\x59\xb6\x5b\x2e\xa3\x3e\x80\x8f\x61\x79\x35\xfd\xb8\x15\xba\xa1\xab\x28\x8a\xa1\xd5\x2a\x76\x65\x14\x91\x2c\x19\xbd\x92\x9d\x37\xa2\xa2\xe9\x60\x78\x5c\x9e\xf7\xa2\x7e\x6b\xbc\x62\xf6\x50\x79\x67\xe9\xc0\x06\xb4\x25\xbb\x87\xa5\x50\xea\x08\x61\x39\x1d\x0c\xa5\xf0\x48\x6a\xcb\x32\x71\x7b\x97\x6c\xc8\x1a\xd2\x89\x15\x26\xe7\x2a\x09\x92\x07\x95\x63\x72\x85\x24\xa7\x6d\x62\x1a\xad\xcf\x7b\xdd\xfe\x83\x74\x3a\x18\x36\xc1\x1c\x63\xfb\xad\xc2\x6e\x3a\x18\xc6\x48\xc8\xcb\x84\xdd\xd8\x92\x26\xe1\xe8\x15\x05\x1f\x3e\xe0\x80\x19\x3c\xa5\x37\xcf\x18\x3c\x7d\xbe\xbd\xcf\xd2\xc5\xfc\x21\xbd\x79\xee\x7d\x23\xc9\xba\x09\x2c\x13\xf2\xbd\x9f\xa2\xa2\x8a\xed\x7e\x3a\x18\x8a\xdd\x06\x67\xc9\xdf\x7f\x51\xb5\x64\x2f\xf4\x65\x82\xa7\xf6\x12\x60\x30\x79\x3e\xeb\x3a\xac\xa8\x52\x66\xcd\xef\x93\x2c\xb3\xcf\x95\xdb\x4c\x07\xc3\x7c\x8d\xf1\x06\xc9\x2b\x1d\xfe\x45\x6b\x31\xbf\xc7\x74\x8a\xc9\x4b\xfa\x79\x2f\x92\x5c\x55\xc2\xe4\x0f\xf4\xa8\x9c\x77\xc8\x59\x6e\xc8\x9e\x9a\x3c\xfc\x1f\xc8\xed\x02\xdb\xee\xcd\x1a\x8f\x0f\xaf\x20\xce\x9e\x9e\xe2\x8c\xec\x96\x6c\xdc\xbd\x67\xcf\xcf\x67\x6f\x73\xbe\x31\x91\x6c\xc2\x6b\x44\x36\x3f\x19\x9d\xbe\x85\xd1\x9e\x04\xe3\xf1\xd1\xf2\xbd\x9e\x8e\xbd\xfc\xf4\x5e\x2f\x9b\x66\x45\x9a\xfc\xc9\xa3\xfb\x30\x1d\x0c\x8f\xa5\xef\xa3\x4f\x5a\x7a\x44\x8f\xca\xe3\x22\xfa\x6f\x00\xee\x9c\xb3\x57\x30\x06\x00\x00"),
+		},
+		"/scripts/port_listener.sh": &vfsgen۰CompressedFileInfo{
+			name:             "port_listener.sh",
+			modTime:          time.Date(2026, 10, 19, 18, 9, 12, 125626538, time.UTC),
+			uncompressedSize: 2111,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x54\x6d\x6f\xdb\x36\x17\xfd\xae\x5f\x71\x2a\x1b\xad\xfd\x3c\x8e\x1c\xbb\x5f\xb6\x24\x0e\xe0\x25\x2e\xe6\x35\xb3\x81\xc8\x5d\x51\x14\x85\x41\x53\xd7\x12\x11\x89\xe4\x48\x2a\x8e\xd7\xe4\xbf\x0f\x94\xe4\x97\x2c\xe9\x66\x01\x96\x74\x79\xee\xe1\xb9\xf7\x1e\xaa\xf5\xa6\xbf\x12\xb2\xbf\x62\x36\x0b\x5a\x2d\x5c\x29\xbd\x35\x22\xcd\x1c\x86\xa7\x83\x9f\x11\x67\x4c\xa6\x19\x13\xf8\x4d\xc8\xf4\xba\x54\x98\xca\xb5\x32\x05\x73\x42\x49\x2c\x88\x67\x52\xe5\x2a\xdd\x82\xab\xa8\x87\x1b\x97\x44\x41\xab\xe5\x69\x6e\x04\x27\x69\x29\x41\x29\x13\x32\x70\x19\x61\xac\x19\xcf\x68\xb7\xd2\xc3\x1f\x64\xac\x67\x19\x46\xa7\xe8\x78\x40\xd8\x2c\x85\xdd\x73\x4f\xb1\x55\x25\x0a\xb6\x85\x54\x0e\xa5\x25\xb8\x4c\x58\xac\x45\x4e\xa0\x07\x4e\xda\x41\x48\x70\x55\xe8\x5c\x30\xc9\x09\x1b\xe1\x32\xb8\xc3\x06\x5e\x09\xbe\x34\x1c\x6a\xe5\x98\x90\x60\xe0\x4a\x6f\xa1\xd6\xc7\x40\x30\xd7\x88\xae\x7e\x99\x73\xfa\xac\xdf\xdf\x6c\x36\x11\xab\x14\x47\xca\xa4\xfd\xbc\xc6\xda\xfe\xcd\xf4\x6a\x32\x8b\x27\x27\xc3\xe8\xb4\xc9\xfa\x24\x73\xb2\x16\x86\xfe\x2c\x85\xa1\x04\xab\x2d\x98\xd6\xb9\xe0\x6c\x95\x13\x72\xb6\x81\x32\x60\xa9\x21\x4a\xe0\x94\x57\xbd\x31\xc2\x09\x99\xf6\x60\xd5\xda\x6d\x98\x21\x2f\x35\x11\xd6\x19\xb1\x2a\xdd\xb3\xa6\xed\x34\x0a\xfb\x0c\xa0\x24\x98\x44\x38\x8e\x31\x8d\x43\xfc\x32\x8e\xa7\x71\xcf\x93\x7c\x9e\x2e\x7e\x9d\x7f\x5a\xe0\xf3\xf8\xf6\x76\x3c\x5b\x4c\x27\x31\xe6\xb7\xb8\x9a\xcf\xae\xa7\x8b\xe9\x7c\x16\x63\xfe\x01\xe3\xd9\x17\x7c\x9c\xce\xae\x7b\x20\xe1\x32\x32\xa0\x07\x6d\x7c\x05\xca\x40\xf8\x76\x52\x35\x45\xc4\x44\xcf\x24\xac\x55\x3d\x47\xab\x89\x8b\xb5\xe0\xc8\x99\x4c\x4b\x96\x12\x52\x75\x4f\x46\x0a\x99\x42\x93\x29\x84\xf5\x63\xb5\x60\x32\xf1\x34\xb9\x28\x84\xab\xfc\x62\x5f\xd6\x15\x05\x41\x0b\x0b\x3f\x58\xcb\x8d\xd0\x0e\xd6\x31\xe3\x2a\x29\xd6\x29\x6d\xc1\xe0\xa8\xd0\xca\x30\xb3\x85\xe3\x1a\xb9\xb0\x8e\x24\x19\xef\x88\xaa\x9d\x3c\x23\x7e\x57\x51\x6a\x65\x1c\x0c\x31\x9e\xb1\x95\xc8\x85\xdb\x46\x41\x0b\xa5\x65\x29\x9d\x05\x2d\xa0\x5a\x5f\xee\xf2\x23\x9b\xd5\x7b\xe1\xc2\xc7\x2f\x71\x61\x89\x2b\x99\xd8\xcb\x1e\xb4\x11\xd2\x59\x84\x35\xd6\x97\x75\xa1\x45\x72\x19\x42\xd4\xbe\xd9\x6b\xf0\xb2\x3d\x07\x25\x3f\xda\x40\xe9\x3a\x37\x08\x76\xf1\xa5\x36\x2a\x35\xac\x18\xbd\x0b\x84\x2f\xcc\xc1\x2a\x7e\x47\xae\x07\xbb\xb5\x3d\x38\x51\x50\x60\x31\x6a\xa2\x51\x7d\xeb\x34\x6f\xe3\x0f\xcb\xe9\x6c\xb2\xe8\xed\x56\xe3\xf9\xd5\xc7\x65\xbc\xb8\x9d\x8c\x7f\xef\x06\x36\xb2\xe4\xfc\x82\xd2\xfb\x84\x78\x7e\xb3\xf4\xa0\x67\x39\xcb\xdb\xc9\xa7\x78\x32\xbe\xbe\xbe\xed\x61\xe0\xf3\x56\x42\x26\x9d\x4e\x78\x1a\x55\x57\xd8\x83\x90\xae\x63\xb7\x36\x62\x26\xbd\xff\x3a\xf8\xd6\xed\x7a\x54\x5d\x41\x67\x30\xfc\xa9\xd9\xcb\x6b\x55\xa5\xeb\x0c\xba\x41\x42\x2c\xc9\x85\x24\x8c\xaa\x0a\x22\xff\xd7\xe9\xe2\xff\xcf\x99\x86\xdf\xba\xc1\x26\xf3\x27\xf8\x18\x74\x81\x5d\xf6\x59\xe0\x0f\xa0\x33\xdb\xfa\xc1\x5f\x5c\x49\xd9\xc3\xd2\x37\x24\x62\xdc\x1f\xfb\x4e\xf7\xd9\x62\xc4\x73\x65\xa9\x09\x36\x1f\x86\xa6\xd2\x46\xdf\x81\x4c\x33\x6b\x83\x77\x41\x50\x0d\xbe\xd3\xc5\xf7\x6a\x25\x57\x9c\xe5\xd5\xf0\x46\xed\xc1\x51\xa4\x31\xc4\xa8\x3d\x3c\x86\x6d\x5d\xa6\xe4\x72\x25\x64\x15\x3c\xbc\x8e\xda\x1d\xae\x8a\x82\xc9\x04\x27\xf7\x0d\xec\x3d\x1e\x1f\xf1\x22\xfa\x6a\x70\x58\x17\x20\xd6\xf8\x8a\x93\xbf\x10\xb6\xbf\x1f\xa8\x9f\x42\x7c\x3b\xf7\xce\x93\xfb\x4a\x88\x67\x0a\x21\x19\xa3\xcc\xd9\x8e\x56\x1c\x7d\x83\x9c\x6a\xdc\x7d\xec\xd7\x10\x97\x6f\x87\x07\x8a\x07\xe1\x50\xd7\xbb\x16\x41\x75\x97\x2a\x2b\xf5\x8b\xbd\x4f\xb8\x0f\xfd\xd3\xbf\x4f\xa1\x8f\xfa\xae\xd5\x4f\x4d\xb7\x9e\x42\x5c\xa2\x9f\xd0\x7d\x5f\x96\x79\x8e\xe1\xe5\xdb\x01\xde\x1e\xf7\x4f\x24\xa3\xf6\x9b\x7a\xbb\x16\x36\x4c\x38\x30\x14\xaa\x20\xe9\xfc\x69\x2e\xd8\x1d\xc1\x96\x86\x0e\x27\x5a\x58\xac\x54\x29\x93\x2a\xc5\xe6\x44\xba\x51\x2d\xd6\x78\x83\x3b\x91\xe7\x38\x39\xf5\x0a\xb4\x48\x5e\xd9\xfd\xdf\x1a\xb7\x66\x22\xaf\x9b\x55\x57\x07\x25\x2b\x1f\x60\x5f\xd8\x7f\x34\xac\x1e\xc3\xe1\x3b\xd1\x88\x08\x9e\xbc\xc7\x94\xde\x5b\xac\x52\x19\xb6\x07\xaf\x34\xe7\xf1\x11\xce\x94\xe4\x53\x38\xb3\x54\xa3\x1a\x77\x55\x23\x3c\xd8\xbd\x7a\x45\xd8\x1e\xfa\x86\xbf\x0f\xf7\xf1\xf3\xf3\x06\xad\xf4\x31\x58\xf9\x51\x0e\x5f\xc0\xfe\xd7\x7d\xbd\x19\xa5\xbc\x93\x6a\x23\xf7\xd6\x6c\x0f\x7e\x58\x7e\xc3\x46\x96\xf1\xe0\xef\x01\x00\x88\x2a\xe4\x29\x3f\x08\x00\x00"),
+		},
+		"/scripts/run_init_step.sh": &vfsgen۰CompressedFileInfo{
+			name:             "run_init_step.sh",
+			modTime:          time.Date(2026, 10, 19, 19, 51, 31, 23552077, time.UTC),
+			uncompressedSize: 1646,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x53\x6d\x6f\xdb\x36\x10\xfe\xae\x5f\xf1\x54\x0a\x86\x16\x70\xec\xc4\x5f\x8a\xb5\x4d\x37\x2f\xc9\x30\x6f\x81\x03\xc4\xee\x8a\x62\x18\x06\x5a\x3a\x49\x07\x50\xa4\x4a\x9e\xea\x78\x86\xff\xfb\x40\xca\x91\x9d\x74\x41\x80\x44\xbc\xe3\xdd\xf3\xc6\xec\xd5\x64\xcd\x66\xb2\x56\xbe\x4e\xb2\x0c\xd7\xb6\xdd\x3a\xae\x6a\xc1\xf4\xe2\xf2\x47\x2c\x6b\x65\xaa\x5a\x31\x7e\x67\x53\xdd\x74\x16\x73\x53\x5a\xd7\x28\x61\x6b\xb0\xa2\xbc\x36\x56\xdb\x6a\x8b\xdc\x8e\x47\xb8\x93\x62\x9c\x64\x59\x18\x73\xc7\x39\x19\x4f\x05\x3a\x53\x90\x83\xd4\x84\x59\xab\xf2\x9a\x9e\x2a\x23\xfc\x49\xce\x87\x29\xd3\xf1\x05\x5e\x87\x86\xf4\x50\x4a\xdf\xbc\x0f\x23\xb6\xb6\x43\xa3\xb6\x30\x56\xd0\x79\x82\xd4\xec\x51\xb2\x26\xd0\x63\x4e\xad\x80\x0d\x72\xdb\xb4\x9a\x95\xc9\x09\x1b\x96\x1a\x72\x5c\x10\x90\xe0\xcb\x61\x86\x5d\x8b\x62\x03\x85\xdc\xb6\x5b\xd8\xf2\xb4\x11\x4a\x0e\xa0\xe3\x4f\x2d\xd2\xbe\x9b\x4c\x36\x9b\xcd\x58\x45\xc4\x63\xeb\xaa\x89\xee\x7b\xfd\xe4\x6e\x7e\x7d\xbb\x58\xde\x9e\x4f\xc7\x17\x87\x5b\x9f\x8c\x26\xef\xe1\xe8\x6b\xc7\x8e\x0a\xac\xb7\x50\x6d\xab\x39\x57\x6b\x4d\xd0\x6a\x03\xeb\xa0\x2a\x47\x54\x40\x6c\x40\xbd\x71\x2c\x6c\xaa\x11\xbc\x2d\x65\xa3\x1c\x05\xa8\x05\x7b\x71\xbc\xee\xe4\x99\x68\x4f\x18\xd9\x3f\x6b\xb0\x06\xca\x20\x9d\x2d\x31\x5f\xa6\xf8\x65\xb6\x9c\x2f\x47\x61\xc8\xe7\xf9\xea\xb7\xfb\x4f\x2b\x7c\x9e\x3d\x3c\xcc\x16\xab\xf9\xed\x12\xf7\x0f\xb8\xbe\x5f\xdc\xcc\x57\xf3\xfb\xc5\x12\xf7\xbf\x62\xb6\xf8\x82\x3f\xe6\x8b\x9b\x11\x88\xa5\x26\x07\x7a\x6c\x5d\x60\x60\x1d\x38\xc8\x49\xd1\x45\x2c\x89\x9e\x41\x28\x6d\xef\xa3\x6f\x29\xe7\x92\x73\x68\x65\xaa\x4e\x55\x84\xca\x7e\x23\x67\xd8\x54\x68\xc9\x35\xec\x83\xad\x1e\xca\x14\x61\x8c\xe6\x86\x25\xe6\xc5\x7f\xcf\x6b\x9c\x24\x19\x56\xc1\x58\x9f\x3b\x6e\x05\x42\x4d\xab\x95\x10\x5c\x67\x3c\x14\x8c\x2d\x08\x6c\x58\x58\x69\xfe\x37\x8e\x81\x75\x28\xa8\xd5\x76\xdb\x90\x91\xa7\x8b\x07\x4f\xd7\x9d\x29\xf4\x49\x18\x5a\xe5\x54\x43\x42\xce\x27\x19\x1c\x85\xfd\xbd\x45\x81\x49\x3f\x05\xb9\x35\xe2\xac\xd6\xe4\x46\xd8\xd4\x9c\xd7\x60\x1f\x2f\x5b\xa3\xb7\xd8\xa8\xed\x8b\x49\x50\x2e\x7c\xfa\x10\x6f\xb1\xb1\xd8\x83\xf0\xe3\xc8\x86\x8e\x8b\x0e\xe0\x38\xc4\x43\xc5\xbd\x69\x78\x68\x38\xf7\x29\xd8\x78\x2e\xe8\x14\x75\xc1\x8e\x72\xb1\x6e\x3b\x0a\xe2\x81\x05\xad\x63\x23\x3d\x18\x7a\x64\x41\x1e\xe4\xe8\xa9\x26\xd9\x30\xdd\x84\x6f\x68\xe5\x05\x9a\x4d\x6c\xf0\x52\xd8\x4e\x46\xf0\x1c\xde\xc6\x77\xf7\xc3\x43\x68\x9a\xb8\xc4\xc7\x07\xe6\x48\x3a\x67\x8e\xd2\x34\x2a\xaf\xd9\x50\x64\x34\x78\x52\x28\x51\xef\x92\x0c\xc0\x78\x39\x10\x3b\xd2\x0f\xe9\x5e\x47\xe7\x46\x18\xcf\x5c\xd5\x0b\xc5\x12\xfe\x56\x5d\x30\xcb\x3f\x09\x1c\x0a\x5f\x3b\x1b\xb2\xbe\xa9\xc9\x0c\x82\x25\x19\x6c\x27\x6d\x40\xfe\x8c\x52\xbf\x35\x50\xb8\xfa\x30\x10\xf9\x98\x24\xae\x33\xff\x78\xa1\xf6\xf5\x1b\xec\x92\xf0\x7a\xb5\xcd\x95\x3e\xe0\xb9\x3a\xbb\x8c\x67\xbe\xe6\x52\x92\xf8\x2f\x97\xf8\x0b\xaf\x70\x5e\x22\x3d\xdb\xf5\x5d\xfb\x14\x7f\xbf\x0f\x02\x9a\xd8\x11\x7e\x29\xaf\x2d\xd2\x03\xa9\xa1\x2f\xea\x54\xda\xce\x14\x29\x3e\xfe\x30\x7d\xd1\x1d\x50\x5d\x5d\x4e\xdf\xa6\xc3\x79\x2f\x29\x2e\xa7\x6f\xe3\x51\xc9\x3d\x84\x2c\xec\x82\x23\x3f\x64\xf6\x7f\xd2\x62\x0d\xbc\x14\x6c\x9e\xf4\x6a\x3a\x2f\x71\xff\x9a\x86\x24\x1d\x85\x8f\x73\x63\xae\x4e\x59\xa5\x67\x3f\xa7\xf8\x30\x29\xe8\xdb\xc4\x74\x5a\x9f\xc8\x13\x52\x74\x75\xf6\x53\xf2\x02\xfd\xd9\x2e\x14\xf6\x69\x72\x82\xfe\x70\x96\xec\x8f\x5a\x63\xb7\xeb\xbd\x1b\x52\xb0\xdf\xef\x76\x70\xca\x54\x74\xf0\x7d\xbf\x3f\x69\xea\xcb\x64\x0a\xec\xf7\xc9\x7f\x03\x00\xe0\x79\x4c\xba\x6e\x06\x00\x00"),
 		},
 	}
 	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/scripts"].(os.FileInfo),
 	}
 	fs["/scripts"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
-		fs["/scripts/test.sh"].(os.FileInfo),
+		fs["/scripts/check_cgroup_driver.sh"].(os.FileInfo),
+		fs["/scripts/check_containerd_version.sh"].(os.FileInfo),
+		fs["/scripts/check_cpu_num.sh"].(os.FileInfo),
+		fs["/scripts/check_cri_socket.sh"].(os.FileInfo),
+		fs["/scripts/check_docker_version.sh"].(os.FileInfo),
+		fs["/scripts/check_etcd_health.sh"].(os.FileInfo),
+		fs["/scripts/check_ha.sh"].(os.FileInfo),
+		fs["/scripts/check_hostname.sh"].(os.FileInfo),
+		fs["/scripts/check_kernel_version.sh"].(os.FileInfo),
+		fs["/scripts/check_kubelet_version.sh"].(os.FileInfo),
+		fs["/scripts/check_memory_capacity.sh"].(os.FileInfo),
+		fs["/scripts/check_port_occupied.sh"].(os.FileInfo),
+		fs["/scripts/check_port_reachable.sh"].(os.FileInfo),
+		fs["/scripts/check_residue.sh"].(os.FileInfo),
+		fs["/scripts/check_root_disk_volume.sh"].(os.FileInfo),
+		fs["/scripts/check_system_distribution.sh"].(os.FileInfo),
+		fs["/scripts/check_system_preference.sh"].(os.FileInfo),
+		fs["/scripts/check_time_sync.sh"].(os.FileInfo),
+		fs["/scripts/check_vip.sh"].(os.FileInfo),
+		fs["/scripts/clean_node.sh"].(os.FileInfo),
+		fs["/scripts/create_bootstrap_token.sh"].(os.FileInfo),
+		fs["/scripts/deploy_etcd.sh"].(os.FileInfo),
+		fs["/scripts/deploy_master.sh"].(os.FileInfo),
+		fs["/scripts/disk_probe.py"].(os.FileInfo),
+		fs["/scripts/disk_probe.sh"].(os.FileInfo),
+		fs["/scripts/init_change_firewall.sh"].(os.FileInfo),
+		fs["/scripts/init_change_hostalias.sh"].(os.FileInfo),
+		fs["/scripts/init_change_hostname.sh"].(os.FileInfo),
+		fs["/scripts/init_change_network.sh"].(os.FileInfo),
+		fs["/scripts/init_change_ntp.sh"].(os.FileInfo),
+		fs["/scripts/init_change_route.sh"].(os.FileInfo),
+		fs["/scripts/init_change_swap.sh"].(os.FileInfo),
+		fs["/scripts/init_change_timezone.sh"].(os.FileInfo),
+		fs["/scripts/init_container_runtime.sh"].(os.FileInfo),
+		fs["/scripts/init_deploy_haproxy.sh"].(os.FileInfo),
+		fs["/scripts/init_deploy_haproxy_keepalived"].(os.FileInfo),
+		fs["/scripts/init_deploy_keepalived.sh"].(os.FileInfo),
+		fs["/scripts/join_worker.sh"].(os.FileInfo),
+		fs["/scripts/lib.sh"].(os.FileInfo),
+		fs["/scripts/lib_kubeadm.sh"].(os.FileInfo),
+		fs["/scripts/lib_residue.sh"].(os.FileInfo),
+		fs["/scripts/load_images.sh"].(os.FileInfo),
+		fs["/scripts/net_probe.py"].(os.FileInfo),
+		fs["/scripts/net_probe.sh"].(os.FileInfo),
+		fs["/scripts/node_fingerprint.sh"].(os.FileInfo),
+		fs["/scripts/port_listener.sh"].(os.FileInfo),
+		fs["/scripts/run_init_step.sh"].(os.FileInfo),
+	}
+	fs["/scripts/init_deploy_haproxy_keepalived"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/scripts/init_deploy_haproxy_keepalived/docker.sh"].(os.FileInfo),
+		fs["/scripts/init_deploy_haproxy_keepalived/lib.sh"].(os.FileInfo),
+		fs["/scripts/init_deploy_haproxy_keepalived/setup.sh"].(os.FileInfo),
+		fs["/scripts/init_deploy_haproxy_keepalived/systemd.sh"].(os.FileInfo),
 	}
 
 	return fs
@@ -53,10 +454,15 @@ func (fs vfsgen۰FS) Open(path string) (http.File, error) {
 	}
 
 	switch f := f.(type) {
-	case *vfsgen۰FileInfo:
-		return &vfsgen۰File{
-			vfsgen۰FileInfo: f,
-			Reader:          bytes.NewReader(f.content),
+	case *vfsgen۰CompressedFileInfo:
+		gr, err := gzip.NewReader(bytes.NewReader(f.compressedContent))
+		if err != nil {
+			// This should never happen because we generate the gzip bytes such that they are always valid.
+			panic("unexpected error reading own gzip compressed bytes: " + err.Error())
+		}
+		return &vfsgen۰CompressedFile{
+			vfsgen۰CompressedFileInfo: f,
+			gr:                        gr,
 		}, nil
 	case *vfsgen۰DirInfo:
 		return &vfsgen۰Dir{
@@ -68,39 +474,75 @@ func (fs vfsgen۰FS) Open(path string) (http.File, error) {
 	}
 }
 
-// We already imported "compress/gzip" and "io/ioutil", but ended up not using them. Avoid unused import error.
-var _ = gzip.Reader{}
-var _ = ioutil.Discard
-
-// vfsgen۰FileInfo is a static definition of an uncompressed file (because it's not worth gzip compressing).
-type vfsgen۰FileInfo struct {
-	name    string
-	modTime time.Time
-	content []byte
+// vfsgen۰CompressedFileInfo is a static definition of a gzip compressed file.
+type vfsgen۰CompressedFileInfo struct {
+	name              string
+	modTime           time.Time
+	compressedContent []byte
+	uncompressedSize  int64
 }
 
-func (f *vfsgen۰FileInfo) Readdir(count int) ([]os.FileInfo, error) {
+func (f *vfsgen۰CompressedFileInfo) Readdir(count int) ([]os.FileInfo, error) {
 	return nil, fmt.Errorf("cannot Readdir from file %s", f.name)
 }
-func (f *vfsgen۰FileInfo) Stat() (os.FileInfo, error) { return f, nil }
+func (f *vfsgen۰CompressedFileInfo) Stat() (os.FileInfo, error) { return f, nil }
 
-func (f *vfsgen۰FileInfo) NotWorthGzipCompressing() {}
+func (f *vfsgen۰CompressedFileInfo) GzipBytes() []byte {
+	return f.compressedContent
+}
 
-func (f *vfsgen۰FileInfo) Name() string       { return f.name }
-func (f *vfsgen۰FileInfo) Size() int64        { return int64(len(f.content)) }
-func (f *vfsgen۰FileInfo) Mode() os.FileMode  { return 0444 }
-func (f *vfsgen۰FileInfo) ModTime() time.Time { return f.modTime }
-func (f *vfsgen۰FileInfo) IsDir() bool        { return false }
-func (f *vfsgen۰FileInfo) Sys() interface{}   { return nil }
+func (f *vfsgen۰CompressedFileInfo) Name() string       { return f.name }
+func (f *vfsgen۰CompressedFileInfo) Size() int64        { return f.uncompressedSize }
+func (f *vfsgen۰CompressedFileInfo) Mode() os.FileMode  { return 0444 }
+func (f *vfsgen۰CompressedFileInfo) ModTime() time.Time { return f.modTime }
+func (f *vfsgen۰CompressedFileInfo) IsDir() bool        { return false }
+func (f *vfsgen۰CompressedFileInfo) Sys() interface{}   { return nil }
 
-// vfsgen۰File is an opened file instance.
-type vfsgen۰File struct {
-	*vfsgen۰FileInfo
-	*bytes.Reader
+// vfsgen۰CompressedFile is an opened compressedFile instance.
+type vfsgen۰CompressedFile struct {
+	*vfsgen۰CompressedFileInfo
+	gr      *gzip.Reader
+	grPos   int64 // Actual gr uncompressed position.
+	seekPos int64 // Seek uncompressed position.
 }
 
-func (f *vfsgen۰File) Close() error {
-	return nil
+func (f *vfsgen۰CompressedFile) Read(p []byte) (n int, err error) {
+	if f.grPos > f.seekPos {
+		// Rewind to beginning.
+		err = f.gr.Reset(bytes.NewReader(f.compressedContent))
+		if err != nil {
+			return 0, err
+		}
+		f.grPos = 0
+	}
+	if f.grPos < f.seekPos {
+		// Fast-forward.
+		_, err = io.CopyN(ioutil.Discard, f.gr, f.seekPos-f.grPos)
+		if err != nil {
+			return 0, err
+		}
+		f.grPos = f.seekPos
+	}
+	n, err = f.gr.Read(p)
+	f.grPos += int64(n)
+	f.seekPos = f.grPos
+	return n, err
+}
+func (f *vfsgen۰CompressedFile) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+		f.seekPos = 0 + offset
+	case io.SeekCurrent:
+		f.seekPos += offset
+	case io.SeekEnd:
+		f.seekPos = f.uncompressedSize + offset
+	default:
+		panic(fmt.Errorf("invalid whence value: %v", whence))
+	}
+	return f.seekPos, nil
+}
+func (f *vfsgen۰CompressedFile) Close() error {
+	return f.gr.Close()
 }
 
 // vfsgen۰DirInfo is a static definition of a directory.
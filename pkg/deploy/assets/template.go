@@ -0,0 +1,61 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assets
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"text/template"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+)
+
+// templateFuncs are the functions which can be used in script templates
+var templateFuncs = template.FuncMap{
+	// quote makes a value a single shell word, values from users should always be quoted
+	"quote": deploy.ShellQuote,
+}
+
+// RenderScript renders the script template at path in Assets with data,
+// missing keys of data are treated as errors.
+func RenderScript(path string, data interface{}) ([]byte, error) {
+	file, err := Assets.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open script template: %v, error: %v", path, err)
+	}
+	defer file.Close()
+
+	content, err := ioutil.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read script template: %v, error: %v", path, err)
+	}
+
+	return renderScript(path, string(content), data)
+}
+
+func renderScript(name, content string, data interface{}) ([]byte, error) {
+	tmpl, err := template.New(name).Funcs(templateFuncs).Option("missingkey=error").Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse script template: %v, error: %v", name, err)
+	}
+
+	var buffer bytes.Buffer
+	if err := tmpl.Execute(&buffer, data); err != nil {
+		return nil, fmt.Errorf("failed to render script template: %v, error: %v", name, err)
+	}
+
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderScript(t *testing.T) {
+	tests := []struct {
+		content string
+		data    interface{}
+		want    string
+		wantErr bool
+	}{
+		{
+			content: "setup.sh -n {{ quote .Address }} -i {{ quote .Interface }}",
+			data: map[string]string{
+				"Address":   "192.168.1.100",
+				"Interface": "eth0; rm -rf /",
+			},
+			want: "setup.sh -n '192.168.1.100' -i 'eth0; rm -rf /'",
+		},
+		{
+			content: "echo {{ .Missing }}",
+			data:    map[string]string{},
+			wantErr: true,
+		},
+		{
+			content: "echo {{ .Broken",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		got, err := renderScript("test.sh", test.content, test.data)
+		if test.wantErr {
+			assert.Error(t, err)
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, test.want, string(got))
+	}
+}
+
+func TestRenderScriptNotFound(t *testing.T) {
+	_, err := RenderScript("/scripts/not_found.sh", nil)
+	assert.Error(t, err)
+}
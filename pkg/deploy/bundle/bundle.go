@@ -0,0 +1,246 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bundle ships the embedded scripts to nodes as a versioned directory, so that
+// scripts can source their sibling libraries and stale copies are never reused.
+package bundle
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	"github.com/kpaas-io/kpaas/pkg/deploy/assets"
+	"github.com/kpaas-io/kpaas/pkg/deploy/command"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+)
+
+const (
+	scriptsDir = "/scripts"
+	// versionFile is written after all scripts are uploaded, a bundle without it is incomplete
+	versionFile   = ".version"
+	versionLength = 16
+)
+
+// RemoteRoot is the directory on nodes which bundles are put in
+var RemoteRoot = "/var/lib/kpaas/scripts"
+
+// Bundle is the scripts in assets
+type Bundle struct {
+	// Version is the content hash of all scripts
+	Version string
+	files   map[string][]byte
+}
+
+var (
+	defaultBundle    *Bundle
+	defaultBundleErr error
+	loadOnce         sync.Once
+
+	nodeLocksLock sync.Mutex
+	// nodeLocks serializes the uploads of the bundle to the same node
+	nodeLocks = make(map[string]*sync.Mutex)
+)
+
+// Get returns the bundle of the embedded scripts
+func Get() (*Bundle, error) {
+	loadOnce.Do(func() {
+		defaultBundle, defaultBundleErr = Load(assets.Assets, scriptsDir)
+	})
+
+	return defaultBundle, defaultBundleErr
+}
+
+// Load reads all files under dir of fs as a bundle
+func Load(fs http.FileSystem, dir string) (*Bundle, error) {
+	b := &Bundle{
+		files: make(map[string][]byte),
+	}
+
+	if err := b.load(fs, dir, ""); err != nil {
+		return nil, err
+	}
+
+	paths := b.Files()
+	hash := sha256.New()
+	for _, p := range paths {
+		// the length prefixes make the hash unambiguous
+		fmt.Fprintf(hash, "%d:%s%d:", len(p), p, len(b.files[p]))
+		hash.Write(b.files[p])
+	}
+	b.Version = hex.EncodeToString(hash.Sum(nil))[:versionLength]
+
+	return b, nil
+}
+
+func (b *Bundle) load(fs http.FileSystem, dir, relativeDir string) error {
+	file, err := fs.Open(dir)
+	if err != nil {
+		return fmt.Errorf("failed to open %v, error: %v", dir, err)
+	}
+	defer file.Close()
+
+	infos, err := file.Readdir(0)
+	if err != nil {
+		return fmt.Errorf("failed to read directory %v, error: %v", dir, err)
+	}
+
+	for _, info := range infos {
+		filePath := path.Join(dir, info.Name())
+		relativePath := path.Join(relativeDir, info.Name())
+
+		if info.IsDir() {
+			if err := b.load(fs, filePath, relativePath); err != nil {
+				return err
+			}
+			continue
+		}
+
+		f, err := fs.Open(filePath)
+		if err != nil {
+			return fmt.Errorf("failed to open %v, error: %v", filePath, err)
+		}
+		content, err := ioutil.ReadAll(f)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("failed to read %v, error: %v", filePath, err)
+		}
+
+		b.files[relativePath] = content
+	}
+
+	return nil
+}
+
+// Files returns the relative paths of all files in the bundle in order
+func (b *Bundle) Files() []string {
+	paths := make([]string, 0, len(b.files))
+	for p := range b.files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	return paths
+}
+
+// RemoteDir returns the directory of the bundle on nodes
+func (b *Bundle) RemoteDir() string {
+	return path.Join(RemoteRoot, b.Version)
+}
+
+// RemotePath returns the path of a script of the bundle on nodes
+func (b *Bundle) RemotePath(script string) string {
+	return path.Join(b.RemoteDir(), strings.TrimPrefix(script, "/"))
+}
+
+// Ensure uploads the bundle to the machine if it's not there. The version file on the node
+// is always checked, so a complete bundle is reused and a removed one is uploaded again.
+func (b *Bundle) Ensure(m machine.Machine) error {
+	lock := b.nodeLock(m)
+	lock.Lock()
+	defer lock.Unlock()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"node":    m.GetName(),
+		"version": b.Version,
+	})
+
+	versionPath := path.Join(b.RemoteDir(), versionFile)
+	_, stdout, err := m.Run("cat " + deploy.ShellQuote(versionPath))
+	if err == nil && strings.TrimSpace(string(stdout)) == b.Version {
+		logger.Debug("script bundle exists")
+		return nil
+	}
+
+	logger.Debug("Start to upload script bundle")
+
+	for _, p := range b.Files() {
+		if err := m.PutFile(bytes.NewReader(b.files[p]), b.RemotePath(p), &machine.FileOptions{Mode: 0755}); err != nil {
+			return fmt.Errorf("failed to upload script %v to %v, error: %v", p, m.GetName(), err)
+		}
+	}
+
+	if err := m.PutFile(strings.NewReader(b.Version+"\n"), versionPath, nil); err != nil {
+		return fmt.Errorf("failed to upload script bundle version to %v, error: %v", m.GetName(), err)
+	}
+
+	logger.Debug("Finish to upload script bundle")
+	return nil
+}
+
+func (b *Bundle) nodeLock(m machine.Machine) *sync.Mutex {
+	nodeLocksLock.Lock()
+	defer nodeLocksLock.Unlock()
+
+	node := m.GetNode()
+	key := fmt.Sprintf("%v/%v:%v/%v", node.GetName(), node.GetIp(), node.GetSsh().GetPort(), b.RemoteDir())
+	lock, ok := nodeLocks[key]
+	if !ok {
+		lock = &sync.Mutex{}
+		nodeLocks[key] = lock
+	}
+
+	return lock
+}
+
+// Command returns a command which runs script by bash inside the bundle directory,
+// so that scripts can source their libraries by relative paths.
+func (b *Bundle) Command(m machine.Machine, script string, args ...string) *command.ShellCommand {
+	return command.NewShellCommand(m, "bash", append([]string{strings.TrimPrefix(script, "/")}, args...)...).
+		WithWorkDir(b.RemoteDir())
+}
+
+// NewRenderedScriptCommand uploads the bundle to the machine if needed and returns a command running the script
+// template rendered with data, which is read from stdin by bash inside the bundle directory.
+func NewRenderedScriptCommand(m machine.Machine, template string, data interface{}) (*command.ShellCommand, error) {
+	script, err := assets.RenderScript(path.Join(scriptsDir, template), data)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := Get()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.Ensure(m); err != nil {
+		return nil, err
+	}
+
+	return command.NewShellCommand(m, "bash", "-s").WithWorkDir(b.RemoteDir()).WithStdin(string(script)), nil
+}
+
+// NewScriptCommand uploads the bundle to the machine if needed and returns a command running script in it
+func NewScriptCommand(m machine.Machine, script string, args ...string) (*command.ShellCommand, error) {
+	b, err := Get()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.Ensure(m); err != nil {
+		return nil, err
+	}
+
+	return b.Command(m, script, args...), nil
+}
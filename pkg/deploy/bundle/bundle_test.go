@@ -0,0 +1,109 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine/fake"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func newTestBundle(t *testing.T, files map[string]string) *Bundle {
+	dir, err := ioutil.TempDir("", "bundle")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for p, content := range files {
+		filePath := filepath.Join(dir, "scripts", p)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(filePath), 0755))
+		assert.NoError(t, ioutil.WriteFile(filePath, []byte(content), 0644))
+	}
+
+	b, err := Load(http.Dir(dir), "/scripts")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return b
+}
+
+func TestLoad(t *testing.T) {
+	b := newTestBundle(t, map[string]string{
+		"lib.sh":         "lib",
+		"check.sh":       ". lib.sh",
+		"sub/library.sh": "library",
+	})
+	assert.Equal(t, []string{"check.sh", "lib.sh", "sub/library.sh"}, b.Files())
+	assert.Len(t, b.Version, versionLength)
+
+	same := newTestBundle(t, map[string]string{
+		"lib.sh":         "lib",
+		"check.sh":       ". lib.sh",
+		"sub/library.sh": "library",
+	})
+	assert.Equal(t, b.Version, same.Version)
+
+	changed := newTestBundle(t, map[string]string{
+		"lib.sh":         "lib changed",
+		"check.sh":       ". lib.sh",
+		"sub/library.sh": "library",
+	})
+	assert.NotEqual(t, b.Version, changed.Version)
+}
+
+func TestEnsure(t *testing.T) {
+	b := newTestBundle(t, map[string]string{
+		"lib.sh":   "lib",
+		"check.sh": ". lib.sh",
+	})
+
+	m := fake.NewMachine(&pb.Node{Name: "ensure-node", Ip: "192.168.1.1"})
+	assert.NoError(t, b.Ensure(m))
+
+	assert.Equal(t, []string{b.RemoteDir() + "/.version", b.RemoteDir() + "/check.sh", b.RemoteDir() + "/lib.sh"}, m.Files())
+	assert.Equal(t, os.FileMode(0755), m.File(b.RemotePath("check.sh")).Mode)
+	assert.Len(t, m.Commands(), 1)
+
+	// the bundle is uploaded again if the version file is gone, e.g. the node was cleaned
+	m.SetFile(b.RemotePath("check.sh"), []byte("stale"), 0755)
+	assert.NoError(t, b.Ensure(m))
+	assert.Equal(t, ". lib.sh", string(m.File(b.RemotePath("check.sh")).Content))
+	assert.Len(t, m.Commands(), 2)
+
+	// a complete bundle on the node is reused
+	existing := fake.NewMachine(&pb.Node{Name: "existing-node", Ip: "192.168.1.2"}).
+		On("^cat ", fake.Response{Stdout: b.Version + "\n"})
+	assert.NoError(t, b.Ensure(existing))
+	assert.Empty(t, existing.Files())
+
+	cmd := b.Command(m, "check.sh", "--name", "node 1")
+	assert.Equal(t, "cd '"+b.RemoteDir()+"' && bash 'check.sh' '--name' 'node 1'", cmd.String())
+}
+
+func TestGet(t *testing.T) {
+	b, err := Get()
+	assert.NoError(t, err)
+	assert.Contains(t, b.Files(), "lib.sh")
+	assert.Contains(t, b.Files(), "init_deploy_haproxy_keepalived/setup.sh")
+}
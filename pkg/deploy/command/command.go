@@ -15,11 +15,14 @@
 package command
 
 import (
+	"bytes"
 	"fmt"
-	"io/ioutil"
+	"os"
 	"os/exec"
+	"regexp"
 	"strings"
 
+	"github.com/kpaas-io/kpaas/pkg/deploy"
 	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
 )
 
@@ -27,81 +30,187 @@ type Command interface {
 	Execute() ([]byte, []byte, error)
 }
 
-// ShellCommand is a command execute by shell
-type ShellCommand struct {
-	machine *machine.Machine
+var envNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// env is an environment variable of a command
+type env struct {
+	name  string
+	value string
+}
+
+// spec is the common part of shell commands: ordered arguments, environment variables, working directory and stdin
+type spec struct {
 	cmd     string
-	subCmd  string
-	options map[string]string
+	args    []string
+	envs    []env
+	workDir string
+	stdin   *string
+	err     error
+}
+
+func (s *spec) addArgs(args ...string) {
+	s.args = append(s.args, args...)
+}
+
+func (s *spec) addFlag(name, value string) {
+	s.args = append(s.args, name, value)
+}
+
+func (s *spec) addEnv(name, value string) {
+	if !envNamePattern.MatchString(name) && s.err == nil {
+		s.err = fmt.Errorf("invalid environment variable name: %q", name)
+	}
+	s.envs = append(s.envs, env{name: name, value: value})
+}
+
+func (s *spec) setStdin(stdin string) {
+	s.stdin = &stdin
+}
+
+// ShellCommand is a command execute by shell on a machine, all arguments are quoted
+// so that they are passed to the command as they are.
+type ShellCommand struct {
+	spec
+	machine machine.Machine
 }
 
-func NewShellCommand(machine *machine.Machine, cmd string, subCmd string, options map[string]string) *ShellCommand {
+func NewShellCommand(machine machine.Machine, cmd string, args ...string) *ShellCommand {
 	return &ShellCommand{
+		spec: spec{
+			cmd:  cmd,
+			args: args,
+		},
 		machine: machine,
-		cmd:     cmd,
-		subCmd:  subCmd,
-		options: options,
 	}
 }
 
-func (c *ShellCommand) Execute() (stderr, stdout []byte, err error) {
-	cmds := []string{
-		c.cmd,
-		c.subCmd,
+// WithArgs appends arguments to the command
+func (c *ShellCommand) WithArgs(args ...string) *ShellCommand {
+	c.addArgs(args...)
+	return c
+}
+
+// WithFlag appends a flag and its value to the command, e.g. "-n", "eth0"
+func (c *ShellCommand) WithFlag(name, value string) *ShellCommand {
+	c.addFlag(name, value)
+	return c
+}
+
+// WithEnv sets an environment variable of the command
+func (c *ShellCommand) WithEnv(name, value string) *ShellCommand {
+	c.addEnv(name, value)
+	return c
+}
+
+// WithWorkDir sets the working directory of the command
+func (c *ShellCommand) WithWorkDir(dir string) *ShellCommand {
+	c.workDir = dir
+	return c
+}
+
+// WithStdin sets the content passed to the stdin of the command
+func (c *ShellCommand) WithStdin(stdin string) *ShellCommand {
+	c.setStdin(stdin)
+	return c
+}
+
+// String returns the command line run by shell
+func (c *ShellCommand) String() string {
+	words := make([]string, 0, len(c.envs)+len(c.args)+1)
+	for _, e := range c.envs {
+		words = append(words, e.name+"="+deploy.ShellQuote(e.value))
+	}
+
+	words = append(words, c.cmd)
+	for _, arg := range c.args {
+		words = append(words, deploy.ShellQuote(arg))
+	}
+
+	cmd := strings.Join(words, " ")
+	if c.stdin != nil {
+		cmd = fmt.Sprintf("printf '%%s' %s | %s", deploy.ShellQuote(*c.stdin), cmd)
 	}
-	for k, v := range c.options {
-		cmds = append(cmds, k+" "+v)
+	if c.workDir != "" {
+		cmd = fmt.Sprintf("cd %s && %s", deploy.ShellQuote(c.workDir), cmd)
 	}
 
-	cmd := strings.Join(cmds, " ")
+	return cmd
+}
 
-	stderr, stdout, err = c.machine.Run(cmd)
+func (c *ShellCommand) Execute() (stderr, stdout []byte, err error) {
+	if c.err != nil {
+		return nil, nil, c.err
+	}
 
-	return
+	return c.machine.Run(c.String())
 }
 
+// LocalShellCommand is a command executed on the host the deploy controller runs on
 type LocalShellCommand struct {
-	cmd     string
-	subCmds []string
-	options map[string]string
+	spec
 }
 
-func NewLocalShellCommand(cmd string, subCmds []string, options map[string]string) *LocalShellCommand {
+func NewLocalShellCommand(cmd string, args ...string) *LocalShellCommand {
 	return &LocalShellCommand{
-		cmd:     cmd,
-		subCmds: subCmds,
-		options: options,
+		spec: spec{
+			cmd:  cmd,
+			args: args,
+		},
 	}
 }
 
-func (c *LocalShellCommand) Execute() (stderr, stdout []byte, err error) {
-	args := make([]string, 0)
-	args = append(args, c.subCmds...)
-	for k, v := range c.options {
-		arg := fmt.Sprintf("%v=%v", k, v)
-		args = append(args, arg)
-	}
+// WithArgs appends arguments to the command
+func (c *LocalShellCommand) WithArgs(args ...string) *LocalShellCommand {
+	c.addArgs(args...)
+	return c
+}
 
-	cmd := exec.Command(c.cmd, args...)
-	errReader, err := cmd.StderrPipe()
-	if err != nil {
-		return
-	}
+// WithFlag appends a flag and its value to the command, e.g. "-n", "eth0"
+func (c *LocalShellCommand) WithFlag(name, value string) *LocalShellCommand {
+	c.addFlag(name, value)
+	return c
+}
 
-	outReader, err := cmd.StdoutPipe()
-	if err != nil {
-		return
-	}
+// WithEnv sets an environment variable of the command
+func (c *LocalShellCommand) WithEnv(name, value string) *LocalShellCommand {
+	c.addEnv(name, value)
+	return c
+}
 
-	err = cmd.Start()
+// WithWorkDir sets the working directory of the command
+func (c *LocalShellCommand) WithWorkDir(dir string) *LocalShellCommand {
+	c.workDir = dir
+	return c
+}
+
+// WithStdin sets the content passed to the stdin of the command
+func (c *LocalShellCommand) WithStdin(stdin string) *LocalShellCommand {
+	c.setStdin(stdin)
+	return c
+}
 
-	if stderr, err = ioutil.ReadAll(errReader); err != nil {
-		return
+func (c *LocalShellCommand) Execute() (stderr, stdout []byte, err error) {
+	if c.err != nil {
+		return nil, nil, c.err
 	}
 
-	if stdout, err = ioutil.ReadAll(outReader); err != nil {
-		return
+	var errBuffer, outBuffer bytes.Buffer
+
+	cmd := exec.Command(c.cmd, c.args...)
+	cmd.Dir = c.workDir
+	cmd.Stderr = &errBuffer
+	cmd.Stdout = &outBuffer
+	if len(c.envs) > 0 {
+		cmd.Env = os.Environ()
+		for _, e := range c.envs {
+			cmd.Env = append(cmd.Env, e.name+"="+e.value)
+		}
+	}
+	if c.stdin != nil {
+		cmd.Stdin = strings.NewReader(*c.stdin)
 	}
 
-	return
+	err = cmd.Run()
+
+	return errBuffer.Bytes(), outBuffer.Bytes(), err
 }
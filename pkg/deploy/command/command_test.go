@@ -0,0 +1,82 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine/fake"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestShellCommandString(t *testing.T) {
+	tests := []struct {
+		cmd  *ShellCommand
+		want string
+	}{
+		{
+			cmd:  NewShellCommand(nil, "bash", "/tmp/scripts/check.sh"),
+			want: "bash '/tmp/scripts/check.sh'",
+		},
+		{
+			cmd: NewShellCommand(nil, "bash", "setup.sh").
+				WithFlag("-n", "192.168.1.100").
+				WithFlag("-i", "eth0; reboot").
+				WithArgs("keepalived", "run"),
+			want: "bash 'setup.sh' '-n' '192.168.1.100' '-i' 'eth0; reboot' 'keepalived' 'run'",
+		},
+		{
+			cmd: NewShellCommand(nil, "bash", "my script.sh").
+				WithEnv("LANG", "C").
+				WithEnv("NAME", "it's").
+				WithWorkDir("/tmp/work dir"),
+			want: `cd '/tmp/work dir' && LANG='C' NAME='it'"'"'s' bash 'my script.sh'`,
+		},
+		{
+			cmd:  NewShellCommand(nil, "cat").WithStdin("a b\n"),
+			want: "printf '%s' 'a b\n' | cat",
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.want, test.cmd.String())
+	}
+}
+
+func TestShellCommandExecute(t *testing.T) {
+	m := fake.NewMachine(&pb.Node{Name: "node1"}).On("^bash", fake.Response{Stdout: "ok"})
+
+	_, stdout, err := NewShellCommand(m, "bash", "check.sh").Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", string(stdout))
+	assert.Equal(t, []string{"bash 'check.sh'"}, m.Commands())
+
+	_, _, err = NewShellCommand(m, "bash").WithEnv("INVALID-NAME", "value").Execute()
+	assert.Error(t, err)
+	assert.Len(t, m.Commands(), 1)
+}
+
+func TestLocalShellCommandExecute(t *testing.T) {
+	stderr, stdout, err := NewLocalShellCommand("sh", "-c", `cat; echo "$NAME"; pwd; echo error >&2`).
+		WithEnv("NAME", "kpaas").
+		WithWorkDir("/").
+		WithStdin("input\n").
+		Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, "input\nkpaas\n/\n", string(stdout))
+	assert.Equal(t, "error\n", string(stderr))
+}
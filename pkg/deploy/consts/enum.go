@@ -22,3 +22,22 @@ const (
 	NodeRoleWorker  NodeRole = "worker"
 	NodeRoleIngress NodeRole = "ingress"
 )
+
+type ContainerRuntime string
+
+const (
+	ContainerRuntimeDocker     ContainerRuntime = "docker"
+	ContainerRuntimeContainerd ContainerRuntime = "containerd"
+)
+
+// KubeAPIServerConnectType is how the nodes and clients connect to the apiservers
+type KubeAPIServerConnectType string
+
+const (
+	// KubeAPIServerConnectTypeFirstMasterIP connects to the apiserver of the first master directly
+	KubeAPIServerConnectTypeFirstMasterIP KubeAPIServerConnectType = "firstMasterIP"
+	// KubeAPIServerConnectTypeKeepalived connects to the apiservers by the haproxy on the masters through the keepalived vip
+	KubeAPIServerConnectTypeKeepalived KubeAPIServerConnectType = "keepalived"
+	// KubeAPIServerConnectTypeLoadbalancer connects to the apiservers through an external load balancer
+	KubeAPIServerConnectTypeLoadbalancer KubeAPIServerConnectType = "loadbalancer"
+)
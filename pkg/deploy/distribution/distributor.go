@@ -0,0 +1,459 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package distribution
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	DefaultCacheDir              = "/var/lib/kpaas/cache"
+	defaultFanout                = 2
+	defaultControllerConcurrency = 1
+
+	// SourceCache means the node already had the artifact in its cache
+	SourceCache = "cache"
+	// SourceController means the artifact was pushed by the controller
+	SourceController = "controller"
+)
+
+// newMachine creates the machines of the nodes, it's replaced by fake machines in tests
+var newMachine = machine.NewMachine
+
+// Config is the configuration of a Distributor
+type Config struct {
+	Nodes []*pb.Node
+	// GroupBy groups the nodes by rack or subnet, nodes are grouped by /24 subnet if it's nil
+	GroupBy GroupFunc
+	// Fanout is the number of peers a seeded node relays the artifact to in a round
+	Fanout int
+	// ControllerConcurrency is the number of nodes the controller pushes the artifact to at the same time
+	ControllerConcurrency int
+	// ControllerBandwidth limits the bytes per second of every controller-to-node link, 0 means unlimited
+	ControllerBandwidth int64
+	// RelayBandwidth limits the bytes per second of every node-to-node link, 0 means unlimited
+	RelayBandwidth int64
+	// CacheDir is the content-addressed cache on every node, DefaultCacheDir is used if it's empty
+	CacheDir string
+}
+
+// Artifact is a local file to be distributed to RemotePath of all nodes
+type Artifact struct {
+	LocalPath  string
+	RemotePath string
+	Mode       os.FileMode
+}
+
+// NodeResult is the distribution result of a node
+type NodeResult struct {
+	NodeName string
+	// Source is where the node got the artifact from: SourceCache, SourceController or a peer's name
+	Source string
+	Err    error
+}
+
+// Result is the distribution result of all nodes
+type Result struct {
+	Nodes []*NodeResult
+}
+
+// Failed returns the results of failed nodes
+func (r *Result) Failed() []*NodeResult {
+	var failed []*NodeResult
+	for _, node := range r.Nodes {
+		if node.Err != nil {
+			failed = append(failed, node)
+		}
+	}
+	return failed
+}
+
+// Distributor pushes artifacts to many nodes: the controller pushes an artifact once per group,
+// then seeded nodes relay it to their peers in the same group over ssh.
+type Distributor struct {
+	config       Config
+	controllerCh chan struct{}
+}
+
+// NewDistributor returns a distributor based on the config.
+func NewDistributor(cfg *Config) (*Distributor, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("distribution config is nil")
+	}
+	if len(cfg.Nodes) == 0 {
+		return nil, fmt.Errorf("invalid distribution config: nodes is empty")
+	}
+
+	config := *cfg
+	if config.GroupBy == nil {
+		config.GroupBy = GroupBySubnet(defaultSubnetPrefixLength)
+	}
+	if config.Fanout <= 0 {
+		config.Fanout = defaultFanout
+	}
+	if config.ControllerConcurrency <= 0 {
+		config.ControllerConcurrency = defaultControllerConcurrency
+	}
+	if config.CacheDir == "" {
+		config.CacheDir = DefaultCacheDir
+	}
+
+	return &Distributor{
+		config:       config,
+		controllerCh: make(chan struct{}, config.ControllerConcurrency),
+	}, nil
+}
+
+// node is the distribution state of a node
+type node struct {
+	*pb.Node
+	machine machine.Machine
+
+	lock          sync.Mutex
+	keyPut        bool
+	keyAuthorized bool
+
+	result *NodeResult
+}
+
+// distribution is a running distribution of an artifact
+type distribution struct {
+	*Distributor
+	artifact *Artifact
+	checksum string
+	key      *relayKey
+	nodes    map[string]*node
+}
+
+// Distribute pushes the artifact to all nodes, errors of single nodes are reported in the result.
+func (d *Distributor) Distribute(artifact *Artifact) (*Result, error) {
+	if artifact == nil || artifact.LocalPath == "" || artifact.RemotePath == "" {
+		return nil, fmt.Errorf("invalid artifact: %+v", artifact)
+	}
+
+	checksum, err := fileChecksum(artifact.LocalPath)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := newRelayKey()
+	if err != nil {
+		return nil, err
+	}
+
+	dist := &distribution{
+		Distributor: d,
+		artifact:    artifact,
+		checksum:    checksum,
+		key:         key,
+		nodes:       make(map[string]*node),
+	}
+
+	logger := logrus.WithField("artifact", artifact.LocalPath)
+	logger.Debugf("Start to distribute artifact to %d nodes, checksum: %v", len(d.config.Nodes), checksum)
+
+	dist.connect()
+	defer dist.close()
+
+	var wg sync.WaitGroup
+	for _, group := range groupNodes(d.config.Nodes, d.config.GroupBy) {
+		wg.Add(1)
+		go func(group []*pb.Node) {
+			defer wg.Done()
+			dist.distributeGroup(group)
+		}(group)
+	}
+	wg.Wait()
+
+	dist.place()
+
+	result := &Result{}
+	for _, n := range d.config.Nodes {
+		result.Nodes = append(result.Nodes, dist.nodes[n.GetName()].result)
+	}
+
+	logger.Debugf("Finish to distribute artifact: %d nodes failed", len(result.Failed()))
+	return result, nil
+}
+
+// connect creates machines of all nodes and checks their caches
+func (d *distribution) connect() {
+	for _, n := range d.config.Nodes {
+		d.nodes[n.GetName()] = &node{
+			Node:   n,
+			result: &NodeResult{NodeName: n.GetName()},
+		}
+	}
+
+	d.forEach(d.config.Nodes, func(n *node) {
+		m, err := newMachine(n.Node)
+		if err != nil {
+			n.result.Err = err
+			return
+		}
+		n.machine = m
+
+		if checksum, err := m.Checksum(d.cachePath()); err == nil && checksum == d.checksum {
+			logrus.Debugf("artifact %v is already cached on %v", d.artifact.LocalPath, n.GetName())
+			n.result.Source = SourceCache
+		}
+	})
+}
+
+// close removes the relay key from all nodes and closes the machines
+func (d *distribution) close() {
+	d.forEach(d.config.Nodes, func(n *node) {
+		if n.machine == nil {
+			return
+		}
+
+		if n.keyAuthorized {
+			if stderr, _, err := n.machine.Run(d.key.unauthorizeCommand()); err != nil || len(stderr) != 0 {
+				logrus.Warnf("failed to remove relay key from %v, error: %v, stderr: %s", n.GetName(), err, stderr)
+			}
+		}
+
+		if n.keyPut {
+			if stderr, _, err := n.machine.Run("rm -f " + deploy.ShellQuote(d.key.privateKeyPath(d.config.CacheDir))); err != nil || len(stderr) != 0 {
+				logrus.Warnf("failed to remove relay private key from %v, error: %v, stderr: %s", n.GetName(), err, stderr)
+			}
+		}
+
+		n.machine.Close()
+	})
+}
+
+func (d *distribution) distributeGroup(group []*pb.Node) {
+	var seeded, pending []string
+	for _, n := range group {
+		state := d.nodes[n.GetName()]
+		switch {
+		case state.result.Err != nil:
+			// the node can't be connected
+		case state.result.Source == SourceCache:
+			seeded = append(seeded, n.GetName())
+		default:
+			pending = append(pending, n.GetName())
+		}
+	}
+
+	// push the artifact from the controller if no node in the group has it
+	for len(seeded) == 0 && len(pending) > 0 {
+		name := pending[0]
+		pending = pending[1:]
+		if d.pushFromController(d.nodes[name]) {
+			seeded = append(seeded, name)
+		}
+	}
+
+	for len(pending) > 0 {
+		relays := planRelays(seeded, pending, d.config.Fanout)
+		pending = pending[len(relays):]
+
+		var lock sync.Mutex
+		var wg sync.WaitGroup
+		for _, r := range relays {
+			wg.Add(1)
+			go func(r relay) {
+				defer wg.Done()
+
+				from, to := d.nodes[r.from], d.nodes[r.to]
+				err := d.relay(from, to)
+				if err != nil {
+					logrus.Warnf("failed to relay artifact from %v to %v, push it from controller instead, error: %v", r.from, r.to, err)
+					if !d.pushFromController(to) {
+						return
+					}
+				} else {
+					to.result.Source = from.GetName()
+				}
+
+				lock.Lock()
+				seeded = append(seeded, r.to)
+				lock.Unlock()
+			}(r)
+		}
+		wg.Wait()
+	}
+}
+
+func (d *distribution) pushFromController(n *node) bool {
+	d.controllerCh <- struct{}{}
+	defer func() { <-d.controllerCh }()
+
+	file, err := os.Open(d.artifact.LocalPath)
+	if err != nil {
+		n.result.Err = fmt.Errorf("open %v failed, error: %v", d.artifact.LocalPath, err)
+		return false
+	}
+	defer file.Close()
+
+	logger := logrus.WithField("artifact", d.artifact.LocalPath)
+	err = n.machine.PutFile(file, d.cachePath(), &machine.FileOptions{
+		BandwidthLimit: d.config.ControllerBandwidth,
+		Progress: func(transferred, total int64) {
+			logger.Tracef("pushed %d/%d bytes to %v", transferred, total, n.GetName())
+		},
+	})
+	if err != nil {
+		n.result.Err = fmt.Errorf("failed to push artifact to %v, error: %v", n.GetName(), err)
+		return false
+	}
+
+	n.result.Source = SourceController
+	return true
+}
+
+// relay copies the artifact from the cache of a seeded node to the cache of its peer
+func (d *distribution) relay(from, to *node) error {
+	if err := d.putKey(from); err != nil {
+		return err
+	}
+	if err := d.authorizeKey(to); err != nil {
+		return err
+	}
+
+	tempPath := d.cachePath() + "." + d.key.id
+	if _, _, err := to.machine.Run("mkdir -p " + deploy.ShellQuote(path.Dir(tempPath))); err != nil {
+		return err
+	}
+
+	cmd := relayCommand(d.key.privateKeyPath(d.config.CacheDir), d.cachePath(), to.Ssh.GetAuth().GetUsername(), to.GetIp(), to.Ssh.GetPort(), tempPath, d.config.RelayBandwidth)
+	stderr, _, err := from.machine.Run(cmd)
+	if err != nil {
+		return err
+	}
+
+	checksum, err := to.machine.Checksum(tempPath)
+	if err != nil || checksum != d.checksum {
+		to.machine.Run("rm -f " + deploy.ShellQuote(tempPath))
+		return fmt.Errorf("artifact on %v mismatched after relay, error: %v, stderr: %s", to.GetName(), err, stderr)
+	}
+
+	if stderr, _, err := to.machine.Run(fmt.Sprintf("mv -f %s %s", deploy.ShellQuote(tempPath), deploy.ShellQuote(d.cachePath()))); err != nil || len(stderr) != 0 {
+		return fmt.Errorf("failed to move artifact into cache on %v, error: %v, stderr: %s", to.GetName(), err, stderr)
+	}
+
+	return nil
+}
+
+func (d *distribution) putKey(n *node) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	if n.keyPut {
+		return nil
+	}
+
+	if err := n.machine.PutFile(bytes.NewReader(d.key.privateKey), d.key.privateKeyPath(d.config.CacheDir), &machine.FileOptions{Mode: 0600}); err != nil {
+		return fmt.Errorf("failed to put relay key to %v, error: %v", n.GetName(), err)
+	}
+
+	n.keyPut = true
+	return nil
+}
+
+func (d *distribution) authorizeKey(n *node) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	if n.keyAuthorized {
+		return nil
+	}
+
+	// mark it first so that a partially written key will be removed too
+	n.keyAuthorized = true
+	if stderr, _, err := n.machine.Run(d.key.authorizeCommand()); err != nil || len(stderr) != 0 {
+		return fmt.Errorf("failed to authorize relay key on %v, error: %v, stderr: %s", n.GetName(), err, stderr)
+	}
+
+	return nil
+}
+
+// place copies the artifact from the cache to its remote path on all seeded nodes
+func (d *distribution) place() {
+	d.forEach(d.config.Nodes, func(n *node) {
+		if n.result.Err != nil || n.result.Source == "" {
+			return
+		}
+
+		mode := d.artifact.Mode
+		if mode == 0 {
+			mode = 0644
+		}
+
+		remotePath := d.artifact.RemotePath
+		tempPath := remotePath + "." + d.key.id
+		cmd := fmt.Sprintf("mkdir -p %s && cp -f %s %s && chmod %o %s && mv -f %s %s",
+			deploy.ShellQuote(path.Dir(remotePath)),
+			deploy.ShellQuote(d.cachePath()), deploy.ShellQuote(tempPath),
+			mode.Perm(), deploy.ShellQuote(tempPath),
+			deploy.ShellQuote(tempPath), deploy.ShellQuote(remotePath))
+		if _, _, err := n.machine.Run(cmd); err != nil {
+			n.result.Err = fmt.Errorf("failed to copy artifact to %v on %v, error: %v", remotePath, n.GetName(), err)
+			return
+		}
+
+		checksum, err := n.machine.Checksum(remotePath)
+		if err != nil || checksum != d.checksum {
+			n.result.Err = fmt.Errorf("artifact mismatched at %v on %v, error: %v", remotePath, n.GetName(), err)
+		}
+	})
+}
+
+// forEach calls fn for every node concurrently
+func (d *distribution) forEach(nodes []*pb.Node, fn func(n *node)) {
+	var wg sync.WaitGroup
+	for _, n := range nodes {
+		wg.Add(1)
+		go func(n *node) {
+			defer wg.Done()
+			fn(n)
+		}(d.nodes[n.GetName()])
+	}
+	wg.Wait()
+}
+
+func (d *distribution) cachePath() string {
+	return path.Join(d.config.CacheDir, "sha256", d.checksum)
+}
+
+func fileChecksum(localPath string) (string, error) {
+	file, err := os.Open(localPath)
+	if err != nil {
+		return "", fmt.Errorf("open %v failed, error: %v", localPath, err)
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("failed to calculate checksum of %v, error: %v", localPath, err)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
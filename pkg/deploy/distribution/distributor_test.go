@@ -0,0 +1,321 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package distribution
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine/fake"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+var relayCommentPattern = regexp.MustCompile(relayKeyComment + `-[0-9a-f]+`)
+
+// testCluster is a set of fake nodes which reach each other by their ips
+type testCluster struct {
+	machines map[string]*testMachine
+}
+
+func newTestCluster(nodes ...*pb.Node) *testCluster {
+	c := &testCluster{machines: make(map[string]*testMachine)}
+	for _, node := range nodes {
+		c.machines[node.GetIp()] = &testMachine{Machine: fake.NewMachine(node), cluster: c}
+	}
+	return c
+}
+
+func (c *testCluster) newMachine(node *pb.Node) (machine.Machine, error) {
+	m, ok := c.machines[node.GetIp()]
+	if !ok {
+		return nil, fmt.Errorf("failed to connect %v", node.GetIp())
+	}
+	return m, nil
+}
+
+// testMachine is a fake machine which emulates the commands run by the distributor:
+// the relay key in authorized_keys, scp to the peers, and copying and moving files.
+type testMachine struct {
+	*fake.Machine
+	cluster *testCluster
+
+	lock           sync.Mutex
+	authorizedKeys []string
+	// putLimits are the bandwidth limits of the files put by the controller
+	putLimits map[string]int64
+	// relayLimits are the bandwidth limits in Kbit/s of the scp run on the machine
+	relayLimits []int
+}
+
+func (m *testMachine) PutFile(content io.Reader, remotePath string, opts *machine.FileOptions) error {
+	m.lock.Lock()
+	if m.putLimits == nil {
+		m.putLimits = make(map[string]int64)
+	}
+	m.putLimits[remotePath] = opts.BandwidthLimit
+	m.lock.Unlock()
+
+	return m.Machine.PutFile(content, remotePath, opts)
+}
+
+func (m *testMachine) Run(cmd string) (stderr, stdout []byte, err error) {
+	if stderr, stdout, err = m.Machine.Run(cmd); err != nil || len(stderr) != 0 {
+		return
+	}
+
+	for _, segment := range strings.Split(cmd, " && ") {
+		args := strings.Fields(segment)
+		for i := range args {
+			args[i] = strings.Trim(args[i], "'")
+		}
+
+		switch args[0] {
+		case "echo":
+			m.lock.Lock()
+			m.authorizedKeys = append(m.authorizedKeys, strings.Trim(segment[len("echo "):strings.Index(segment, " >> ")], "'"))
+			m.lock.Unlock()
+		case "sed":
+			comment := relayCommentPattern.FindString(segment)
+			m.lock.Lock()
+			var keys []string
+			for _, key := range m.authorizedKeys {
+				if !strings.HasSuffix(key, " "+comment) {
+					keys = append(keys, key)
+				}
+			}
+			m.authorizedKeys = keys
+			m.lock.Unlock()
+		case "cp", "mv":
+			m.copyFile(m, args[2], args[3])
+		case "scp":
+			if err := m.scp(args); err != nil {
+				return []byte(err.Error()), nil, err
+			}
+		}
+	}
+
+	return nil, nil, nil
+}
+
+func (m *testMachine) scp(args []string) error {
+	for i, arg := range args {
+		if arg == "-l" {
+			limit, _ := strconv.Atoi(args[i+1])
+			m.lock.Lock()
+			m.relayLimits = append(m.relayLimits, limit)
+			m.lock.Unlock()
+		}
+	}
+
+	// the destination is <user>@<host>:<path>
+	destination := strings.SplitN(strings.SplitN(args[len(args)-1], "@", 2)[1], ":", 2)
+	peer, ok := m.cluster.machines[destination[0]]
+	if !ok {
+		return fmt.Errorf("failed to connect %v", destination[0])
+	}
+	if len(peer.AuthorizedKeys()) == 0 {
+		return fmt.Errorf("permission denied by %v", destination[0])
+	}
+
+	return m.copyFile(peer, args[len(args)-2], destination[1])
+}
+
+func (m *testMachine) copyFile(to *testMachine, src, dst string) error {
+	file := m.File(src)
+	if file == nil {
+		return fmt.Errorf("%v does not exist", src)
+	}
+	to.SetFile(dst, file.Content, file.Mode)
+	return nil
+}
+
+func (m *testMachine) AuthorizedKeys() []string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return append([]string(nil), m.authorizedKeys...)
+}
+
+func newTestNode(name, ip string) *pb.Node {
+	return &pb.Node{
+		Name: name,
+		Ip:   ip,
+		Ssh: &pb.SSH{
+			Port: 22,
+			Auth: &pb.Auth{Username: "root"},
+		},
+	}
+}
+
+func newTestArtifact(t *testing.T, content string) (*Artifact, string) {
+	file, err := ioutil.TempFile("", "artifact")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	if _, err := file.WriteString(content); err != nil {
+		t.Fatal(err)
+	}
+
+	hash := sha256.Sum256([]byte(content))
+	artifact := &Artifact{
+		LocalPath:  file.Name(),
+		RemotePath: "/opt/images/" + filepath.Base(file.Name()),
+	}
+	return artifact, hex.EncodeToString(hash[:])
+}
+
+func TestDistribute(t *testing.T) {
+	artifact, checksum := newTestArtifact(t, "artifact")
+	defer os.Remove(artifact.LocalPath)
+
+	nodes := []*pb.Node{
+		newTestNode("a1", "10.0.1.1"),
+		newTestNode("a2", "10.0.1.2"),
+		newTestNode("a3", "10.0.1.3"),
+		newTestNode("b1", "10.0.2.1"),
+		newTestNode("b2", "10.0.2.2"),
+	}
+	cluster := newTestCluster(nodes...)
+	defer func(original func(*pb.Node) (machine.Machine, error)) { newMachine = original }(newMachine)
+	newMachine = cluster.newMachine
+
+	// a1 has the artifact in its cache already
+	cachePath := "/cache/sha256/" + checksum
+	cluster.machines["10.0.1.1"].SetFile(cachePath, []byte("artifact"), 0644)
+
+	distributor, err := NewDistributor(&Config{
+		Nodes:               nodes,
+		ControllerBandwidth: 1000000,
+		RelayBandwidth:      125000,
+		CacheDir:            "/cache",
+	})
+	assert.NoError(t, err)
+
+	result, err := distributor.Distribute(artifact)
+	assert.NoError(t, err)
+	assert.Empty(t, result.Failed())
+
+	sources := make(map[string]string)
+	for _, node := range result.Nodes {
+		sources[node.NodeName] = node.Source
+	}
+	assert.Equal(t, map[string]string{
+		"a1": SourceCache,
+		"a2": "a1",
+		"a3": "a1",
+		"b1": SourceController,
+		"b2": "b1",
+	}, sources)
+
+	for _, node := range nodes {
+		m := cluster.machines[node.GetIp()]
+		if assert.NotNil(t, m.File(artifact.RemotePath), node.GetName()) {
+			assert.Equal(t, "artifact", string(m.File(artifact.RemotePath).Content))
+		}
+		assert.Empty(t, m.AuthorizedKeys(), node.GetName())
+		assert.True(t, m.Closed())
+	}
+
+	// the controller pushes the artifact once for the group without the cache
+	for _, m := range cluster.machines {
+		limit, pushed := m.putLimits[cachePath]
+		assert.Equal(t, m.GetName() == "b1", pushed, m.GetName())
+		if pushed {
+			assert.Equal(t, int64(1000000), limit)
+		}
+	}
+
+	// the seeded nodes relay the artifact with the bandwidth limit, and their relay keys are removed
+	assert.Equal(t, []int{1000, 1000}, cluster.machines["10.0.1.1"].relayLimits)
+	assert.Equal(t, []int{1000}, cluster.machines["10.0.2.1"].relayLimits)
+	for _, ip := range []string{"10.0.1.1", "10.0.2.1"} {
+		assert.Contains(t, strings.Join(cluster.machines[ip].Commands(), "\n"), "rm -f '/cache/."+relayKeyComment+"-")
+	}
+}
+
+func TestDistributeFailure(t *testing.T) {
+	artifact, _ := newTestArtifact(t, "artifact")
+	defer os.Remove(artifact.LocalPath)
+
+	nodes := []*pb.Node{
+		newTestNode("a1", "10.0.1.1"),
+		newTestNode("a2", "10.0.1.2"),
+		newTestNode("a3", "10.0.1.3"),
+		newTestNode("b1", "10.0.2.1"),
+	}
+	// b1 can't be connected
+	cluster := newTestCluster(nodes[:3]...)
+	defer func(original func(*pb.Node) (machine.Machine, error)) { newMachine = original }(newMachine)
+	newMachine = cluster.newMachine
+
+	// the relay to a3 fails after the relay key is authorized on a3
+	cluster.machines["10.0.1.1"].On(`^scp .*@10\.0\.1\.3:`, fake.Response{Err: errors.New("connection reset")})
+
+	distributor, err := NewDistributor(&Config{Nodes: nodes, CacheDir: "/cache"})
+	assert.NoError(t, err)
+
+	result, err := distributor.Distribute(artifact)
+	assert.NoError(t, err)
+
+	failed := result.Failed()
+	if assert.Len(t, failed, 1) {
+		assert.Equal(t, "b1", failed[0].NodeName)
+	}
+
+	sources := make(map[string]string)
+	for _, node := range result.Nodes {
+		sources[node.NodeName] = node.Source
+	}
+	// a3 gets the artifact from the controller instead
+	assert.Equal(t, map[string]string{
+		"a1": SourceController,
+		"a2": "a1",
+		"a3": SourceController,
+		"b1": "",
+	}, sources)
+
+	for _, m := range cluster.machines {
+		assert.Equal(t, "artifact", string(m.File(artifact.RemotePath).Content), m.GetName())
+		assert.Empty(t, m.AuthorizedKeys(), m.GetName())
+	}
+	assert.Contains(t, strings.Join(cluster.machines["10.0.1.3"].Commands(), "\n"), "sed -i")
+}
+
+func TestDistributeInvalidArtifact(t *testing.T) {
+	distributor, err := NewDistributor(&Config{Nodes: []*pb.Node{newTestNode("a1", "10.0.1.1")}})
+	assert.NoError(t, err)
+
+	_, err = distributor.Distribute(&Artifact{LocalPath: "/not/exist", RemotePath: "/opt/artifact"})
+	assert.Error(t, err)
+
+	_, err = distributor.Distribute(&Artifact{LocalPath: "/not/exist"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,97 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package distribution
+
+import (
+	"net"
+	"sort"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	defaultSubnetPrefixLength = 24
+)
+
+// GroupFunc returns the group (e.g. rack or subnet) of a node, the artifact is pushed
+// from the controller once per group and relayed among the nodes in the same group.
+type GroupFunc func(node *pb.Node) string
+
+// GroupBySubnet groups nodes by the IPv4 subnet of the given prefix length.
+func GroupBySubnet(prefixLength int) GroupFunc {
+	return func(node *pb.Node) string {
+		ip := net.ParseIP(node.GetIp()).To4()
+		if ip == nil {
+			return node.GetIp()
+		}
+
+		subnet := net.IPNet{
+			IP:   ip.Mask(net.CIDRMask(prefixLength, 32)),
+			Mask: net.CIDRMask(prefixLength, 32),
+		}
+		return subnet.String()
+	}
+}
+
+// groupNodes groups the nodes with groupBy, the returned groups and the nodes in them keep the input order
+func groupNodes(nodes []*pb.Node, groupBy GroupFunc) [][]*pb.Node {
+	index := make(map[string]int)
+	var groups [][]*pb.Node
+
+	for _, node := range nodes {
+		key := groupBy(node)
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], node)
+	}
+
+	return groups
+}
+
+// relay is a transfer of the artifact from a seeded node to a peer
+type relay struct {
+	from string
+	to   string
+}
+
+// planRelays returns the relays of the next round: every seeded node serves at most fanout peers,
+// so the number of seeded nodes grows by a factor of (fanout + 1) every round.
+func planRelays(seeded, pending []string, fanout int) []relay {
+	if fanout <= 0 {
+		fanout = 1
+	}
+
+	seeds := make([]string, len(seeded))
+	copy(seeds, seeded)
+	sort.Strings(seeds)
+
+	var relays []relay
+	next := 0
+	for round := 0; round < fanout; round++ {
+		for _, seed := range seeds {
+			if next >= len(pending) {
+				return relays
+			}
+			relays = append(relays, relay{from: seed, to: pending[next]})
+			next++
+		}
+	}
+
+	return relays
+}
@@ -0,0 +1,103 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package distribution
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestGroupBySubnet(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want string
+	}{
+		{
+			ip:   "192.168.1.10",
+			want: "192.168.1.0/24",
+		},
+		{
+			ip:   "192.168.2.10",
+			want: "192.168.2.0/24",
+		},
+		{
+			ip:   "not an ip",
+			want: "not an ip",
+		},
+	}
+
+	groupBy := GroupBySubnet(24)
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, groupBy(&pb.Node{Ip: tt.ip}))
+	}
+}
+
+func TestGroupNodes(t *testing.T) {
+	nodes := []*pb.Node{
+		{Name: "a1", Ip: "10.0.1.1"},
+		{Name: "b1", Ip: "10.0.2.1"},
+		{Name: "a2", Ip: "10.0.1.2"},
+		{Name: "c1", Ip: "10.0.3.1"},
+		{Name: "b2", Ip: "10.0.2.2"},
+	}
+
+	groups := groupNodes(nodes, GroupBySubnet(24))
+	assert.Equal(t, [][]*pb.Node{
+		{nodes[0], nodes[2]},
+		{nodes[1], nodes[4]},
+		{nodes[3]},
+	}, groups)
+}
+
+func TestPlanRelays(t *testing.T) {
+	tests := []struct {
+		seeded  []string
+		pending []string
+		fanout  int
+		want    []relay
+	}{
+		{
+			seeded:  []string{"n1"},
+			pending: []string{"n2", "n3", "n4"},
+			fanout:  1,
+			want:    []relay{{from: "n1", to: "n2"}},
+		},
+		{
+			seeded:  []string{"n2", "n1"},
+			pending: []string{"n3", "n4", "n5"},
+			fanout:  1,
+			want:    []relay{{from: "n1", to: "n3"}, {from: "n2", to: "n4"}},
+		},
+		{
+			seeded:  []string{"n1", "n2"},
+			pending: []string{"n3", "n4", "n5"},
+			fanout:  2,
+			want:    []relay{{from: "n1", to: "n3"}, {from: "n2", to: "n4"}, {from: "n1", to: "n5"}},
+		},
+		{
+			seeded:  []string{"n1"},
+			pending: nil,
+			fanout:  0,
+			want:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, planRelays(tt.seeded, tt.pending, tt.fanout))
+	}
+}
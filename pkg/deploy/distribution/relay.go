@@ -0,0 +1,111 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package distribution
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"path"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+)
+
+const (
+	relayKeyBits      = 2048
+	relayKeyComment   = "kpaas-relay"
+	authorizedKeyFile = "~/.ssh/authorized_keys"
+)
+
+// relayKey is an ephemeral ssh key pair which allows seeded nodes to log in their peers
+// during a distribution, it's removed from all nodes after the distribution.
+type relayKey struct {
+	id            string
+	privateKey    []byte
+	authorizedKey string
+}
+
+func newRelayKey() (*relayKey, error) {
+	idBytes := make([]byte, 8)
+	if _, err := rand.Read(idBytes); err != nil {
+		return nil, fmt.Errorf("failed to generate relay key id, error: %v", err)
+	}
+	id := hex.EncodeToString(idBytes)
+
+	key, err := rsa.GenerateKey(rand.Reader, relayKeyBits)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate relay key, error: %v", err)
+	}
+
+	publicKey, err := ssh.NewPublicKey(&key.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate relay public key, error: %v", err)
+	}
+
+	return &relayKey{
+		id: id,
+		privateKey: pem.EncodeToMemory(&pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(key),
+		}),
+		authorizedKey: fmt.Sprintf("%s %s-%s", strings.TrimSpace(string(ssh.MarshalAuthorizedKey(publicKey))), relayKeyComment, id),
+	}, nil
+}
+
+func (k *relayKey) comment() string {
+	return fmt.Sprintf("%s-%s", relayKeyComment, k.id)
+}
+
+// privateKeyPath is where the private key is put on seeded nodes
+func (k *relayKey) privateKeyPath(cacheDir string) string {
+	return path.Join(cacheDir, fmt.Sprintf(".%s.key", k.comment()))
+}
+
+func (k *relayKey) authorizeCommand() string {
+	return fmt.Sprintf("mkdir -p ~/.ssh && chmod 700 ~/.ssh && echo %s >> %s && chmod 600 %s",
+		deploy.ShellQuote(k.authorizedKey), authorizedKeyFile, authorizedKeyFile)
+}
+
+func (k *relayKey) unauthorizeCommand() string {
+	return fmt.Sprintf("test -f %s && sed -i %s %s", authorizedKeyFile, deploy.ShellQuote(fmt.Sprintf("/ %s$/d", k.comment())), authorizedKeyFile)
+}
+
+// relayCommand copies srcPath on the seeded node to dstPath on the peer with scp,
+// bandwidth is in bytes per second and 0 means unlimited.
+func relayCommand(keyPath, srcPath, user, host string, port uint32, dstPath string, bandwidth int64) string {
+	args := []string{"scp", "-q", "-B",
+		"-o", "StrictHostKeyChecking=no",
+		"-o", "UserKnownHostsFile=/dev/null",
+		"-i", deploy.ShellQuote(keyPath),
+		"-P", fmt.Sprintf("%d", port),
+	}
+	if bandwidth > 0 {
+		// scp limits the bandwidth in Kbit/s
+		kbits := bandwidth * 8 / 1000
+		if kbits == 0 {
+			kbits = 1
+		}
+		args = append(args, "-l", fmt.Sprintf("%d", kbits))
+	}
+	args = append(args, deploy.ShellQuote(srcPath), deploy.ShellQuote(fmt.Sprintf("%s@%s:%s", user, host, dstPath)))
+
+	return strings.Join(args, " ")
+}
@@ -0,0 +1,211 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kube is a minimal client of the kubernetes api, it only supports the requests used by the deployment.
+package kube
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/pki"
+)
+
+const (
+	requestTimeout = 30 * time.Second
+	mergePatchType = "application/merge-patch+json"
+
+	// patchAttempts is the number of attempts to patch a node if it's modified by others at the same time
+	patchAttempts = 5
+)
+
+// Client talks to the apiserver at the endpoint by the client certificate of the deploy controller
+type Client struct {
+	endpoint   string
+	httpClient *http.Client
+}
+
+// nodePatch is the merge patch of a node, the status is not patched by it
+type nodePatch struct {
+	Metadata ObjectMeta `json:"metadata"`
+	Spec     NodeSpec   `json:"spec"`
+}
+
+// StatusError is the error returned if the apiserver responds with a status other than success
+type StatusError struct {
+	Code    int
+	Message string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("apiserver responded with status %v: %v", e.Code, e.Message)
+}
+
+// NewClient returns the client of the apiserver at the endpoint <host>:<port>, the certificate of the apiserver
+// is verified by the cluster ca.
+func NewClient(endpoint string, clusterPKI *pki.ClusterPKI) (*Client, error) {
+	pair, err := clusterPKI.ControllerClient()
+	if err != nil {
+		return nil, err
+	}
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(clusterPKI.CA.Cert)
+	return &Client{
+		endpoint: endpoint,
+		httpClient: &http.Client{
+			Timeout: requestTimeout,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					RootCAs: rootCAs,
+					Certificates: []tls.Certificate{{
+						Certificate: [][]byte{pair.Cert.Raw},
+						PrivateKey:  pair.Key,
+						Leaf:        pair.Cert,
+					}},
+				},
+			},
+		},
+	}, nil
+}
+
+// GetNode returns the node of the name, or nil if it's not found
+func (c *Client) GetNode(name string) (*Node, error) {
+	node := new(Node)
+	if err := c.do(http.MethodGet, nodePath(name), "", nil, node); err != nil {
+		if statusErr, ok := err.(*StatusError); ok && statusErr.Code == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get node %v, error: %v", name, err)
+	}
+	return node, nil
+}
+
+// ApplyNodeSettings adds or updates the labels, annotations and taints of the node, and removes the taints in
+// RemoveTaints. The other labels, annotations and taints of the node are kept.
+func (c *Client) ApplyNodeSettings(name string, settings *NodeSettings) error {
+	var err error
+	for i := 0; i < patchAttempts; i++ {
+		if err = c.applyNodeSettings(name, settings); !isConflict(err) {
+			break
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to apply settings of node %v, error: %v", name, err)
+	}
+	return nil
+}
+
+func (c *Client) applyNodeSettings(name string, settings *NodeSettings) error {
+	node, err := c.GetNode(name)
+	if err != nil {
+		return err
+	}
+	if node == nil {
+		return fmt.Errorf("node %v is not found", name)
+	}
+
+	// the taints are a list which is replaced as a whole by the merge patch, the resource version makes the patch
+	// fail with a conflict if the node is modified after it's got.
+	patch := &nodePatch{
+		Metadata: ObjectMeta{
+			ResourceVersion: node.Metadata.ResourceVersion,
+			Labels:          settings.Labels,
+			Annotations:     settings.Annotations,
+		},
+		Spec: NodeSpec{Taints: mergeTaints(node.Spec.Taints, settings.Taints, settings.RemoveTaints)},
+	}
+	body, err := json.Marshal(patch)
+	if err != nil {
+		return err
+	}
+	return c.do(http.MethodPatch, nodePath(name), mergePatchType, body, nil)
+}
+
+// mergeTaints returns the taints with the new taints added, a taint with the same key and effect as a new one
+// is replaced, and the taints with the same key and effect as one in removed are dropped.
+func mergeTaints(taints, newTaints, removed []Taint) []Taint {
+	merged := []Taint{}
+	for _, taint := range taints {
+		if !containsTaint(newTaints, taint) && !containsTaint(removed, taint) {
+			merged = append(merged, taint)
+		}
+	}
+	return append(merged, newTaints...)
+}
+
+func containsTaint(taints []Taint, taint Taint) bool {
+	for _, t := range taints {
+		if t.Key == taint.Key && t.Effect == taint.Effect {
+			return true
+		}
+	}
+	return false
+}
+
+func isConflict(err error) bool {
+	statusErr, ok := err.(*StatusError)
+	return ok && statusErr.Code == http.StatusConflict
+}
+
+func nodePath(name string) string {
+	return "/api/v1/nodes/" + url.PathEscape(name)
+}
+
+func (c *Client) do(method, path, contentType string, body []byte, result interface{}) error {
+	request, err := http.NewRequest(method, "https://"+c.endpoint+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "application/json")
+	if contentType != "" {
+		request.Header.Set("Content-Type", contentType)
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	content, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return &StatusError{Code: response.StatusCode, Message: statusMessage(content)}
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(content, result)
+}
+
+// statusMessage returns the message of the status object in the failed response
+func statusMessage(content []byte) string {
+	status := struct {
+		Message string `json:"message"`
+	}{}
+	if err := json.Unmarshal(content, &status); err != nil || status.Message == "" {
+		return string(content)
+	}
+	return status.Message
+}
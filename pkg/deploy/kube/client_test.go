@@ -0,0 +1,216 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kube
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/pki"
+)
+
+// fakeAPIServer serves the nodes api and requires the client certificate issued by the cluster ca
+type fakeAPIServer struct {
+	sync.Mutex
+	server    *httptest.Server
+	nodes     map[string]*Node
+	conflicts int
+	patches   []map[string]interface{}
+}
+
+func newFakeAPIServer(t *testing.T, clusterPKI *pki.ClusterPKI) *fakeAPIServer {
+	pair, err := clusterPKI.CA.Issue(&pki.CertConfig{
+		CommonName: pki.APIServerCommonName,
+		AltNames:   pki.AltNames{IPs: []net.IP{net.ParseIP("127.0.0.1")}},
+		Usages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := &fakeAPIServer{nodes: make(map[string]*Node)}
+	s.server = httptest.NewUnstartedServer(http.HandlerFunc(s.serve))
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clusterPKI.CA.Cert)
+	s.server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{pair.Cert.Raw}, PrivateKey: pair.Key}},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	}
+	s.server.StartTLS()
+	return s
+}
+
+func (s *fakeAPIServer) endpoint() string {
+	return strings.TrimPrefix(s.server.URL, "https://")
+}
+
+func (s *fakeAPIServer) serve(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+
+	if r.TLS.PeerCertificates[0].Subject.CommonName != pki.ControllerClientCommonName {
+		http.Error(w, `{"message":"forbidden"}`, http.StatusForbidden)
+		return
+	}
+	node, ok := s.nodes[strings.TrimPrefix(r.URL.Path, "/api/v1/nodes/")]
+	if !ok {
+		http.Error(w, `{"message":"node not found"}`, http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		json.NewEncoder(w).Encode(node)
+	case http.MethodPatch:
+		if r.Header.Get("Content-Type") != mergePatchType {
+			http.Error(w, "unsupported patch", http.StatusUnsupportedMediaType)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		patch := &Node{}
+		json.Unmarshal(body, patch)
+		if patch.Metadata.ResourceVersion != node.Metadata.ResourceVersion || s.conflicts > 0 {
+			s.conflicts--
+			http.Error(w, `{"message":"the object has been modified"}`, http.StatusConflict)
+			return
+		}
+		raw := make(map[string]interface{})
+		json.Unmarshal(body, &raw)
+		s.patches = append(s.patches, raw)
+
+		for k, v := range patch.Metadata.Labels {
+			node.Metadata.Labels[k] = v
+		}
+		for k, v := range patch.Metadata.Annotations {
+			node.Metadata.Annotations[k] = v
+		}
+		node.Spec.Taints = patch.Spec.Taints
+		json.NewEncoder(w).Encode(node)
+	default:
+		http.Error(w, "", http.StatusMethodNotAllowed)
+	}
+}
+
+func newTestClient(t *testing.T) (*Client, *fakeAPIServer) {
+	clusterPKI, err := pki.NewClusterPKI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := newFakeAPIServer(t, clusterPKI)
+	client, err := NewClient(server.endpoint(), clusterPKI)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, server
+}
+
+func TestGetNode(t *testing.T) {
+	client, server := newTestClient(t)
+	defer server.server.Close()
+	server.nodes["node1"] = &Node{
+		Metadata: ObjectMeta{Name: "node1", ResourceVersion: "1"},
+		Status:   NodeStatus{Conditions: []NodeCondition{{Type: "MemoryPressure", Status: "False"}, {Type: ConditionReady, Status: "True"}}},
+	}
+
+	node, err := client.GetNode("node1")
+	assert.NoError(t, err)
+	assert.Equal(t, "node1", node.Metadata.Name)
+	assert.True(t, node.Ready())
+
+	node, err = client.GetNode("node2")
+	assert.NoError(t, err)
+	assert.Nil(t, node)
+
+	// the apiserver is not trusted by another cluster ca
+	otherPKI, err := pki.NewClusterPKI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherClient, err := NewClient(server.endpoint(), otherPKI)
+	assert.NoError(t, err)
+	_, err = otherClient.GetNode("node1")
+	assert.Error(t, err)
+}
+
+func TestNodeReady(t *testing.T) {
+	assert.False(t, (&Node{}).Ready())
+	assert.False(t, (&Node{Status: NodeStatus{Conditions: []NodeCondition{{Type: ConditionReady, Status: "False"}}}}).Ready())
+	assert.False(t, (&Node{Status: NodeStatus{Conditions: []NodeCondition{{Type: ConditionReady, Status: "Unknown"}}}}).Ready())
+}
+
+func TestApplyNodeSettings(t *testing.T) {
+	client, server := newTestClient(t)
+	defer server.server.Close()
+	server.nodes["node1"] = &Node{
+		Metadata: ObjectMeta{
+			Name:            "node1",
+			ResourceVersion: "1",
+			Labels:          map[string]string{"kubernetes.io/hostname": "node1", "zone": "a"},
+			Annotations:     map[string]string{"kubeadm.alpha.kubernetes.io/cri-socket": "/var/run/dockershim.sock"},
+		},
+		Spec: NodeSpec{Taints: []Taint{
+			{Key: "node.kubernetes.io/not-ready", Effect: "NoSchedule"},
+			{Key: "node-role.kubernetes.io/master", Effect: "NoSchedule"},
+			{Key: "dedicated", Value: "db", Effect: "NoSchedule"},
+		}},
+	}
+	server.conflicts = 2
+
+	err := client.ApplyNodeSettings("node1", &NodeSettings{
+		Labels:       map[string]string{"zone": "b", "env": "prod"},
+		Annotations:  map[string]string{"owner": "kpaas"},
+		Taints:       []Taint{{Key: "dedicated", Value: "web", Effect: "NoSchedule"}, {Key: "gpu", Effect: "NoExecute"}},
+		RemoveTaints: []Taint{{Key: "node-role.kubernetes.io/master", Effect: "NoSchedule"}},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, server.patches, 1)
+	assert.NotContains(t, server.patches[0], "status")
+
+	node := server.nodes["node1"]
+	assert.Equal(t, map[string]string{"kubernetes.io/hostname": "node1", "zone": "b", "env": "prod"}, node.Metadata.Labels)
+	assert.Equal(t, "kpaas", node.Metadata.Annotations["owner"])
+	assert.Len(t, node.Metadata.Annotations, 2)
+	assert.Equal(t, []Taint{
+		{Key: "node.kubernetes.io/not-ready", Effect: "NoSchedule"},
+		{Key: "dedicated", Value: "web", Effect: "NoSchedule"},
+		{Key: "gpu", Effect: "NoExecute"},
+	}, node.Spec.Taints)
+
+	// the patch fails if the node keeps being modified
+	server.conflicts = patchAttempts
+	err = client.ApplyNodeSettings("node1", &NodeSettings{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "the object has been modified")
+
+	err = client.ApplyNodeSettings("node2", &NodeSettings{})
+	assert.Error(t, err)
+}
+
+func TestMergeTaints(t *testing.T) {
+	assert.Equal(t, []Taint{}, mergeTaints(nil, nil, nil))
+	assert.Equal(t, []Taint{{Key: "a", Effect: "NoSchedule"}, {Key: "a", Value: "1", Effect: "NoExecute"}},
+		mergeTaints([]Taint{{Key: "a", Effect: "NoSchedule"}}, []Taint{{Key: "a", Value: "1", Effect: "NoExecute"}}, nil))
+}
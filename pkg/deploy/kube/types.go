@@ -0,0 +1,85 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kube
+
+const (
+	// ConditionReady is the type of the node condition which is true if the node is ready to run pods
+	ConditionReady = "Ready"
+
+	// WorkerRoleLabel is the label of the worker nodes, which is shown as the role of the nodes by kubectl
+	WorkerRoleLabel = "node-role.kubernetes.io/worker"
+)
+
+// MasterTaint is the taint added to the masters by kubeadm, which keeps the pods off the masters
+var MasterTaint = Taint{Key: "node-role.kubernetes.io/master", Effect: "NoSchedule"}
+
+// Node is a kubernetes node with the fields used by the deployment
+type Node struct {
+	Metadata ObjectMeta `json:"metadata"`
+	Spec     NodeSpec   `json:"spec"`
+	Status   NodeStatus `json:"status"`
+}
+
+// ObjectMeta is the metadata of a kubernetes object
+type ObjectMeta struct {
+	Name            string            `json:"name,omitempty"`
+	ResourceVersion string            `json:"resourceVersion,omitempty"`
+	Labels          map[string]string `json:"labels,omitempty"`
+	Annotations     map[string]string `json:"annotations,omitempty"`
+}
+
+// NodeSpec is the spec of a node
+type NodeSpec struct {
+	Taints []Taint `json:"taints"`
+}
+
+// Taint makes the pods which don't tolerate it not scheduled to or not running on the node
+type Taint struct {
+	Key    string `json:"key"`
+	Value  string `json:"value,omitempty"`
+	Effect string `json:"effect"`
+}
+
+// NodeStatus is the status of a node
+type NodeStatus struct {
+	Conditions []NodeCondition `json:"conditions,omitempty"`
+}
+
+// NodeCondition is a condition of a node
+type NodeCondition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// NodeSettings are the labels, annotations and taints applied to a node
+type NodeSettings struct {
+	Labels      map[string]string
+	Annotations map[string]string
+	Taints      []Taint
+	// RemoveTaints are the taints removed from the node, they're matched by the key and effect
+	RemoveTaints []Taint
+}
+
+// Ready returns if the ready condition of the node is true
+func (n *Node) Ready() bool {
+	for _, condition := range n.Status.Conditions {
+		if condition.Type == ConditionReady {
+			return condition.Status == "True"
+		}
+	}
+	return false
+}
@@ -17,6 +17,7 @@ package deploy
 import (
 	"io"
 	"os"
+	"strings"
 )
 
 var (
@@ -37,3 +38,12 @@ func MustCopy(dst io.Writer, src io.Reader) {
 		panic(err)
 	}
 }
+
+// ShellQuote quotes s as a single word for POSIX shells
+func ShellQuote(s string) string {
+	if s == "" {
+		return "''"
+	}
+
+	return "'" + strings.ReplaceAll(s, "'", `'"'"'`) + "'"
+}
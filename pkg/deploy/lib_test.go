@@ -0,0 +1,53 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShellQuote(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{
+			input: "",
+			want:  "''",
+		},
+		{
+			input: "/tmp/test.sh",
+			want:  "'/tmp/test.sh'",
+		},
+		{
+			input: "/tmp/my scripts/test.sh",
+			want:  "'/tmp/my scripts/test.sh'",
+		},
+		{
+			input: "eth0; rm -rf /",
+			want:  "'eth0; rm -rf /'",
+		},
+		{
+			input: "it's",
+			want:  `'it'"'"'s'`,
+		},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, ShellQuote(tt.input))
+	}
+}
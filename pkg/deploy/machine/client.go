@@ -51,5 +51,6 @@ func NewExecClient(node *pb.Node) (*ExecClient, error) {
 // Close will only close ssh client
 // no need to close sftp client since it's based on ssh client
 func (m *ExecClient) Close() {
+	m.SFTPClient.Close()
 	m.SSHClient.Close()
 }
@@ -0,0 +1,282 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machine
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/mount"
+	dockerclient "github.com/docker/docker/client"
+)
+
+// ContainerOptions describes a container to run on a machine
+type ContainerOptions struct {
+	Name    string
+	Image   string
+	Command []string
+	Env     []string
+	Labels  map[string]string
+	// Binds are host volumes in docker format, e.g. "/etc/kubernetes:/etc/kubernetes:ro"
+	Binds       []string
+	Mounts      []mount.Mount
+	HostNetwork bool
+	Privileged  bool
+	// RestartPolicy is one of "", "no", "always", "on-failure" and "unless-stopped"
+	RestartPolicy string
+	// AlwaysPull pulls the image even if it exists on the machine
+	AlwaysPull bool
+}
+
+// Container is a brief of a container on a machine
+type Container struct {
+	ID     string
+	Name   string
+	Image  string
+	State  string
+	Status string
+	Labels map[string]string
+}
+
+// Docker operates the docker of a machine
+type Docker interface {
+	PullImage(image string) error
+	LoadImage(tarball io.Reader) error
+	ImageExists(image string) (bool, error)
+	RunContainer(opts *ContainerOptions) (string, error)
+	StopContainer(nameOrID string, timeout time.Duration) error
+	RemoveContainer(nameOrID string) error
+	ListContainers(all bool) ([]*Container, error)
+}
+
+// dockerOperator implements Docker with a docker client which is connected lazily
+type dockerOperator struct {
+	name    string
+	connect func() (*dockerclient.Client, error)
+}
+
+// jsonMessage is a message of the json stream returned by docker
+type jsonMessage struct {
+	Stream      string `json:"stream,omitempty"`
+	Status      string `json:"status,omitempty"`
+	Error       string `json:"error,omitempty"`
+	ErrorDetail *struct {
+		Message string `json:"message,omitempty"`
+	} `json:"errorDetail,omitempty"`
+}
+
+// readJSONMessages drains the json stream returned by docker and returns the error in it
+func readJSONMessages(in io.Reader) error {
+	decoder := json.NewDecoder(in)
+	for {
+		var msg jsonMessage
+		if err := decoder.Decode(&msg); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return fmt.Errorf("failed to decode docker message, error: %v", err)
+		}
+
+		if msg.ErrorDetail != nil && msg.ErrorDetail.Message != "" {
+			return errors.New(msg.ErrorDetail.Message)
+		}
+		if msg.Error != "" {
+			return errors.New(msg.Error)
+		}
+	}
+}
+
+// PullImage pulls the image on the machine
+func (d *dockerOperator) PullImage(image string) error {
+	cli, err := d.connect()
+	if err != nil {
+		return err
+	}
+
+	reader, err := cli.ImagePull(context.Background(), image, types.ImagePullOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to pull image %v on machine: %v, error: %v", image, d.name, err)
+	}
+	defer reader.Close()
+
+	if err := readJSONMessages(reader); err != nil {
+		return fmt.Errorf("failed to pull image %v on machine: %v, error: %v", image, d.name, err)
+	}
+
+	return nil
+}
+
+// LoadImage loads images from a tarball, which is created by "docker save", on the machine
+func (d *dockerOperator) LoadImage(tarball io.Reader) error {
+	cli, err := d.connect()
+	if err != nil {
+		return err
+	}
+
+	resp, err := cli.ImageLoad(context.Background(), tarball, true)
+	if err != nil {
+		return fmt.Errorf("failed to load image on machine: %v, error: %v", d.name, err)
+	}
+	defer resp.Body.Close()
+
+	if err := readJSONMessages(resp.Body); err != nil {
+		return fmt.Errorf("failed to load image on machine: %v, error: %v", d.name, err)
+	}
+
+	return nil
+}
+
+// ImageExists checks if the image exists on the machine
+func (d *dockerOperator) ImageExists(image string) (bool, error) {
+	cli, err := d.connect()
+	if err != nil {
+		return false, err
+	}
+
+	images, err := cli.ImageList(context.Background(), types.ImageListOptions{
+		Filters: filters.NewArgs(filters.Arg("reference", image)),
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to list image %v on machine: %v, error: %v", image, d.name, err)
+	}
+
+	return len(images) > 0, nil
+}
+
+// RunContainer creates and starts a container on the machine, it returns the container id.
+// The image is pulled if it doesn't exist on the machine or AlwaysPull is set.
+func (d *dockerOperator) RunContainer(opts *ContainerOptions) (string, error) {
+	if opts == nil || opts.Image == "" {
+		return "", fmt.Errorf("invalid container options: %+v", opts)
+	}
+
+	cli, err := d.connect()
+	if err != nil {
+		return "", err
+	}
+
+	pull := opts.AlwaysPull
+	if !pull {
+		exists, err := d.ImageExists(opts.Image)
+		if err != nil {
+			return "", err
+		}
+		pull = !exists
+	}
+
+	if pull {
+		if err := d.PullImage(opts.Image); err != nil {
+			return "", err
+		}
+	}
+
+	hostConfig := &container.HostConfig{
+		Binds:         opts.Binds,
+		Mounts:        opts.Mounts,
+		Privileged:    opts.Privileged,
+		RestartPolicy: container.RestartPolicy{Name: opts.RestartPolicy},
+	}
+	if opts.HostNetwork {
+		hostConfig.NetworkMode = "host"
+	}
+
+	created, err := cli.ContainerCreate(context.Background(), &container.Config{
+		Image:  opts.Image,
+		Cmd:    opts.Command,
+		Env:    opts.Env,
+		Labels: opts.Labels,
+	}, hostConfig, nil, opts.Name)
+	if err != nil {
+		return "", fmt.Errorf("failed to create container %v on machine: %v, error: %v", opts.Name, d.name, err)
+	}
+
+	if err := cli.ContainerStart(context.Background(), created.ID, types.ContainerStartOptions{}); err != nil {
+		return "", fmt.Errorf("failed to start container %v on machine: %v, error: %v", opts.Name, d.name, err)
+	}
+
+	return created.ID, nil
+}
+
+// StopContainer stops the container, which is a name or an id, on the machine.
+// The container is killed if it's not stopped in timeout.
+func (d *dockerOperator) StopContainer(nameOrID string, timeout time.Duration) error {
+	cli, err := d.connect()
+	if err != nil {
+		return err
+	}
+
+	if err := cli.ContainerStop(context.Background(), nameOrID, &timeout); err != nil {
+		return fmt.Errorf("failed to stop container %v on machine: %v, error: %v", nameOrID, d.name, err)
+	}
+
+	return nil
+}
+
+// RemoveContainer removes the container, a running container is removed forcibly.
+func (d *dockerOperator) RemoveContainer(nameOrID string) error {
+	cli, err := d.connect()
+	if err != nil {
+		return err
+	}
+
+	if err := cli.ContainerRemove(context.Background(), nameOrID, types.ContainerRemoveOptions{Force: true}); err != nil {
+		return fmt.Errorf("failed to remove container %v on machine: %v, error: %v", nameOrID, d.name, err)
+	}
+
+	return nil
+}
+
+// ListContainers lists the containers on the machine, stopped containers are included if all is true.
+func (d *dockerOperator) ListContainers(all bool) ([]*Container, error) {
+	cli, err := d.connect()
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := cli.ContainerList(context.Background(), types.ContainerListOptions{All: all})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers on machine: %v, error: %v", d.name, err)
+	}
+
+	containers := make([]*Container, 0, len(list))
+	for _, c := range list {
+		containers = append(containers, &Container{
+			ID:     c.ID,
+			Name:   containerName(c.Names),
+			Image:  c.Image,
+			State:  c.State,
+			Status: c.Status,
+			Labels: c.Labels,
+		})
+	}
+
+	return containers, nil
+}
+
+// containerName returns the name of a container from its names returned by docker, e.g. ["/etcd"]
+func containerName(names []string) string {
+	if len(names) == 0 {
+		return ""
+	}
+	return strings.TrimPrefix(names[0], "/")
+}
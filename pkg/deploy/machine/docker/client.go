@@ -20,11 +20,13 @@ import (
 	"github.com/docker/docker/client"
 )
 
-func NewTunneledClient(hostName string) (*client.Client, error) {
-	localDockerSocketFile := composeLocalDockerSocketFile(hostName)
-	opt := client.WithHost(localDockerSocketFile)
+// NewTunneledClient returns a docker client which connects the remote docker through the tunnel
+func NewTunneledClient(tunnel *Tunnel) (*client.Client, error) {
+	if tunnel == nil || tunnel.SocketFile() == "" {
+		return nil, fmt.Errorf("docker tunnel is not started")
+	}
 
-	cli, err := client.NewClientWithOpts(opt)
+	cli, err := client.NewClientWithOpts(client.WithHost(tunnel.Host()), client.WithAPIVersionNegotiation())
 	if err != nil {
 		return nil, fmt.Errorf("failed to create docker client, error: %v", err)
 	}
@@ -16,9 +16,12 @@ package docker
 
 import (
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net"
 	"os"
-	"strings"
+	"path/filepath"
+	"sync"
 
 	"github.com/sirupsen/logrus"
 	"golang.org/x/crypto/ssh"
@@ -27,78 +30,255 @@ import (
 )
 
 const (
-	localSocketDir     = "/tmp/"
-	dockerSocketSuffix = ".docker.sock"
+	socketDirPrefix    = "kpaas-docker-"
+	dockerSocketName   = "docker.sock"
 	remoteDockerSocket = "/var/run/docker.sock"
 )
 
+// ReconnectFunc creates a new ssh client to the remote host when the current one is broken
+type ReconnectFunc func() (*ssh.Client, error)
+
+// Tunnel forwards connections of a local unix socket to the docker socket of a remote host over ssh.
 type Tunnel struct {
-	done                chan struct{}
-	remoteHostName      string
+	remoteHostName string
+	reconnect      ReconnectFunc
+
+	lock      sync.Mutex
+	sshClient *ssh.Client
+	// ownedClient is the ssh client created by reconnect which should be closed by the tunnel
+	ownedClient *ssh.Client
+
+	socketDir           string
 	localUnixSocketFile string
-	sshClient           *ssh.Client
+	listener            net.Listener
+	conns               map[net.Conn]struct{}
+	started             bool
+	done                chan struct{}
+	closeOnce           sync.Once
+	wg                  sync.WaitGroup
 }
 
-func NewTunnel(sshClient *ssh.Client, hostName string) *Tunnel {
+// NewTunnel returns a tunnel to the docker of hostName, reconnect can be nil if the
+// tunnel should not reconnect when the ssh client is broken.
+func NewTunnel(sshClient *ssh.Client, hostName string, reconnect ReconnectFunc) *Tunnel {
 	return &Tunnel{
-		sshClient:           sshClient,
-		done:                make(chan struct{}),
-		localUnixSocketFile: composeLocalDockerSocketFile(hostName),
+		remoteHostName: hostName,
+		reconnect:      reconnect,
+		sshClient:      sshClient,
+		conns:          make(map[net.Conn]struct{}),
+		done:           make(chan struct{}),
 	}
 }
 
-func (t *Tunnel) Start() (err error) {
-	listener, err := net.Listen("unix", t.localUnixSocketFile)
+// Start listens on a unix socket in a private directory and serves the tunnel in background.
+func (t *Tunnel) Start() error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	select {
+	case <-t.done:
+		return fmt.Errorf("docker tunnel to %v is closed", t.remoteHostName)
+	default:
+	}
+
+	if t.started {
+		return nil
+	}
+
+	socketDir, err := ioutil.TempDir("", socketDirPrefix+t.remoteHostName+"-")
 	if err != nil {
-		return fmt.Errorf("failed to listen local docker, error: %v", err)
+		return fmt.Errorf("failed to create socket directory for docker tunnel to %v, error: %v", t.remoteHostName, err)
 	}
 
-	for {
-		select {
-		case <-t.done:
-			return
-		default:
+	// the socket is only accessible by the current user
+	if err := os.Chmod(socketDir, 0700); err != nil {
+		os.RemoveAll(socketDir)
+		return fmt.Errorf("failed to chmod socket directory: %v, error: %v", socketDir, err)
+	}
 
-		}
+	socketFile := filepath.Join(socketDir, dockerSocketName)
+	listener, err := net.Listen("unix", socketFile)
+	if err != nil {
+		os.RemoveAll(socketDir)
+		return fmt.Errorf("failed to listen local docker socket: %v, error: %v", socketFile, err)
+	}
+
+	t.socketDir = socketDir
+	t.localUnixSocketFile = socketFile
+	t.listener = listener
+	t.started = true
 
+	t.wg.Add(1)
+	go t.serve(listener)
+
+	logrus.Debugf("docker tunnel to %v is listening on %v", t.remoteHostName, socketFile)
+	return nil
+}
+
+// SocketFile returns the local unix socket file of the tunnel, it's empty before the tunnel is started.
+func (t *Tunnel) SocketFile() string {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return t.localUnixSocketFile
+}
+
+// Host returns the docker host of the tunnel which can be used by a docker client
+func (t *Tunnel) Host() string {
+	return "unix://" + t.SocketFile()
+}
+
+func (t *Tunnel) serve(listener net.Listener) {
+	defer t.wg.Done()
+
+	for {
 		localConn, err := listener.Accept()
 		if err != nil {
-			logrus.Errorf("failed to accept local connection, error: %v", err)
-			continue
+			select {
+			case <-t.done:
+				return
+			default:
+			}
+
+			if netErr, ok := err.(net.Error); ok && netErr.Temporary() {
+				logrus.Warnf("failed to accept local connection of docker tunnel to %v, error: %v", t.remoteHostName, err)
+				continue
+			}
+
+			logrus.Errorf("docker tunnel to %v stopped, error: %v", t.remoteHostName, err)
+			return
 		}
 
-		dstConn, err := t.sshClient.Dial("unix", remoteDockerSocket)
+		dstConn, err := t.dial()
 		if err != nil {
 			logrus.Errorf("failed to dial %v:%v, error: %v", t.remoteHostName, remoteDockerSocket, err)
+			localConn.Close()
 			continue
 		}
 
+		if !t.track(localConn, dstConn) {
+			localConn.Close()
+			dstConn.Close()
+			return
+		}
+
+		t.wg.Add(1)
 		go t.forward(dstConn, localConn)
 	}
 }
 
+// dial connects the remote docker socket, the ssh client is reconnected once if dialing failed.
+func (t *Tunnel) dial() (net.Conn, error) {
+	t.lock.Lock()
+	client := t.sshClient
+	t.lock.Unlock()
+
+	if client == nil {
+		return nil, fmt.Errorf("docker tunnel to %v has no ssh client", t.remoteHostName)
+	}
+
+	conn, err := client.Dial("unix", remoteDockerSocket)
+	if err == nil || t.reconnect == nil {
+		return conn, err
+	}
+
+	logrus.Warnf("failed to dial docker of %v, reconnecting, error: %v", t.remoteHostName, err)
+	newClient, reconnectErr := t.reconnect()
+	if reconnectErr != nil {
+		return nil, fmt.Errorf("failed to reconnect %v, error: %v, dial error: %v", t.remoteHostName, reconnectErr, err)
+	}
+
+	t.lock.Lock()
+	select {
+	case <-t.done:
+		t.lock.Unlock()
+		newClient.Close()
+		return nil, fmt.Errorf("docker tunnel to %v is closed", t.remoteHostName)
+	default:
+	}
+	if t.ownedClient != nil {
+		t.ownedClient.Close()
+	}
+	t.sshClient = newClient
+	t.ownedClient = newClient
+	t.lock.Unlock()
+
+	return newClient.Dial("unix", remoteDockerSocket)
+}
+
+// track records the connections so that they can be closed with the tunnel, it returns false if the tunnel is closed.
+func (t *Tunnel) track(conns ...net.Conn) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	select {
+	case <-t.done:
+		return false
+	default:
+	}
+
+	for _, conn := range conns {
+		t.conns[conn] = struct{}{}
+	}
+	return true
+}
+
+func (t *Tunnel) untrack(conns ...net.Conn) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for _, conn := range conns {
+		delete(t.conns, conn)
+	}
+}
+
 func (t *Tunnel) forward(dst, src net.Conn) {
 	defer func() {
 		dst.Close()
 		src.Close()
+		t.untrack(dst, src)
+		t.wg.Done()
 	}()
 
-	go deploy.MustCopy(src, dst)
-	deploy.MustCopy(dst, src)
+	go func() {
+		// copying fails when the connections are closed, so the error is not fatal here
+		io.Copy(src, dst)
+		// close both sides so that the other direction is finished too
+		src.Close()
+		dst.Close()
+	}()
+	io.Copy(dst, src)
 }
 
+// Close stops the tunnel, closes all forwarded connections and removes the socket directory.
+// It's safe to call Close multiple times.
 func (t *Tunnel) Close() (err error) {
-	close(t.done)
+	t.closeOnce.Do(func() {
+		t.lock.Lock()
+		close(t.done)
+		if t.listener != nil {
+			t.listener.Close()
+		}
+		for conn := range t.conns {
+			conn.Close()
+		}
+		t.lock.Unlock()
+
+		t.wg.Wait()
 
-	if deploy.FileExist(t.localUnixSocketFile) {
-		if err = os.Remove(t.localUnixSocketFile); err != nil {
-			err = fmt.Errorf("failed to remove socket file: %v, error: %v", t.localUnixSocketFile, err)
+		t.lock.Lock()
+		defer t.lock.Unlock()
+
+		if t.ownedClient != nil {
+			t.ownedClient.Close()
 		}
-	}
 
-	return
-}
+		if t.socketDir != "" && deploy.FileExist(t.socketDir) {
+			if err = os.RemoveAll(t.socketDir); err != nil {
+				err = fmt.Errorf("failed to remove socket directory: %v, error: %v", t.socketDir, err)
+			}
+		}
+	})
 
-func composeLocalDockerSocketFile(name string) string {
-	return strings.Join([]string{localSocketDir, name, dockerSocketSuffix}, "")
+	return
 }
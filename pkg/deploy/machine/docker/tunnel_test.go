@@ -0,0 +1,71 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docker
+
+import (
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+)
+
+func TestTunnelLifecycle(t *testing.T) {
+	tunnel := NewTunnel(nil, "node1", nil)
+	assert.Equal(t, "", tunnel.SocketFile())
+
+	assert.NoError(t, tunnel.Start())
+	// start again does nothing
+	assert.NoError(t, tunnel.Start())
+
+	socketFile := tunnel.SocketFile()
+	assert.Equal(t, dockerSocketName, filepath.Base(socketFile))
+	assert.Equal(t, "unix://"+socketFile, tunnel.Host())
+	assert.True(t, deploy.FileExist(socketFile))
+
+	conn, err := net.Dial("unix", socketFile)
+	assert.NoError(t, err)
+	conn.Close()
+
+	closed := make(chan error)
+	go func() {
+		closed <- tunnel.Close()
+	}()
+
+	select {
+	case err := <-closed:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("close of tunnel is blocked")
+	}
+
+	assert.False(t, deploy.FileExist(filepath.Dir(socketFile)))
+	assert.NoError(t, tunnel.Close())
+	assert.Error(t, tunnel.Start())
+}
+
+func TestTunnelSocketDirIsPrivate(t *testing.T) {
+	first := NewTunnel(nil, "node1", nil)
+	second := NewTunnel(nil, "node1", nil)
+	assert.NoError(t, first.Start())
+	defer first.Close()
+	assert.NoError(t, second.Start())
+	defer second.Close()
+
+	assert.NotEqual(t, first.SocketFile(), second.SocketFile())
+}
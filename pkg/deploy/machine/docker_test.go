@@ -0,0 +1,66 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machine
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadJSONMessages(t *testing.T) {
+	tests := []struct {
+		input   string
+		wantErr string
+	}{
+		{
+			input: `{"status":"Pulling from library/busybox"}
+{"status":"Digest: sha256:abc"}`,
+		},
+		{
+			input: `{"status":"Pulling from library/busybox"}
+{"errorDetail":{"message":"manifest unknown"},"error":"manifest unknown"}`,
+			wantErr: "manifest unknown",
+		},
+		{
+			input:   `{"error":"no space left on device"}`,
+			wantErr: "no space left on device",
+		},
+		{
+			// the message is not a format
+			input:   `{"error":"disk usage is 100%s"}`,
+			wantErr: "disk usage is 100%s",
+		},
+		{
+			input:   `not json`,
+			wantErr: "failed to decode docker message",
+		},
+	}
+
+	for _, test := range tests {
+		err := readJSONMessages(strings.NewReader(test.input))
+		if test.wantErr == "" {
+			assert.NoError(t, err)
+		} else if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), test.wantErr)
+		}
+	}
+}
+
+func TestContainerName(t *testing.T) {
+	assert.Equal(t, "", containerName(nil))
+	assert.Equal(t, "etcd", containerName([]string{"/etcd"}))
+}
@@ -0,0 +1,378 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fake provides a scriptable machine for tests, which records all commands
+// run on it and returns canned outputs.
+package fake
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// Response is the canned output of a command
+type Response struct {
+	Stdout string
+	Stderr string
+	Err    error
+}
+
+type rule struct {
+	pattern  *regexp.Regexp
+	response Response
+}
+
+// File is a file put to the fake machine
+type File struct {
+	Content []byte
+	Mode    os.FileMode
+}
+
+// Machine is a fake machine.Machine, files and containers are kept in memory.
+type Machine struct {
+	Node *pb.Node
+
+	lock       sync.Mutex
+	rules      []rule
+	commands   []string
+	files      map[string]*File
+	images     map[string]bool
+	containers []*machine.Container
+	// DockerErr is returned by all docker operations if it's not nil
+	DockerErr error
+	closed    bool
+}
+
+// NewMachine returns a fake machine of node
+func NewMachine(node *pb.Node) *Machine {
+	return &Machine{
+		Node:   node,
+		files:  make(map[string]*File),
+		images: make(map[string]bool),
+	}
+}
+
+// On makes the commands matching the regular expression pattern return the response,
+// rules added later take precedence over earlier ones.
+func (m *Machine) On(pattern string, response Response) *Machine {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.rules = append([]rule{{pattern: regexp.MustCompile(pattern), response: response}}, m.rules...)
+	return m
+}
+
+// Commands returns all commands run on the machine in order
+func (m *Machine) Commands() []string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return append([]string(nil), m.commands...)
+}
+
+// File returns the file at remotePath, or nil if it doesn't exist
+func (m *Machine) File(remotePath string) *File {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.files[remotePath]
+}
+
+// Files returns the paths of all files on the machine in order
+func (m *Machine) Files() []string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	paths := make([]string, 0, len(m.files))
+	for p := range m.files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// SetFile puts a file to the machine directly
+func (m *Machine) SetFile(remotePath string, content []byte, mode os.FileMode) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.files[remotePath] = &File{Content: content, Mode: mode}
+}
+
+// Closed returns true if the machine is closed
+func (m *Machine) Closed() bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.closed
+}
+
+func (m *Machine) GetNode() *pb.Node {
+	return m.Node
+}
+
+func (m *Machine) GetName() string {
+	return m.Node.GetName()
+}
+
+// Run records cmd and returns the response of the latest matched rule
+func (m *Machine) Run(cmd string) (stderr, stdout []byte, err error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.commands = append(m.commands, cmd)
+	for _, r := range m.rules {
+		if r.pattern.MatchString(cmd) {
+			return []byte(r.response.Stderr), []byte(r.response.Stdout), r.response.Err
+		}
+	}
+
+	return nil, nil, nil
+}
+
+func (m *Machine) PutFile(content io.Reader, remotePath string, opts *machine.FileOptions) error {
+	data, err := ioutil.ReadAll(content)
+	if err != nil {
+		return err
+	}
+
+	mode := os.FileMode(0644)
+	if opts != nil && opts.Mode != 0 {
+		mode = opts.Mode.Perm()
+	}
+	if opts != nil && opts.Progress != nil {
+		opts.Progress(int64(len(data)), int64(len(data)))
+	}
+
+	m.SetFile(remotePath, data, mode)
+	return nil
+}
+
+func (m *Machine) FetchFile(localPath, remotePath string) error {
+	file := m.File(remotePath)
+	if file == nil {
+		return fmt.Errorf("%v:%v does not exist", m.GetName(), remotePath)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(localPath), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(localPath, file.Content, file.Mode)
+}
+
+func (m *Machine) PutDir(localDir, remoteDir string, fileNeeded func(path string) bool) error {
+	localDir = strings.TrimSuffix(localDir, "/")
+	remoteDir = strings.TrimSuffix(remoteDir, "/") + "/" + filepath.Base(localDir)
+
+	return filepath.Walk(localDir, func(localPath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !fileNeeded(localPath) {
+			return nil
+		}
+
+		content, err := ioutil.ReadFile(localPath)
+		if err != nil {
+			return err
+		}
+
+		m.SetFile(remoteDir+strings.TrimPrefix(localPath, localDir), content, info.Mode().Perm())
+		return nil
+	})
+}
+
+func (m *Machine) FetchDir(localDir, remoteDir string, fileNeeded func(path string) bool) error {
+	remoteDir = strings.TrimSuffix(remoteDir, "/")
+	localDir = strings.TrimSuffix(localDir, "/") + "/" + filepath.Base(remoteDir)
+
+	found := false
+	for _, remotePath := range m.Files() {
+		if !strings.HasPrefix(remotePath, remoteDir+"/") {
+			continue
+		}
+
+		found = true
+		if !fileNeeded(remotePath) {
+			continue
+		}
+
+		if err := m.FetchFile(localDir+strings.TrimPrefix(remotePath, remoteDir), remotePath); err != nil {
+			return err
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("%v:%v does not exist", m.GetName(), remoteDir)
+	}
+
+	return nil
+}
+
+func (m *Machine) Checksum(remotePath string) (string, error) {
+	file := m.File(remotePath)
+	if file == nil {
+		return "", fmt.Errorf("%v:%v does not exist", m.GetName(), remotePath)
+	}
+
+	hash := sha256.Sum256(file.Content)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+func (m *Machine) Close() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.closed = true
+}
+
+// docker records a docker operation as a command, e.g. "docker pull busybox"
+func (m *Machine) docker(args ...string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.commands = append(m.commands, "docker "+strings.Join(args, " "))
+	return m.DockerErr
+}
+
+func (m *Machine) PullImage(image string) error {
+	if err := m.docker("pull", image); err != nil {
+		return err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.images[image] = true
+	return nil
+}
+
+func (m *Machine) LoadImage(tarball io.Reader) error {
+	if _, err := io.Copy(ioutil.Discard, tarball); err != nil {
+		return err
+	}
+
+	return m.docker("load")
+}
+
+func (m *Machine) ImageExists(image string) (bool, error) {
+	if err := m.docker("image", "inspect", image); err != nil {
+		return false, err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.images[image], nil
+}
+
+func (m *Machine) RunContainer(opts *machine.ContainerOptions) (string, error) {
+	if err := m.docker("run", opts.Name, opts.Image); err != nil {
+		return "", err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	id := fmt.Sprintf("%064d", len(m.containers)+1)
+	m.images[opts.Image] = true
+	m.containers = append(m.containers, &machine.Container{
+		ID:     id,
+		Name:   opts.Name,
+		Image:  opts.Image,
+		State:  "running",
+		Labels: opts.Labels,
+	})
+
+	return id, nil
+}
+
+func (m *Machine) StopContainer(nameOrID string, timeout time.Duration) error {
+	if err := m.docker("stop", nameOrID); err != nil {
+		return err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	container := m.findContainer(nameOrID)
+	if container == nil {
+		return fmt.Errorf("no such container: %v", nameOrID)
+	}
+
+	container.State = "exited"
+	return nil
+}
+
+func (m *Machine) RemoveContainer(nameOrID string) error {
+	if err := m.docker("rm", nameOrID); err != nil {
+		return err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for i, container := range m.containers {
+		if container.Name == nameOrID || container.ID == nameOrID {
+			m.containers = append(m.containers[:i], m.containers[i+1:]...)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("no such container: %v", nameOrID)
+}
+
+func (m *Machine) ListContainers(all bool) ([]*machine.Container, error) {
+	if err := m.docker("ps"); err != nil {
+		return nil, err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	var containers []*machine.Container
+	for _, container := range m.containers {
+		if all || container.State == "running" {
+			c := *container
+			containers = append(containers, &c)
+		}
+	}
+
+	return containers, nil
+}
+
+func (m *Machine) findContainer(nameOrID string) *machine.Container {
+	for _, container := range m.containers {
+		if container.Name == nameOrID || container.ID == nameOrID {
+			return container
+		}
+	}
+	return nil
+}
+
+var _ machine.Machine = &Machine{}
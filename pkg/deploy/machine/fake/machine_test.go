@@ -0,0 +1,106 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestMachineRun(t *testing.T) {
+	m := NewMachine(&pb.Node{Name: "node1"}).
+		On("^docker version", Response{Stdout: "18.09.0"}).
+		On("^docker version --format", Response{Stdout: "19.03.5"}).
+		On("^fail", Response{Stderr: "failed", Err: fmt.Errorf("exit 1")})
+
+	_, stdout, err := m.Run("docker version --format '{{.Server.Version}}'")
+	assert.NoError(t, err)
+	assert.Equal(t, "19.03.5", string(stdout))
+
+	_, stdout, err = m.Run("docker version")
+	assert.NoError(t, err)
+	assert.Equal(t, "18.09.0", string(stdout))
+
+	stderr, _, err := m.Run("fail now")
+	assert.Error(t, err)
+	assert.Equal(t, "failed", string(stderr))
+
+	stderr, stdout, err = m.Run("unknown")
+	assert.NoError(t, err)
+	assert.Empty(t, stderr)
+	assert.Empty(t, stdout)
+
+	assert.Equal(t, []string{"docker version --format '{{.Server.Version}}'", "docker version", "fail now", "unknown"}, m.Commands())
+}
+
+func TestMachineFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fake-machine")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	m := NewMachine(&pb.Node{Name: "node1"})
+	assert.NoError(t, m.PutFile(strings.NewReader("content"), "/etc/kpaas/file", &machine.FileOptions{Mode: 0600}))
+	assert.Equal(t, &File{Content: []byte("content"), Mode: 0600}, m.File("/etc/kpaas/file"))
+
+	checksum, err := m.Checksum("/etc/kpaas/file")
+	assert.NoError(t, err)
+	assert.Equal(t, "ed7002b439e9ac845f22357d822bac1444730fbdb6016d3ec9432297b9ec9f73", checksum)
+
+	assert.NoError(t, m.FetchDir(dir, "/etc/kpaas", func(string) bool { return true }))
+	content, err := ioutil.ReadFile(filepath.Join(dir, "kpaas", "file"))
+	assert.NoError(t, err)
+	assert.Equal(t, "content", string(content))
+
+	assert.NoError(t, m.PutDir(filepath.Join(dir, "kpaas"), "/tmp", func(string) bool { return true }))
+	assert.Equal(t, []string{"/etc/kpaas/file", "/tmp/kpaas/file"}, m.Files())
+
+	m.Close()
+	assert.True(t, m.Closed())
+}
+
+func TestMachineDocker(t *testing.T) {
+	m := NewMachine(&pb.Node{Name: "node1"})
+
+	id, err := m.RunContainer(&machine.ContainerOptions{Name: "etcd", Image: "etcd:3.4"})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, id)
+
+	exists, err := m.ImageExists("etcd:3.4")
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	assert.NoError(t, m.StopContainer("etcd", 0))
+	containers, err := m.ListContainers(false)
+	assert.NoError(t, err)
+	assert.Empty(t, containers)
+	containers, err = m.ListContainers(true)
+	assert.NoError(t, err)
+	assert.Len(t, containers, 1)
+
+	assert.NoError(t, m.RemoveContainer(id))
+	assert.Error(t, m.RemoveContainer(id))
+
+	m.DockerErr = fmt.Errorf("docker is down")
+	assert.Error(t, m.PullImage("busybox"))
+}
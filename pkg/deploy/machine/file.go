@@ -0,0 +1,195 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machine
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	defaultFileMode os.FileMode = 0644
+	tempFileSuffix              = ".kpaas-tmp"
+)
+
+// ProgressFunc reports the progress of a file transfer, total is -1 if the size is unknown.
+type ProgressFunc func(transferred, total int64)
+
+// FileOwner is the numeric owner of a remote file
+type FileOwner struct {
+	UID int
+	GID int
+}
+
+// FileOptions controls how a file is put to a machine
+type FileOptions struct {
+	// Mode is the permission of the file, defaultFileMode is used if it's zero
+	Mode os.FileMode
+	// Owner will be applied to the file if it's not nil
+	Owner *FileOwner
+	// Progress will be called every time a chunk of the file is transferred
+	Progress ProgressFunc
+	// BandwidthLimit is the maximum bytes per second of the transfer, 0 means unlimited
+	BandwidthLimit int64
+}
+
+func (o *FileOptions) mode() os.FileMode {
+	if o == nil || o.Mode == 0 {
+		return defaultFileMode
+	}
+	return o.Mode.Perm()
+}
+
+func (o *FileOptions) owner() *FileOwner {
+	if o == nil {
+		return nil
+	}
+	return o.Owner
+}
+
+func (o *FileOptions) progress() ProgressFunc {
+	if o == nil {
+		return nil
+	}
+	return o.Progress
+}
+
+func (o *FileOptions) bandwidthLimit() int64 {
+	if o == nil {
+		return 0
+	}
+	return o.BandwidthLimit
+}
+
+// progressWriter counts the bytes written through it and reports them to a ProgressFunc
+type progressWriter struct {
+	w           io.Writer
+	total       int64
+	transferred int64
+	progress    ProgressFunc
+}
+
+func newProgressWriter(w io.Writer, total int64, progress ProgressFunc) io.Writer {
+	if progress == nil {
+		return w
+	}
+	return &progressWriter{
+		w:        w,
+		total:    total,
+		progress: progress,
+	}
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	p.transferred += int64(n)
+	p.progress(p.transferred, p.total)
+	return n, err
+}
+
+// limitedWriter limits the bytes per second written through it
+type limitedWriter struct {
+	w       io.Writer
+	limiter *rate.Limiter
+}
+
+func newLimitedWriter(w io.Writer, bytesPerSecond int64) io.Writer {
+	if bytesPerSecond <= 0 {
+		return w
+	}
+	return &limitedWriter{
+		w:       w,
+		limiter: rate.NewLimiter(rate.Limit(bytesPerSecond), int(bytesPerSecond)),
+	}
+}
+
+func (l *limitedWriter) Write(b []byte) (int, error) {
+	written := 0
+	for written < len(b) {
+		chunk := b[written:]
+		if len(chunk) > l.limiter.Burst() {
+			chunk = chunk[:l.limiter.Burst()]
+		}
+
+		if err := l.limiter.WaitN(context.Background(), len(chunk)); err != nil {
+			return written, err
+		}
+
+		n, err := l.w.Write(chunk)
+		written += n
+		if err != nil {
+			return written, err
+		}
+	}
+
+	return written, nil
+}
+
+// checksumContent returns a reader positioned at the beginning of content along with
+// the size and sha256 checksum of content. If content is not seekable, it is buffered in memory.
+func checksumContent(content io.Reader) (io.Reader, int64, string, error) {
+	seeker, ok := content.(io.ReadSeeker)
+	if !ok {
+		data, err := ioutil.ReadAll(content)
+		if err != nil {
+			return nil, 0, "", fmt.Errorf("failed to read content, error: %v", err)
+		}
+		seeker = bytes.NewReader(data)
+	}
+
+	start, err := seeker.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, 0, "", fmt.Errorf("failed to seek content, error: %v", err)
+	}
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, seeker)
+	if err != nil {
+		return nil, 0, "", fmt.Errorf("failed to calculate checksum of content, error: %v", err)
+	}
+
+	if _, err := seeker.Seek(start, io.SeekStart); err != nil {
+		return nil, 0, "", fmt.Errorf("failed to rewind content, error: %v", err)
+	}
+
+	return seeker, size, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// parseChecksum gets the checksum from the output of sha256sum
+func parseChecksum(output []byte) (string, error) {
+	fields := strings.Fields(string(output))
+	if len(fields) == 0 || len(fields[0]) != sha256.Size*2 {
+		return "", fmt.Errorf("unexpected sha256sum output: %q", output)
+	}
+
+	return fields[0], nil
+}
+
+// tempFilePath returns a hidden temporary path next to filePath, so that it can be renamed atomically
+func tempFilePath(filePath string) string {
+	dir, base := path.Split(filePath)
+	return path.Join(dir, fmt.Sprintf(".%s.%d%s", base, time.Now().UnixNano(), tempFileSuffix))
+}
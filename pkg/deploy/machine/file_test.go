@@ -0,0 +1,129 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machine
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	// sha256 checksum of "hello"
+	helloChecksum = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+)
+
+// onlyReader hides the Seek method of the underlying reader
+type onlyReader struct {
+	r *strings.Reader
+}
+
+func (o *onlyReader) Read(p []byte) (int, error) {
+	return o.r.Read(p)
+}
+
+func TestChecksumContent(t *testing.T) {
+	tests := []struct {
+		content io.Reader
+	}{
+		{
+			content: strings.NewReader("hello"),
+		},
+		{
+			content: &onlyReader{r: strings.NewReader("hello")},
+		},
+	}
+
+	for _, tt := range tests {
+		reader, size, checksum, err := checksumContent(tt.content)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), size)
+		assert.Equal(t, helloChecksum, checksum)
+
+		// the returned reader should still return the whole content
+		data, err := ioutil.ReadAll(reader)
+		assert.NoError(t, err)
+		assert.Equal(t, "hello", string(data))
+	}
+}
+
+func TestParseChecksum(t *testing.T) {
+	tests := []struct {
+		output []byte
+		want   string
+		valid  bool
+	}{
+		{
+			output: []byte(helloChecksum + "  /tmp/hello\n"),
+			want:   helloChecksum,
+			valid:  true,
+		},
+		{
+			output: []byte(""),
+			valid:  false,
+		},
+		{
+			output: []byte("sha256sum: /tmp/hello: No such file or directory"),
+			valid:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		checksum, err := parseChecksum(tt.output)
+		assert.Equal(t, tt.valid, err == nil)
+		assert.Equal(t, tt.want, checksum)
+	}
+}
+
+func TestProgressWriter(t *testing.T) {
+	var reported []int64
+	buf := new(bytes.Buffer)
+	w := newProgressWriter(buf, 10, func(transferred, total int64) {
+		assert.Equal(t, int64(10), total)
+		reported = append(reported, transferred)
+	})
+
+	w.Write([]byte("hello"))
+	w.Write([]byte("world"))
+
+	assert.Equal(t, "helloworld", buf.String())
+	assert.Equal(t, []int64{5, 10}, reported)
+
+	// no wrapper is needed without a progress func
+	assert.Equal(t, buf, newProgressWriter(buf, 10, nil))
+}
+
+func TestTempFilePath(t *testing.T) {
+	tempPath := tempFilePath("/tmp/scripts/test.sh")
+	assert.True(t, strings.HasPrefix(tempPath, "/tmp/scripts/.test.sh."))
+	assert.True(t, strings.HasSuffix(tempPath, tempFileSuffix))
+}
+
+func TestLimitedWriter(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w := newLimitedWriter(buf, 4)
+
+	n, err := w.Write([]byte("helloworld"))
+	assert.NoError(t, err)
+	assert.Equal(t, 10, n)
+	assert.Equal(t, "helloworld", buf.String())
+
+	// no wrapper is needed without a limit
+	assert.Equal(t, buf, newLimitedWriter(buf, 0))
+}
@@ -0,0 +1,136 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machine
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+)
+
+const (
+	// containerdNamespace is the namespace of containerd which kubelet uses through the cri plugin
+	containerdNamespace = "k8s.io"
+	// containerdDoneMark is printed after a ctr command succeeded, as the exit status of commands is not returned
+	containerdDoneMark = "kpaas-ctr-done"
+	defaultRegistry    = "docker.io"
+	officialRepository = "library"
+	defaultTag         = "latest"
+)
+
+// Images operates the images of a machine through its container runtime
+type Images interface {
+	PullImage(image string) error
+	// LoadImage loads images from a tarball, which is created by "docker save"
+	LoadImage(tarball io.Reader) error
+	ImageExists(image string) (bool, error)
+}
+
+// NewImages returns the image operator of the container runtime on the machine,
+// images of containerd are operated by ctr in the namespace used by kubelet.
+func NewImages(m Machine, runtime consts.ContainerRuntime) (Images, error) {
+	switch runtime {
+	case "", consts.ContainerRuntimeDocker:
+		return m, nil
+	case consts.ContainerRuntimeContainerd:
+		return &containerdImages{machine: m}, nil
+	default:
+		return nil, fmt.Errorf("unknown container runtime %q", runtime)
+	}
+}
+
+// containerdImages implements Images by running ctr on the machine
+type containerdImages struct {
+	machine Machine
+}
+
+// runCtr runs ctr with the arguments in the namespace of kubelet, it returns the stdout if ctr succeeded.
+func (c *containerdImages) runCtr(args ...string) (string, error) {
+	quoted := make([]string, 0, len(args))
+	for _, arg := range args {
+		quoted = append(quoted, deploy.ShellQuote(arg))
+	}
+	cmd := fmt.Sprintf("ctr --namespace %v %v && echo %v", containerdNamespace, strings.Join(quoted, " "), containerdDoneMark)
+
+	stderr, stdout, err := c.machine.Run(cmd)
+	if err != nil {
+		return "", err
+	}
+
+	output := strings.TrimSpace(string(stdout))
+	if !strings.HasSuffix(output, containerdDoneMark) {
+		return "", fmt.Errorf("%s", strings.TrimSpace(string(stderr)))
+	}
+	return strings.TrimSpace(strings.TrimSuffix(output, containerdDoneMark)), nil
+}
+
+func (c *containerdImages) PullImage(image string) error {
+	if _, err := c.runCtr("images", "pull", NormalizeImage(image)); err != nil {
+		return fmt.Errorf("failed to pull image %v on machine: %v, error: %v", image, c.machine.GetName(), err)
+	}
+	return nil
+}
+
+// LoadImage puts the tarball to the machine and imports it, the tarball is removed after importing
+func (c *containerdImages) LoadImage(tarball io.Reader) error {
+	remotePath := path.Join("/tmp", fmt.Sprintf("kpaas-images-%v.tar", time.Now().UnixNano()))
+	if err := c.machine.PutFile(tarball, remotePath, nil); err != nil {
+		return fmt.Errorf("failed to put images to machine: %v, error: %v", c.machine.GetName(), err)
+	}
+	defer c.machine.Run("rm -f " + deploy.ShellQuote(remotePath))
+
+	if _, err := c.runCtr("images", "import", remotePath); err != nil {
+		return fmt.Errorf("failed to load image on machine: %v, error: %v", c.machine.GetName(), err)
+	}
+	return nil
+}
+
+func (c *containerdImages) ImageExists(image string) (bool, error) {
+	output, err := c.runCtr("images", "list", "--quiet")
+	if err != nil {
+		return false, fmt.Errorf("failed to list image %v on machine: %v, error: %v", image, c.machine.GetName(), err)
+	}
+
+	normalized := NormalizeImage(image)
+	for _, ref := range strings.Split(output, "\n") {
+		if strings.TrimSpace(ref) == normalized {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// NormalizeImage returns the fully qualified reference of an image as docker resolves it,
+// which containerd requires, e.g. "busybox" is "docker.io/library/busybox:latest".
+func NormalizeImage(image string) string {
+	name := image
+	// the first component is a registry if it's a host name
+	if i := strings.Index(name, "/"); i < 0 {
+		name = path.Join(defaultRegistry, officialRepository, name)
+	} else if first := name[:i]; !strings.ContainsAny(first, ".:") && first != "localhost" {
+		name = path.Join(defaultRegistry, name)
+	}
+
+	// a tag follows the last ":" after the last "/", the image is untagged if it has no tag or digest
+	if !strings.Contains(name, "@") && !strings.Contains(name[strings.LastIndex(name, "/"):], ":") {
+		name += ":" + defaultTag
+	}
+	return name
+}
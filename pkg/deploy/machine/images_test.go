@@ -0,0 +1,87 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machine_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine/fake"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestNormalizeImage(t *testing.T) {
+	tests := map[string]string{
+		"busybox":                         "docker.io/library/busybox:latest",
+		"coreos/etcd:v3.3.15":             "docker.io/coreos/etcd:v3.3.15",
+		"k8s.gcr.io/pause:3.1":            "k8s.gcr.io/pause:3.1",
+		"localhost/pause":                 "localhost/pause:latest",
+		"registry:5000/kpaas/etcd":        "registry:5000/kpaas/etcd:latest",
+		"busybox@sha256:0123456789abcdef": "docker.io/library/busybox@sha256:0123456789abcdef",
+	}
+
+	for image, want := range tests {
+		assert.Equal(t, want, machine.NormalizeImage(image), image)
+	}
+}
+
+func TestContainerdImages(t *testing.T) {
+	m := fake.NewMachine(&pb.Node{Name: "node1"})
+	m.On(`ctr --namespace k8s.io 'images' 'list' '--quiet'`, fake.Response{
+		Stdout: "docker.io/library/busybox:latest\nk8s.gcr.io/pause:3.1\nkpaas-ctr-done\n",
+	})
+	m.On(`ctr --namespace k8s.io 'images' 'pull' 'docker.io/library/nginx:latest'`, fake.Response{
+		Stderr: "ctr: failed to resolve reference",
+	})
+	m.On(`ctr --namespace k8s.io 'images' 'import' '/tmp/kpaas-images-`, fake.Response{
+		Stdout: "unpacking docker.io/library/busybox:latest...done\nkpaas-ctr-done\n",
+	})
+
+	images, err := machine.NewImages(m, consts.ContainerRuntimeContainerd)
+	assert.NoError(t, err)
+
+	exists, err := images.ImageExists("busybox")
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	exists, err = images.ImageExists("k8s.gcr.io/pause:3.2")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	err = images.PullImage("nginx")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "failed to resolve reference")
+	}
+
+	assert.NoError(t, images.LoadImage(strings.NewReader("tarball")))
+	files := m.Files()
+	if assert.Len(t, files, 1) {
+		assert.Equal(t, "tarball", string(m.File(files[0]).Content))
+		// the tarball is removed after importing
+		commands := m.Commands()
+		assert.Equal(t, fmt.Sprintf("rm -f '%v'", files[0]), commands[len(commands)-1])
+	}
+
+	docker, err := machine.NewImages(m, consts.ContainerRuntimeDocker)
+	assert.NoError(t, err)
+	assert.Equal(t, m, docker)
+
+	_, err = machine.NewImages(m, "rkt")
+	assert.Error(t, err)
+}
@@ -15,6 +15,8 @@
 package machine
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -30,7 +32,7 @@ import (
 )
 
 // Run will run command on remote machine
-func (m *Machine) Run(cmd string) (stderr, stdout []byte, err error) {
+func (m *SSHMachine) Run(cmd string) (stderr, stdout []byte, err error) {
 	session, err := mssh.NewSession(m.SSHClient)
 	if err != nil {
 		return nil, nil, fmt.Errorf("unable to get session of machine(%v), error: %v", m.Name, err)
@@ -65,53 +67,192 @@ func (m *Machine) Run(cmd string) (stderr, stdout []byte, err error) {
 	return stderr, stdout, nil
 }
 
-func (m *Machine) PutFile(content io.Reader, remotePath string) error {
+// PutFile puts content to remotePath on the machine. The content is written to a temporary file
+// first, which is renamed to remotePath after its checksum is verified. The transfer is skipped
+// if remotePath already has the same content.
+func (m *SSHMachine) PutFile(content io.Reader, remotePath string, opts *FileOptions) error {
+	content, size, checksum, err := checksumContent(content)
+	if err != nil {
+		return err
+	}
+
+	if m.remoteFileMatches(remotePath, size, checksum) {
+		logrus.Debugf("%v:%v is unchanged, skip putting it", m.Name, remotePath)
+		return m.setFileAttributes(remotePath, opts)
+	}
+
 	// create parent dir if not exists
 	remoteDir := path.Dir(remotePath)
 	if err := m.SFTPClient.MkdirAll(remoteDir); err != nil {
 		return fmt.Errorf("mkdirall %v failed, error: %v", remoteDir, err)
 	}
 
-	remoteFile, err := m.SFTPClient.Create(remotePath)
-	if err != nil {
-		return fmt.Errorf("create file %v failed: %v", remotePath, err)
+	tempPath := tempFilePath(remotePath)
+	if err := m.putTempFile(content, size, tempPath, opts); err != nil {
+		m.removeQuietly(tempPath)
+		return err
+	}
+
+	if err := m.verifyChecksum(tempPath, checksum); err != nil {
+		m.removeQuietly(tempPath)
+		return err
+	}
+
+	if err := m.setFileAttributes(tempPath, opts); err != nil {
+		m.removeQuietly(tempPath)
+		return err
 	}
-	defer remoteFile.Close()
 
-	deploy.MustCopy(remoteFile, content)
+	if err := m.SFTPClient.PosixRename(tempPath, remotePath); err != nil {
+		m.removeQuietly(tempPath)
+		return fmt.Errorf("rename %v to %v failed, error: %v", tempPath, remotePath, err)
+	}
 
 	logrus.Debugf("put file to: %v", remotePath)
 
 	return nil
 }
 
-func (m *Machine) FetchFile(localPath, remotePath string) error {
+func (m *SSHMachine) putTempFile(content io.Reader, size int64, tempPath string, opts *FileOptions) error {
+	tempFile, err := m.SFTPClient.OpenFile(tempPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC|os.O_EXCL)
+	if err != nil {
+		return fmt.Errorf("create file %v failed: %v", tempPath, err)
+	}
+
+	writer := newLimitedWriter(newProgressWriter(tempFile, size, opts.progress()), opts.bandwidthLimit())
+	if _, err := io.Copy(writer, content); err != nil {
+		tempFile.Close()
+		return fmt.Errorf("write file %v failed, error: %v", tempPath, err)
+	}
+
+	if err := tempFile.Close(); err != nil {
+		return fmt.Errorf("close file %v failed, error: %v", tempPath, err)
+	}
+
+	return nil
+}
+
+func (m *SSHMachine) setFileAttributes(remotePath string, opts *FileOptions) error {
+	if err := m.SFTPClient.Chmod(remotePath, opts.mode()); err != nil {
+		return fmt.Errorf("chmod %v failed, error: %v", remotePath, err)
+	}
+
+	if owner := opts.owner(); owner != nil {
+		if err := m.SFTPClient.Chown(remotePath, owner.UID, owner.GID); err != nil {
+			return fmt.Errorf("chown %v failed, error: %v", remotePath, err)
+		}
+	}
+
+	return nil
+}
+
+// remoteFileMatches checks if remotePath exists and has the desired size and checksum
+func (m *SSHMachine) remoteFileMatches(remotePath string, size int64, checksum string) bool {
+	info, err := m.SFTPClient.Stat(remotePath)
+	if err != nil || !info.Mode().IsRegular() || info.Size() != size {
+		return false
+	}
+
+	remoteChecksum, err := m.Checksum(remotePath)
+	if err != nil {
+		logrus.Debugf("failed to get checksum of %v:%v, error: %v", m.Name, remotePath, err)
+		return false
+	}
+
+	return remoteChecksum == checksum
+}
+
+func (m *SSHMachine) verifyChecksum(remotePath, checksum string) error {
+	remoteChecksum, err := m.Checksum(remotePath)
+	if err != nil {
+		return err
+	}
+
+	if remoteChecksum != checksum {
+		return fmt.Errorf("checksum of %v:%v mismatched, expected: %v, actual: %v", m.Name, remotePath, checksum, remoteChecksum)
+	}
+
+	return nil
+}
+
+// Checksum returns the sha256 checksum of remotePath on the machine
+func (m *SSHMachine) Checksum(remotePath string) (string, error) {
+	stderr, stdout, err := m.Run("sha256sum " + deploy.ShellQuote(remotePath))
+	if err != nil {
+		return "", fmt.Errorf("failed to get checksum of %v:%v, error: %v", m.Name, remotePath, err)
+	}
+
+	checksum, err := parseChecksum(stdout)
+	if err != nil {
+		return "", fmt.Errorf("failed to get checksum of %v:%v, error: %v, stderr: %s", m.Name, remotePath, err, stderr)
+	}
+
+	return checksum, nil
+}
+
+func (m *SSHMachine) removeQuietly(remotePath string) {
+	if err := m.SFTPClient.Remove(remotePath); err != nil {
+		logrus.Warnf("failed to remove %v:%v, error: %v", m.Name, remotePath, err)
+	}
+}
+
+// FetchFile fetches remotePath on the machine to localPath. The file is written to a temporary
+// file first, which is renamed to localPath after its checksum is verified.
+func (m *SSHMachine) FetchFile(localPath, remotePath string) error {
 	remoteFile, err := m.SFTPClient.Open(remotePath)
 	if err != nil {
 		return fmt.Errorf("open remote file %v failed, error: %v", remotePath, err)
 	}
 	defer remoteFile.Close()
 
+	info, err := remoteFile.Stat()
+	if err != nil {
+		return fmt.Errorf("stat remote file %v failed, error: %v", remotePath, err)
+	}
+
 	// create parent dir if not exists
 	localDir := path.Dir(localPath)
 	if err := os.MkdirAll(localDir, 0755); err != nil {
 		return fmt.Errorf("mkdirall %v failed, error: %v", localDir, err)
 	}
 
-	localFile, err := os.Create(localPath)
+	localFile, err := ioutil.TempFile(localDir, "."+path.Base(localPath)+".*"+tempFileSuffix)
 	if err != nil {
-		return fmt.Errorf("create local file %v failed, error: %v", localPath, err)
+		return fmt.Errorf("create local file in %v failed, error: %v", localDir, err)
+	}
+	tempPath := localFile.Name()
+
+	hash := sha256.New()
+	_, err = io.Copy(io.MultiWriter(localFile, hash), remoteFile)
+	if closeErr := localFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tempPath)
+		return fmt.Errorf("fetch file %v:%v failed, error: %v", m.Name, remotePath, err)
 	}
-	defer localFile.Close()
 
-	deploy.MustCopy(localFile, remoteFile)
+	if err := m.verifyChecksum(remotePath, hex.EncodeToString(hash.Sum(nil))); err != nil {
+		os.Remove(tempPath)
+		return err
+	}
+
+	if err := os.Chmod(tempPath, info.Mode().Perm()); err != nil {
+		os.Remove(tempPath)
+		return fmt.Errorf("chmod %v failed, error: %v", tempPath, err)
+	}
+
+	if err := os.Rename(tempPath, localPath); err != nil {
+		os.Remove(tempPath)
+		return fmt.Errorf("rename %v to %v failed, error: %v", tempPath, localPath, err)
+	}
 
 	logrus.Debugf("fetch file from %s on %s to %s", remotePath, m.Name, localPath)
 
 	return nil
 }
 
-func (m *Machine) FetchDir(localDir, remoteDir string, fileNeeded func(path string) bool) error {
+func (m *SSHMachine) FetchDir(localDir, remoteDir string, fileNeeded func(path string) bool) error {
 	logrus.Debugf("fetch %v:%v to %v", m.Name, remoteDir, localDir)
 
 	remoteDir = strings.TrimSuffix(remoteDir, "/")
@@ -161,7 +302,7 @@ func (m *Machine) FetchDir(localDir, remoteDir string, fileNeeded func(path stri
 	return nil
 }
 
-func (m *Machine) PutDir(localDir, remoteDir string, fileNeeded func(path string) bool) error {
+func (m *SSHMachine) PutDir(localDir, remoteDir string, fileNeeded func(path string) bool) error {
 	logrus.Debugf("copy %v to %v:%v", localDir, m.Name, remoteDir)
 
 	localDir = strings.TrimPrefix(strings.TrimSuffix(localDir, "/"), "./")
@@ -188,13 +329,8 @@ func (m *Machine) PutDir(localDir, remoteDir string, fileNeeded func(path string
 			}
 		} else {
 			if fileNeeded(localPath) {
-				// copy file
-				localFile, err := os.Open(localPath)
-				if err != nil {
-					return fmt.Errorf("open %v failed, error: %v", localPath, err)
-				}
-
-				if err := m.PutFile(localFile, remotePath); err != nil {
+				// copy file with the same permission
+				if err := m.putLocalFile(localPath, remotePath, info.Mode()); err != nil {
 					return fmt.Errorf("failed to copy file:%v to %v:%v, error: %v", localPath, m.Name, remotePath, err)
 				}
 			}
@@ -208,3 +344,13 @@ func (m *Machine) PutDir(localDir, remoteDir string, fileNeeded func(path string
 
 	return nil
 }
+
+func (m *SSHMachine) putLocalFile(localPath, remotePath string, mode os.FileMode) error {
+	localFile, err := os.Open(localPath)
+	if err != nil {
+		return fmt.Errorf("open %v failed, error: %v", localPath, err)
+	}
+	defer localFile.Close()
+
+	return m.PutFile(localFile, remotePath, &FileOptions{Mode: mode})
+}
@@ -0,0 +1,293 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machine
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	dockerclient "github.com/docker/docker/client"
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+var (
+	localNodesLock sync.RWMutex
+	// localNodes are the names of the nodes which are the host the deploy controller runs on
+	localNodes = make(map[string]bool)
+	// loopbackLocal is true if the nodes of loopback ips are the host the deploy controller runs on
+	loopbackLocal = true
+)
+
+// SetLocalNodes sets the names of the nodes which are the host the deploy controller runs on,
+// besides the nodes of loopback ips. The nodes set before are replaced.
+func SetLocalNodes(names ...string) {
+	localNodesLock.Lock()
+	defer localNodesLock.Unlock()
+
+	localNodes = make(map[string]bool, len(names))
+	for _, name := range names {
+		localNodes[name] = true
+	}
+}
+
+// SetLoopbackLocal sets if the nodes of loopback ips are the host the deploy controller runs on, which is
+// enabled by default. It's disabled by the tests whose ssh servers listen on the loopback ip.
+func SetLoopbackLocal(enabled bool) {
+	localNodesLock.Lock()
+	defer localNodesLock.Unlock()
+
+	loopbackLocal = enabled
+}
+
+// IsLocalNode returns true if the node is the host the deploy controller runs on, which is the node
+// of a loopback ip, or a node set by SetLocalNodes.
+func IsLocalNode(node *pb.Node) bool {
+	localNodesLock.RLock()
+	defer localNodesLock.RUnlock()
+
+	if ip := net.ParseIP(node.GetIp()); ip != nil && ip.IsLoopback() && loopbackLocal {
+		return true
+	}
+	return localNodes[node.GetName()]
+}
+
+// LocalMachine is the host the deploy controller runs on, it's used by all-in-one installs
+// so that the node needs not to be connected by ssh.
+type LocalMachine struct {
+	*pb.Node
+	dockerOperator
+
+	dockerLock   sync.Mutex
+	DockerClient *dockerclient.Client
+}
+
+// NewLocalMachine returns the local host as the machine of node
+func NewLocalMachine(node *pb.Node) Machine {
+	m := &LocalMachine{
+		Node: node,
+	}
+	m.dockerOperator = dockerOperator{
+		name:    node.GetName(),
+		connect: m.connectDocker,
+	}
+
+	return m
+}
+
+func (m *LocalMachine) GetNode() *pb.Node {
+	return m.Node
+}
+
+// connectDocker creates a docker client based on the environment, e.g. DOCKER_HOST
+func (m *LocalMachine) connectDocker() (*dockerclient.Client, error) {
+	m.dockerLock.Lock()
+	defer m.dockerLock.Unlock()
+
+	if m.DockerClient != nil {
+		return m.DockerClient, nil
+	}
+
+	client, err := dockerclient.NewClientWithOpts(dockerclient.FromEnv, dockerclient.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create local docker client, error: %v", err)
+	}
+
+	m.DockerClient = client
+
+	return client, nil
+}
+
+// Run runs cmd by sh on the local host. Like the ssh machine, a non-zero exit status
+// is not treated as an error, the caller should check stderr.
+func (m *LocalMachine) Run(cmd string) (stderr, stdout []byte, err error) {
+	var errBuffer, outBuffer bytes.Buffer
+
+	command := exec.Command("/bin/sh", "-c", cmd)
+	command.Stderr = &errBuffer
+	command.Stdout = &outBuffer
+
+	if err := command.Run(); err != nil {
+		if _, ok := err.(*exec.ExitError); !ok {
+			return nil, nil, fmt.Errorf("unable to run cmd(%v) on local machine(%v), error: %v", cmd, m.GetName(), err)
+		}
+	}
+
+	return errBuffer.Bytes(), outBuffer.Bytes(), nil
+}
+
+// PutFile writes content to remotePath on the local host atomically
+func (m *LocalMachine) PutFile(content io.Reader, remotePath string, opts *FileOptions) error {
+	return writeLocalFile(content, remotePath, opts)
+}
+
+// writeLocalFile writes content to a temporary file which is renamed to filePath, the write is
+// skipped if filePath already has the same content.
+func writeLocalFile(content io.Reader, filePath string, opts *FileOptions) error {
+	content, size, checksum, err := checksumContent(content)
+	if err != nil {
+		return err
+	}
+
+	if current, err := fileChecksum(filePath); err == nil && current == checksum {
+		logrus.Debugf("%v is unchanged, skip putting it", filePath)
+		return setLocalFileAttributes(filePath, opts)
+	}
+
+	dir := filepath.Dir(filePath)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("mkdirall %v failed, error: %v", dir, err)
+	}
+
+	tempFile, err := ioutil.TempFile(dir, "."+filepath.Base(filePath)+".*"+tempFileSuffix)
+	if err != nil {
+		return fmt.Errorf("create file in %v failed, error: %v", dir, err)
+	}
+	tempPath := tempFile.Name()
+
+	writer := newLimitedWriter(newProgressWriter(tempFile, size, opts.progress()), opts.bandwidthLimit())
+	_, err = io.Copy(writer, content)
+	if closeErr := tempFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tempPath)
+		return fmt.Errorf("write file %v failed, error: %v", tempPath, err)
+	}
+
+	if err := setLocalFileAttributes(tempPath, opts); err != nil {
+		os.Remove(tempPath)
+		return err
+	}
+
+	if err := os.Rename(tempPath, filePath); err != nil {
+		os.Remove(tempPath)
+		return fmt.Errorf("rename %v to %v failed, error: %v", tempPath, filePath, err)
+	}
+
+	logrus.Debugf("put file to: %v", filePath)
+
+	return nil
+}
+
+func setLocalFileAttributes(filePath string, opts *FileOptions) error {
+	if err := os.Chmod(filePath, opts.mode()); err != nil {
+		return fmt.Errorf("chmod %v failed, error: %v", filePath, err)
+	}
+
+	if owner := opts.owner(); owner != nil {
+		if err := os.Chown(filePath, owner.UID, owner.GID); err != nil {
+			return fmt.Errorf("chown %v failed, error: %v", filePath, err)
+		}
+	}
+
+	return nil
+}
+
+// FetchFile copies remotePath to localPath on the local host
+func (m *LocalMachine) FetchFile(localPath, remotePath string) error {
+	info, err := os.Stat(remotePath)
+	if err != nil {
+		return fmt.Errorf("stat file %v failed, error: %v", remotePath, err)
+	}
+
+	return copyLocalFile(remotePath, localPath, info.Mode())
+}
+
+// PutDir copies localDir into remoteDir on the local host
+func (m *LocalMachine) PutDir(localDir, remoteDir string, fileNeeded func(path string) bool) error {
+	localDir = strings.TrimSuffix(localDir, "/")
+	return copyLocalDir(localDir, strings.TrimSuffix(remoteDir, "/")+"/"+filepath.Base(localDir), fileNeeded)
+}
+
+// FetchDir copies remoteDir into localDir on the local host
+func (m *LocalMachine) FetchDir(localDir, remoteDir string, fileNeeded func(path string) bool) error {
+	remoteDir = strings.TrimSuffix(remoteDir, "/")
+	return copyLocalDir(remoteDir, strings.TrimSuffix(localDir, "/")+"/"+filepath.Base(remoteDir), fileNeeded)
+}
+
+func (m *LocalMachine) Checksum(remotePath string) (string, error) {
+	return fileChecksum(remotePath)
+}
+
+func (m *LocalMachine) Close() {
+	if m.DockerClient != nil {
+		m.DockerClient.Close()
+	}
+}
+
+func copyLocalDir(srcDir, dstDir string, fileNeeded func(path string) bool) error {
+	if !deploy.FileExist(srcDir) {
+		return fmt.Errorf("directory:%v doesn't exist", srcDir)
+	}
+
+	return filepath.Walk(srcDir, func(srcPath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return fmt.Errorf("walk %v failed, error: %v", srcPath, err)
+		}
+
+		dstPath := dstDir + strings.TrimPrefix(srcPath, srcDir)
+		if info.IsDir() {
+			if err := os.MkdirAll(dstPath, 0755); err != nil {
+				return fmt.Errorf("failed to mkdir %v, error: %v", dstPath, err)
+			}
+			return nil
+		}
+
+		if !fileNeeded(srcPath) {
+			return nil
+		}
+
+		logrus.Debugf("copy %v to %v", srcPath, dstPath)
+		return copyLocalFile(srcPath, dstPath, info.Mode())
+	})
+}
+
+func copyLocalFile(srcPath, dstPath string, mode os.FileMode) error {
+	src, err := os.Open(srcPath)
+	if err != nil {
+		return fmt.Errorf("open %v failed, error: %v", srcPath, err)
+	}
+	defer src.Close()
+
+	return writeLocalFile(src, dstPath, &FileOptions{Mode: mode})
+}
+
+func fileChecksum(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", fmt.Errorf("open %v failed, error: %v", filePath, err)
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("failed to calculate checksum of %v, error: %v", filePath, err)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machine
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestLocalMachineRun(t *testing.T) {
+	m := NewLocalMachine(&pb.Node{Name: "local"})
+	defer m.Close()
+
+	stderr, stdout, err := m.Run("echo hello; echo world >&2; exit 1")
+	assert.NoError(t, err)
+	assert.Equal(t, "hello\n", string(stdout))
+	assert.Equal(t, "world\n", string(stderr))
+}
+
+func TestLocalMachineFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "local-machine")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	m := NewLocalMachine(&pb.Node{Name: "local"})
+	defer m.Close()
+
+	filePath := filepath.Join(dir, "remote", "file")
+	assert.NoError(t, m.PutFile(strings.NewReader("content"), filePath, &FileOptions{Mode: 0600}))
+
+	info, err := os.Stat(filePath)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	checksum, err := m.Checksum(filePath)
+	assert.NoError(t, err)
+	assert.Equal(t, "ed7002b439e9ac845f22357d822bac1444730fbdb6016d3ec9432297b9ec9f73", checksum)
+
+	fetchedPath := filepath.Join(dir, "local", "file")
+	assert.NoError(t, m.FetchFile(fetchedPath, filePath))
+	content, err := ioutil.ReadFile(fetchedPath)
+	assert.NoError(t, err)
+	assert.Equal(t, "content", string(content))
+
+	assert.NoError(t, m.PutDir(filepath.Join(dir, "remote"), filepath.Join(dir, "put"), func(string) bool { return true }))
+	content, err = ioutil.ReadFile(filepath.Join(dir, "put", "remote", "file"))
+	assert.NoError(t, err)
+	assert.Equal(t, "content", string(content))
+
+	assert.NoError(t, m.FetchDir(filepath.Join(dir, "fetch"), filepath.Join(dir, "remote"), func(string) bool { return false }))
+	assert.DirExists(t, filepath.Join(dir, "fetch", "remote"))
+	_, err = os.Stat(filepath.Join(dir, "fetch", "remote", "file"))
+	assert.True(t, os.IsNotExist(err))
+
+	assert.Error(t, m.FetchDir(filepath.Join(dir, "fetch"), filepath.Join(dir, "missing"), func(string) bool { return true }))
+}
+
+func TestNewMachineLoopback(t *testing.T) {
+	SetLoopbackLocal(true)
+	// the other tests connect the ssh test servers on the loopback ip
+	defer SetLoopbackLocal(false)
+
+	for _, node := range []*pb.Node{{Name: "node1", Ip: "127.0.0.1"}, {Name: "node1", Ip: "::1"}} {
+		assert.True(t, IsLocalNode(node), node.GetIp())
+
+		m, err := NewMachine(node)
+		assert.NoError(t, err)
+		assert.IsType(t, &LocalMachine{}, m)
+		assert.Equal(t, node, m.GetNode())
+		m.Close()
+	}
+
+	assert.False(t, IsLocalNode(&pb.Node{Name: "node1", Ip: "192.168.1.1"}))
+
+	SetLoopbackLocal(false)
+	assert.False(t, IsLocalNode(&pb.Node{Name: "node1", Ip: "127.0.0.1"}))
+}
+
+func TestNewMachineLocalNodes(t *testing.T) {
+	tests := []struct {
+		node      *pb.Node
+		wantLocal bool
+	}{
+		{
+			node:      &pb.Node{Name: "node1", Ip: "192.168.1.1"},
+			wantLocal: true,
+		},
+		{
+			// the local nodes are local even if the nodes of loopback ips are connected by ssh
+			node:      &pb.Node{Name: "node1", Ip: "127.0.0.1"},
+			wantLocal: true,
+		},
+		{
+			node:      &pb.Node{Name: "node2", Ip: "192.168.1.2"},
+			wantLocal: false,
+		},
+	}
+
+	SetLocalNodes("node1")
+	defer SetLocalNodes()
+	for _, test := range tests {
+		assert.Equal(t, test.wantLocal, IsLocalNode(test.node), test.node.GetName())
+		if !test.wantLocal {
+			continue
+		}
+
+		m, err := NewMachine(test.node)
+		assert.NoError(t, err)
+		assert.IsType(t, &LocalMachine{}, m)
+		assert.Equal(t, test.node, m.GetNode())
+		m.Close()
+	}
+}
@@ -16,39 +16,96 @@ package machine
 
 import (
 	"fmt"
+	"io"
+	"sync"
 
 	dockerclient "github.com/docker/docker/client"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/ssh"
 
 	"github.com/kpaas-io/kpaas/pkg/deploy/machine/docker"
+	mssh "github.com/kpaas-io/kpaas/pkg/deploy/machine/ssh"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
-type Machine struct {
+// Machine is a node which commands can be run on and files can be transferred to
+type Machine interface {
+	Docker
+
+	// GetNode returns the node of the machine
+	GetNode() *pb.Node
+	// GetName returns the name of the node
+	GetName() string
+	// Run runs cmd by shell on the machine
+	Run(cmd string) (stderr, stdout []byte, err error)
+	PutFile(content io.Reader, remotePath string, opts *FileOptions) error
+	FetchFile(localPath, remotePath string) error
+	PutDir(localDir, remoteDir string, fileNeeded func(path string) bool) error
+	FetchDir(localDir, remoteDir string, fileNeeded func(path string) bool) error
+	// Checksum returns the sha256 checksum of remotePath
+	Checksum(remotePath string) (string, error)
+	Close()
+}
+
+// SSHMachine is a machine connected by ssh
+type SSHMachine struct {
 	*ExecClient
 	*pb.Node
+	dockerOperator
+
+	// dockerLock guards the docker tunnel and client, which are created on the first docker operation
+	dockerLock   sync.Mutex
 	DockerTunnel *docker.Tunnel
 	DockerClient *dockerclient.Client
 }
 
-func NewMachine(node *pb.Node) (*Machine, error) {
+// NewMachine returns the local machine if the node is the host the deploy controller runs on,
+// or connects the node by ssh.
+func NewMachine(node *pb.Node) (Machine, error) {
+	if IsLocalNode(node) {
+		return NewLocalMachine(node), nil
+	}
+
 	client, err := NewExecClient(node)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create execution client for machine: %v(%v), error: %v", node.Name, node.Ip, err)
 	}
 
-	return &Machine{
+	m := &SSHMachine{
 		ExecClient: client,
 		Node:       node,
-	}, nil
+	}
+	m.dockerOperator = dockerOperator{
+		name:    node.Name,
+		connect: m.connectDocker,
+	}
+
+	return m, nil
 }
 
-// SetDockerTunnel create a Docker Tunnel to remote Node when needed
-func (m *Machine) SetDockerTunnel() {
-	m.DockerTunnel = docker.NewTunnel(m.SSHClient, m.Name)
+func (m *SSHMachine) GetNode() *pb.Node {
+	return m.Node
 }
 
-func (m *Machine) SetDockerClient() error {
-	client, err := docker.NewTunneledClient(m.Name)
+// ConnectDocker starts a docker tunnel to the machine and creates a docker client through it,
+// it does nothing if the docker client is already created.
+func (m *SSHMachine) ConnectDocker() error {
+	m.dockerLock.Lock()
+	defer m.dockerLock.Unlock()
+
+	if m.DockerClient != nil {
+		return nil
+	}
+
+	if m.DockerTunnel == nil {
+		m.DockerTunnel = docker.NewTunnel(m.SSHClient, m.Name, m.reconnect)
+	}
+
+	if err := m.DockerTunnel.Start(); err != nil {
+		return fmt.Errorf("failed to start docker tunnel to machine: %v, error: %v", m.Name, err)
+	}
+
+	client, err := docker.NewTunneledClient(m.DockerTunnel)
 	if err != nil {
 		return fmt.Errorf("failed to create tunneled docker client to machine: %v, error: %v", m.Name, err)
 	}
@@ -58,8 +115,35 @@ func (m *Machine) SetDockerClient() error {
 	return nil
 }
 
-func (m *Machine) Close() {
-	m.Close()
-	m.DockerClient.Close()
-	m.DockerTunnel.Close()
+func (m *SSHMachine) connectDocker() (*dockerclient.Client, error) {
+	if err := m.ConnectDocker(); err != nil {
+		return nil, err
+	}
+
+	m.dockerLock.Lock()
+	defer m.dockerLock.Unlock()
+
+	return m.DockerClient, nil
+}
+
+// reconnect creates a new ssh client for the docker tunnel when the current one is broken
+func (m *SSHMachine) reconnect() (*ssh.Client, error) {
+	return mssh.NewClient(m.Ssh.Auth.Username, m.Ip, m.Ssh)
+}
+
+func (m *SSHMachine) Close() {
+	m.dockerLock.Lock()
+	defer m.dockerLock.Unlock()
+
+	if m.DockerClient != nil {
+		m.DockerClient.Close()
+	}
+
+	if m.DockerTunnel != nil {
+		if err := m.DockerTunnel.Close(); err != nil {
+			logrus.Warnf("failed to close docker tunnel to machine: %v, error: %v", m.Name, err)
+		}
+	}
+
+	m.ExecClient.Close()
 }
@@ -0,0 +1,171 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machine
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine/sshtest"
+)
+
+func init() {
+	// the ssh test servers listen on the loopback ip, whose nodes are the local machine by default
+	SetLoopbackLocal(false)
+}
+
+func newTestSSHMachine(t *testing.T) (Machine, *sshtest.Server) {
+	server, err := sshtest.NewServer(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := NewMachine(server.Node("node1"))
+	if err != nil {
+		server.Close()
+		t.Fatal(err)
+	}
+	// the node of the server is connected by ssh though its ip is the loopback ip
+	assert.IsType(t, &SSHMachine{}, m)
+
+	return m, server
+}
+
+func TestSSHMachineRun(t *testing.T) {
+	m, server := newTestSSHMachine(t)
+	defer server.Close()
+	defer m.Close()
+
+	stderr, stdout, err := m.Run("echo hello; echo world >&2")
+	assert.NoError(t, err)
+	assert.Equal(t, "hello\n", string(stdout))
+	assert.Equal(t, "world\n", string(stderr))
+	assert.Equal(t, []string{"echo hello; echo world >&2"}, server.Commands())
+}
+
+func TestSSHMachinePutFile(t *testing.T) {
+	m, server := newTestSSHMachine(t)
+	defer server.Close()
+	defer m.Close()
+
+	remotePath := filepath.Join(server.Root, "etc", "file")
+	assert.NoError(t, m.PutFile(strings.NewReader("content"), remotePath, &FileOptions{Mode: 0600}))
+
+	content, err := ioutil.ReadFile(remotePath)
+	assert.NoError(t, err)
+	assert.Equal(t, "content", string(content))
+
+	info, err := os.Stat(remotePath)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	// the unchanged file is not transferred again
+	transferred := false
+	assert.NoError(t, m.PutFile(strings.NewReader("content"), remotePath, &FileOptions{
+		Progress: func(int64, int64) { transferred = true },
+	}))
+	assert.False(t, transferred)
+
+	localPath := filepath.Join(server.Root, "local", "file")
+	assert.NoError(t, m.FetchFile(localPath, remotePath))
+	content, err = ioutil.ReadFile(localPath)
+	assert.NoError(t, err)
+	assert.Equal(t, "content", string(content))
+}
+
+func TestSSHMachineDir(t *testing.T) {
+	m, server := newTestSSHMachine(t)
+	defer server.Close()
+	defer m.Close()
+
+	localDir := filepath.Join(server.Root, "local", "conf")
+	assert.NoError(t, os.MkdirAll(filepath.Join(localDir, "sub"), 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(localDir, "a.conf"), []byte("a"), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(localDir, "sub", "b.sh"), []byte("b"), 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(localDir, "sub", "skipped"), []byte("c"), 0644))
+
+	onlyConfAndScripts := func(path string) bool {
+		return strings.HasSuffix(path, ".conf") || strings.HasSuffix(path, ".sh")
+	}
+
+	remoteDir := filepath.Join(server.Root, "remote")
+	assert.NoError(t, m.PutDir(localDir, remoteDir, onlyConfAndScripts))
+
+	info, err := os.Stat(filepath.Join(remoteDir, "conf", "sub", "b.sh"))
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0755), info.Mode().Perm())
+	_, err = os.Stat(filepath.Join(remoteDir, "conf", "sub", "skipped"))
+	assert.True(t, os.IsNotExist(err))
+
+	fetchDir := filepath.Join(server.Root, "fetch")
+	assert.NoError(t, m.FetchDir(fetchDir, filepath.Join(remoteDir, "conf"), func(string) bool { return true }))
+	content, err := ioutil.ReadFile(filepath.Join(fetchDir, "conf", "a.conf"))
+	assert.NoError(t, err)
+	assert.Equal(t, "a", string(content))
+
+	assert.Error(t, m.FetchDir(fetchDir, filepath.Join(remoteDir, "missing"), func(string) bool { return true }))
+}
+
+func TestSSHMachineDocker(t *testing.T) {
+	m, server := newTestSSHMachine(t)
+	defer server.Close()
+	defer m.Close()
+
+	// serve a fake docker on a unix socket which is forwarded as the docker socket of the node
+	socketFile := filepath.Join(server.Root, "docker.sock")
+	listener, err := net.Listen("unix", socketFile)
+	assert.NoError(t, err)
+	defer listener.Close()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/_ping", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("API-Version", "1.40")
+		fmt.Fprint(w, "OK")
+	})
+	mux.HandleFunc("/v1.40/containers/json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"Id":"abc","Names":["/etcd"],"Image":"etcd:3.4","State":"running","Status":"Up 1 hour"}]`)
+	})
+	go http.Serve(listener, mux)
+
+	server.ForwardSocket("/var/run/docker.sock", socketFile)
+
+	// the docker tunnel is started once by concurrent operations
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			containers, err := m.ListContainers(false)
+			assert.NoError(t, err)
+			assert.Equal(t, []*Container{{ID: "abc", Name: "etcd", Image: "etcd:3.4", State: "running", Status: "Up 1 hour"}}, containers)
+		}()
+	}
+	wg.Wait()
+
+	tunnelSocket := m.(*SSHMachine).DockerTunnel.SocketFile()
+	m.Close()
+	_, err = os.Stat(filepath.Dir(tunnelSocket))
+	assert.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,368 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sshtest provides an in-process SSH server with SFTP for tests, so that machines
+// can be exercised end-to-end without network access.
+package sshtest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"sync"
+	"syscall"
+
+	"github.com/pkg/sftp"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/ssh"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	// User and Password are the credentials accepted by the server
+	User     = "root"
+	Password = "sshtest"
+)
+
+// Handler handles a command executed on the server and returns its exit status
+type Handler func(cmd string, stdin io.Reader, stdout, stderr io.Writer) int
+
+// ShellHandler runs commands by /bin/sh in dir, which is the home directory of the commands too.
+func ShellHandler(dir string) Handler {
+	return func(cmd string, stdin io.Reader, stdout, stderr io.Writer) int {
+		command := exec.Command("/bin/sh", "-c", cmd)
+		command.Dir = dir
+		command.Env = append(os.Environ(), "HOME="+dir)
+		command.Stdout = stdout
+		command.Stderr = stderr
+
+		// stdin is not waited for, otherwise the command never finishes if the client keeps stdin open
+		if pipe, err := command.StdinPipe(); err == nil {
+			go func() {
+				io.Copy(pipe, stdin)
+				pipe.Close()
+			}()
+		}
+
+		if err := command.Run(); err != nil {
+			if exitErr, ok := err.(*exec.ExitError); ok {
+				if status, ok := exitErr.Sys().(syscall.WaitStatus); ok {
+					return status.ExitStatus()
+				}
+			}
+			fmt.Fprintln(stderr, err)
+			return 127
+		}
+
+		return 0
+	}
+}
+
+// Server is an in-process SSH server listening on the loopback interface.
+// The SFTP subsystem operates on the host filesystem, tests should use paths under Root.
+type Server struct {
+	// Root is a temporary directory which is removed when the server is closed
+	Root string
+
+	handler  Handler
+	listener net.Listener
+	config   *ssh.ServerConfig
+
+	lock     sync.Mutex
+	commands []string
+	sockets  map[string]string
+	conns    map[net.Conn]struct{}
+	closed   bool
+	wg       sync.WaitGroup
+}
+
+// NewServer starts a server which runs commands by handler, or by ShellHandler(Root) if handler is nil.
+func NewServer(handler Handler) (*Server, error) {
+	root, err := ioutil.TempDir("", "sshtest")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create root directory, error: %v", err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		os.RemoveAll(root)
+		return nil, fmt.Errorf("failed to generate host key, error: %v", err)
+	}
+
+	hostKey, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		os.RemoveAll(root)
+		return nil, fmt.Errorf("failed to create host key signer, error: %v", err)
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		os.RemoveAll(root)
+		return nil, fmt.Errorf("failed to listen, error: %v", err)
+	}
+
+	if handler == nil {
+		handler = ShellHandler(root)
+	}
+
+	s := &Server{
+		Root:     root,
+		handler:  handler,
+		listener: listener,
+		sockets:  make(map[string]string),
+		conns:    make(map[net.Conn]struct{}),
+	}
+
+	s.config = &ssh.ServerConfig{
+		PasswordCallback: func(meta ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+			if meta.User() == User && string(password) == Password {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("password rejected for %v", meta.User())
+		},
+		// all public keys are accepted
+		PublicKeyCallback: func(meta ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			return nil, nil
+		},
+	}
+	s.config.AddHostKey(hostKey)
+
+	s.wg.Add(1)
+	go s.serve()
+
+	return s, nil
+}
+
+// Port returns the port the server listens on
+func (s *Server) Port() uint32 {
+	return uint32(s.listener.Addr().(*net.TCPAddr).Port)
+}
+
+// Node returns a node which connects the server by password. The nodes of loopback ips are the local
+// machine by default, so the tests connecting the server disable it by machine.SetLoopbackLocal(false).
+func (s *Server) Node(name string) *pb.Node {
+	return &pb.Node{
+		Name: name,
+		Ip:   "127.0.0.1",
+		Ssh: &pb.SSH{
+			Port: s.Port(),
+			Auth: &pb.Auth{
+				Type:       "password",
+				Username:   User,
+				Credential: Password,
+			},
+		},
+	}
+}
+
+// ForwardSocket makes the unix socket remotePath forwarded by clients connect localPath,
+// e.g. the docker socket of a node can be served by a fake docker.
+func (s *Server) ForwardSocket(remotePath, localPath string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.sockets[remotePath] = localPath
+}
+
+// Commands returns all commands executed on the server in order
+func (s *Server) Commands() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return append([]string(nil), s.commands...)
+}
+
+// Close stops the server, closes all connections and removes Root
+func (s *Server) Close() {
+	s.lock.Lock()
+	s.closed = true
+	s.listener.Close()
+	for conn := range s.conns {
+		conn.Close()
+	}
+	s.lock.Unlock()
+
+	s.wg.Wait()
+	os.RemoveAll(s.Root)
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		s.lock.Lock()
+		if s.closed {
+			s.lock.Unlock()
+			conn.Close()
+			return
+		}
+		s.conns[conn] = struct{}{}
+		s.wg.Add(1)
+		s.lock.Unlock()
+
+		go s.handleConn(conn)
+	}
+}
+
+func (s *Server) handleConn(conn net.Conn) {
+	defer func() {
+		conn.Close()
+		s.lock.Lock()
+		delete(s.conns, conn)
+		s.lock.Unlock()
+		s.wg.Done()
+	}()
+
+	serverConn, channels, requests, err := ssh.NewServerConn(conn, s.config)
+	if err != nil {
+		logrus.Debugf("ssh handshake failed, error: %v", err)
+		return
+	}
+	defer serverConn.Close()
+
+	go ssh.DiscardRequests(requests)
+
+	var wg sync.WaitGroup
+	for newChannel := range channels {
+		wg.Add(1)
+		go func(newChannel ssh.NewChannel) {
+			defer wg.Done()
+
+			switch newChannel.ChannelType() {
+			case "session":
+				s.handleSession(newChannel)
+			case "direct-streamlocal@openssh.com":
+				s.handleStreamLocal(newChannel)
+			default:
+				newChannel.Reject(ssh.UnknownChannelType, "unsupported channel type")
+			}
+		}(newChannel)
+	}
+	wg.Wait()
+}
+
+type execPayload struct {
+	Command string
+}
+
+type exitStatusPayload struct {
+	Status uint32
+}
+
+type streamLocalPayload struct {
+	SocketPath string
+	Reserved0  string
+	Reserved1  uint32
+}
+
+func (s *Server) handleSession(newChannel ssh.NewChannel) {
+	channel, requests, err := newChannel.Accept()
+	if err != nil {
+		return
+	}
+	defer channel.Close()
+
+	for req := range requests {
+		switch req.Type {
+		case "env":
+			req.Reply(true, nil)
+		case "exec":
+			var payload execPayload
+			if err := ssh.Unmarshal(req.Payload, &payload); err != nil {
+				req.Reply(false, nil)
+				continue
+			}
+			req.Reply(true, nil)
+
+			s.lock.Lock()
+			s.commands = append(s.commands, payload.Command)
+			s.lock.Unlock()
+
+			status := s.handler(payload.Command, channel, channel, channel.Stderr())
+			channel.SendRequest("exit-status", false, ssh.Marshal(&exitStatusPayload{Status: uint32(status)}))
+			return
+		case "subsystem":
+			var payload execPayload
+			if err := ssh.Unmarshal(req.Payload, &payload); err != nil || payload.Command != "sftp" {
+				req.Reply(false, nil)
+				continue
+			}
+			req.Reply(true, nil)
+
+			server, err := sftp.NewServer(channel)
+			if err != nil {
+				return
+			}
+			if err := server.Serve(); err != nil && err != io.EOF {
+				logrus.Debugf("sftp server stopped, error: %v", err)
+			}
+			return
+		default:
+			req.Reply(false, nil)
+		}
+	}
+}
+
+func (s *Server) handleStreamLocal(newChannel ssh.NewChannel) {
+	var payload streamLocalPayload
+	if err := ssh.Unmarshal(newChannel.ExtraData(), &payload); err != nil {
+		newChannel.Reject(ssh.ConnectionFailed, "invalid payload")
+		return
+	}
+
+	s.lock.Lock()
+	socketPath, ok := s.sockets[payload.SocketPath]
+	s.lock.Unlock()
+	if !ok {
+		socketPath = payload.SocketPath
+	}
+
+	conn, err := net.Dial("unix", socketPath)
+	if err != nil {
+		newChannel.Reject(ssh.ConnectionFailed, err.Error())
+		return
+	}
+	defer conn.Close()
+
+	channel, requests, err := newChannel.Accept()
+	if err != nil {
+		return
+	}
+	defer channel.Close()
+	go ssh.DiscardRequests(requests)
+
+	done := make(chan struct{})
+	go func() {
+		io.Copy(channel, conn)
+		channel.CloseWrite()
+		close(done)
+	}()
+	io.Copy(conn, channel)
+	if unixConn, ok := conn.(*net.UnixConn); ok {
+		unixConn.CloseWrite()
+	}
+	<-done
+}
@@ -0,0 +1,80 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sshtest
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ssh"
+)
+
+func dial(t *testing.T, s *Server, password string) (*ssh.Client, error) {
+	return ssh.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", s.Port()), &ssh.ClientConfig{
+		User:            User,
+		Auth:            []ssh.AuthMethod{ssh.Password(password)},
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+	})
+}
+
+func TestServerWithHandler(t *testing.T) {
+	s, err := NewServer(func(cmd string, stdin io.Reader, stdout, stderr io.Writer) int {
+		fmt.Fprintf(stdout, "out: %v", cmd)
+		fmt.Fprintf(stderr, "err: %v", cmd)
+		return 3
+	})
+	assert.NoError(t, err)
+	defer s.Close()
+
+	_, err = dial(t, s, "wrong")
+	assert.Error(t, err)
+
+	client, err := dial(t, s, Password)
+	assert.NoError(t, err)
+	defer client.Close()
+
+	session, err := client.NewSession()
+	assert.NoError(t, err)
+	defer session.Close()
+
+	output, err := session.CombinedOutput("hostname")
+	assert.Contains(t, string(output), "out: hostname")
+	assert.Contains(t, string(output), "err: hostname")
+	if exitErr, ok := err.(*ssh.ExitError); assert.True(t, ok) {
+		assert.Equal(t, 3, exitErr.ExitStatus())
+	}
+
+	assert.Equal(t, []string{"hostname"}, s.Commands())
+}
+
+func TestServerWithShell(t *testing.T) {
+	s, err := NewServer(nil)
+	assert.NoError(t, err)
+	defer s.Close()
+
+	client, err := dial(t, s, Password)
+	assert.NoError(t, err)
+	defer client.Close()
+
+	session, err := client.NewSession()
+	assert.NoError(t, err)
+	defer session.Close()
+
+	output, err := session.Output("pwd; echo $HOME")
+	assert.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("%v\n%v\n", s.Root, s.Root), string(output))
+}
@@ -0,0 +1,66 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"github.com/kpaas-io/kpaas/pkg/deploy/bundle"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+)
+
+// CheckOperation runs a check script of the script bundle on a machine,
+// the stdout of the script is the value to be checked.
+type CheckOperation struct {
+	operation.BaseOperation
+}
+
+// InitOperation runs an init or deployment script of the script bundle on a machine, the exit code of
+// the script is printed in the last line of stdout.
+type InitOperation struct {
+	operation.BaseOperation
+}
+
+// initRunnerTemplate is the template of the script which runs the init scripts with the rendered parameters
+const initRunnerTemplate = "run_init_step.sh"
+
+// initRunnerData is the data of the runner template
+type initRunnerData struct {
+	Script string
+	Args   []string
+}
+
+// NewInitOperation returns an operation running the init script with the arguments, which are rendered into
+// the runner template, so they are passed to the script as they are.
+func NewInitOperation(m machine.Machine, script string, args ...string) (operation.Operation, error) {
+	cmd, err := bundle.NewRenderedScriptCommand(m, initRunnerTemplate, &initRunnerData{Script: script, Args: args})
+	if err != nil {
+		return nil, err
+	}
+
+	ops := &InitOperation{}
+	ops.AddCommands(cmd)
+	return ops, nil
+}
+
+func NewCheckOperation(m machine.Machine, script string, args ...string) (operation.Operation, error) {
+	cmd, err := bundle.NewScriptCommand(m, script, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	ops := &CheckOperation{}
+	ops.AddCommands(cmd)
+	return ops, nil
+}
@@ -0,0 +1,146 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clock
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check"
+)
+
+const (
+	checkScript     = "check_time_sync.sh"
+	changeNTPScript = "init_change_ntp.sh"
+
+	ServiceNone = "none"
+)
+
+// Operation runs the time synchronization check script on a machine, and records
+// the time of the controller before and after running the script.
+type Operation struct {
+	operation.Operation
+	Sent     time.Time
+	Received time.Time
+}
+
+// TimeSync is the time synchronization state of a node
+type TimeSync struct {
+	// Service is the time synchronization service which is running, it's ServiceNone if there is no one.
+	Service      string
+	Synchronized bool
+	Time         time.Time
+}
+
+func NewCheckTimeSyncOperation(m machine.Machine) (*Operation, error) {
+	op, err := check.NewCheckOperation(m, checkScript)
+	if err != nil {
+		return nil, err
+	}
+	return &Operation{Operation: op}, nil
+}
+
+func (o *Operation) Do() (stderr, stdout []byte, err error) {
+	o.Sent = time.Now()
+	stderr, stdout, err = o.Operation.Do()
+	o.Received = time.Now()
+	return
+}
+
+// NewChangeNTPOperation returns an operation which configures the ntp servers on a machine
+// and steps the clock of the machine to the servers.
+func NewChangeNTPOperation(m machine.Machine, servers []string) (operation.Operation, error) {
+	if len(servers) == 0 {
+		return nil, fmt.Errorf("no ntp server is given")
+	}
+	return check.NewInitOperation(m, changeNTPScript, servers...)
+}
+
+// ParseTimeSync parses the output of the time synchronization check script
+func ParseTimeSync(output string) (*TimeSync, error) {
+	result := &TimeSync{}
+	var hasService, hasTime bool
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.SplitN(strings.TrimSpace(line), "=", 2)
+		if len(fields) != 2 {
+			continue
+		}
+
+		switch fields[0] {
+		case "service":
+			result.Service = fields[1]
+			hasService = true
+		case "synchronized":
+			result.Synchronized = fields[1] == "yes"
+		case "time":
+			seconds, err := strconv.ParseFloat(fields[1], 64)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse time %q, error: %v", fields[1], err)
+			}
+			integer, fraction := math.Modf(seconds)
+			result.Time = time.Unix(int64(integer), int64(fraction*float64(time.Second)))
+			hasTime = true
+		}
+	}
+
+	if !hasService || !hasTime {
+		return nil, fmt.Errorf("failed to parse time synchronization state from %q", output)
+	}
+	return result, nil
+}
+
+// Skew returns the minimum clock skew of a node which is consistent with the time sampled on the
+// node, as the controller only knows the time was sampled between sent and received. The skew is
+// positive if the clock of the node is ahead of the controller.
+func Skew(nodeTime, sent, received time.Time) time.Duration {
+	switch {
+	case nodeTime.Before(sent):
+		return nodeTime.Sub(sent)
+	case nodeTime.After(received):
+		return nodeTime.Sub(received)
+	default:
+		return 0
+	}
+}
+
+// CheckTimeSync checks that a time synchronization service is running and synchronized,
+// and the clock skew between the node and the controller is not larger than maxSkew.
+func CheckTimeSync(output string, sent, received time.Time, maxSkew time.Duration) error {
+	timeSync, err := ParseTimeSync(output)
+	if err != nil {
+		return err
+	}
+
+	var problems []string
+	if timeSync.Service == ServiceNone {
+		problems = append(problems, "no time synchronization service (chronyd, ntpd or systemd-timesyncd) is running")
+	} else if !timeSync.Synchronized {
+		problems = append(problems, fmt.Sprintf("%v is running but the clock is not synchronized", timeSync.Service))
+	}
+
+	if skew := Skew(timeSync.Time, sent, received); skew > maxSkew || skew < -maxSkew {
+		problems = append(problems, fmt.Sprintf("clock skew from the controller is %v, exceeds %v", skew, maxSkew))
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%v", strings.Join(problems, "; "))
+	}
+	return nil
+}
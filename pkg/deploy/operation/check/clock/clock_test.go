@@ -0,0 +1,117 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTimeSync(t *testing.T) {
+	tests := []struct {
+		output  string
+		want    *TimeSync
+		wantErr bool
+	}{
+		{
+			output: "service=chronyd\nsynchronized=yes\ntime=1573203600.250000000\n",
+			want: &TimeSync{
+				Service:      "chronyd",
+				Synchronized: true,
+				Time:         time.Unix(1573203600, 250000000),
+			},
+		},
+		{
+			output: "service=none\nsynchronized=no\ntime=1573203600\n",
+			want: &TimeSync{
+				Service: ServiceNone,
+				Time:    time.Unix(1573203600, 0),
+			},
+		},
+		{
+			output:  "service=ntpd\nsynchronized=yes\n",
+			wantErr: true,
+		},
+		{
+			output:  "service=ntpd\nsynchronized=yes\ntime=1573203600.N\n",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		timeSync, err := ParseTimeSync(test.output)
+		if test.wantErr {
+			assert.Error(t, err)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, test.want.Service, timeSync.Service)
+		assert.Equal(t, test.want.Synchronized, timeSync.Synchronized)
+		assert.WithinDuration(t, test.want.Time, timeSync.Time, time.Microsecond)
+	}
+}
+
+func TestSkew(t *testing.T) {
+	sent := time.Unix(1573203600, 0)
+	received := sent.Add(200 * time.Millisecond)
+
+	assert.Equal(t, time.Duration(0), Skew(sent.Add(100*time.Millisecond), sent, received))
+	assert.Equal(t, 2*time.Second, Skew(received.Add(2*time.Second), sent, received))
+	assert.Equal(t, -3*time.Second, Skew(sent.Add(-3*time.Second), sent, received))
+}
+
+func TestCheckTimeSync(t *testing.T) {
+	sent := time.Unix(1573203600, 0)
+	received := sent.Add(100 * time.Millisecond)
+
+	tests := []struct {
+		output  string
+		wantErr bool
+	}{
+		{
+			output: "service=chronyd\nsynchronized=yes\ntime=1573203600.050000000",
+		},
+		{
+			output: "service=systemd-timesyncd\nsynchronized=yes\ntime=1573203600.900000000",
+		},
+		{
+			output:  "service=none\nsynchronized=no\ntime=1573203600.050000000",
+			wantErr: true,
+		},
+		{
+			output:  "service=ntpd\nsynchronized=no\ntime=1573203600.050000000",
+			wantErr: true,
+		},
+		{
+			output:  "service=chronyd\nsynchronized=yes\ntime=1573203602.000000000",
+			wantErr: true,
+		},
+		{
+			output:  "service=chronyd\nsynchronized=yes\ntime=1573203598.000000000",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		err := CheckTimeSync(test.output, sent, received, time.Second)
+		if test.wantErr {
+			assert.Error(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containerd
+
+import (
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check"
+)
+
+const (
+	script = "check_containerd_version.sh"
+)
+
+func NewCheckContainerdOperation(m machine.Machine) (operation.Operation, error) {
+	return check.NewCheckOperation(m, script)
+}
+
+// check if containerd version satisfies the version constraint
+func CheckContainerdVersion(containerdVersion string, versionConstraint string) error {
+	return operation.CheckVersion(containerdVersion, versionConstraint)
+}
@@ -0,0 +1,44 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containerd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckContainerdVersion(t *testing.T) {
+	tests := []struct {
+		version string
+		wantErr bool
+	}{
+		{version: "1.2.10"},
+		{version: "1.3.0"},
+		{version: "1.2.10-1"},
+		{version: "v1.3.0-beta.1"},
+		{version: "1.1.7", wantErr: true},
+		{version: "", wantErr: true},
+	}
+
+	for _, test := range tests {
+		err := CheckContainerdVersion(test.version, "1.2.0")
+		if test.wantErr {
+			assert.Error(t, err, test.version)
+		} else {
+			assert.NoError(t, err, test.version)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cri checks and configures the container runtime which kubelet talks to, it could be docker or containerd.
+package cri
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	checkSocketScript       = "check_cri_socket.sh"
+	checkCgroupDriverScript = "check_cgroup_driver.sh"
+	initScript              = "init_container_runtime.sh"
+
+	CgroupDriverSystemd  = "systemd"
+	CgroupDriverCgroupfs = "cgroupfs"
+	initSystemd          = "systemd"
+)
+
+// Runtime returns the container runtime of the cluster config, it's docker if not set
+func Runtime(clusterConfig *pb.ClusterConfig) (consts.ContainerRuntime, error) {
+	switch runtime := consts.ContainerRuntime(clusterConfig.GetContainerRuntime()); runtime {
+	case "":
+		return consts.ContainerRuntimeDocker, nil
+	case consts.ContainerRuntimeDocker, consts.ContainerRuntimeContainerd:
+		return runtime, nil
+	default:
+		return "", fmt.Errorf("unknown container runtime %q", runtime)
+	}
+}
+
+// Socket returns the socket path of the container runtime
+func Socket(runtime consts.ContainerRuntime) string {
+	switch runtime {
+	case consts.ContainerRuntimeContainerd:
+		return "/run/containerd/containerd.sock"
+	default:
+		return "/var/run/docker.sock"
+	}
+}
+
+// CgroupDriver is the cgroup driver of the container runtime and the init system of a node
+type CgroupDriver struct {
+	Driver        string
+	SystemdIsInit bool
+}
+
+func NewCheckSocketOperation(m machine.Machine, runtime consts.ContainerRuntime) (operation.Operation, error) {
+	return check.NewCheckOperation(m, checkSocketScript, string(runtime))
+}
+
+func NewCheckCgroupDriverOperation(m machine.Machine, runtime consts.ContainerRuntime) (operation.Operation, error) {
+	return check.NewCheckOperation(m, checkCgroupDriverScript, string(runtime))
+}
+
+// NewInitOperation returns an operation which installs containerd if it's missing, enables its cri plugin,
+// and sets the cgroup driver of the container runtime to systemd, the runtime is restarted.
+func NewInitOperation(m machine.Machine, runtime consts.ContainerRuntime) (operation.Operation, error) {
+	return check.NewInitOperation(m, initScript, string(runtime))
+}
+
+// parseKeyValues parses the "key=value" lines of the output of a script
+func parseKeyValues(output string) map[string]string {
+	values := make(map[string]string)
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.SplitN(strings.TrimSpace(line), "=", 2)
+		if len(fields) == 2 {
+			values[fields[0]] = fields[1]
+		}
+	}
+	return values
+}
+
+// CheckSocket checks the output of the socket check script
+func CheckSocket(output string) error {
+	values := parseKeyValues(output)
+	ready, ok := values["ready"]
+	if !ok {
+		return fmt.Errorf("failed to parse cri socket state from %q", output)
+	}
+	if ready != "yes" {
+		return fmt.Errorf("cri socket %v is not ready: %v", values["socket"], values["error"])
+	}
+	return nil
+}
+
+// ParseCgroupDriver parses the output of the cgroup driver check script
+func ParseCgroupDriver(output string) (*CgroupDriver, error) {
+	values := parseKeyValues(output)
+	driver, hasDriver := values["driver"]
+	initSystem, hasInit := values["init"]
+	if !hasDriver || !hasInit {
+		return nil, fmt.Errorf("failed to parse cgroup driver from %q", output)
+	}
+
+	return &CgroupDriver{
+		Driver:        driver,
+		SystemdIsInit: initSystem == initSystemd,
+	}, nil
+}
+
+// CheckCgroupDriver checks the cgroup driver of the container runtime is systemd if systemd is the
+// init system, as two cgroup managers make the node unstable under resource pressure, or cgroupfs otherwise.
+func CheckCgroupDriver(output string) error {
+	cgroupDriver, err := ParseCgroupDriver(output)
+	if err != nil {
+		return err
+	}
+
+	want := CgroupDriverCgroupfs
+	if cgroupDriver.SystemdIsInit {
+		want = CgroupDriverSystemd
+	}
+
+	switch cgroupDriver.Driver {
+	case want:
+		return nil
+	case CgroupDriverSystemd, CgroupDriverCgroupfs:
+		return fmt.Errorf("cgroup driver is %v, but it should be %v", cgroupDriver.Driver, want)
+	default:
+		return fmt.Errorf("unknown cgroup driver %q, is the container runtime installed?", cgroupDriver.Driver)
+	}
+}
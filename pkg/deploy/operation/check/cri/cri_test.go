@@ -0,0 +1,80 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cri
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestRuntime(t *testing.T) {
+	runtime, err := Runtime(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, consts.ContainerRuntimeDocker, runtime)
+
+	runtime, err = Runtime(&pb.ClusterConfig{ContainerRuntime: "containerd"})
+	assert.NoError(t, err)
+	assert.Equal(t, consts.ContainerRuntimeContainerd, runtime)
+	assert.Equal(t, "/run/containerd/containerd.sock", Socket(runtime))
+
+	_, err = Runtime(&pb.ClusterConfig{ContainerRuntime: "rkt"})
+	assert.Error(t, err)
+}
+
+func TestCheckSocket(t *testing.T) {
+	assert.NoError(t, CheckSocket("socket=/run/containerd/containerd.sock\nready=yes\n"))
+	assert.EqualError(t, CheckSocket("socket=/run/containerd/containerd.sock\nready=no\nerror=the cri plugin of containerd is not loaded\n"),
+		"cri socket /run/containerd/containerd.sock is not ready: the cri plugin of containerd is not loaded")
+	assert.Error(t, CheckSocket(""))
+}
+
+func TestCheckCgroupDriver(t *testing.T) {
+	tests := []struct {
+		output  string
+		wantErr string
+	}{
+		{
+			output: "driver=systemd\ninit=systemd\n",
+		},
+		{
+			output: "driver=cgroupfs\ninit=other\n",
+		},
+		{
+			output:  "driver=cgroupfs\ninit=systemd\n",
+			wantErr: "cgroup driver is cgroupfs, but it should be systemd",
+		},
+		{
+			output:  "driver=unknown\ninit=systemd\n",
+			wantErr: `unknown cgroup driver "unknown"`,
+		},
+		{
+			output:  "driver=systemd\n",
+			wantErr: "failed to parse cgroup driver",
+		},
+	}
+
+	for _, test := range tests {
+		err := CheckCgroupDriver(test.output)
+		if test.wantErr == "" {
+			assert.NoError(t, err)
+		} else if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), test.wantErr)
+		}
+	}
+}
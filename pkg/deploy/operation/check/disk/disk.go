@@ -0,0 +1,113 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disk
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	diskProbeScript = "disk_probe.sh"
+
+	// DefaultEtcdDataDir is the data directory of etcd if it's not set in the cluster config
+	DefaultEtcdDataDir = "/var/lib/etcd"
+	// FsyncCount is the number of wal records written by the probe, which takes about 5 seconds
+	// on a disk with the suggested latency
+	FsyncCount = 500
+	// WriteMiB is the size written sequentially by the probe
+	WriteMiB = 64
+)
+
+// FsyncResult is the fdatasync latency measured by the probe
+type FsyncResult struct {
+	Count int     `json:"count"`
+	P50Ms float64 `json:"p50Ms"`
+	P99Ms float64 `json:"p99Ms"`
+	MaxMs float64 `json:"maxMs"`
+}
+
+// WriteResult is the sequential write throughput measured by the probe
+type WriteResult struct {
+	MiB     int     `json:"mib"`
+	Seconds float64 `json:"seconds"`
+	MiBps   float64 `json:"mibps"`
+}
+
+// ProbeResult is the output of the disk probe
+type ProbeResult struct {
+	Dir   string       `json:"dir"`
+	Fsync *FsyncResult `json:"fsync"`
+	Write *WriteResult `json:"write"`
+	Error string       `json:"error"`
+}
+
+// EtcdDataDir returns the etcd data directory of the cluster config
+func EtcdDataDir(clusterConfig *pb.ClusterConfig) string {
+	if dir := clusterConfig.GetEtcdDataDir(); dir != "" {
+		return dir
+	}
+	return DefaultEtcdDataDir
+}
+
+// NewProbeOperation returns an operation which measures the disk performance of dir on a machine
+func NewProbeOperation(m machine.Machine, dir string) (operation.Operation, error) {
+	return check.NewCheckOperation(m, diskProbeScript, dir, strconv.Itoa(FsyncCount), strconv.Itoa(WriteMiB))
+}
+
+// ParseProbeResult parses the output of the disk probe
+func ParseProbeResult(output string) (*ProbeResult, error) {
+	result := &ProbeResult{}
+	if err := json.Unmarshal([]byte(output), result); err != nil {
+		return nil, fmt.Errorf("failed to parse disk probe result %q, error: %v", output, err)
+	}
+	if result.Error != "" {
+		return nil, fmt.Errorf("failed to probe disk of %v, error: %v", result.Dir, result.Error)
+	}
+	if result.Fsync == nil || result.Write == nil {
+		return nil, fmt.Errorf("incomplete disk probe result %q", output)
+	}
+	return result, nil
+}
+
+func (r *ProbeResult) String() string {
+	return fmt.Sprintf("fsync p99 %.2fms (p50 %.2fms, max %.2fms), sequential write %.1fMiB/s",
+		r.Fsync.P99Ms, r.Fsync.P50Ms, r.Fsync.MaxMs, r.Write.MiBps)
+}
+
+// CheckEtcdDisk checks the 99th percentile of the fsync latency is at most maxFsyncP99Ms,
+// and the sequential write throughput is at least minWriteMiBps.
+func CheckEtcdDisk(output string, maxFsyncP99Ms, minWriteMiBps float64) error {
+	result, err := ParseProbeResult(output)
+	if err != nil {
+		return err
+	}
+
+	if result.Fsync.P99Ms > maxFsyncP99Ms {
+		return fmt.Errorf("fsync p99 of %v is %.2fms, which should be at most %vms, %v",
+			result.Dir, result.Fsync.P99Ms, maxFsyncP99Ms, result)
+	}
+	if result.Write.MiBps < minWriteMiBps {
+		return fmt.Errorf("sequential write throughput of %v is %.1fMiB/s, which should be at least %vMiB/s, %v",
+			result.Dir, result.Write.MiBps, minWriteMiBps, result)
+	}
+	return nil
+}
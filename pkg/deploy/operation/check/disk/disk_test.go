@@ -0,0 +1,78 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	fastDisk = `{"dir": "/var/lib/etcd", "fsync": {"count": 500, "p50Ms": 0.5, "p99Ms": 2.25, "maxMs": 4.0}, ` +
+		`"write": {"mib": 64, "seconds": 0.5, "mibps": 128.0}, "error": ""}`
+	slowFsyncDisk = `{"dir": "/var/lib/etcd", "fsync": {"count": 500, "p50Ms": 8.0, "p99Ms": 25.5, "maxMs": 40.0}, ` +
+		`"write": {"mib": 64, "seconds": 0.5, "mibps": 128.0}, "error": ""}`
+	slowWriteDisk = `{"dir": "/var/lib/etcd", "fsync": {"count": 500, "p50Ms": 0.5, "p99Ms": 2.25, "maxMs": 4.0}, ` +
+		`"write": {"mib": 64, "seconds": 8.0, "mibps": 8.0}, "error": ""}`
+	readOnlyDisk = `{"dir": "/var/lib/etcd", "fsync": null, "write": null, "error": "[Errno 30] Read-only file system"}`
+)
+
+func TestEtcdDataDir(t *testing.T) {
+	assert.Equal(t, DefaultEtcdDataDir, EtcdDataDir(nil))
+	assert.Equal(t, "/data/etcd", EtcdDataDir(&pb.ClusterConfig{EtcdDataDir: "/data/etcd"}))
+}
+
+func TestCheckEtcdDisk(t *testing.T) {
+	tests := []struct {
+		output  string
+		wantErr string
+	}{
+		{
+			output: fastDisk,
+		},
+		{
+			output:  slowFsyncDisk,
+			wantErr: "fsync p99 of /var/lib/etcd is 25.50ms, which should be at most 10ms",
+		},
+		{
+			output:  slowWriteDisk,
+			wantErr: "sequential write throughput of /var/lib/etcd is 8.0MiB/s, which should be at least 20MiB/s",
+		},
+		{
+			output:  readOnlyDisk,
+			wantErr: "Read-only file system",
+		},
+		{
+			output:  "error: python is required to check the disk",
+			wantErr: "failed to parse disk probe result",
+		},
+	}
+
+	for _, test := range tests {
+		err := CheckEtcdDisk(test.output, 10, 20)
+		if test.wantErr == "" {
+			assert.NoError(t, err)
+		} else if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), test.wantErr)
+		}
+	}
+
+	result, err := ParseProbeResult(fastDisk)
+	assert.NoError(t, err)
+	assert.Equal(t, "fsync p99 2.25ms (p50 0.50ms, max 4.00ms), sequential write 128.0MiB/s", result.String())
+}
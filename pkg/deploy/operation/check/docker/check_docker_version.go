@@ -15,45 +15,22 @@
 package docker
 
 import (
-	"github.com/kpaas-io/kpaas/pkg/deploy/assets"
-	"github.com/kpaas-io/kpaas/pkg/deploy/command"
 	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
 	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
-	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check"
 )
 
 const (
-	script    = "/scripts/check_docker_version.sh"
-	remoteDir = "/tmp"
+	script = "check_docker_version.sh"
 )
 
-type CheckDockerOperation struct {
-	operation.BaseOperation
+func NewCheckDockerOperation(m machine.Machine) (operation.Operation, error) {
+	return check.NewCheckOperation(m, script)
 }
 
-func NewCheckDockerOperation(config *pb.NodeCheckConfig) (operation.Operation, error) {
-	ops := &CheckDockerOperation{}
-	m, err := machine.NewMachine(config.Node)
-	if err != nil {
-		return nil, err
-	}
-
-	scriptFile, err := assets.Assets.Open(script)
-	if err != nil {
-		return nil, err
-	}
-
-	if err := m.PutFile(scriptFile, remoteDir+script); err != nil {
-		return nil, err
-	}
-
-	ops.AddCommands(command.NewShellCommand(m, "bash", remoteDir+script, nil))
-	return ops, nil
-}
-
-// check docker version if version larger or equal than standard version
-func CheckDockerVersion(dockerVersion string, standardVersion string, comparedSymbol string) error {
-	err := operation.CheckVersion(dockerVersion, standardVersion, comparedSymbol)
+// check if docker version satisfies the version constraint, e.g. ">=18.09 <20"
+func CheckDockerVersion(dockerVersion string, versionConstraint string) error {
+	err := operation.CheckVersion(dockerVersion, versionConstraint)
 	if err != nil {
 		return err
 	}
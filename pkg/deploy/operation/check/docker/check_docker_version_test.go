@@ -58,9 +58,24 @@ func TestCheckDockerVersion(t *testing.T) {
 			desiredVersion:  desiredDockerVersion,
 			want:            fmt.Errorf("%v, desired version: %v, actual version: 17.03.1-ee-7", versionTooLow, desiredDockerVersion),
 		},
+		{
+			comparedVersion: "19.03.5-ce",
+			desiredVersion:  desiredDockerVersion,
+			want:            nil,
+		},
+		{
+			comparedVersion: "19.03.5-ce",
+			desiredVersion:  ">=18.09 <19",
+			want:            fmt.Errorf("version too high, desired version: >=18.09 <19, actual version: 19.03.5-ce"),
+		},
+		{
+			comparedVersion: "18.09.7",
+			desiredVersion:  ">=18.09 <20",
+			want:            nil,
+		},
 	}
 
 	for _, eachValue := range testSample {
-		assert.Equal(t, eachValue.want, CheckDockerVersion(eachValue.comparedVersion, eachValue.desiredVersion, ">"))
+		assert.Equal(t, eachValue.want, CheckDockerVersion(eachValue.comparedVersion, eachValue.desiredVersion))
 	}
 }
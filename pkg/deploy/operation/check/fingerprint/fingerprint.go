@@ -0,0 +1,53 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fingerprint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check"
+)
+
+const (
+	script = "node_fingerprint.sh"
+)
+
+// NewFingerprintOperation returns an operation which prints the facts of a node, e.g. the kernel
+// release and the boot id, which the results of the check items depend on
+func NewFingerprintOperation(m machine.Machine) (operation.Operation, error) {
+	return check.NewCheckOperation(m, script)
+}
+
+// Fingerprint returns the fingerprint of the facts of a node and the inputs of checking, e.g. the
+// roles and the profile, the fingerprint is changed if any of them is changed
+func Fingerprint(facts string, inputs ...string) string {
+	hash := sha256.New()
+	for _, line := range strings.Split(facts, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			hash.Write([]byte(line))
+			hash.Write([]byte{'\n'})
+		}
+	}
+	for _, input := range inputs {
+		// the separator keeps the inputs from running into each other
+		hash.Write([]byte{0})
+		hash.Write([]byte(input))
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,35 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fingerprint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFingerprint(t *testing.T) {
+	facts := "hostname=node1\nboot=6c5e3861\nkernel=4.19.91\n"
+	fingerprint := Fingerprint(facts, "master", "profile")
+
+	assert.Len(t, fingerprint, 64)
+	// blank lines and the spaces around the facts don't matter
+	assert.Equal(t, fingerprint, Fingerprint("\n  hostname=node1\nboot=6c5e3861 \nkernel=4.19.91\n\n", "master", "profile"))
+	// the node rebooted
+	assert.NotEqual(t, fingerprint, Fingerprint("hostname=node1\nboot=0f1e2d3c\nkernel=4.19.91\n", "master", "profile"))
+	// the inputs changed
+	assert.NotEqual(t, fingerprint, Fingerprint(facts, "master", "lab"))
+	assert.NotEqual(t, fingerprint, Fingerprint(facts, "masterprofile"))
+}
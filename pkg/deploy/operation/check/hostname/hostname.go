@@ -0,0 +1,170 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hostname
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check"
+)
+
+const (
+	checkScript = "check_hostname.sh"
+	// ChangeHostnameScript sets the hostname of a node
+	ChangeHostnameScript = "init_change_hostname.sh"
+	// ChangeHostAliasScript sets the host entries of /etc/hosts on a node
+	ChangeHostAliasScript = "init_change_hostalias.sh"
+
+	maxHostnameLength = 253
+	maxLabelLength    = 63
+)
+
+var hostnameRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+
+// HostsEntry is an entry of /etc/hosts
+type HostsEntry struct {
+	IP    string
+	Names []string
+}
+
+// HostInfo is the hostname information gathered from a node
+type HostInfo struct {
+	Hostname  string
+	FQDN      string
+	PrimaryIP string
+	Hosts     []HostsEntry
+	// Resolved are the ipv4 addresses of the names resolved on the node
+	Resolved map[string][]string
+}
+
+// NewCheckHostnameOperation gathers the hostname information of a node, and resolves the names on the node
+func NewCheckHostnameOperation(m machine.Machine, names []string) (operation.Operation, error) {
+	return check.NewCheckOperation(m, checkScript, names...)
+}
+
+// NewChangeHostnameOperation sets the hostname of a node
+func NewChangeHostnameOperation(m machine.Machine, hostname string) (operation.Operation, error) {
+	if err := ValidateHostname(hostname); err != nil {
+		return nil, err
+	}
+	return check.NewInitOperation(m, ChangeHostnameScript, hostname)
+}
+
+// NewChangeHostAliasOperation sets the ips of the names in /etc/hosts of a node, the aliases are keyed by name
+func NewChangeHostAliasOperation(m machine.Machine, aliases map[string]string) (operation.Operation, error) {
+	return check.NewInitOperation(m, ChangeHostAliasScript, HostAliasArgs(aliases)...)
+}
+
+// HostAliasArgs returns the arguments of the host alias script, which are the ips and names sorted by name
+func HostAliasArgs(aliases map[string]string) []string {
+	names := make([]string, 0, len(aliases))
+	for name := range aliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	args := make([]string, 0, 2*len(names))
+	for _, name := range names {
+		args = append(args, aliases[name], name)
+	}
+	return args
+}
+
+// ParseHostInfo parses the output of the hostname check script
+func ParseHostInfo(output string) (*HostInfo, error) {
+	info := &HostInfo{
+		Resolved: make(map[string][]string),
+	}
+	var hasHostname bool
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.SplitN(strings.TrimSpace(line), "=", 2)
+		if len(fields) != 2 {
+			continue
+		}
+
+		values := strings.Fields(fields[1])
+		switch fields[0] {
+		case "hostname":
+			info.Hostname = strings.TrimSpace(fields[1])
+			hasHostname = info.Hostname != ""
+		case "fqdn":
+			info.FQDN = strings.TrimSpace(fields[1])
+		case "ip":
+			info.PrimaryIP = strings.TrimSpace(fields[1])
+		case "hosts":
+			if len(values) >= 2 {
+				info.Hosts = append(info.Hosts, HostsEntry{IP: values[0], Names: values[1:]})
+			}
+		case "resolve":
+			if len(values) >= 1 {
+				info.Resolved[values[0]] = values[1:]
+			}
+		}
+	}
+
+	if !hasHostname {
+		return nil, fmt.Errorf("failed to parse hostname from %q", output)
+	}
+	return info, nil
+}
+
+// ResolvesTo returns if the name is resolved to the ip on the node
+func (h *HostInfo) ResolvesTo(name, ip string) bool {
+	for _, resolved := range h.Resolved[name] {
+		if resolved == ip {
+			return true
+		}
+	}
+	return false
+}
+
+// HostsIPs returns the ips of the name in /etc/hosts of the node
+func (h *HostInfo) HostsIPs(name string) []string {
+	var ips []string
+	for _, entry := range h.Hosts {
+		for _, entryName := range entry.Names {
+			if entryName == name {
+				ips = append(ips, entry.IP)
+				break
+			}
+		}
+	}
+	return ips
+}
+
+// ValidateHostname checks that the hostname is a lowercase RFC 1123 subdomain, which is required for node names
+func ValidateHostname(hostname string) error {
+	if len(hostname) == 0 {
+		return fmt.Errorf("hostname is empty")
+	}
+	if len(hostname) > maxHostnameLength {
+		return fmt.Errorf("hostname %q is longer than %d characters", hostname, maxHostnameLength)
+	}
+	if !hostnameRegexp.MatchString(hostname) {
+		return fmt.Errorf("hostname %q is not a valid RFC 1123 subdomain: it must consist of lowercase alphanumeric characters, '-' or '.', "+
+			"and must start and end with an alphanumeric character", hostname)
+	}
+	for _, label := range strings.Split(hostname, ".") {
+		if len(label) > maxLabelLength {
+			return fmt.Errorf("hostname %q has a label longer than %d characters", hostname, maxLabelLength)
+		}
+	}
+	return nil
+}
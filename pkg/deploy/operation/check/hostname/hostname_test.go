@@ -0,0 +1,88 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hostname
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseHostInfo(t *testing.T) {
+	output := `hostname=node1
+fqdn=node1.example.com
+ip=192.168.31.11
+hosts=127.0.0.1 localhost localhost.localdomain
+hosts=192.168.31.12 node2
+resolve=node1 192.168.31.11
+resolve=node2 192.168.31.12 
+resolve=node3 
+`
+	info, err := ParseHostInfo(output)
+	assert.NoError(t, err)
+	assert.Equal(t, &HostInfo{
+		Hostname:  "node1",
+		FQDN:      "node1.example.com",
+		PrimaryIP: "192.168.31.11",
+		Hosts: []HostsEntry{
+			{IP: "127.0.0.1", Names: []string{"localhost", "localhost.localdomain"}},
+			{IP: "192.168.31.12", Names: []string{"node2"}},
+		},
+		Resolved: map[string][]string{
+			"node1": {"192.168.31.11"},
+			"node2": {"192.168.31.12"},
+			"node3": {},
+		},
+	}, info)
+
+	assert.True(t, info.ResolvesTo("node2", "192.168.31.12"))
+	assert.False(t, info.ResolvesTo("node2", "192.168.31.13"))
+	assert.False(t, info.ResolvesTo("node3", "192.168.31.13"))
+	assert.False(t, info.ResolvesTo("node4", "192.168.31.14"))
+	assert.Equal(t, []string{"192.168.31.12"}, info.HostsIPs("node2"))
+	assert.Equal(t, []string{"127.0.0.1"}, info.HostsIPs("localhost.localdomain"))
+	assert.Empty(t, info.HostsIPs("node3"))
+
+	_, err = ParseHostInfo("fqdn=\nip=192.168.31.11\n")
+	assert.Error(t, err)
+}
+
+func TestValidateHostname(t *testing.T) {
+	tests := []struct {
+		hostname string
+		wantErr  bool
+	}{
+		{hostname: "node1"},
+		{hostname: "node-1.example.com"},
+		{hostname: "1node"},
+		{hostname: "", wantErr: true},
+		{hostname: "Node1", wantErr: true},
+		{hostname: "node_1", wantErr: true},
+		{hostname: "-node1", wantErr: true},
+		{hostname: "node1.", wantErr: true},
+		{hostname: strings.Repeat("a", 64), wantErr: true},
+		{hostname: strings.Repeat("a.", 127) + "a", wantErr: true},
+	}
+
+	for _, test := range tests {
+		err := ValidateHostname(test.hostname)
+		if test.wantErr {
+			assert.Error(t, err, test.hostname)
+		} else {
+			assert.NoError(t, err, test.hostname)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubelet
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check"
+)
+
+const (
+	script = "check_kubelet_version.sh"
+)
+
+func NewCheckKubeletVersionOperation(m machine.Machine) (operation.Operation, error) {
+	return check.NewCheckOperation(m, script)
+}
+
+// VersionConstraint returns the constraint of the kubelet version for a kubernetes version, kubelet
+// should be the same minor version as the cluster, e.g. "~1.17.0" for "v1.17.3"
+func VersionConstraint(kubernetesVersion string) (string, error) {
+	version, err := operation.ParseVersion(kubernetesVersion)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("~%v.%v.0", version.Major, version.Minor), nil
+}
+
+// check if the installed kubelet version satisfies the kubernetes version of the cluster,
+// it's passed if kubelet is not installed, which will be installed by the deployment
+func CheckKubeletVersion(kubeletVersion string, kubernetesVersion string) error {
+	kubeletVersion = strings.TrimSpace(kubeletVersion)
+	if kubeletVersion == "" {
+		return nil
+	}
+
+	constraint, err := VersionConstraint(kubernetesVersion)
+	if err != nil {
+		return err
+	}
+	return operation.CheckVersion(kubeletVersion, constraint)
+}
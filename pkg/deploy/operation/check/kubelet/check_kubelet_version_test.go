@@ -0,0 +1,45 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubelet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckKubeletVersion(t *testing.T) {
+	tests := []struct {
+		kubeletVersion    string
+		kubernetesVersion string
+		wantErr           bool
+	}{
+		{kubeletVersion: "", kubernetesVersion: "v1.17.0"},
+		{kubeletVersion: "1.17.3\n", kubernetesVersion: "v1.17.0"},
+		{kubeletVersion: "1.17.0", kubernetesVersion: "1.17.3"},
+		{kubeletVersion: "1.16.4", kubernetesVersion: "v1.17.0", wantErr: true},
+		{kubeletVersion: "1.18.0", kubernetesVersion: "v1.17.0", wantErr: true},
+		{kubeletVersion: "1.17.0", kubernetesVersion: "latest", wantErr: true},
+	}
+
+	for _, test := range tests {
+		err := CheckKubeletVersion(test.kubeletVersion, test.kubernetesVersion)
+		if test.wantErr {
+			assert.Error(t, err, test.kubeletVersion)
+		} else {
+			assert.NoError(t, err, test.kubeletVersion)
+		}
+	}
+}
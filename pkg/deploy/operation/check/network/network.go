@@ -0,0 +1,132 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package network
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check"
+)
+
+const (
+	netProbeScript = "net_probe.sh"
+
+	// BGPPort is used by calico to exchange routes
+	BGPPort uint32 = 179
+	// VXLANPort is the vxlan_port in charts/calico/Values.yaml
+	VXLANPort uint32 = 4789
+	// DesiredPathMTU is the veth_mtu in charts/calico/Values.yaml plus the 50 bytes of vxlan overhead
+	DesiredPathMTU uint32 = 1450
+)
+
+var (
+	// TCPPorts are the tcp ports used by the CNI between nodes
+	TCPPorts = []uint32{BGPPort}
+	// UDPPorts are the udp ports used by the CNI between nodes
+	UDPPorts = []uint32{VXLANPort}
+)
+
+// ProbeServer is the output of starting the probe server
+type ProbeServer struct {
+	Pid int `json:"pid"`
+	// TCP and UDP are the ports bound by the server, the ports in use by others are not included
+	TCP    []uint32 `json:"tcp"`
+	UDP    []uint32 `json:"udp"`
+	Errors []string `json:"errors"`
+}
+
+// PortResult is the result of probing a port
+type PortResult struct {
+	Port  uint32 `json:"port"`
+	OK    bool   `json:"ok"`
+	Error string `json:"error"`
+}
+
+// ICMPResult is the result of ping
+type ICMPResult struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error"`
+}
+
+// MTUResult is the result of probing the path mtu, PathMTU is the largest mtu which works
+type MTUResult struct {
+	OK      bool   `json:"ok"`
+	PathMTU uint32 `json:"pathMTU"`
+	Error   string `json:"error"`
+}
+
+// ProbeResult is the output of probing a node from another node
+type ProbeResult struct {
+	TCP  []*PortResult `json:"tcp"`
+	UDP  []*PortResult `json:"udp"`
+	ICMP *ICMPResult   `json:"icmp"`
+	MTU  *MTUResult    `json:"mtu"`
+}
+
+func joinPorts(ports []uint32) string {
+	if len(ports) == 0 {
+		return "-"
+	}
+
+	s := make([]string, 0, len(ports))
+	for _, p := range ports {
+		s = append(s, strconv.Itoa(int(p)))
+	}
+	return strings.Join(s, ",")
+}
+
+// NewStartProbeServerOperation starts the probe server on the node, it exits after seconds
+func NewStartProbeServerOperation(m machine.Machine, seconds int, tcpPorts, udpPorts []uint32) (operation.Operation, error) {
+	return check.NewCheckOperation(m, netProbeScript, "serve", strconv.Itoa(seconds), joinPorts(tcpPorts), joinPorts(udpPorts))
+}
+
+// NewStopProbeServerOperation stops the probe server with the pid
+func NewStopProbeServerOperation(m machine.Machine, pid int) (operation.Operation, error) {
+	return check.NewCheckOperation(m, netProbeScript, "stop", strconv.Itoa(pid))
+}
+
+// NewProbeOperation probes the ports, icmp and path mtu of ip from the node
+func NewProbeOperation(m machine.Machine, ip string, tcpPorts, udpPorts []uint32, mtu uint32) (operation.Operation, error) {
+	return check.NewCheckOperation(m, netProbeScript, "probe", ip, joinPorts(tcpPorts), joinPorts(udpPorts), strconv.Itoa(int(mtu)))
+}
+
+// ParseProbeServer parses the output of starting the probe server
+func ParseProbeServer(stdout string) (*ProbeServer, error) {
+	server := &ProbeServer{}
+	if err := json.Unmarshal([]byte(strings.TrimSpace(stdout)), server); err != nil {
+		return nil, fmt.Errorf("unexpected output of probe server: %q, error: %v", stdout, err)
+	}
+	if server.Pid <= 0 {
+		return nil, fmt.Errorf("unexpected pid of probe server: %v", server.Pid)
+	}
+	return server, nil
+}
+
+// ParseProbeResult parses the output of probing
+func ParseProbeResult(stdout string) (*ProbeResult, error) {
+	result := &ProbeResult{}
+	if err := json.Unmarshal([]byte(strings.TrimSpace(stdout)), result); err != nil {
+		return nil, fmt.Errorf("unexpected output of probe: %q, error: %v", stdout, err)
+	}
+	if result.ICMP == nil || result.MTU == nil {
+		return nil, fmt.Errorf("unexpected output of probe: %q, icmp or mtu result missing", stdout)
+	}
+	return result, nil
+}
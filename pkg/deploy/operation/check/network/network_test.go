@@ -0,0 +1,58 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package network
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseProbeServer(t *testing.T) {
+	server, err := ParseProbeServer(`{"tcp": [179], "udp": [], "errors": ["udp/4789: [Errno 98] Address already in use"], "pid": 1234}` + "\n")
+	assert.NoError(t, err)
+	assert.Equal(t, &ProbeServer{
+		Pid:    1234,
+		TCP:    []uint32{179},
+		UDP:    []uint32{},
+		Errors: []string{"udp/4789: [Errno 98] Address already in use"},
+	}, server)
+
+	_, err = ParseProbeServer("error: python is required")
+	assert.Error(t, err)
+	_, err = ParseProbeServer(`{"tcp": [179], "udp": [4789], "errors": []}`)
+	assert.Error(t, err)
+}
+
+func TestParseProbeResult(t *testing.T) {
+	result, err := ParseProbeResult(`{"tcp": [{"port": 179, "ok": true, "error": ""}], "udp": [{"port": 4789, "ok": false, "error": "no echo received"}], "icmp": {"ok": true, "error": ""}, "mtu": {"ok": false, "pathMTU": 1400, "error": "message too long"}}`)
+	assert.NoError(t, err)
+	assert.Equal(t, &ProbeResult{
+		TCP:  []*PortResult{{Port: 179, OK: true}},
+		UDP:  []*PortResult{{Port: 4789, Error: "no echo received"}},
+		ICMP: &ICMPResult{OK: true},
+		MTU:  &MTUResult{PathMTU: 1400, Error: "message too long"},
+	}, result)
+
+	_, err = ParseProbeResult(`{"tcp": [], "udp": []}`)
+	assert.Error(t, err)
+	_, err = ParseProbeResult("")
+	assert.Error(t, err)
+}
+
+func TestJoinPorts(t *testing.T) {
+	assert.Equal(t, "-", joinPorts(nil))
+	assert.Equal(t, "179,5473", joinPorts([]uint32{179, 5473}))
+}
@@ -0,0 +1,53 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package port
+
+import (
+	"strconv"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check"
+)
+
+const (
+	portOccupiedScript  = "check_port_occupied.sh"
+	portListenerScript  = "port_listener.sh"
+	portReachableScript = "check_port_reachable.sh"
+)
+
+// NewCheckPortOccupiedOperation lists the listening ports of the node in the ranges
+func NewCheckPortOccupiedOperation(m machine.Machine, ranges []Range) (operation.Operation, error) {
+	args := make([]string, 0, len(ranges))
+	for _, r := range ranges {
+		args = append(args, r.String())
+	}
+	return check.NewCheckOperation(m, portOccupiedScript, args...)
+}
+
+// NewStartPortListenerOperation starts a temporary listener on the port of the node, the listener exits after seconds
+func NewStartPortListenerOperation(m machine.Machine, port uint32, seconds int) (operation.Operation, error) {
+	return check.NewCheckOperation(m, portListenerScript, "start", strconv.Itoa(int(port)), strconv.Itoa(seconds))
+}
+
+// NewStopPortListenerOperation stops the port listener with the pid
+func NewStopPortListenerOperation(m machine.Machine, pid int) (operation.Operation, error) {
+	return check.NewCheckOperation(m, portListenerScript, "stop", strconv.Itoa(pid))
+}
+
+// NewCheckPortReachableOperation checks if the port of ip is reachable from the node
+func NewCheckPortReachableOperation(m machine.Machine, ip string, port uint32, timeoutSeconds int) (operation.Operation, error) {
+	return check.NewCheckOperation(m, portReachableScript, ip, strconv.Itoa(int(port)), strconv.Itoa(timeoutSeconds))
+}
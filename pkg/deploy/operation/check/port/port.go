@@ -0,0 +1,186 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package port
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	APIServerPort  uint32 = 6443
+	EtcdClientPort uint32 = 2379
+	EtcdPeerPort   uint32 = 2380
+	KubeletPort    uint32 = 10250
+
+	DefaultNodePortFrom uint32 = 30000
+	DefaultNodePortTo   uint32 = 32767
+)
+
+// Range is a range of ports, a single port is a range whose From equals to To
+type Range struct {
+	From uint32
+	To   uint32
+}
+
+func (r Range) String() string {
+	if r.From == r.To {
+		return strconv.Itoa(int(r.From))
+	}
+	return fmt.Sprintf("%d-%d", r.From, r.To)
+}
+
+// Contains returns if the port is in the range
+func (r Range) Contains(port uint32) bool {
+	return port >= r.From && port <= r.To
+}
+
+func single(port uint32) Range {
+	return Range{From: port, To: port}
+}
+
+// ReachabilityRule requires the port of the nodes with the destination role
+// to be reachable from the nodes with any of the source roles.
+type ReachabilityRule struct {
+	Port        uint32
+	Destination consts.NodeRole
+	Sources     []consts.NodeRole
+}
+
+// ReachabilityRules are the ports which are accessed across nodes
+var ReachabilityRules = []ReachabilityRule{
+	{
+		Port:        APIServerPort,
+		Destination: consts.NodeRoleMaster,
+		Sources:     []consts.NodeRole{consts.NodeRoleMaster, consts.NodeRoleWorker, consts.NodeRoleIngress},
+	},
+	{
+		Port:        EtcdClientPort,
+		Destination: consts.NodeRoleEtcd,
+		Sources:     []consts.NodeRole{consts.NodeRoleMaster},
+	},
+	{
+		Port:        EtcdPeerPort,
+		Destination: consts.NodeRoleEtcd,
+		Sources:     []consts.NodeRole{consts.NodeRoleEtcd},
+	},
+	{
+		Port:        KubeletPort,
+		Destination: consts.NodeRoleWorker,
+		Sources:     []consts.NodeRole{consts.NodeRoleMaster},
+	},
+	{
+		Port:        KubeletPort,
+		Destination: consts.NodeRoleIngress,
+		Sources:     []consts.NodeRole{consts.NodeRoleMaster},
+	},
+}
+
+// NodePortRange returns the node port range of the cluster config, the default range is used if it's not set
+func NodePortRange(clusterConfig *pb.ClusterConfig) Range {
+	nodePortRange := clusterConfig.GetNodePortRange()
+	if nodePortRange.GetFrom() == 0 || nodePortRange.GetTo() < nodePortRange.GetFrom() {
+		return Range{From: DefaultNodePortFrom, To: DefaultNodePortTo}
+	}
+	return Range{From: nodePortRange.GetFrom(), To: nodePortRange.GetTo()}
+}
+
+// RequiredPorts returns the ports which should be free on a node with the roles
+func RequiredPorts(roles []string, clusterConfig *pb.ClusterConfig) []Range {
+	var ranges []Range
+	added := make(map[Range]bool)
+	add := func(r Range) {
+		if !added[r] {
+			added[r] = true
+			ranges = append(ranges, r)
+		}
+	}
+
+	for _, role := range roles {
+		switch consts.NodeRole(role) {
+		case consts.NodeRoleEtcd:
+			add(Range{From: EtcdClientPort, To: EtcdPeerPort})
+		case consts.NodeRoleMaster:
+			add(single(APIServerPort))
+			add(single(KubeletPort))
+			add(NodePortRange(clusterConfig))
+		case consts.NodeRoleWorker, consts.NodeRoleIngress:
+			add(single(KubeletPort))
+			add(NodePortRange(clusterConfig))
+		}
+	}
+
+	return ranges
+}
+
+// ParseOccupiedPorts parses the output of check_port_occupied.sh
+func ParseOccupiedPorts(stdout string) ([]uint32, error) {
+	var ports []uint32
+	for _, line := range strings.Fields(stdout) {
+		port, err := strconv.ParseUint(line, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected occupied port %q", line)
+		}
+		ports = append(ports, uint32(port))
+	}
+	return ports, nil
+}
+
+// CheckPortsOccupied returns an error which lists the occupied ports if any
+func CheckPortsOccupied(stdout string) error {
+	ports, err := ParseOccupiedPorts(stdout)
+	if err != nil {
+		return err
+	}
+	if len(ports) == 0 {
+		return nil
+	}
+
+	occupied := make([]string, 0, len(ports))
+	for _, p := range ports {
+		occupied = append(occupied, strconv.Itoa(int(p)))
+	}
+	return fmt.Errorf("ports %v are already in use", strings.Join(occupied, ", "))
+}
+
+// ParsePortListener parses the output of starting a port listener and returns the pid of the listener
+func ParsePortListener(stdout string) (int, error) {
+	fields := strings.Fields(stdout)
+	if len(fields) != 2 || fields[0] != "listening" {
+		return 0, fmt.Errorf("unexpected output of port listener: %q", stdout)
+	}
+
+	pid, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return 0, fmt.Errorf("unexpected pid of port listener: %q", fields[1])
+	}
+	return pid, nil
+}
+
+// CheckPortReachable checks the output of check_port_reachable.sh
+func CheckPortReachable(stdout string) error {
+	result := strings.TrimSpace(stdout)
+	if result == "reachable" {
+		return nil
+	}
+	if result == "" {
+		return fmt.Errorf("unreachable: no result")
+	}
+	return fmt.Errorf("%v", result)
+}
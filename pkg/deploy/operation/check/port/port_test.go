@@ -0,0 +1,111 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package port
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestRequiredPorts(t *testing.T) {
+	clusterConfig := &pb.ClusterConfig{
+		NodePortRange: &pb.NodePortRange{From: 31000, To: 31999},
+	}
+
+	tests := []struct {
+		roles         []string
+		clusterConfig *pb.ClusterConfig
+		want          []Range
+	}{
+		{
+			roles: nil,
+			want:  nil,
+		},
+		{
+			roles: []string{"etcd"},
+			want:  []Range{{From: 2379, To: 2380}},
+		},
+		{
+			roles:         []string{"master", "worker"},
+			clusterConfig: clusterConfig,
+			want:          []Range{{From: 6443, To: 6443}, {From: 10250, To: 10250}, {From: 31000, To: 31999}},
+		},
+		{
+			roles: []string{"worker"},
+			want:  []Range{{From: 10250, To: 10250}, {From: 30000, To: 32767}},
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.want, RequiredPorts(test.roles, test.clusterConfig))
+	}
+}
+
+func TestRangeString(t *testing.T) {
+	assert.Equal(t, "6443", Range{From: 6443, To: 6443}.String())
+	assert.Equal(t, "2379-2380", Range{From: 2379, To: 2380}.String())
+}
+
+func TestCheckPortsOccupied(t *testing.T) {
+	tests := []struct {
+		stdout  string
+		wantErr bool
+	}{
+		{
+			stdout: "",
+		},
+		{
+			stdout:  "6443\n30080\n",
+			wantErr: true,
+		},
+		{
+			stdout:  "tcp",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		err := CheckPortsOccupied(test.stdout)
+		if test.wantErr {
+			assert.Error(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+
+	ports, err := ParseOccupiedPorts("6443\n30080\n")
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{6443, 30080}, ports)
+}
+
+func TestParsePortListener(t *testing.T) {
+	pid, err := ParsePortListener("listening 1234\n")
+	assert.NoError(t, err)
+	assert.Equal(t, 1234, pid)
+
+	_, err = ParsePortListener("")
+	assert.Error(t, err)
+	_, err = ParsePortListener("listening pid")
+	assert.Error(t, err)
+}
+
+func TestCheckPortReachable(t *testing.T) {
+	assert.NoError(t, CheckPortReachable("reachable\n"))
+	assert.EqualError(t, CheckPortReachable("unreachable: Connection refused\n"), "unreachable: Connection refused")
+	assert.Error(t, CheckPortReachable(""))
+}
@@ -0,0 +1,100 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package residue
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check"
+)
+
+const (
+	checkScript = "check_residue.sh"
+	cleanScript = "clean_node.sh"
+	// confirmArg must be passed to the clean script, or it refuses to run
+	confirmArg = "--confirm"
+
+	KindDirectory = "directory"
+	KindProcess   = "process"
+	KindIptables  = "iptables"
+	KindInterface = "interface"
+	KindMount     = "mount"
+)
+
+// Residue is something left on a node by a previous kubernetes or etcd installation
+type Residue struct {
+	Kind string
+	// Name is the path, the process name, the chain prefix with the number of chains or the interface name
+	Name string
+}
+
+func (r Residue) String() string {
+	return fmt.Sprintf("%v %v", r.Kind, r.Name)
+}
+
+func NewCheckResidueOperation(m machine.Machine) (operation.Operation, error) {
+	return check.NewCheckOperation(m, checkScript)
+}
+
+// NewCleanNodeOperation returns an operation which removes all residues on a machine,
+// the kubernetes and etcd data on the machine is lost, so it must only be run after
+// the user confirmed it.
+func NewCleanNodeOperation(m machine.Machine) (operation.Operation, error) {
+	return check.NewCheckOperation(m, cleanScript, confirmArg)
+}
+
+// ParseResidues parses the output of the residue check script
+func ParseResidues(output string) ([]Residue, error) {
+	var residues []Residue
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		fields := strings.SplitN(line, "=", 2)
+		if len(fields) != 2 || fields[1] == "" {
+			return nil, fmt.Errorf("failed to parse residue from %q", line)
+		}
+		switch fields[0] {
+		case KindDirectory, KindProcess, KindIptables, KindInterface, KindMount:
+		default:
+			return nil, fmt.Errorf("unknown residue kind %q", fields[0])
+		}
+		residues = append(residues, Residue{Kind: fields[0], Name: fields[1]})
+	}
+
+	return residues, nil
+}
+
+// CheckResidues returns an error listing the residues if there is any
+func CheckResidues(output string) error {
+	residues, err := ParseResidues(output)
+	if err != nil {
+		return err
+	}
+	if len(residues) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(residues))
+	for _, residue := range residues {
+		names = append(names, residue.String())
+	}
+	return fmt.Errorf("found residues of a previous installation: %v", strings.Join(names, ", "))
+}
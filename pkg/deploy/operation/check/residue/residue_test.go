@@ -0,0 +1,68 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package residue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseResidues(t *testing.T) {
+	tests := []struct {
+		output  string
+		want    []Residue
+		wantErr bool
+	}{
+		{
+			output: "",
+			want:   nil,
+		},
+		{
+			output: "directory=/etc/kubernetes\nprocess=kubelet\niptables=KUBE- 42\ninterface=cali1234\nmount=/var/lib/kubelet/pods/x\n",
+			want: []Residue{
+				{Kind: KindDirectory, Name: "/etc/kubernetes"},
+				{Kind: KindProcess, Name: "kubelet"},
+				{Kind: KindIptables, Name: "KUBE- 42"},
+				{Kind: KindInterface, Name: "cali1234"},
+				{Kind: KindMount, Name: "/var/lib/kubelet/pods/x"},
+			},
+		},
+		{
+			output:  "directory=",
+			wantErr: true,
+		},
+		{
+			output:  "file=/etc/kubernetes/admin.conf",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		residues, err := ParseResidues(test.output)
+		if test.wantErr {
+			assert.Error(t, err)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, test.want, residues)
+	}
+}
+
+func TestCheckResidues(t *testing.T) {
+	assert.NoError(t, CheckResidues("\n"))
+	assert.EqualError(t, CheckResidues("directory=/var/lib/etcd\ninterface=cni0"),
+		"found residues of a previous installation: directory /var/lib/etcd, interface cni0")
+}
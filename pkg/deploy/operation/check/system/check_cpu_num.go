@@ -15,9 +15,19 @@
 package system
 
 import (
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
 	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check"
 )
 
+const (
+	cpuNumScript = "check_cpu_num.sh"
+)
+
+func NewCheckCPUNumsOperation(m machine.Machine) (operation.Operation, error) {
+	return check.NewCheckOperation(m, cpuNumScript)
+}
+
 // check if CPU numbers larger or equal than desired cores
 func CheckCPUNums(cpuCore string, desiredCPUCore float64) error {
 	err := operation.CheckEntity(cpuCore, desiredCPUCore)
@@ -15,12 +15,22 @@
 package system
 
 import (
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
 	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check"
 )
 
-// check if kernel version larger or equal than standard version
-func CheckKernelVersion(kernelVersion string, standardVersion string, checkStandard string) error {
-	err := operation.CheckVersion(kernelVersion, standardVersion, checkStandard)
+const (
+	kernelVersionScript = "check_kernel_version.sh"
+)
+
+func NewCheckKernelVersionOperation(m machine.Machine) (operation.Operation, error) {
+	return check.NewCheckOperation(m, kernelVersionScript)
+}
+
+// check if kernel version satisfies the version constraint, e.g. ">=4.19"
+func CheckKernelVersion(kernelVersion string, versionConstraint string) error {
+	err := operation.CheckVersion(kernelVersion, versionConstraint)
 	if err != nil {
 		return err
 	}
@@ -52,9 +52,19 @@ func TestCheckKernelVersion(t *testing.T) {
 			desiredVersion:  desiredKernelVersion,
 			want:            fmt.Errorf("version too low, desired version: %v, actual version: 4.18.5-041805-generic", desiredKernelVersion),
 		},
+		{
+			comparedVersion: "4.19.91-24.8.an8.x86_64",
+			desiredVersion:  desiredKernelVersion,
+			want:            nil,
+		},
+		{
+			comparedVersion: "4.19.91-24.8.an8.x86_64",
+			desiredVersion:  ">=4.19 <5",
+			want:            nil,
+		},
 	}
 
 	for _, eachValue := range testSample {
-		assert.Equal(t, eachValue.want, CheckKernelVersion(eachValue.comparedVersion, eachValue.desiredVersion, ">"))
+		assert.Equal(t, eachValue.want, CheckKernelVersion(eachValue.comparedVersion, eachValue.desiredVersion))
 	}
 }
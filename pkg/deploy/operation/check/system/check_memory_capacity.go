@@ -15,9 +15,19 @@
 package system
 
 import (
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
 	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check"
 )
 
+const (
+	memoryCapacityScript = "check_memory_capacity.sh"
+)
+
+func NewCheckMemoryCapacityOperation(m machine.Machine) (operation.Operation, error) {
+	return check.NewCheckOperation(m, memoryCapacityScript)
+}
+
 // check if memory capacity satisfied with minimal requirement
 func CheckMemoryCapacity(comparedMemory string, desiredMemory float64) error {
 	err := operation.CheckEntity(comparedMemory, desiredMemory)
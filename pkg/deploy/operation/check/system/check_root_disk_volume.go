@@ -15,9 +15,19 @@
 package system
 
 import (
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
 	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check"
 )
 
+const (
+	rootDiskVolumeScript = "check_root_disk_volume.sh"
+)
+
+func NewCheckRootDiskVolumeOperation(m machine.Machine) (operation.Operation, error) {
+	return check.NewCheckOperation(m, rootDiskVolumeScript)
+}
+
 // check if root disk volume satisfied with desired disk volume
 func CheckRootDiskVolume(rootDiskVolume string, desiredDiskVolume float64) error {
 	err := operation.CheckEntity(rootDiskVolume, desiredDiskVolume)
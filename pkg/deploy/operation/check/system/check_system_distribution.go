@@ -19,15 +19,23 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
 	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check"
 )
 
 const (
 	DistributionCentos string = "centos"
 	DistributionUbuntu string = "ubuntu"
 	DistributionRHEL   string = "rhel"
+
+	systemDistributionScript = "check_system_distribution.sh"
 )
 
+func NewCheckSystemDistributionOperation(m machine.Machine) (operation.Operation, error) {
+	return check.NewCheckOperation(m, systemDistributionScript)
+}
+
 // check if system distribution can be supported
 func CheckSystemDistribution(disName string) error {
 	logger := logrus.WithFields(logrus.Fields{
@@ -0,0 +1,46 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package system
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check"
+)
+
+const (
+	systemPreferenceScript = "check_system_preference.sh"
+)
+
+func NewCheckSystemPreferenceOperation(m machine.Machine) (operation.Operation, error) {
+	return check.NewCheckOperation(m, systemPreferenceScript)
+}
+
+// check if sysctl preferences are satisfied, the check script reports errors in stderr
+func CheckSystemPreference(stderr string) error {
+	if errMsg := strings.TrimSpace(stderr); errMsg != "" {
+		return fmt.Errorf("%v", errMsg)
+	}
+
+	return nil
+}
+
+// NewFixSystemPreferenceOperation sets and persists the unsatisfied sysctl preferences, the output is the same as the check
+func NewFixSystemPreferenceOperation(m machine.Machine) (operation.Operation, error) {
+	return check.NewCheckOperation(m, systemPreferenceScript, "--fix")
+}
@@ -0,0 +1,145 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcd
+
+import (
+	"fmt"
+	"net"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check/cri"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check/disk"
+	initop "github.com/kpaas-io/kpaas/pkg/deploy/operation/init"
+	"github.com/kpaas-io/kpaas/pkg/deploy/pki"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	deployScript = "deploy_etcd.sh"
+	healthScript = "check_etcd_health.sh"
+
+	// StepDeploy is the name of the step which installs etcd and starts its service
+	StepDeploy = "deploy etcd"
+
+	ClientPort = 2379
+	PeerPort   = 2380
+
+	// ConfigPath is the environment file of the etcd service, etcd reads its flags from ETCD_* variables
+	ConfigPath = "/etc/etcd/etcd.env"
+
+	// DefaultImageRepository is the repository of the etcd image if it's not set in the cluster config
+	DefaultImageRepository = "k8s.gcr.io"
+	// ImageTag is the tag of the etcd image, the etcd version is the part before "-"
+	ImageTag = "3.4.3-0"
+)
+
+// Image returns the etcd image of the cluster
+func Image(clusterConfig *pb.ClusterConfig) string {
+	repository := clusterConfig.GetImageRepository()
+	if repository == "" {
+		repository = DefaultImageRepository
+	}
+	return fmt.Sprintf("%v/etcd:%v", strings.TrimSuffix(repository, "/"), ImageTag)
+}
+
+// PeerURL returns the url which the etcd member of the node listens for peers
+func PeerURL(node *pb.Node) string {
+	return fmt.Sprintf("https://%v", net.JoinHostPort(node.GetIp(), fmt.Sprint(PeerPort)))
+}
+
+// ClientURL returns the url which the etcd member of the node listens for clients
+func ClientURL(node *pb.Node) string {
+	return fmt.Sprintf("https://%v", net.JoinHostPort(node.GetIp(), fmt.Sprint(ClientPort)))
+}
+
+// InitialCluster returns the initial cluster membership of the etcd nodes, the members are named by the node names
+func InitialCluster(nodes []*pb.Node) string {
+	members := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		members = append(members, fmt.Sprintf("%v=%v", node.GetName(), PeerURL(node)))
+	}
+	sort.Strings(members)
+	return strings.Join(members, ",")
+}
+
+// Endpoints returns the client urls of all etcd nodes
+func Endpoints(nodes []*pb.Node) []string {
+	endpoints := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		endpoints = append(endpoints, ClientURL(node))
+	}
+	sort.Strings(endpoints)
+	return endpoints
+}
+
+// Config returns the environment file of the etcd member on the node, the nodes are all members of the cluster.
+// Both the peers and the clients are authenticated by the certificates signed by the etcd ca, which are
+// distributed by pki.ClusterPKI.EtcdFiles.
+func Config(node *pb.Node, nodes []*pb.Node, clusterConfig *pb.ClusterConfig) string {
+	localClientURL := fmt.Sprintf("https://127.0.0.1:%v", ClientPort)
+	values := [][2]string{
+		{"ETCD_NAME", node.GetName()},
+		{"ETCD_DATA_DIR", disk.EtcdDataDir(clusterConfig)},
+		{"ETCD_LISTEN_PEER_URLS", PeerURL(node)},
+		{"ETCD_INITIAL_ADVERTISE_PEER_URLS", PeerURL(node)},
+		{"ETCD_LISTEN_CLIENT_URLS", fmt.Sprintf("%v,%v", localClientURL, ClientURL(node))},
+		{"ETCD_ADVERTISE_CLIENT_URLS", ClientURL(node)},
+		{"ETCD_INITIAL_CLUSTER", InitialCluster(nodes)},
+		{"ETCD_INITIAL_CLUSTER_STATE", "new"},
+		{"ETCD_INITIAL_CLUSTER_TOKEN", "kpaas-etcd-cluster"},
+		{"ETCD_CERT_FILE", path.Join(pki.EtcdDir, "server.crt")},
+		{"ETCD_KEY_FILE", path.Join(pki.EtcdDir, "server.key")},
+		{"ETCD_CLIENT_CERT_AUTH", "true"},
+		{"ETCD_TRUSTED_CA_FILE", path.Join(pki.EtcdDir, "ca.crt")},
+		{"ETCD_PEER_CERT_FILE", path.Join(pki.EtcdDir, "peer.crt")},
+		{"ETCD_PEER_KEY_FILE", path.Join(pki.EtcdDir, "peer.key")},
+		{"ETCD_PEER_CLIENT_CERT_AUTH", "true"},
+		{"ETCD_PEER_TRUSTED_CA_FILE", path.Join(pki.EtcdDir, "ca.crt")},
+		{"ETCD_SNAPSHOT_COUNT", "10000"},
+	}
+
+	var builder strings.Builder
+	for _, value := range values {
+		fmt.Fprintf(&builder, "%v=%v\n", value[0], value[1])
+	}
+	return builder.String()
+}
+
+// DeployStep returns the step which installs etcd on the node by the container runtime of the cluster and
+// (re)starts the etcd service with the config in ConfigPath. The service is not restarted if nothing is changed.
+func DeployStep(clusterConfig *pb.ClusterConfig) (*initop.Step, error) {
+	runtime, err := cri.Runtime(clusterConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	image := Image(clusterConfig)
+	if runtime == consts.ContainerRuntimeContainerd {
+		image = machine.NormalizeImage(image)
+	}
+	return &initop.Step{Name: StepDeploy, Script: deployScript, Args: []string{string(runtime), image, ConfigPath}}, nil
+}
+
+// NewHealthOperation returns an operation which lists the members of the etcd cluster and checks the health
+// of the endpoints by the healthcheck client certificate
+func NewHealthOperation(m machine.Machine, nodes []*pb.Node) (operation.Operation, error) {
+	return check.NewCheckOperation(m, healthScript, pki.EtcdDir, strings.Join(Endpoints(nodes), ","))
+}
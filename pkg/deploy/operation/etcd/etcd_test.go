@@ -0,0 +1,148 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+var testNodes = []*pb.Node{
+	{Name: "node2", Ip: "192.168.1.2"},
+	{Name: "node1", Ip: "192.168.1.1"},
+	{Name: "node3", Ip: "192.168.1.3"},
+}
+
+func TestInitialCluster(t *testing.T) {
+	assert.Equal(t, "node1=https://192.168.1.1:2380,node2=https://192.168.1.2:2380,node3=https://192.168.1.3:2380",
+		InitialCluster(testNodes))
+	assert.Equal(t, []string{"https://192.168.1.1:2379", "https://192.168.1.2:2379", "https://192.168.1.3:2379"},
+		Endpoints(testNodes))
+}
+
+func TestImage(t *testing.T) {
+	assert.Equal(t, "k8s.gcr.io/etcd:3.4.3-0", Image(nil))
+	assert.Equal(t, "registry.example.com/k8s/etcd:3.4.3-0", Image(&pb.ClusterConfig{ImageRepository: "registry.example.com/k8s/"}))
+}
+
+func TestConfig(t *testing.T) {
+	config := Config(testNodes[1], testNodes, &pb.ClusterConfig{EtcdDataDir: "/data/etcd"})
+	lines := strings.Split(strings.TrimSpace(config), "\n")
+	assert.Contains(t, lines, "ETCD_NAME=node1")
+	assert.Contains(t, lines, "ETCD_DATA_DIR=/data/etcd")
+	assert.Contains(t, lines, "ETCD_LISTEN_CLIENT_URLS=https://127.0.0.1:2379,https://192.168.1.1:2379")
+	assert.Contains(t, lines, "ETCD_INITIAL_ADVERTISE_PEER_URLS=https://192.168.1.1:2380")
+	assert.Contains(t, lines, "ETCD_INITIAL_CLUSTER="+InitialCluster(testNodes))
+	assert.Contains(t, lines, "ETCD_PEER_CLIENT_CERT_AUTH=true")
+	assert.Contains(t, lines, "ETCD_TRUSTED_CA_FILE=/etc/kubernetes/pki/etcd/ca.crt")
+
+	assert.Contains(t, Config(testNodes[1], testNodes, nil), "ETCD_DATA_DIR=/var/lib/etcd\n")
+}
+
+func TestDeployStep(t *testing.T) {
+	step, err := DeployStep(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"docker", "k8s.gcr.io/etcd:3.4.3-0", ConfigPath}, step.Args)
+
+	step, err = DeployStep(&pb.ClusterConfig{ContainerRuntime: "containerd", ImageRepository: "registry"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"containerd", "docker.io/registry/etcd:3.4.3-0", ConfigPath}, step.Args)
+
+	_, err = DeployStep(&pb.ClusterConfig{ContainerRuntime: "rkt"})
+	assert.Error(t, err)
+}
+
+const healthyOutput = `member=1a2b, started, node1, https://192.168.1.1:2380, https://192.168.1.1:2379, false
+member=3c4d, started, node2, https://192.168.1.2:2380, https://192.168.1.2:2379, false
+member=5e6f, started, node3, https://192.168.1.3:2380, https://192.168.1.3:2379, false
+health=https://192.168.1.1:2379 is healthy: successfully committed proposal: took = 2.1ms
+health=https://192.168.1.2:2379 is healthy: successfully committed proposal: took = 1.9ms
+health=https://192.168.1.3:2379 is unhealthy: failed to commit proposal: context deadline exceeded
+`
+
+func TestParseHealth(t *testing.T) {
+	health, err := ParseHealth(healthyOutput)
+	assert.NoError(t, err)
+	assert.Len(t, health.Members, 3)
+	assert.Equal(t, &Member{ID: "1a2b", Name: "node1", PeerURL: "https://192.168.1.1:2380", Started: true}, health.Members[0])
+	assert.Equal(t, map[string]bool{
+		"https://192.168.1.1:2379": true,
+		"https://192.168.1.2:2379": true,
+		"https://192.168.1.3:2379": false,
+	}, health.Endpoints)
+
+	_, err = ParseHealth("error=context deadline exceeded\n")
+	assert.Error(t, err)
+	_, err = ParseHealth("")
+	assert.Error(t, err)
+	_, err = ParseHealth("member=1a2b started\n")
+	assert.Error(t, err)
+}
+
+func TestCheckHealth(t *testing.T) {
+	tests := []struct {
+		output  string
+		nodes   []*pb.Node
+		wantErr string
+	}{
+		{
+			// one of three endpoints is unhealthy, the quorum is kept
+			output: healthyOutput,
+			nodes:  testNodes,
+		},
+		{
+			output:  strings.Replace(healthyOutput, "192.168.1.2:2379 is healthy", "192.168.1.2:2379 is unhealthy", 1),
+			nodes:   testNodes,
+			wantErr: "no quorum: 1 of 3 endpoints are healthy",
+		},
+		{
+			output:  strings.Replace(healthyOutput, "5e6f, started", "5e6f, unstarted", 1),
+			nodes:   testNodes,
+			wantErr: "member node3 is not started",
+		},
+		{
+			output:  healthyOutput,
+			nodes:   testNodes[:2],
+			wantErr: `unknown member "node3"`,
+		},
+		{
+			output:  healthyOutput,
+			nodes:   append(testNodes[:2:2], &pb.Node{Name: "node4", Ip: "192.168.1.3"}),
+			wantErr: "node node4 is not a member",
+		},
+		{
+			output:  healthyOutput,
+			nodes:   append(testNodes[:2:2], &pb.Node{Name: "node3", Ip: "192.168.1.4"}),
+			wantErr: "peer url of member node3 is https://192.168.1.3:2380",
+		},
+	}
+
+	for _, test := range tests {
+		health, err := ParseHealth(test.output)
+		if !assert.NoError(t, err) {
+			continue
+		}
+		err = CheckHealth(health, test.nodes)
+		if test.wantErr == "" {
+			assert.NoError(t, err)
+		} else if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), test.wantErr)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	memberPrefix = "member="
+	healthPrefix = "health="
+	errorPrefix  = "error="
+)
+
+// Member is a member of the etcd cluster
+type Member struct {
+	ID      string
+	Name    string
+	PeerURL string
+	Started bool
+}
+
+// Health is the members and the health of the endpoints of the etcd cluster
+type Health struct {
+	Members []*Member
+	// Endpoints are the client urls and if they are healthy
+	Endpoints map[string]bool
+}
+
+// ParseHealth parses the output of the health operation, the lines are:
+//
+//	member=<id>, <status>, <name>, <peer urls>, <client urls>, <is learner>
+//	health=<endpoint> is healthy|unhealthy: <detail>
+//	error=<message>
+func ParseHealth(output string) (*Health, error) {
+	health := &Health{Endpoints: make(map[string]bool)}
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, errorPrefix):
+			return nil, fmt.Errorf("failed to check etcd cluster: %v", strings.TrimPrefix(line, errorPrefix))
+
+		case strings.HasPrefix(line, memberPrefix):
+			fields := strings.Split(strings.TrimPrefix(line, memberPrefix), ", ")
+			if len(fields) < 4 {
+				return nil, fmt.Errorf("failed to parse etcd member from %q", line)
+			}
+			health.Members = append(health.Members, &Member{
+				ID:      fields[0],
+				Started: fields[1] == "started",
+				Name:    fields[2],
+				// the member is listening on only one peer url
+				PeerURL: fields[3],
+			})
+
+		case strings.HasPrefix(line, healthPrefix):
+			fields := strings.Fields(strings.TrimPrefix(line, healthPrefix))
+			if len(fields) < 3 || fields[1] != "is" {
+				return nil, fmt.Errorf("failed to parse etcd endpoint health from %q", line)
+			}
+			health.Endpoints[fields[0]] = strings.TrimSuffix(fields[2], ":") == "healthy"
+		}
+	}
+
+	if len(health.Members) == 0 {
+		return nil, fmt.Errorf("no etcd member is found in %q", output)
+	}
+	return health, nil
+}
+
+// CheckHealth checks if the members of the etcd cluster are exactly the nodes and they are started,
+// and a quorum of the endpoints is healthy.
+func CheckHealth(health *Health, nodes []*pb.Node) error {
+	members := make(map[string]*Member, len(health.Members))
+	for _, member := range health.Members {
+		members[member.Name] = member
+	}
+
+	var problems []string
+	for _, node := range nodes {
+		member, ok := members[node.GetName()]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("node %v is not a member", node.GetName()))
+			continue
+		}
+		delete(members, node.GetName())
+
+		if !member.Started {
+			problems = append(problems, fmt.Sprintf("member %v is not started", member.Name))
+		}
+		if member.PeerURL != PeerURL(node) {
+			problems = append(problems, fmt.Sprintf("peer url of member %v is %v, desired: %v", member.Name, member.PeerURL, PeerURL(node)))
+		}
+	}
+	for name := range members {
+		problems = append(problems, fmt.Sprintf("unknown member %q", name))
+	}
+
+	var healthy int
+	var unhealthy []string
+	for _, endpoint := range Endpoints(nodes) {
+		if health.Endpoints[endpoint] {
+			healthy++
+		} else {
+			unhealthy = append(unhealthy, endpoint)
+		}
+	}
+	if quorum := len(nodes)/2 + 1; healthy < quorum {
+		problems = append(problems, fmt.Sprintf("no quorum: %d of %d endpoints are healthy, desired: %d, unhealthy endpoints: %v",
+			healthy, len(nodes), quorum, strings.Join(unhealthy, ",")))
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("etcd cluster is unhealthy: %v", strings.Join(problems, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ha provides the steps to deploy the highly available endpoint of the apiservers, which is the keepalived
+// vip held by one of the masters, and the haproxy on every master which proxies all apiservers.
+package ha
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	initop "github.com/kpaas-io/kpaas/pkg/deploy/operation/init"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/kubeadm"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	checkVIPScript         = "check_vip.sh"
+	deployHaproxyScript    = "init_deploy_haproxy.sh"
+	deployKeepalivedScript = "init_deploy_keepalived.sh"
+	checkHAScript          = "check_ha.sh"
+
+	// StepCheckVIP is the name of the step which checks the vip and the network interface before the deployment
+	StepCheckVIP = "check vip"
+	// StepDeployHaproxy is the name of the step which deploys haproxy
+	StepDeployHaproxy = "deploy haproxy"
+	// StepDeployKeepalived is the name of the step which deploys keepalived
+	StepDeployKeepalived = "deploy keepalived"
+	// StepCheckHA is the name of the step which checks the vip answers after the masters are deployed
+	StepCheckHA = "check ha"
+)
+
+// Enabled returns if the apiservers are connected through the keepalived vip, which requires haproxy and keepalived
+// deployed on the masters.
+func Enabled(clusterConfig *pb.ClusterConfig) bool {
+	connectType := clusterConfig.GetKubeAPIServerConnect().GetType()
+	return consts.KubeAPIServerConnectType(connectType) == consts.KubeAPIServerConnectTypeKeepalived
+}
+
+// Validate checks the keepalived config and the masters: the vip and the network interface are set, the vip is
+// a valid ip which is not the ip of any master, and the ips of the masters are valid.
+func Validate(clusterConfig *pb.ClusterConfig, masters []*pb.Node) error {
+	if len(masters) == 0 {
+		return fmt.Errorf("no master to deploy haproxy and keepalived")
+	}
+
+	keepalived := clusterConfig.GetKubeAPIServerConnect().GetKeepalived()
+	vip := keepalived.GetVip()
+	if err := initop.CheckKeepalivedParameter(vip, keepalived.GetNetInterfaceName()); err != nil {
+		return fmt.Errorf("invalid keepalived vip %q or network interface %q, error: %v", vip, keepalived.GetNetInterfaceName(), err)
+	}
+	if net.ParseIP(vip).IsUnspecified() {
+		return fmt.Errorf("invalid keepalived vip %q", vip)
+	}
+
+	for _, master := range masters {
+		if master.GetIp() == vip {
+			return fmt.Errorf("keepalived vip %v is the ip of master %v", vip, master.GetName())
+		}
+	}
+	ips := masterIPs(masters)
+	if err := initop.CheckHaproxyParameter(ips...); err != nil {
+		return fmt.Errorf("invalid master ips %v, error: %v", ips, err)
+	}
+	return nil
+}
+
+// Upstreams returns the addresses of the apiservers on the masters, which are the upstreams of haproxy
+func Upstreams(masters []*pb.Node) []string {
+	upstreams := make([]string, 0, len(masters))
+	for _, master := range masters {
+		upstreams = append(upstreams, net.JoinHostPort(master.GetIp(), strconv.Itoa(kubeadm.APIServerPort)))
+	}
+	return upstreams
+}
+
+func masterIPs(masters []*pb.Node) []string {
+	ips := make([]string, 0, len(masters))
+	for _, master := range masters {
+		ips = append(ips, master.GetIp())
+	}
+	return ips
+}
+
+// DeploySteps returns the steps to deploy haproxy and keepalived on a master in order: the vip and the network
+// interface are checked first, so the vip is not taken from another host.
+func DeploySteps(clusterConfig *pb.ClusterConfig, masters []*pb.Node) ([]*initop.Step, error) {
+	if err := Validate(clusterConfig, masters); err != nil {
+		return nil, err
+	}
+
+	keepalived := clusterConfig.GetKubeAPIServerConnect().GetKeepalived()
+	return []*initop.Step{
+		{
+			Name:   StepCheckVIP,
+			Script: checkVIPScript,
+			Args:   append([]string{keepalived.GetVip(), keepalived.GetNetInterfaceName()}, masterIPs(masters)...),
+		},
+		{
+			Name:   StepDeployHaproxy,
+			Script: deployHaproxyScript,
+			Args:   []string{strings.Join(Upstreams(masters), " ")},
+		},
+		{
+			Name:   StepDeployKeepalived,
+			Script: deployKeepalivedScript,
+			Args:   []string{keepalived.GetVip(), keepalived.GetNetInterfaceName()},
+		},
+	}, nil
+}
+
+// CheckStep returns the step which checks haproxy and keepalived are running on a master, so it's able to take
+// over the vip if the master holding it fails, and the vip answers on both the apiserver port and the haproxy port.
+func CheckStep(clusterConfig *pb.ClusterConfig) *initop.Step {
+	return &initop.Step{
+		Name:   StepCheckHA,
+		Script: checkHAScript,
+		Args: []string{
+			clusterConfig.GetKubeAPIServerConnect().GetKeepalived().GetVip(),
+			strconv.Itoa(kubeadm.APIServerPort),
+			strconv.Itoa(kubeadm.HaproxyPort),
+		},
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func keepalivedConfig(vip, iface string) *pb.ClusterConfig {
+	return &pb.ClusterConfig{
+		KubeAPIServerConnect: &pb.KubeAPIServerConnect{
+			Type:       "keepalived",
+			Keepalived: &pb.Keepalived{Vip: vip, NetInterfaceName: iface},
+		},
+	}
+}
+
+func TestEnabled(t *testing.T) {
+	assert.True(t, Enabled(keepalivedConfig("192.168.1.100", "eth0")))
+	assert.False(t, Enabled(&pb.ClusterConfig{}))
+	assert.False(t, Enabled(&pb.ClusterConfig{KubeAPIServerConnect: &pb.KubeAPIServerConnect{Type: "loadbalancer"}}))
+}
+
+func TestValidate(t *testing.T) {
+	masters := []*pb.Node{{Name: "master1", Ip: "192.168.1.1"}, {Name: "master2", Ip: "192.168.1.2"}}
+
+	tests := []struct {
+		clusterConfig *pb.ClusterConfig
+		masters       []*pb.Node
+		wantErr       bool
+	}{
+		{clusterConfig: keepalivedConfig("192.168.1.100", "eth0"), masters: masters},
+		{clusterConfig: keepalivedConfig("192.168.1.100", "eth0"), wantErr: true},
+		{clusterConfig: keepalivedConfig("", "eth0"), masters: masters, wantErr: true},
+		{clusterConfig: keepalivedConfig("192.168.1.100", ""), masters: masters, wantErr: true},
+		{clusterConfig: keepalivedConfig("192.168.1.256", "eth0"), masters: masters, wantErr: true},
+		{clusterConfig: keepalivedConfig("0.0.0.0", "eth0"), masters: masters, wantErr: true},
+		{clusterConfig: keepalivedConfig("192.168.1.2", "eth0"), masters: masters, wantErr: true},
+		{clusterConfig: keepalivedConfig("192.168.1.100", "eth0"), masters: []*pb.Node{{Name: "master1", Ip: "master1"}}, wantErr: true},
+	}
+
+	for i, test := range tests {
+		err := Validate(test.clusterConfig, test.masters)
+		assert.Equal(t, test.wantErr, err != nil, "%v: %v", i, err)
+	}
+}
+
+func TestDeploySteps(t *testing.T) {
+	masters := []*pb.Node{{Name: "master1", Ip: "192.168.1.1"}, {Name: "master2", Ip: "192.168.1.2"}}
+
+	steps, err := DeploySteps(keepalivedConfig("192.168.1.100", "eth0"), masters)
+	assert.NoError(t, err)
+	if assert.Len(t, steps, 3) {
+		assert.Equal(t, "check_vip.sh", steps[0].Script)
+		assert.Equal(t, []string{"192.168.1.100", "eth0", "192.168.1.1", "192.168.1.2"}, steps[0].Args)
+		assert.Equal(t, "init_deploy_haproxy.sh", steps[1].Script)
+		assert.Equal(t, []string{"192.168.1.1:6443 192.168.1.2:6443"}, steps[1].Args)
+		assert.Equal(t, "init_deploy_keepalived.sh", steps[2].Script)
+		assert.Equal(t, []string{"192.168.1.100", "eth0"}, steps[2].Args)
+	}
+
+	_, err = DeploySteps(keepalivedConfig("192.168.1.100", ""), masters)
+	assert.Error(t, err)
+
+	step := CheckStep(keepalivedConfig("192.168.1.100", "eth0"))
+	assert.Equal(t, "check_ha.sh", step.Script)
+	assert.Equal(t, []string{"192.168.1.100", "6443", "4443"}, step.Args)
+}
@@ -0,0 +1,83 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package images provides the image tarballs which are distributed to the nodes before deploying, and the step to
+// load them, so that the nodes are able to be deployed without pulling the images from the registries.
+package images
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"sort"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check/cri"
+	initop "github.com/kpaas-io/kpaas/pkg/deploy/operation/init"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	loadScript = "load_images.sh"
+	// tarballExt is the extension of the image tarballs created by "docker save"
+	tarballExt = ".tar"
+
+	// StepLoad is the name of the step which loads the image tarballs
+	StepLoad = "load images"
+)
+
+// RemoteDir is the directory on nodes which the image tarballs are distributed to
+var RemoteDir = "/var/lib/kpaas/images"
+
+// Tarballs returns the paths of the image tarballs in the directory of the controller in order,
+// there is no tarball if dir is empty.
+func Tarballs(dir string) ([]string, error) {
+	if dir == "" {
+		return nil, nil
+	}
+
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image directory %v, error: %v", dir, err)
+	}
+
+	var tarballs []string
+	for _, info := range infos {
+		if info.Mode().IsRegular() && filepath.Ext(info.Name()) == tarballExt {
+			tarballs = append(tarballs, filepath.Join(dir, info.Name()))
+		}
+	}
+	sort.Strings(tarballs)
+
+	return tarballs, nil
+}
+
+// RemotePath returns the path of the image tarball on nodes
+func RemotePath(tarball string) string {
+	return path.Join(RemoteDir, filepath.Base(tarball))
+}
+
+// LoadStep returns the step which loads the image tarballs on the node by the container runtime of the cluster
+func LoadStep(clusterConfig *pb.ClusterConfig, tarballs []string) (*initop.Step, error) {
+	runtime, err := cri.Runtime(clusterConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	args := []string{string(runtime)}
+	for _, tarball := range tarballs {
+		args = append(args, RemotePath(tarball))
+	}
+	return &initop.Step{Name: StepLoad, Script: loadScript, Args: args}, nil
+}
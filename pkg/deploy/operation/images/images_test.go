@@ -0,0 +1,65 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package images
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestTarballs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "images")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, name := range []string{"pause.tar", "etcd.tar", "README.md"} {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(name), 0644))
+	}
+	assert.NoError(t, os.Mkdir(filepath.Join(dir, "old.tar"), 0755))
+
+	tarballs, err := Tarballs(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "etcd.tar"), filepath.Join(dir, "pause.tar")}, tarballs)
+
+	tarballs, err = Tarballs("")
+	assert.NoError(t, err)
+	assert.Empty(t, tarballs)
+
+	_, err = Tarballs(filepath.Join(dir, "not-exist"))
+	assert.Error(t, err)
+}
+
+func TestLoadStep(t *testing.T) {
+	tarballs := []string{"/images/etcd.tar", "/images/pause.tar"}
+
+	step, err := LoadStep(nil, tarballs)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"docker", "/var/lib/kpaas/images/etcd.tar", "/var/lib/kpaas/images/pause.tar"}, step.Args)
+
+	step, err = LoadStep(&pb.ClusterConfig{ContainerRuntime: "containerd"}, tarballs)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"containerd", "/var/lib/kpaas/images/etcd.tar", "/var/lib/kpaas/images/pause.tar"}, step.Args)
+
+	_, err = LoadStep(&pb.ClusterConfig{ContainerRuntime: "rkt"}, tarballs)
+	assert.Error(t, err)
+}
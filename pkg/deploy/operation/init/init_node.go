@@ -0,0 +1,106 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package init
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check/hostname"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	exitCodePrefix = "exit="
+
+	StepSwap      = "swap"
+	StepFirewall  = "firewall"
+	StepHostname  = "hostname"
+	StepTimezone  = "timezone"
+	StepHostAlias = "host aliases"
+	StepRoute     = "route"
+	StepNetwork   = "network"
+	StepNTP       = "ntp"
+)
+
+// Step is a step to initialize the os of a node, which runs an init script with the arguments
+type Step struct {
+	Name   string
+	Script string
+	Args   []string
+}
+
+// NodeSteps returns the steps to initialize a node in order, with the settings of the cluster.
+// The nodes are all nodes of the cluster, which are resolved by their names on the node.
+// All the init scripts are idempotent, so the steps can be run again.
+func NodeSteps(node *pb.Node, nodes []*pb.Node, clusterConfig *pb.ClusterConfig) []*Step {
+	aliases := make(map[string]string, len(nodes))
+	for _, n := range nodes {
+		aliases[n.GetName()] = n.GetIp()
+	}
+
+	var subnets []string
+	for _, subnet := range []string{clusterConfig.GetPodSubnet(), clusterConfig.GetServiceSubnet()} {
+		if subnet != "" {
+			subnets = append(subnets, subnet)
+		}
+	}
+
+	steps := []*Step{
+		{Name: StepSwap, Script: "init_change_swap.sh"},
+		{Name: StepFirewall, Script: "init_change_firewall.sh"},
+		{Name: StepHostname, Script: hostname.ChangeHostnameScript, Args: []string{node.GetName()}},
+		{Name: StepTimezone, Script: "init_change_timezone.sh"},
+		{Name: StepHostAlias, Script: hostname.ChangeHostAliasScript, Args: hostname.HostAliasArgs(aliases)},
+		{Name: StepRoute, Script: "init_change_route.sh", Args: subnets},
+		{Name: StepNetwork, Script: "init_change_network.sh"},
+	}
+
+	if ntpServers := clusterConfig.GetNtpServers(); len(ntpServers) > 0 {
+		steps = append(steps, &Step{Name: StepNTP, Script: "init_change_ntp.sh", Args: ntpServers})
+	}
+
+	return steps
+}
+
+// NewStepOperation returns an operation which runs the step on a machine, the exit code of the
+// script is printed in the last line of stdout
+func NewStepOperation(m machine.Machine, step *Step) (operation.Operation, error) {
+	return check.NewInitOperation(m, step.Script, step.Args...)
+}
+
+// ParseStepOutput returns the output of the step and the exit code of its script
+func ParseStepOutput(stdout string) (string, int, error) {
+	output := strings.TrimRight(stdout, "\n")
+	lastLine := output
+	if i := strings.LastIndex(output, "\n"); i >= 0 {
+		output, lastLine = output[:i], output[i+1:]
+	} else {
+		output = ""
+	}
+
+	if !strings.HasPrefix(lastLine, exitCodePrefix) {
+		return stdout, 0, fmt.Errorf("failed to parse the exit code from %q", lastLine)
+	}
+	exitCode, err := strconv.Atoi(strings.TrimPrefix(lastLine, exitCodePrefix))
+	if err != nil {
+		return stdout, 0, fmt.Errorf("failed to parse the exit code from %q, error: %v", lastLine, err)
+	}
+	return output, exitCode, nil
+}
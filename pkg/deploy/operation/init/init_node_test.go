@@ -0,0 +1,110 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package init
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/bundle"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestNodeSteps(t *testing.T) {
+	nodes := []*pb.Node{
+		{Name: "node2", Ip: "192.168.1.12"},
+		{Name: "node1", Ip: "192.168.1.11"},
+	}
+
+	steps := NodeSteps(nodes[1], nodes, &pb.ClusterConfig{
+		PodSubnet:  "172.31.0.0/16",
+		NtpServers: []string{"ntp1.example.com", "ntp2.example.com"},
+	})
+
+	var names []string
+	for _, step := range steps {
+		names = append(names, step.Name)
+	}
+	assert.Equal(t, []string{StepSwap, StepFirewall, StepHostname, StepTimezone, StepHostAlias, StepRoute, StepNetwork, StepNTP}, names)
+	assert.Equal(t, []string{"node1"}, steps[2].Args)
+	assert.Equal(t, []string{"192.168.1.11", "node1", "192.168.1.12", "node2"}, steps[4].Args)
+	assert.Equal(t, []string{"172.31.0.0/16"}, steps[5].Args)
+	assert.Equal(t, []string{"ntp1.example.com", "ntp2.example.com"}, steps[7].Args)
+
+	// the ntp servers are optional
+	assert.Len(t, NodeSteps(nodes[0], nodes, nil), 7)
+}
+
+func TestParseStepOutput(t *testing.T) {
+	tests := []struct {
+		stdout       string
+		wantOutput   string
+		wantExitCode int
+		wantErr      bool
+	}{
+		{stdout: "exit=0\n", wantOutput: "", wantExitCode: 0},
+		{stdout: "delete route: 172.31.0.0/16 dev docker0\nexit=0\n", wantOutput: "delete route: 172.31.0.0/16 dev docker0"},
+		{stdout: "hostname can not be empty\nexit=1", wantOutput: "hostname can not be empty", wantExitCode: 1},
+		{stdout: "killed", wantErr: true},
+		{stdout: "exit=x\n", wantErr: true},
+	}
+
+	for _, test := range tests {
+		output, exitCode, err := ParseStepOutput(test.stdout)
+		if test.wantErr {
+			assert.Error(t, err, test.stdout)
+			continue
+		}
+		assert.NoError(t, err, test.stdout)
+		assert.Equal(t, test.wantOutput, output)
+		assert.Equal(t, test.wantExitCode, exitCode)
+	}
+}
+
+func TestStepOperation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "init-step")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	bundle.RemoteRoot = filepath.Join(dir, "scripts")
+
+	m := machine.NewLocalMachine(&pb.Node{Name: "node1", Ip: "127.0.0.1"})
+	defer m.Close()
+
+	// the parameters are rendered into the runner, so they are passed to the script as they are
+	op, err := NewStepOperation(m, &Step{Name: "resolve", Script: "check_hostname.sh", Args: []string{"it's; $(exit 3)", "node1"}})
+	assert.NoError(t, err)
+	_, stdout, err := op.Do()
+	assert.NoError(t, err)
+	output, exitCode, err := ParseStepOutput(string(stdout))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, exitCode)
+	assert.Contains(t, output, "resolve=it's; $(exit 3) \n")
+	assert.Contains(t, output, "resolve=node1 ")
+
+	op, err = NewStepOperation(m, &Step{Name: "missing", Script: "not_found.sh"})
+	assert.NoError(t, err)
+	// the error of the exit code depends on the machine, the exit code is printed anyway
+	_, stdout, _ = op.Do()
+	_, exitCode, err = ParseStepOutput(string(stdout))
+	assert.NoError(t, err)
+	assert.Equal(t, 127, exitCode)
+}
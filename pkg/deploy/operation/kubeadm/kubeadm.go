@@ -0,0 +1,429 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubeadm
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check/cri"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/etcd"
+	initop "github.com/kpaas-io/kpaas/pkg/deploy/operation/init"
+	"github.com/kpaas-io/kpaas/pkg/deploy/pki"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	deployMasterScript         = "deploy_master.sh"
+	joinWorkerScript           = "join_worker.sh"
+	createBootstrapTokenScript = "create_bootstrap_token.sh"
+
+	// StepDeployMaster is the name of the step which initializes or joins a master by kubeadm
+	StepDeployMaster = "deploy master"
+	// StepJoinWorker is the name of the step which joins a worker by kubeadm
+	StepJoinWorker = "join worker"
+	// StepCreateBootstrapToken is the name of the step which creates a bootstrap token on a master
+	StepCreateBootstrapToken = "create bootstrap token"
+
+	// WorkerTokenTTL is the ttl of the bootstrap token created for the workers to join, it's short-lived
+	// since the workers join right after it's created
+	WorkerTokenTTL = "1h"
+
+	// ConfigPath is the kubeadm config file on the nodes
+	ConfigPath = "/etc/kubernetes/kubeadm-config.yaml"
+
+	// APIServerPort is the port which the apiservers listen on
+	APIServerPort = 6443
+	// HaproxyPort is the port which the haproxy on the masters listens on to proxy the apiservers, which
+	// is the port of the control plane endpoint if the apiservers are connected through the keepalived vip
+	HaproxyPort = 4443
+
+	kubeadmAPIVersion = "kubeadm.k8s.io/v1beta2"
+	kubeletAPIVersion = "kubelet.config.k8s.io/v1beta1"
+
+	tokenChars        = "abcdefghijklmnopqrstuvwxyz0123456789"
+	tokenIDLength     = 6
+	tokenSecretLength = 16
+	tokenTTL          = "24h0m0s"
+)
+
+// InitConfiguration is the kubeadm config to initialize the first master
+type InitConfiguration struct {
+	APIVersion       string            `yaml:"apiVersion"`
+	Kind             string            `yaml:"kind"`
+	BootstrapTokens  []*BootstrapToken `yaml:"bootstrapTokens,omitempty"`
+	NodeRegistration *NodeRegistration `yaml:"nodeRegistration"`
+	LocalAPIEndpoint *APIEndpoint      `yaml:"localAPIEndpoint,omitempty"`
+}
+
+// BootstrapToken is a token for the nodes to join the cluster
+type BootstrapToken struct {
+	Token  string   `yaml:"token"`
+	TTL    string   `yaml:"ttl"`
+	Groups []string `yaml:"groups"`
+	Usages []string `yaml:"usages"`
+}
+
+// NodeRegistration is how a node registers itself to the cluster
+type NodeRegistration struct {
+	Name             string            `yaml:"name"`
+	CRISocket        string            `yaml:"criSocket"`
+	KubeletExtraArgs map[string]string `yaml:"kubeletExtraArgs,omitempty"`
+}
+
+// APIEndpoint is the address of an apiserver
+type APIEndpoint struct {
+	AdvertiseAddress string `yaml:"advertiseAddress"`
+	BindPort         int    `yaml:"bindPort"`
+}
+
+// ClusterConfiguration is the kubeadm config of the control plane, which is shared by all masters
+type ClusterConfiguration struct {
+	APIVersion           string     `yaml:"apiVersion"`
+	Kind                 string     `yaml:"kind"`
+	ClusterName          string     `yaml:"clusterName,omitempty"`
+	KubernetesVersion    string     `yaml:"kubernetesVersion"`
+	ImageRepository      string     `yaml:"imageRepository,omitempty"`
+	ControlPlaneEndpoint string     `yaml:"controlPlaneEndpoint"`
+	Networking           Networking `yaml:"networking"`
+	Etcd                 Etcd       `yaml:"etcd"`
+	APIServer            APIServer  `yaml:"apiServer,omitempty"`
+}
+
+// Networking is the subnets of the cluster
+type Networking struct {
+	PodSubnet     string `yaml:"podSubnet,omitempty"`
+	ServiceSubnet string `yaml:"serviceSubnet"`
+	DNSDomain     string `yaml:"dnsDomain"`
+}
+
+// Etcd is the etcd cluster used by the apiservers
+type Etcd struct {
+	External *ExternalEtcd `yaml:"external"`
+}
+
+// ExternalEtcd is an etcd cluster which is not managed by kubeadm
+type ExternalEtcd struct {
+	Endpoints []string `yaml:"endpoints"`
+	CAFile    string   `yaml:"caFile"`
+	CertFile  string   `yaml:"certFile"`
+	KeyFile   string   `yaml:"keyFile"`
+}
+
+// APIServer is the settings of the apiservers
+type APIServer struct {
+	ExtraArgs map[string]string `yaml:"extraArgs,omitempty"`
+}
+
+// KubeletConfiguration is the kubelet config shared by all nodes, kubeadm uploads it to the cluster
+type KubeletConfiguration struct {
+	APIVersion   string `yaml:"apiVersion"`
+	Kind         string `yaml:"kind"`
+	CgroupDriver string `yaml:"cgroupDriver"`
+}
+
+// JoinConfiguration is the kubeadm config for a node to join the cluster
+type JoinConfiguration struct {
+	APIVersion       string            `yaml:"apiVersion"`
+	Kind             string            `yaml:"kind"`
+	NodeRegistration *NodeRegistration `yaml:"nodeRegistration"`
+	Discovery        Discovery         `yaml:"discovery"`
+	ControlPlane     *JoinControlPlane `yaml:"controlPlane,omitempty"`
+}
+
+// Discovery is how a node finds and trusts the cluster to join
+type Discovery struct {
+	BootstrapToken *BootstrapTokenDiscovery `yaml:"bootstrapToken"`
+}
+
+// BootstrapTokenDiscovery discovers the cluster by a bootstrap token, the ca is verified by its hash
+type BootstrapTokenDiscovery struct {
+	Token             string   `yaml:"token"`
+	APIServerEndpoint string   `yaml:"apiServerEndpoint"`
+	CACertHashes      []string `yaml:"caCertHashes"`
+}
+
+// JoinControlPlane is the settings of a master which joins the control plane
+type JoinControlPlane struct {
+	LocalAPIEndpoint *APIEndpoint `yaml:"localAPIEndpoint"`
+}
+
+// NewBootstrapToken returns a random bootstrap token in the format of <6 chars>.<16 chars>
+func NewBootstrapToken() (string, error) {
+	id, err := randomString(tokenIDLength)
+	if err != nil {
+		return "", err
+	}
+	secret, err := randomString(tokenSecretLength)
+	if err != nil {
+		return "", err
+	}
+	return id + "." + secret, nil
+}
+
+func randomString(length int) (string, error) {
+	chars := make([]byte, length)
+	max := big.NewInt(int64(len(tokenChars)))
+	for i := range chars {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate bootstrap token, error: %v", err)
+		}
+		chars[i] = tokenChars[n.Int64()]
+	}
+	return string(chars), nil
+}
+
+// CACertHash returns the hash of the ca public key, which is used by the joining nodes to verify the cluster
+func CACertHash(cert *x509.Certificate) string {
+	hash := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return "sha256:" + hex.EncodeToString(hash[:])
+}
+
+// ControlPlaneEndpoint returns the address of the apiservers for the nodes and clients by the connect type of
+// the cluster: the first master, the haproxy through the keepalived vip, or the load balancer.
+func ControlPlaneEndpoint(clusterConfig *pb.ClusterConfig, masters []*pb.Node) (string, error) {
+	connect := clusterConfig.GetKubeAPIServerConnect()
+	switch consts.KubeAPIServerConnectType(connect.GetType()) {
+	case "", consts.KubeAPIServerConnectTypeFirstMasterIP:
+		if len(masters) == 0 {
+			return "", fmt.Errorf("no master in the cluster")
+		}
+		return joinHostPort(masters[0].GetIp(), APIServerPort), nil
+
+	case consts.KubeAPIServerConnectTypeKeepalived:
+		vip := connect.GetKeepalived().GetVip()
+		if net.ParseIP(vip) == nil {
+			return "", fmt.Errorf("invalid keepalived vip %q", vip)
+		}
+		return joinHostPort(vip, HaproxyPort), nil
+
+	case consts.KubeAPIServerConnectTypeLoadbalancer:
+		lb := connect.GetLoadbalancer()
+		if net.ParseIP(lb.GetIp()) == nil {
+			return "", fmt.Errorf("invalid load balancer ip %q", lb.GetIp())
+		}
+		port := int(lb.GetPort())
+		if port == 0 {
+			port = APIServerPort
+		}
+		return joinHostPort(lb.GetIp(), port), nil
+
+	default:
+		return "", fmt.Errorf("unknown kube apiserver connect type %q", connect.GetType())
+	}
+}
+
+func joinHostPort(host string, port int) string {
+	return net.JoinHostPort(host, fmt.Sprint(port))
+}
+
+// InitConfig renders the kubeadm config to initialize the control plane on the first master, the apiservers
+// use the etcd nodes as an external etcd cluster, and the nodes join the cluster by the bootstrap token.
+func InitConfig(node *pb.Node, masters, etcdNodes []*pb.Node, clusterConfig *pb.ClusterConfig, token string) ([]byte, error) {
+	nodeRegistration, err := newNodeRegistration(node, clusterConfig)
+	if err != nil {
+		return nil, err
+	}
+	clusterConfiguration, err := newClusterConfiguration(masters, etcdNodes, clusterConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	initConfiguration := &InitConfiguration{
+		APIVersion: kubeadmAPIVersion,
+		Kind:       "InitConfiguration",
+		BootstrapTokens: []*BootstrapToken{{
+			Token:  token,
+			TTL:    tokenTTL,
+			Groups: []string{"system:bootstrappers:kubeadm:default-node-token"},
+			Usages: []string{"signing", "authentication"},
+		}},
+		NodeRegistration: nodeRegistration,
+		LocalAPIEndpoint: &APIEndpoint{AdvertiseAddress: node.GetIp(), BindPort: APIServerPort},
+	}
+	kubeletConfiguration := &KubeletConfiguration{
+		APIVersion: kubeletAPIVersion,
+		Kind:       "KubeletConfiguration",
+		// the container runtimes are initialized with the systemd cgroup driver
+		CgroupDriver: cri.CgroupDriverSystemd,
+	}
+
+	return marshalDocuments(initConfiguration, clusterConfiguration, kubeletConfiguration)
+}
+
+// JoinConfig renders the kubeadm config for a node to join the cluster by the bootstrap token, the node joins
+// the control plane if controlPlane is true. The cluster is trusted if its ca is the ca of the cluster pki.
+func JoinConfig(node *pb.Node, masters []*pb.Node, clusterConfig *pb.ClusterConfig, ca *pki.CA, token string, controlPlane bool) ([]byte, error) {
+	nodeRegistration, err := newNodeRegistration(node, clusterConfig)
+	if err != nil {
+		return nil, err
+	}
+	endpoint, err := ControlPlaneEndpoint(clusterConfig, masters)
+	if err != nil {
+		return nil, err
+	}
+
+	joinConfiguration := &JoinConfiguration{
+		APIVersion:       kubeadmAPIVersion,
+		Kind:             "JoinConfiguration",
+		NodeRegistration: nodeRegistration,
+		Discovery: Discovery{
+			BootstrapToken: &BootstrapTokenDiscovery{
+				Token:             token,
+				APIServerEndpoint: endpoint,
+				CACertHashes:      []string{CACertHash(ca.Cert)},
+			},
+		},
+	}
+	if controlPlane {
+		joinConfiguration.ControlPlane = &JoinControlPlane{
+			LocalAPIEndpoint: &APIEndpoint{AdvertiseAddress: node.GetIp(), BindPort: APIServerPort},
+		}
+	}
+
+	return marshalDocuments(joinConfiguration)
+}
+
+func newNodeRegistration(node *pb.Node, clusterConfig *pb.ClusterConfig) (*NodeRegistration, error) {
+	runtime, err := cri.Runtime(clusterConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NodeRegistration{
+		Name:      node.GetName(),
+		CRISocket: criSocket(runtime),
+		// kubelet may choose another ip of the node if it has more than one network interface
+		KubeletExtraArgs: map[string]string{"node-ip": node.GetIp()},
+	}, nil
+}
+
+// criSocket returns the cri socket of kubelet, kubelet talks to docker by the built-in dockershim
+func criSocket(runtime consts.ContainerRuntime) string {
+	if runtime == consts.ContainerRuntimeDocker {
+		return "/var/run/dockershim.sock"
+	}
+	return cri.Socket(runtime)
+}
+
+func newClusterConfiguration(masters, etcdNodes []*pb.Node, clusterConfig *pb.ClusterConfig) (*ClusterConfiguration, error) {
+	if clusterConfig.GetKubernetesVersion() == "" {
+		return nil, fmt.Errorf("kubernetes version is empty")
+	}
+	if len(etcdNodes) == 0 {
+		return nil, fmt.Errorf("no etcd node in the cluster")
+	}
+	endpoint, err := ControlPlaneEndpoint(clusterConfig, masters)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceSubnet := clusterConfig.GetServiceSubnet()
+	if serviceSubnet == "" {
+		serviceSubnet = pki.DefaultServiceSubnet
+	}
+
+	apiServer := APIServer{}
+	if portRange := clusterConfig.GetNodePortRange(); portRange != nil {
+		if portRange.GetFrom() == 0 || portRange.GetFrom() > portRange.GetTo() || portRange.GetTo() > 65535 {
+			return nil, fmt.Errorf("invalid node port range %v-%v", portRange.GetFrom(), portRange.GetTo())
+		}
+		apiServer.ExtraArgs = map[string]string{
+			"service-node-port-range": fmt.Sprintf("%v-%v", portRange.GetFrom(), portRange.GetTo()),
+		}
+	}
+
+	return &ClusterConfiguration{
+		APIVersion:           kubeadmAPIVersion,
+		Kind:                 "ClusterConfiguration",
+		ClusterName:          clusterConfig.GetClusterName(),
+		KubernetesVersion:    clusterConfig.GetKubernetesVersion(),
+		ImageRepository:      clusterConfig.GetImageRepository(),
+		ControlPlaneEndpoint: endpoint,
+		Networking: Networking{
+			PodSubnet:     clusterConfig.GetPodSubnet(),
+			ServiceSubnet: serviceSubnet,
+			DNSDomain:     pki.DNSDomain,
+		},
+		Etcd: Etcd{
+			External: &ExternalEtcd{
+				Endpoints: etcd.Endpoints(etcdNodes),
+				CAFile:    pki.EtcdDir + "/ca.crt",
+				CertFile:  pki.Dir + "/apiserver-etcd-client.crt",
+				KeyFile:   pki.Dir + "/apiserver-etcd-client.key",
+			},
+		},
+		APIServer: apiServer,
+	}, nil
+}
+
+func marshalDocuments(documents ...interface{}) ([]byte, error) {
+	contents := make([]string, 0, len(documents))
+	for _, document := range documents {
+		content, err := yaml.Marshal(document)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal kubeadm config, error: %v", err)
+		}
+		contents = append(contents, string(content))
+	}
+	return []byte(strings.Join(contents, "---\n")), nil
+}
+
+// DeployMasterStep returns the step which installs kubeadm, kubelet and kubectl of the kubernetes version on the
+// master, then initializes the control plane if init is true, or joins the control plane otherwise, by the
+// kubeadm config in ConfigPath. The master which is already initialized or joined is not changed, and the
+// bootstrap token is recreated on the first master if it's missing, e.g. expired.
+func DeployMasterStep(clusterConfig *pb.ClusterConfig, token string, init bool) *initop.Step {
+	mode := "join"
+	if init {
+		mode = "init"
+	}
+	return &initop.Step{
+		Name:   StepDeployMaster,
+		Script: deployMasterScript,
+		Args:   []string{mode, ConfigPath, clusterConfig.GetKubernetesVersion(), token},
+	}
+}
+
+// JoinWorkerStep returns the step which installs kubeadm, kubelet and kubectl of the kubernetes version on the
+// worker, then joins the cluster by the kubeadm config in ConfigPath. The worker which has already joined is not
+// changed.
+func JoinWorkerStep(clusterConfig *pb.ClusterConfig) *initop.Step {
+	return &initop.Step{
+		Name:   StepJoinWorker,
+		Script: joinWorkerScript,
+		Args:   []string{ConfigPath, clusterConfig.GetKubernetesVersion()},
+	}
+}
+
+// CreateTokenStep returns the step which creates the bootstrap token with the ttl on an initialized master,
+// it succeeds if the token already exists.
+func CreateTokenStep(token, ttl string) *initop.Step {
+	return &initop.Step{
+		Name:   StepCreateBootstrapToken,
+		Script: createBootstrapTokenScript,
+		Args:   []string{token, ttl},
+	}
+}
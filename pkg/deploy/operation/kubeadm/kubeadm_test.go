@@ -0,0 +1,186 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubeadm
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/pki"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+var (
+	testMasters = []*pb.Node{{Name: "master1", Ip: "192.168.1.1"}, {Name: "master2", Ip: "192.168.1.2"}}
+	testEtcd    = []*pb.Node{{Name: "etcd1", Ip: "192.168.1.11"}}
+)
+
+func TestControlPlaneEndpoint(t *testing.T) {
+	tests := []struct {
+		connect *pb.KubeAPIServerConnect
+		want    string
+		wantErr bool
+	}{
+		{connect: nil, want: "192.168.1.1:6443"},
+		{connect: &pb.KubeAPIServerConnect{Type: "firstMasterIP"}, want: "192.168.1.1:6443"},
+		{connect: &pb.KubeAPIServerConnect{Type: "keepalived", Keepalived: &pb.Keepalived{Vip: "192.168.1.100"}}, want: "192.168.1.100:4443"},
+		{connect: &pb.KubeAPIServerConnect{Type: "keepalived"}, wantErr: true},
+		{connect: &pb.KubeAPIServerConnect{Type: "loadbalancer", Loadbalancer: &pb.Loadbalancer{Ip: "10.0.0.1", Port: 443}}, want: "10.0.0.1:443"},
+		{connect: &pb.KubeAPIServerConnect{Type: "loadbalancer", Loadbalancer: &pb.Loadbalancer{Ip: "10.0.0.1"}}, want: "10.0.0.1:6443"},
+		{connect: &pb.KubeAPIServerConnect{Type: "dns"}, wantErr: true},
+	}
+
+	for _, test := range tests {
+		endpoint, err := ControlPlaneEndpoint(&pb.ClusterConfig{KubeAPIServerConnect: test.connect}, testMasters)
+		if test.wantErr {
+			assert.Error(t, err, "%v", test.connect)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, test.want, endpoint)
+	}
+
+	_, err := ControlPlaneEndpoint(nil, nil)
+	assert.Error(t, err)
+}
+
+func TestNewBootstrapToken(t *testing.T) {
+	token, err := NewBootstrapToken()
+	assert.NoError(t, err)
+	assert.Regexp(t, regexp.MustCompile(`^[a-z0-9]{6}\.[a-z0-9]{16}$`), token)
+
+	other, err := NewBootstrapToken()
+	assert.NoError(t, err)
+	assert.NotEqual(t, token, other)
+}
+
+func unmarshalDocuments(t *testing.T, config []byte) map[string]map[string]interface{} {
+	documents := make(map[string]map[string]interface{})
+	for _, content := range strings.Split(string(config), "---\n") {
+		document := make(map[string]interface{})
+		if err := yaml.Unmarshal([]byte(content), &document); err != nil {
+			t.Fatal(err)
+		}
+		documents[document["kind"].(string)] = document
+	}
+	return documents
+}
+
+func TestInitConfig(t *testing.T) {
+	clusterConfig := &pb.ClusterConfig{
+		ClusterName:       "cluster1",
+		KubernetesVersion: "v1.16.4",
+		ImageRepository:   "registry.example.com/k8s",
+		PodSubnet:         "172.31.0.0/16",
+		NodePortRange:     &pb.NodePortRange{From: 30000, To: 32000},
+		ContainerRuntime:  "containerd",
+	}
+	config, err := InitConfig(testMasters[0], testMasters, testEtcd, clusterConfig, "abcdef.0123456789abcdef")
+	assert.NoError(t, err)
+
+	documents := unmarshalDocuments(t, config)
+	assert.Len(t, documents, 3)
+
+	initConfiguration := documents["InitConfiguration"]
+	assert.Equal(t, "kubeadm.k8s.io/v1beta2", initConfiguration["apiVersion"])
+	assert.Equal(t, "abcdef.0123456789abcdef", initConfiguration["bootstrapTokens"].([]interface{})[0].(map[interface{}]interface{})["token"])
+	nodeRegistration := initConfiguration["nodeRegistration"].(map[interface{}]interface{})
+	assert.Equal(t, "master1", nodeRegistration["name"])
+	assert.Equal(t, "/run/containerd/containerd.sock", nodeRegistration["criSocket"])
+	assert.Equal(t, "192.168.1.1", initConfiguration["localAPIEndpoint"].(map[interface{}]interface{})["advertiseAddress"])
+
+	clusterConfiguration := documents["ClusterConfiguration"]
+	assert.Equal(t, "cluster1", clusterConfiguration["clusterName"])
+	assert.Equal(t, "v1.16.4", clusterConfiguration["kubernetesVersion"])
+	assert.Equal(t, "registry.example.com/k8s", clusterConfiguration["imageRepository"])
+	assert.Equal(t, "192.168.1.1:6443", clusterConfiguration["controlPlaneEndpoint"])
+	assert.Equal(t, map[interface{}]interface{}{"podSubnet": "172.31.0.0/16", "serviceSubnet": "10.96.0.0/12", "dnsDomain": "cluster.local"},
+		clusterConfiguration["networking"])
+	external := clusterConfiguration["etcd"].(map[interface{}]interface{})["external"].(map[interface{}]interface{})
+	assert.Equal(t, []interface{}{"https://192.168.1.11:2379"}, external["endpoints"])
+	assert.Equal(t, pki.Dir+"/apiserver-etcd-client.crt", external["certFile"])
+	assert.Equal(t, map[interface{}]interface{}{"extraArgs": map[interface{}]interface{}{"service-node-port-range": "30000-32000"}},
+		clusterConfiguration["apiServer"])
+
+	assert.Equal(t, "systemd", documents["KubeletConfiguration"]["cgroupDriver"])
+
+	// the node port range is not changed if it's not set
+	clusterConfig.NodePortRange = nil
+	config, err = InitConfig(testMasters[0], testMasters, testEtcd, clusterConfig, "abcdef.0123456789abcdef")
+	assert.NoError(t, err)
+	assert.NotContains(t, unmarshalDocuments(t, config)["ClusterConfiguration"], "apiServer")
+
+	for _, invalid := range []*pb.ClusterConfig{
+		{KubernetesVersion: "v1.16.4", NodePortRange: &pb.NodePortRange{From: 32000, To: 30000}},
+		{KubernetesVersion: "v1.16.4", ContainerRuntime: "rkt"},
+		{},
+	} {
+		_, err = InitConfig(testMasters[0], testMasters, testEtcd, invalid, "abcdef.0123456789abcdef")
+		assert.Error(t, err, "%v", invalid)
+	}
+	_, err = InitConfig(testMasters[0], testMasters, nil, &pb.ClusterConfig{KubernetesVersion: "v1.16.4"}, "abcdef.0123456789abcdef")
+	assert.Error(t, err)
+}
+
+func TestJoinConfig(t *testing.T) {
+	ca, err := pki.NewCA(pki.CACommonName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clusterConfig := &pb.ClusterConfig{
+		KubeAPIServerConnect: &pb.KubeAPIServerConnect{Type: "keepalived", Keepalived: &pb.Keepalived{Vip: "192.168.1.100"}},
+	}
+
+	config, err := JoinConfig(testMasters[1], testMasters, clusterConfig, ca, "abcdef.0123456789abcdef", true)
+	assert.NoError(t, err)
+	joinConfiguration := unmarshalDocuments(t, config)["JoinConfiguration"]
+	assert.Equal(t, "/var/run/dockershim.sock", joinConfiguration["nodeRegistration"].(map[interface{}]interface{})["criSocket"])
+	assert.Equal(t, map[interface{}]interface{}{
+		"token":             "abcdef.0123456789abcdef",
+		"apiServerEndpoint": "192.168.1.100:4443",
+		"caCertHashes":      []interface{}{CACertHash(ca.Cert)},
+	}, joinConfiguration["discovery"].(map[interface{}]interface{})["bootstrapToken"])
+	assert.Equal(t, map[interface{}]interface{}{"advertiseAddress": "192.168.1.2", "bindPort": 6443},
+		joinConfiguration["controlPlane"].(map[interface{}]interface{})["localAPIEndpoint"])
+
+	config, err = JoinConfig(testMasters[1], testMasters, clusterConfig, ca, "abcdef.0123456789abcdef", false)
+	assert.NoError(t, err)
+	assert.NotContains(t, unmarshalDocuments(t, config)["JoinConfiguration"], "controlPlane")
+
+	assert.Regexp(t, regexp.MustCompile(`^sha256:[0-9a-f]{64}$`), CACertHash(ca.Cert))
+}
+
+func TestDeployMasterStep(t *testing.T) {
+	step := DeployMasterStep(&pb.ClusterConfig{KubernetesVersion: "v1.16.4"}, "abcdef.0123456789abcdef", true)
+	assert.Equal(t, "deploy_master.sh", step.Script)
+	assert.Equal(t, []string{"init", ConfigPath, "v1.16.4", "abcdef.0123456789abcdef"}, step.Args)
+
+	step = DeployMasterStep(&pb.ClusterConfig{KubernetesVersion: "v1.16.4"}, "abcdef.0123456789abcdef", false)
+	assert.Equal(t, "join", step.Args[0])
+}
+
+func TestWorkerSteps(t *testing.T) {
+	step := JoinWorkerStep(&pb.ClusterConfig{KubernetesVersion: "v1.16.4"})
+	assert.Equal(t, "join_worker.sh", step.Script)
+	assert.Equal(t, []string{ConfigPath, "v1.16.4"}, step.Args)
+
+	step = CreateTokenStep("abcdef.0123456789abcdef", WorkerTokenTTL)
+	assert.Equal(t, "create_bootstrap_token.sh", step.Script)
+	assert.Equal(t, []string{"abcdef.0123456789abcdef", "1h"}, step.Args)
+}
@@ -0,0 +1,216 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operation
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/coreos/go-semver/semver"
+)
+
+const (
+	constraintOr = "||"
+	// invalidSuffixChars are not allowed in the suffix of a version, which are parts of constraints
+	invalidSuffixChars = " \t<>=!|~"
+)
+
+var (
+	// versionPattern matches the numeric part of a version and the suffix, e.g. "v1.17.0-rc.1",
+	// "19.03.5-ce" and "4.19.91-24.8.an8.x86_64"
+	versionPattern = regexp.MustCompile(`^[vV]?(\d+)(?:\.(\d+))?(?:\.(\d+))?(.*)$`)
+	// preReleasePattern matches the suffixes which are pre-releases, other suffixes are vendor or
+	// build suffixes, which don't take part in comparing
+	preReleasePattern = regexp.MustCompile(`(?i)^[-.]?((?:alpha|beta|rc|pre|dev)[0-9A-Za-z.\-]*)$`)
+	// invalidIdentifierChars are the characters not allowed in the identifiers of semver
+	invalidIdentifierChars = regexp.MustCompile(`[^0-9A-Za-z.\-]`)
+	constraintOperators    = []string{">=", "<=", "!=", ">", "<", "=", "~"}
+)
+
+// ParseVersion parses a version of docker, kernel, kubernetes and so on to semver. The missing minor
+// and patch are 0. A suffix is a pre-release if it starts with alpha, beta, rc, pre or dev, e.g.
+// "v1.17.0-rc.1", otherwise it's kept as the metadata, which is ignored in comparing, e.g. "-ce" of
+// "19.03.5-ce" and "-957.el7.x86_64" of "3.10.0-957.el7.x86_64".
+func ParseVersion(raw string) (*semver.Version, error) {
+	matches := versionPattern.FindStringSubmatch(strings.TrimSpace(raw))
+	if matches == nil || strings.ContainsAny(matches[4], invalidSuffixChars) {
+		return nil, fmt.Errorf("%v, invalid version: %q", ErrParaInput, raw)
+	}
+
+	version := &semver.Version{}
+	numbers := []*int64{&version.Major, &version.Minor, &version.Patch}
+	for i, number := range matches[1:4] {
+		if number == "" {
+			continue
+		}
+		value, err := strconv.ParseInt(number, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%v, invalid version: %q, error: %v", ErrParaInput, raw, err)
+		}
+		*numbers[i] = value
+	}
+
+	suffix := matches[4]
+	if pre := preReleasePattern.FindStringSubmatch(suffix); pre != nil {
+		version.PreRelease = semver.PreRelease(strings.Trim(invalidIdentifierChars.ReplaceAllString(pre[1], "-"), ".-"))
+	} else if suffix != "" {
+		version.Metadata = strings.TrimLeft(suffix, "-+.")
+	}
+
+	return version, nil
+}
+
+// constraintTerm is a single comparison of a constraint, e.g. ">=18.09"
+type constraintTerm struct {
+	operator string
+	version  *semver.Version
+}
+
+func (term *constraintTerm) allows(version *semver.Version) bool {
+	// the metadata doesn't take part in comparing
+	v := *version
+	v.Metadata = ""
+	compared := v.Compare(*term.version)
+
+	switch term.operator {
+	case ">=":
+		return compared >= 0
+	case ">":
+		return compared > 0
+	case "<=":
+		return compared <= 0
+	case "<":
+		return compared < 0
+	case "!=":
+		return compared != 0
+	case "~":
+		// the same major and minor, and not less than the version
+		return compared >= 0 && v.Major == term.version.Major && v.Minor == term.version.Minor
+	default:
+		return compared == 0
+	}
+}
+
+// Constraint is a version constraint expression, which is made of comparisons separated by spaces,
+// all of which must be satisfied, e.g. ">=18.09 <20". Expressions separated by "||" are alternatives,
+// e.g. "~1.16 || ~1.17". The operators are >=, >, <=, <, =, != and ~, which means the same minor
+// version and not less than the version. A bare version is a minimum version, e.g. "18.09.0" is ">=18.09.0".
+type Constraint struct {
+	raw          string
+	alternatives [][]*constraintTerm
+}
+
+// ParseConstraint parses a version constraint expression
+func ParseConstraint(raw string) (*Constraint, error) {
+	constraint := &Constraint{raw: strings.TrimSpace(raw)}
+	for _, expression := range strings.Split(raw, constraintOr) {
+		terms, err := parseConstraintTerms(expression)
+		if err != nil {
+			return nil, fmt.Errorf("%v, invalid version constraint: %q, error: %v", ErrParaInput, raw, err)
+		}
+		constraint.alternatives = append(constraint.alternatives, terms)
+	}
+	return constraint, nil
+}
+
+func parseConstraintTerms(expression string) ([]*constraintTerm, error) {
+	var terms []*constraintTerm
+	var pendingOperator string
+	for _, field := range strings.Fields(expression) {
+		operator := ""
+		for _, op := range constraintOperators {
+			if strings.HasPrefix(field, op) {
+				operator = op
+				break
+			}
+		}
+		if pendingOperator != "" {
+			if operator != "" {
+				return nil, fmt.Errorf("operator %v is not followed by a version", pendingOperator)
+			}
+			operator = pendingOperator
+			pendingOperator = ""
+		} else {
+			field = strings.TrimPrefix(field, operator)
+		}
+		// the operator may be separated from the version by spaces, e.g. ">= 18.09"
+		if field == "" {
+			pendingOperator = operator
+			continue
+		}
+		if operator == "" {
+			operator = ">="
+		}
+
+		version, err := ParseVersion(field)
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, &constraintTerm{operator: operator, version: version})
+	}
+
+	if pendingOperator != "" {
+		return nil, fmt.Errorf("operator %v is not followed by a version", pendingOperator)
+	}
+	if len(terms) == 0 {
+		return nil, fmt.Errorf("empty constraint")
+	}
+	return terms, nil
+}
+
+// Allows returns if the version satisfies the constraint
+func (c *Constraint) Allows(version *semver.Version) bool {
+	for _, terms := range c.alternatives {
+		allowed := true
+		for _, term := range terms {
+			if !term.allows(version) {
+				allowed = false
+				break
+			}
+		}
+		if allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// violation returns why the version doesn't satisfy the constraint, it's judged by the first
+// failed comparison of the first alternative.
+func (c *Constraint) violation(version *semver.Version) string {
+	for _, term := range c.alternatives[0] {
+		if term.allows(version) {
+			continue
+		}
+		switch term.operator {
+		case ">=", ">":
+			return ErrTooLow
+		case "<=", "<":
+			return ErrTooHigh
+		case "~":
+			if version.LessThan(*term.version) {
+				return ErrTooLow
+			}
+			return ErrTooHigh
+		}
+	}
+	return ErrNotEqual
+}
+
+func (c *Constraint) String() string {
+	return c.raw
+}
@@ -0,0 +1,115 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/coreos/go-semver/semver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		raw     string
+		want    semver.Version
+		wantErr bool
+	}{
+		{raw: "v1.17.0", want: semver.Version{Major: 1, Minor: 17}},
+		{raw: "19.03.5-ce", want: semver.Version{Major: 19, Minor: 3, Patch: 5, Metadata: "ce"}},
+		{raw: "18.09", want: semver.Version{Major: 18, Minor: 9}},
+		{raw: "4.19.91-24.8.an8.x86_64", want: semver.Version{Major: 4, Minor: 19, Patch: 91, Metadata: "24.8.an8.x86_64"}},
+		{raw: "3.10.0-957.21.3.el7.x86_64\n", want: semver.Version{Major: 3, Minor: 10, Metadata: "957.21.3.el7.x86_64"}},
+		{raw: "v1.18.0-rc.1", want: semver.Version{Major: 1, Minor: 18, PreRelease: "rc.1"}},
+		{raw: "1.3.0-beta.2", want: semver.Version{Major: 1, Minor: 3, PreRelease: "beta.2"}},
+		{raw: "", wantErr: true},
+		{raw: "latest", wantErr: true},
+		{raw: "18.09 <20", wantErr: true},
+	}
+
+	for _, test := range tests {
+		version, err := ParseVersion(test.raw)
+		if test.wantErr {
+			assert.Error(t, err, test.raw)
+			continue
+		}
+		if assert.NoError(t, err, test.raw) {
+			assert.Equal(t, test.want, *version, test.raw)
+		}
+	}
+}
+
+func TestCheckVersion(t *testing.T) {
+	tests := []struct {
+		version    string
+		constraint string
+		want       string
+	}{
+		{version: "19.03.5-ce", constraint: ">=18.09 <20"},
+		{version: "18.09.7", constraint: ">= 18.09 < 20"},
+		{version: "20.10.0", constraint: ">=18.09 <20", want: ErrTooHigh},
+		{version: "17.03.2-ee-8", constraint: ">=18.09 <20", want: ErrTooLow},
+		{version: "17.03.2-ee-8", constraint: "18.09.0", want: ErrTooLow},
+		{version: "4.19.91-24.8.an8.x86_64", constraint: "4.19.46"},
+		{version: "4.19.91-24.8.an8.x86_64", constraint: ">4.19.91", want: ErrTooLow},
+		{version: "v1.17.0", constraint: "=1.17.0"},
+		{version: "v1.17.2", constraint: "~1.17"},
+		{version: "v1.18.0", constraint: "~1.17", want: ErrTooHigh},
+		{version: "v1.16.4", constraint: "~1.17", want: ErrTooLow},
+		// a pre-release is less than the release
+		{version: "v1.17.0-rc.1", constraint: ">=1.17.0", want: ErrTooLow},
+		{version: "v1.16.4", constraint: "~1.16 || ~1.17"},
+		{version: "v1.15.0", constraint: "~1.16 || ~1.17", want: ErrTooLow},
+		{version: "1.17.1", constraint: "!=1.17.1", want: ErrNotEqual},
+		{version: "1.17.1", constraint: "=1.17.0", want: ErrNotEqual},
+	}
+
+	for _, test := range tests {
+		err := CheckVersion(test.version, test.constraint)
+		if test.want == "" {
+			assert.NoError(t, err, "%v %v", test.version, test.constraint)
+		} else {
+			want := fmt.Errorf("%v, desired version: %v, actual version: %v", test.want, test.constraint, test.version)
+			assert.Equal(t, want, err, "%v %v", test.version, test.constraint)
+		}
+	}
+}
+
+func TestParseConstraint(t *testing.T) {
+	tests := []struct {
+		raw     string
+		wantErr bool
+	}{
+		{raw: "18.09.0"},
+		{raw: ">=18.09 <20"},
+		{raw: ">= 18.09 < 20"},
+		{raw: "~1.16 || ~1.17"},
+		{raw: "", wantErr: true},
+		{raw: ">=", wantErr: true},
+		{raw: ">= <20", wantErr: true},
+		{raw: "~1.16 ||", wantErr: true},
+		{raw: ">=latest", wantErr: true},
+	}
+
+	for _, test := range tests {
+		_, err := ParseConstraint(test.raw)
+		if test.wantErr {
+			assert.Error(t, err, test.raw)
+		} else {
+			assert.NoError(t, err, test.raw)
+		}
+	}
+}
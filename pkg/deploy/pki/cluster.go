@@ -0,0 +1,441 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pki
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"os"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	// Dir is the directory of the certificates on the nodes, which is the same as kubeadm's
+	Dir = "/etc/kubernetes/pki"
+	// EtcdDir is the directory of the etcd certificates on the nodes
+	EtcdDir = Dir + "/etcd"
+
+	CACommonName           = "kubernetes"
+	EtcdCACommonName       = "etcd-ca"
+	FrontProxyCACommonName = "front-proxy-ca"
+
+	// EtcdHealthcheckClientCommonName is the common name of the client certificate used to check the etcd cluster
+	EtcdHealthcheckClientCommonName  = "kube-etcd-healthcheck-client"
+	APIServerCommonName              = "kube-apiserver"
+	APIServerKubeletClientCommonName = "kube-apiserver-kubelet-client"
+	APIServerEtcdClientCommonName    = "kube-apiserver-etcd-client"
+	FrontProxyClientCommonName       = "front-proxy-client"
+	// ControllerClientCommonName is the common name of the client certificate used by the deploy controller to
+	// talk to the apiservers
+	ControllerClientCommonName = "kpaas-controller"
+
+	// DefaultServiceSubnet is the service subnet of the cluster if it's not set in the cluster config,
+	// the first ip of the subnet is the cluster ip of the kubernetes service
+	DefaultServiceSubnet = "10.96.0.0/12"
+	// DNSDomain is the dns domain of the services in the cluster
+	DNSDomain = "cluster.local"
+
+	mastersGroup = "system:masters"
+
+	certFileMode os.FileMode = 0644
+	keyFileMode  os.FileMode = 0600
+
+	// nodesDir is the directory of the issued certificates of the nodes in the directory of a cluster in the store
+	nodesDir = "nodes"
+	// certRenewBefore is the remaining validity period, within which a stored certificate is issued again
+	certRenewBefore = 30 * 24 * time.Hour
+)
+
+// File is a certificate or key to be put to a node
+type File struct {
+	Path    string
+	Content []byte
+	Mode    os.FileMode
+}
+
+// ClusterPKI is the certificate authorities and the service account key of a cluster,
+// the certificates of the nodes are issued by the certificate authorities.
+type ClusterPKI struct {
+	// CA is the root certificate authority of the cluster, which signs the certificates of the kubernetes components
+	CA *CA
+	// EtcdCA signs the certificates of the etcd members and the etcd clients
+	EtcdCA *CA
+	// FrontProxyCA signs the client certificate of the apiserver to access the extension apiservers
+	FrontProxyCA *CA
+	// ServiceAccountKey signs the service account tokens
+	ServiceAccountKey *rsa.PrivateKey
+
+	// dir is the directory of the cluster in the store, the certificates of the nodes are kept in it and reused
+	// while they are valid and match the nodes. It's empty if the pki is not from a store, then the certificates
+	// are issued every time.
+	dir  string
+	lock sync.Mutex
+}
+
+// NewClusterPKI returns a cluster pki with new certificate authorities and service account key
+func NewClusterPKI() (*ClusterPKI, error) {
+	p := &ClusterPKI{}
+	var err error
+	if p.CA, err = NewCA(CACommonName); err != nil {
+		return nil, err
+	}
+	if p.EtcdCA, err = NewCA(EtcdCACommonName); err != nil {
+		return nil, err
+	}
+	if p.FrontProxyCA, err = NewCA(FrontProxyCACommonName); err != nil {
+		return nil, err
+	}
+	if p.ServiceAccountKey, err = NewKey(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// EtcdFiles issues the certificates of the etcd member on the node: the server certificate for the clients,
+// the peer certificate and the client certificate for health checks.
+func (p *ClusterPKI) EtcdFiles(node *pb.Node) ([]*File, error) {
+	ip := net.ParseIP(node.GetIp())
+	if ip == nil {
+		return nil, fmt.Errorf("invalid ip %q of node %v", node.GetIp(), node.GetName())
+	}
+
+	certs := []struct {
+		name string
+		cfg  *CertConfig
+	}{
+		{
+			name: "server",
+			cfg: &CertConfig{
+				CommonName: node.GetName(),
+				AltNames: AltNames{
+					DNSNames: []string{node.GetName(), "localhost"},
+					IPs:      []net.IP{ip, net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+				},
+				Usages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+			},
+		},
+		{
+			name: "peer",
+			cfg: &CertConfig{
+				CommonName: node.GetName(),
+				AltNames: AltNames{
+					DNSNames: []string{node.GetName()},
+					IPs:      []net.IP{ip},
+				},
+				Usages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+			},
+		},
+		{
+			name: "healthcheck-client",
+			cfg: &CertConfig{
+				CommonName:   EtcdHealthcheckClientCommonName,
+				Organization: []string{mastersGroup},
+				Usages:       []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+			},
+		},
+	}
+
+	files := caFiles(EtcdDir, "ca", p.EtcdCA, false)
+	for _, cert := range certs {
+		pair, err := p.issue(p.EtcdCA, node, path.Join("etcd", cert.name), cert.cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to issue etcd %v certificate of node %v, error: %v", cert.name, node.GetName(), err)
+		}
+		files = append(files, keyPairFiles(EtcdDir, cert.name, pair)...)
+	}
+	return files, nil
+}
+
+// MasterFiles issues the certificates of the control plane on the master node, the masters are all master
+// nodes of the cluster. The keys of the certificate authorities are included, which are used by the
+// controller manager to sign the certificates of kubelets, and the service account key pair.
+func (p *ClusterPKI) MasterFiles(node *pb.Node, masters []*pb.Node, clusterConfig *pb.ClusterConfig) ([]*File, error) {
+	altNames, err := APIServerAltNames(node, masters, clusterConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	certs := []struct {
+		name string
+		ca   *CA
+		cfg  *CertConfig
+	}{
+		{
+			name: "apiserver",
+			ca:   p.CA,
+			cfg: &CertConfig{
+				CommonName: APIServerCommonName,
+				AltNames:   *altNames,
+				Usages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+			},
+		},
+		{
+			name: "apiserver-kubelet-client",
+			ca:   p.CA,
+			cfg: &CertConfig{
+				CommonName:   APIServerKubeletClientCommonName,
+				Organization: []string{mastersGroup},
+				Usages:       []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+			},
+		},
+		{
+			name: "apiserver-etcd-client",
+			ca:   p.EtcdCA,
+			cfg: &CertConfig{
+				CommonName:   APIServerEtcdClientCommonName,
+				Organization: []string{mastersGroup},
+				Usages:       []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+			},
+		},
+		{
+			name: "front-proxy-client",
+			ca:   p.FrontProxyCA,
+			cfg: &CertConfig{
+				CommonName: FrontProxyClientCommonName,
+				Usages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+			},
+		},
+	}
+
+	var files []*File
+	files = append(files, caFiles(Dir, "ca", p.CA, true)...)
+	files = append(files, caFiles(Dir, "front-proxy-ca", p.FrontProxyCA, true)...)
+	files = append(files, caFiles(EtcdDir, "ca", p.EtcdCA, false)...)
+	for _, cert := range certs {
+		pair, err := p.issue(cert.ca, node, cert.name, cert.cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to issue %v certificate of node %v, error: %v", cert.name, node.GetName(), err)
+		}
+		files = append(files, keyPairFiles(Dir, cert.name, pair)...)
+	}
+
+	publicKey, err := EncodePublicKeyPEM(&p.ServiceAccountKey.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	files = append(files,
+		&File{Path: path.Join(Dir, "sa.key"), Content: EncodeKeyPEM(p.ServiceAccountKey), Mode: keyFileMode},
+		&File{Path: path.Join(Dir, "sa.pub"), Content: publicKey, Mode: certFileMode},
+	)
+	return files, nil
+}
+
+// issue returns the certificate of the node with the name, which is the path of its files in the pki directory
+// without the extension. The stored certificate is reused if it's signed by the ca, isn't about to expire and
+// matches the config, e.g. the subject alternative names are unchanged, otherwise a new one is issued and stored.
+func (p *ClusterPKI) issue(ca *CA, node *pb.Node, name string, cfg *CertConfig) (*KeyPair, error) {
+	if p.dir == "" {
+		return ca.Issue(cfg)
+	}
+
+	dir, err := nodeDir(p.dir, node.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	pair, err := loadKeyPair(dir, name)
+	if err != nil {
+		return nil, err
+	}
+	if pair != nil && reusable(pair, ca, cfg) {
+		return pair, nil
+	}
+
+	if pair, err = ca.Issue(cfg); err != nil {
+		return nil, err
+	}
+	if err := saveKeyPair(dir, name, pair); err != nil {
+		return nil, err
+	}
+	return pair, nil
+}
+
+// reusable returns if the certificate is signed by the ca, isn't about to expire and matches the config
+func reusable(pair *KeyPair, ca *CA, cfg *CertConfig) bool {
+	cert := pair.Cert
+	if publicKey, ok := cert.PublicKey.(*rsa.PublicKey); !ok || publicKey.N.Cmp(pair.Key.N) != 0 || publicKey.E != pair.Key.E {
+		return false
+	}
+	if len(cfg.Usages) == 0 || ca.Verify(cert, cfg.Usages[0]) != nil || time.Now().Add(certRenewBefore).After(cert.NotAfter) {
+		return false
+	}
+
+	ips := make([]string, 0, len(cfg.AltNames.IPs))
+	for _, ip := range cfg.AltNames.IPs {
+		ips = append(ips, ip.String())
+	}
+	certIPs := make([]string, 0, len(cert.IPAddresses))
+	for _, ip := range cert.IPAddresses {
+		certIPs = append(certIPs, ip.String())
+	}
+	usages := make([]string, 0, len(cfg.Usages))
+	for _, usage := range cfg.Usages {
+		usages = append(usages, fmt.Sprint(usage))
+	}
+	certUsages := make([]string, 0, len(cert.ExtKeyUsage))
+	for _, usage := range cert.ExtKeyUsage {
+		certUsages = append(certUsages, fmt.Sprint(usage))
+	}
+
+	return cert.Subject.CommonName == cfg.CommonName &&
+		sameStrings(cert.Subject.Organization, cfg.Organization) &&
+		sameStrings(cert.DNSNames, cfg.AltNames.DNSNames) &&
+		sameStrings(certIPs, ips) &&
+		sameStrings(certUsages, usages)
+}
+
+// sameStrings returns if the strings are the same regardless of the order
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ControllerClient issues the client certificate of the deploy controller, which is in the masters group
+// so it's able to manage all resources of the cluster.
+func (p *ClusterPKI) ControllerClient() (*KeyPair, error) {
+	pair, err := p.CA.Issue(&CertConfig{
+		CommonName:   ControllerClientCommonName,
+		Organization: []string{mastersGroup},
+		Usages:       []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue controller client certificate, error: %v", err)
+	}
+	return pair, nil
+}
+
+// APIServerAltNames returns the subject alternative names of the apiserver certificate on the node: the names
+// of the kubernetes service and the cluster ip, the names and ips of all masters, and the vip of keepalived
+// or the ip of the load balancer in front of the apiservers.
+func APIServerAltNames(node *pb.Node, masters []*pb.Node, clusterConfig *pb.ClusterConfig) (*AltNames, error) {
+	serviceSubnet := clusterConfig.GetServiceSubnet()
+	if serviceSubnet == "" {
+		serviceSubnet = DefaultServiceSubnet
+	}
+	serviceIP, err := firstIP(serviceSubnet)
+	if err != nil {
+		return nil, err
+	}
+
+	dnsNames := map[string]bool{
+		"kubernetes":                          true,
+		"kubernetes.default":                  true,
+		"kubernetes.default.svc":              true,
+		"kubernetes.default.svc." + DNSDomain: true,
+	}
+	ips := map[string]net.IP{serviceIP.String(): serviceIP}
+
+	addIP := func(raw, owner string) error {
+		ip := net.ParseIP(raw)
+		if ip == nil {
+			return fmt.Errorf("invalid ip %q of %v", raw, owner)
+		}
+		ips[ip.String()] = ip
+		return nil
+	}
+
+	for _, n := range append([]*pb.Node{node}, masters...) {
+		dnsNames[n.GetName()] = true
+		if err := addIP(n.GetIp(), "node "+n.GetName()); err != nil {
+			return nil, err
+		}
+	}
+
+	connect := clusterConfig.GetKubeAPIServerConnect()
+	if vip := connect.GetKeepalived().GetVip(); vip != "" {
+		if err := addIP(vip, "keepalived"); err != nil {
+			return nil, err
+		}
+	}
+	if lbIP := connect.GetLoadbalancer().GetIp(); lbIP != "" {
+		if err := addIP(lbIP, "load balancer"); err != nil {
+			return nil, err
+		}
+	}
+
+	altNames := &AltNames{}
+	for name := range dnsNames {
+		altNames.DNSNames = append(altNames.DNSNames, name)
+	}
+	sort.Strings(altNames.DNSNames)
+
+	keys := make([]string, 0, len(ips))
+	for key := range ips {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		altNames.IPs = append(altNames.IPs, ips[key])
+	}
+	return altNames, nil
+}
+
+// firstIP returns the first usable ip of the subnet
+func firstIP(subnet string) (net.IP, error) {
+	_, ipNet, err := net.ParseCIDR(subnet)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subnet %q, error: %v", subnet, err)
+	}
+
+	ip := make(net.IP, len(ipNet.IP))
+	copy(ip, ipNet.IP)
+	for i := len(ip) - 1; i >= 0; i-- {
+		ip[i]++
+		if ip[i] != 0 {
+			break
+		}
+	}
+	if !ipNet.Contains(ip) {
+		return nil, fmt.Errorf("subnet %q is too small", subnet)
+	}
+	return ip, nil
+}
+
+// caFiles returns the certificate of the ca in dir, and its key if withKey is true
+func caFiles(dir, name string, ca *CA, withKey bool) []*File {
+	files := []*File{{Path: path.Join(dir, name+".crt"), Content: EncodeCertPEM(ca.Cert), Mode: certFileMode}}
+	if withKey {
+		files = append(files, &File{Path: path.Join(dir, name+".key"), Content: EncodeKeyPEM(ca.Key), Mode: keyFileMode})
+	}
+	return files
+}
+
+func keyPairFiles(dir, name string, pair *KeyPair) []*File {
+	return []*File{
+		{Path: path.Join(dir, name+".crt"), Content: EncodeCertPEM(pair.Cert), Mode: certFileMode},
+		{Path: path.Join(dir, name+".key"), Content: EncodeKeyPEM(pair.Key), Mode: keyFileMode},
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pki
+
+import (
+	"crypto/x509"
+	"net"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func parseFiles(t *testing.T, files []*File) (map[string]*x509.Certificate, map[string]*File) {
+	certs := make(map[string]*x509.Certificate)
+	byPath := make(map[string]*File)
+	for _, file := range files {
+		byPath[file.Path] = file
+		switch path.Ext(file.Path) {
+		case ".key":
+			assert.EqualValues(t, 0600, file.Mode, file.Path)
+			_, err := ParseKeyPEM(file.Content)
+			assert.NoError(t, err, file.Path)
+		case ".crt":
+			assert.EqualValues(t, 0644, file.Mode, file.Path)
+			cert, err := ParseCertPEM(file.Content)
+			assert.NoError(t, err, file.Path)
+			certs[file.Path] = cert
+		}
+	}
+	return certs, byPath
+}
+
+func TestEtcdFiles(t *testing.T) {
+	p, err := NewClusterPKI()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := p.EtcdFiles(&pb.Node{Name: "node1", Ip: "192.168.1.1"})
+	assert.NoError(t, err)
+	assert.Len(t, files, 7)
+	certs, _ := parseFiles(t, files)
+
+	assert.True(t, certs[EtcdDir+"/ca.crt"].Equal(p.EtcdCA.Cert))
+	server := certs[EtcdDir+"/server.crt"]
+	assert.NoError(t, p.EtcdCA.Verify(server, x509.ExtKeyUsageServerAuth))
+	assert.NoError(t, server.VerifyHostname("192.168.1.1"))
+	assert.NoError(t, server.VerifyHostname("127.0.0.1"))
+	peer := certs[EtcdDir+"/peer.crt"]
+	assert.NoError(t, p.EtcdCA.Verify(peer, x509.ExtKeyUsageClientAuth))
+	assert.NoError(t, peer.VerifyHostname("192.168.1.1"))
+	assert.Error(t, peer.VerifyHostname("127.0.0.1"))
+	client := certs[EtcdDir+"/healthcheck-client.crt"]
+	assert.NoError(t, p.EtcdCA.Verify(client, x509.ExtKeyUsageClientAuth))
+	assert.Error(t, p.EtcdCA.Verify(client, x509.ExtKeyUsageServerAuth))
+	assert.Error(t, p.CA.Verify(client, x509.ExtKeyUsageClientAuth))
+
+	_, err = p.EtcdFiles(&pb.Node{Name: "node1", Ip: "node1"})
+	assert.Error(t, err)
+}
+
+func TestMasterFiles(t *testing.T) {
+	p, err := NewClusterPKI()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	masters := []*pb.Node{{Name: "master1", Ip: "192.168.1.1"}, {Name: "master2", Ip: "192.168.1.2"}}
+	clusterConfig := &pb.ClusterConfig{
+		KubeAPIServerConnect: &pb.KubeAPIServerConnect{
+			Type:       "keepalived",
+			Keepalived: &pb.Keepalived{Vip: "192.168.1.100"},
+		},
+	}
+	files, err := p.MasterFiles(masters[0], masters, clusterConfig)
+	assert.NoError(t, err)
+	certs, byPath := parseFiles(t, files)
+
+	for _, name := range []string{"ca.crt", "ca.key", "front-proxy-ca.crt", "front-proxy-ca.key", "etcd/ca.crt", "sa.key", "sa.pub",
+		"apiserver.crt", "apiserver.key", "apiserver-kubelet-client.crt", "apiserver-etcd-client.crt", "front-proxy-client.crt"} {
+		assert.Contains(t, byPath, path.Join(Dir, name))
+	}
+	assert.NotContains(t, byPath, path.Join(EtcdDir, "ca.key"))
+	assert.EqualValues(t, 0644, byPath[path.Join(Dir, "sa.pub")].Mode)
+	assert.True(t, strings.HasPrefix(string(byPath[path.Join(Dir, "sa.pub")].Content), "-----BEGIN PUBLIC KEY-----"))
+
+	apiserver := certs[path.Join(Dir, "apiserver.crt")]
+	assert.NoError(t, p.CA.Verify(apiserver, x509.ExtKeyUsageServerAuth))
+	for _, host := range []string{"192.168.1.1", "192.168.1.2", "192.168.1.100", "10.96.0.1", "master2", "kubernetes.default.svc.cluster.local"} {
+		assert.NoError(t, apiserver.VerifyHostname(host), host)
+	}
+	assert.NoError(t, p.EtcdCA.Verify(certs[path.Join(Dir, "apiserver-etcd-client.crt")], x509.ExtKeyUsageClientAuth))
+	assert.NoError(t, p.CA.Verify(certs[path.Join(Dir, "apiserver-kubelet-client.crt")], x509.ExtKeyUsageClientAuth))
+	assert.NoError(t, p.FrontProxyCA.Verify(certs[path.Join(Dir, "front-proxy-client.crt")], x509.ExtKeyUsageClientAuth))
+}
+
+func TestControllerClient(t *testing.T) {
+	p, err := NewClusterPKI()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pair, err := p.ControllerClient()
+	assert.NoError(t, err)
+	assert.Equal(t, ControllerClientCommonName, pair.Cert.Subject.CommonName)
+	assert.Equal(t, []string{"system:masters"}, pair.Cert.Subject.Organization)
+	assert.NoError(t, p.CA.Verify(pair.Cert, x509.ExtKeyUsageClientAuth))
+	assert.Error(t, p.CA.Verify(pair.Cert, x509.ExtKeyUsageServerAuth))
+}
+
+func TestAPIServerAltNames(t *testing.T) {
+	node := &pb.Node{Name: "master1", Ip: "192.168.1.1"}
+	altNames, err := APIServerAltNames(node, []*pb.Node{node}, &pb.ClusterConfig{
+		ServiceSubnet: "172.30.0.0/16",
+		KubeAPIServerConnect: &pb.KubeAPIServerConnect{
+			Type:         "loadbalancer",
+			Loadbalancer: &pb.Loadbalancer{Ip: "10.0.0.10", Port: 6443},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"kubernetes", "kubernetes.default", "kubernetes.default.svc", "kubernetes.default.svc.cluster.local", "master1"},
+		altNames.DNSNames)
+	assert.Equal(t, []net.IP{net.ParseIP("10.0.0.10"), net.ParseIP("172.30.0.1").To4(), net.ParseIP("192.168.1.1")}, altNames.IPs)
+
+	_, err = APIServerAltNames(node, nil, &pb.ClusterConfig{ServiceSubnet: "172.30.0.0"})
+	assert.Error(t, err)
+	_, err = APIServerAltNames(node, nil, &pb.ClusterConfig{
+		KubeAPIServerConnect: &pb.KubeAPIServerConnect{Keepalived: &pb.Keepalived{Vip: "vip"}},
+	})
+	assert.Error(t, err)
+}
+
+func TestFirstIP(t *testing.T) {
+	tests := map[string]string{
+		"10.96.0.0/12":  "10.96.0.1",
+		"10.96.0.5/12":  "10.96.0.1",
+		"10.0.0.255/23": "10.0.0.1",
+		"fd00::/108":    "fd00::1",
+	}
+	for subnet, want := range tests {
+		ip, err := firstIP(subnet)
+		assert.NoError(t, err, subnet)
+		assert.Equal(t, want, ip.String(), subnet)
+	}
+
+	_, err := firstIP("10.0.0.1/32")
+	assert.Error(t, err)
+}
@@ -0,0 +1,33 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pki
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+)
+
+// Distribute puts the certificates and keys to the machine with their modes,
+// the files which are unchanged on the machine are not transferred again.
+func Distribute(m machine.Machine, files []*File) error {
+	for _, file := range files {
+		if err := m.PutFile(bytes.NewReader(file.Content), file.Path, &machine.FileOptions{Mode: file.Mode}); err != nil {
+			return fmt.Errorf("failed to put %v to machine: %v, error: %v", file.Path, m.GetName(), err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,250 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pki
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math"
+	"math/big"
+	"net"
+	"time"
+)
+
+const (
+	rsaKeySize = 2048
+	// CADuration is the validity period of the certificate authorities
+	CADuration = 10 * 365 * 24 * time.Hour
+	// CertDuration is the validity period of the certificates issued by the certificate authorities
+	CertDuration = 365 * 24 * time.Hour
+
+	certificateBlockType = "CERTIFICATE"
+	rsaKeyBlockType      = "RSA PRIVATE KEY"
+	publicKeyBlockType   = "PUBLIC KEY"
+)
+
+// AltNames are the subject alternative names of a certificate
+type AltNames struct {
+	DNSNames []string
+	IPs      []net.IP
+}
+
+// CertConfig describes a certificate to be issued
+type CertConfig struct {
+	CommonName   string
+	Organization []string
+	AltNames     AltNames
+	Usages       []x509.ExtKeyUsage
+}
+
+// KeyPair is a certificate and its private key
+type KeyPair struct {
+	Cert *x509.Certificate
+	Key  *rsa.PrivateKey
+}
+
+// CA is a certificate authority which issues certificates
+type CA KeyPair
+
+// NewCA returns a self signed certificate authority with the common name
+func NewCA(commonName string) (*CA, error) {
+	key, err := rsa.GenerateKey(rand.Reader, rsaKeySize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate key of ca %v, error: %v", commonName, err)
+	}
+
+	serial, err := newSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             now.UTC(),
+		NotAfter:              now.Add(CADuration).UTC(),
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create certificate of ca %v, error: %v", commonName, err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate of ca %v, error: %v", commonName, err)
+	}
+
+	return &CA{Cert: cert, Key: key}, nil
+}
+
+// LoadCA returns the certificate authority of the PEM encoded certificate and private key,
+// which is used to import an existing certificate authority.
+func LoadCA(certPEM, keyPEM []byte) (*CA, error) {
+	cert, err := ParseCertPEM(certPEM)
+	if err != nil {
+		return nil, err
+	}
+	key, err := ParseKeyPEM(keyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	if !cert.IsCA {
+		return nil, fmt.Errorf("certificate %v is not a ca", cert.Subject.CommonName)
+	}
+	if publicKey, ok := cert.PublicKey.(*rsa.PublicKey); !ok || publicKey.N.Cmp(key.N) != 0 || publicKey.E != key.E {
+		return nil, fmt.Errorf("private key doesn't match the certificate of ca %v", cert.Subject.CommonName)
+	}
+	if time.Now().After(cert.NotAfter) {
+		return nil, fmt.Errorf("ca %v is expired at %v", cert.Subject.CommonName, cert.NotAfter)
+	}
+	return &CA{Cert: cert, Key: key}, nil
+}
+
+// NewKey returns a new rsa private key, which is used for the keys without certificates, e.g. the service account key
+func NewKey() (*rsa.PrivateKey, error) {
+	key, err := rsa.GenerateKey(rand.Reader, rsaKeySize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate key, error: %v", err)
+	}
+	return key, nil
+}
+
+// Issue returns a key pair signed by the certificate authority
+func (ca *CA) Issue(cfg *CertConfig) (*KeyPair, error) {
+	if cfg.CommonName == "" {
+		return nil, fmt.Errorf("common name of the certificate is empty")
+	}
+	if len(cfg.Usages) == 0 {
+		return nil, fmt.Errorf("usages of certificate %v are empty", cfg.CommonName)
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, rsaKeySize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate key of certificate %v, error: %v", cfg.CommonName, err)
+	}
+
+	serial, err := newSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			CommonName:   cfg.CommonName,
+			Organization: cfg.Organization,
+		},
+		DNSNames:    cfg.AltNames.DNSNames,
+		IPAddresses: cfg.AltNames.IPs,
+		NotBefore:   ca.Cert.NotBefore,
+		NotAfter:    time.Now().Add(CertDuration).UTC(),
+		KeyUsage:    x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: cfg.Usages,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.Cert, key.Public(), ca.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create certificate %v, error: %v", cfg.CommonName, err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate %v, error: %v", cfg.CommonName, err)
+	}
+
+	return &KeyPair{Cert: cert, Key: key}, nil
+}
+
+// EncodeCertPEM returns the PEM encoded certificate
+func EncodeCertPEM(cert *x509.Certificate) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: certificateBlockType, Bytes: cert.Raw})
+}
+
+// EncodeKeyPEM returns the PEM encoded private key
+func EncodeKeyPEM(key *rsa.PrivateKey) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: rsaKeyBlockType, Bytes: x509.MarshalPKCS1PrivateKey(key)})
+}
+
+// EncodePublicKeyPEM returns the PEM encoded public key
+func EncodePublicKeyPEM(key *rsa.PublicKey) ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal public key, error: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: publicKeyBlockType, Bytes: der}), nil
+}
+
+// ParseCertPEM returns the first certificate in the PEM encoded data
+func ParseCertPEM(data []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != certificateBlockType {
+		return nil, fmt.Errorf("no PEM encoded certificate is found")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate, error: %v", err)
+	}
+	return cert, nil
+}
+
+// ParseKeyPEM returns the first rsa private key in the PEM encoded data, the key can be PKCS#1 or PKCS#8 encoded
+func ParseKeyPEM(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM encoded private key is found")
+	}
+
+	if block.Type == rsaKeyBlockType {
+		key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse private key, error: %v", err)
+		}
+		return key, nil
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key, error: %v", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key is %T, only rsa keys are supported", parsed)
+	}
+	return key, nil
+}
+
+// Verify checks if the certificate is signed by the certificate authority for the usage
+func (ca *CA) Verify(cert *x509.Certificate, usage x509.ExtKeyUsage) error {
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.Cert)
+	_, err := cert.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{usage}})
+	return err
+}
+
+func newSerialNumber() (*big.Int, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).SetInt64(math.MaxInt64))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate serial number, error: %v", err)
+	}
+	return serial, nil
+}
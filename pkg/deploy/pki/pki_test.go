@@ -0,0 +1,121 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pki
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCAIssue(t *testing.T) {
+	ca, err := NewCA("etcd-ca")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, ca.Cert.IsCA)
+	assert.Equal(t, "etcd-ca", ca.Cert.Subject.CommonName)
+
+	pair, err := ca.Issue(&CertConfig{
+		CommonName:   "node1",
+		Organization: []string{"kpaas"},
+		AltNames: AltNames{
+			DNSNames: []string{"node1", "localhost"},
+			IPs:      []net.IP{net.ParseIP("192.168.1.1"), net.IPv4(127, 0, 0, 1)},
+		},
+		Usages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "node1", pair.Cert.Subject.CommonName)
+	assert.Equal(t, []string{"kpaas"}, pair.Cert.Subject.Organization)
+	assert.NoError(t, pair.Cert.VerifyHostname("192.168.1.1"))
+	assert.NoError(t, pair.Cert.VerifyHostname("localhost"))
+	assert.Error(t, pair.Cert.VerifyHostname("node2"))
+	assert.NoError(t, ca.Verify(pair.Cert, x509.ExtKeyUsageServerAuth))
+	assert.Error(t, ca.Verify(pair.Cert, x509.ExtKeyUsageClientAuth))
+
+	other, err := NewCA("other-ca")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Error(t, other.Verify(pair.Cert, x509.ExtKeyUsageServerAuth))
+
+	_, err = ca.Issue(&CertConfig{CommonName: "node1"})
+	assert.Error(t, err)
+	_, err = ca.Issue(&CertConfig{Usages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}})
+	assert.Error(t, err)
+}
+
+func TestEncodePEM(t *testing.T) {
+	ca, err := NewCA("ca")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	block, rest := pem.Decode(EncodeCertPEM(ca.Cert))
+	assert.Empty(t, rest)
+	assert.Equal(t, "CERTIFICATE", block.Type)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	assert.NoError(t, err)
+	assert.True(t, cert.Equal(ca.Cert))
+
+	block, rest = pem.Decode(EncodeKeyPEM(ca.Key))
+	assert.Empty(t, rest)
+	assert.Equal(t, "RSA PRIVATE KEY", block.Type)
+	key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	assert.NoError(t, err)
+	assert.Equal(t, ca.Key.N, key.N)
+}
+
+func TestLoadCA(t *testing.T) {
+	ca, err := NewCA("ca")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := LoadCA(EncodeCertPEM(ca.Cert), EncodeKeyPEM(ca.Key))
+	assert.NoError(t, err)
+	assert.True(t, loaded.Cert.Equal(ca.Cert))
+
+	// PKCS#8 encoded keys are supported
+	der, err := x509.MarshalPKCS8PrivateKey(ca.Key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = LoadCA(EncodeCertPEM(ca.Cert), pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	assert.NoError(t, err)
+
+	other, err := NewCA("other")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = LoadCA(EncodeCertPEM(ca.Cert), EncodeKeyPEM(other.Key))
+	assert.Error(t, err)
+
+	pair, err := ca.Issue(&CertConfig{CommonName: "client", Usages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = LoadCA(EncodeCertPEM(pair.Cert), EncodeKeyPEM(pair.Key))
+	assert.Error(t, err)
+
+	_, err = LoadCA([]byte("not a certificate"), EncodeKeyPEM(ca.Key))
+	assert.Error(t, err)
+}
@@ -0,0 +1,261 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pki
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// CAKind is a kind of the certificate authorities of a cluster, which is also the path of
+// its files in the directory of the cluster without the extension
+type CAKind string
+
+const (
+	CAKindCluster    CAKind = "ca"
+	CAKindEtcd       CAKind = "etcd/ca"
+	CAKindFrontProxy CAKind = "front-proxy-ca"
+
+	// DefaultClusterName is the name of the cluster in the store if it's not set in the cluster config
+	DefaultClusterName = "kubernetes"
+
+	serviceAccountKeyFile = "sa.key"
+	storeDirMode          = 0700
+)
+
+// Store keeps the pki of the clusters in a directory of the controller, the files of a cluster are in
+// <dir>/<cluster name> with the same layout as the pki directory of kubeadm, e.g. ca.crt, ca.key,
+// etcd/ca.crt and sa.key. The certificates of the nodes are issued by the certificate authorities when they
+// are distributed, and kept in <dir>/<cluster name>/nodes/<node name> with the same layout, e.g. apiserver.crt,
+// so they are reused by the later deployments while they are valid.
+type Store struct {
+	dir  string
+	lock sync.Mutex
+}
+
+// NewStore returns a store which keeps the pki of the clusters in dir
+func NewStore(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// Get returns the pki of the cluster, the certificate authorities and the service account key which
+// are not in the store are generated and saved, so the existing ones are reused by the later deployments.
+func (s *Store) Get(clusterName string) (*ClusterPKI, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	dir, err := s.clusterDir(clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &ClusterPKI{dir: dir}
+	cas := []struct {
+		kind       CAKind
+		commonName string
+		ca         **CA
+	}{
+		{CAKindCluster, CACommonName, &p.CA},
+		{CAKindEtcd, EtcdCACommonName, &p.EtcdCA},
+		{CAKindFrontProxy, FrontProxyCACommonName, &p.FrontProxyCA},
+	}
+	for _, c := range cas {
+		ca, err := loadCA(dir, c.kind)
+		if err != nil {
+			return nil, err
+		}
+		if ca == nil {
+			if ca, err = NewCA(c.commonName); err != nil {
+				return nil, err
+			}
+			if err := saveCA(dir, c.kind, ca); err != nil {
+				return nil, err
+			}
+		}
+		*c.ca = ca
+	}
+
+	keyPath := filepath.Join(dir, serviceAccountKeyFile)
+	if deploy.FileExist(keyPath) {
+		data, err := ioutil.ReadFile(keyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %v, error: %v", keyPath, err)
+		}
+		if p.ServiceAccountKey, err = ParseKeyPEM(data); err != nil {
+			return nil, fmt.Errorf("failed to load %v, error: %v", keyPath, err)
+		}
+	} else {
+		if p.ServiceAccountKey, err = NewKey(); err != nil {
+			return nil, err
+		}
+		if err := writeFile(keyPath, EncodeKeyPEM(p.ServiceAccountKey), keyFileMode); err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+// ImportCA imports an existing certificate authority of the kind to the cluster, e.g. the root ca of an
+// organization. It fails if the cluster already has a different certificate authority of the kind, since
+// the certificates issued by it would be invalid.
+func (s *Store) ImportCA(clusterName string, kind CAKind, certPEM, keyPEM []byte) error {
+	ca, err := LoadCA(certPEM, keyPEM)
+	if err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	dir, err := s.clusterDir(clusterName)
+	if err != nil {
+		return err
+	}
+
+	existing, err := loadCA(dir, kind)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		if existing.Cert.Equal(ca.Cert) {
+			return nil
+		}
+		return fmt.Errorf("%v of cluster %v already exists", kind, clusterName)
+	}
+	return saveCA(dir, kind, ca)
+}
+
+// ClusterName returns the name of the cluster in the store
+func ClusterName(clusterConfig *pb.ClusterConfig) string {
+	if name := clusterConfig.GetClusterName(); name != "" {
+		return name
+	}
+	return DefaultClusterName
+}
+
+func (s *Store) clusterDir(clusterName string) (string, error) {
+	if !validFileName(clusterName) {
+		return "", fmt.Errorf("invalid cluster name %q", clusterName)
+	}
+	return filepath.Join(s.dir, clusterName), nil
+}
+
+// nodeDir returns the directory of the certificates of the node in the directory of the cluster
+func nodeDir(clusterDir, nodeName string) (string, error) {
+	if !validFileName(nodeName) {
+		return "", fmt.Errorf("invalid node name %q", nodeName)
+	}
+	return filepath.Join(clusterDir, nodesDir, nodeName), nil
+}
+
+// validFileName returns if the name can be used as a file name in the store
+func validFileName(name string) bool {
+	return name != "" && name != "." && name != ".." && !strings.ContainsAny(name, `/\`)
+}
+
+// loadCA returns the certificate authority of the kind in dir, or nil if it's not found
+func loadCA(dir string, kind CAKind) (*CA, error) {
+	certPath := filepath.Join(dir, string(kind)+".crt")
+	keyPath := filepath.Join(dir, string(kind)+".key")
+
+	certExists, keyExists := deploy.FileExist(certPath), deploy.FileExist(keyPath)
+	if !certExists && !keyExists {
+		return nil, nil
+	}
+	if !certExists || !keyExists {
+		return nil, fmt.Errorf("both %v and %v are required", certPath, keyPath)
+	}
+
+	certPEM, err := ioutil.ReadFile(certPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %v, error: %v", certPath, err)
+	}
+	keyPEM, err := ioutil.ReadFile(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %v, error: %v", keyPath, err)
+	}
+	ca, err := LoadCA(certPEM, keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %v, error: %v", kind, err)
+	}
+	return ca, nil
+}
+
+// loadKeyPair returns the key pair with the name in dir, or nil if it's not found
+func loadKeyPair(dir, name string) (*KeyPair, error) {
+	certPath := filepath.Join(dir, name+".crt")
+	keyPath := filepath.Join(dir, name+".key")
+	if !deploy.FileExist(certPath) || !deploy.FileExist(keyPath) {
+		return nil, nil
+	}
+
+	certPEM, err := ioutil.ReadFile(certPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %v, error: %v", certPath, err)
+	}
+	keyPEM, err := ioutil.ReadFile(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %v, error: %v", keyPath, err)
+	}
+	cert, err := ParseCertPEM(certPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %v, error: %v", certPath, err)
+	}
+	key, err := ParseKeyPEM(keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %v, error: %v", keyPath, err)
+	}
+	return &KeyPair{Cert: cert, Key: key}, nil
+}
+
+func saveKeyPair(dir, name string, pair *KeyPair) error {
+	if err := writeFile(filepath.Join(dir, name+".key"), EncodeKeyPEM(pair.Key), keyFileMode); err != nil {
+		return err
+	}
+	return writeFile(filepath.Join(dir, name+".crt"), EncodeCertPEM(pair.Cert), certFileMode)
+}
+
+func saveCA(dir string, kind CAKind, ca *CA) error {
+	if err := writeFile(filepath.Join(dir, string(kind)+".key"), EncodeKeyPEM(ca.Key), keyFileMode); err != nil {
+		return err
+	}
+	return writeFile(filepath.Join(dir, string(kind)+".crt"), EncodeCertPEM(ca.Cert), certFileMode)
+}
+
+// writeFile writes the file atomically, so a partially written file is never loaded
+func writeFile(filePath string, content []byte, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(filePath), storeDirMode); err != nil {
+		return fmt.Errorf("failed to create directory of %v, error: %v", filePath, err)
+	}
+
+	tempPath := filePath + ".tmp"
+	if err := ioutil.WriteFile(tempPath, content, mode); err != nil {
+		os.Remove(tempPath)
+		return fmt.Errorf("failed to write %v, error: %v", tempPath, err)
+	}
+	if err := os.Rename(tempPath, filePath); err != nil {
+		os.Remove(tempPath)
+		return fmt.Errorf("failed to rename %v to %v, error: %v", tempPath, filePath, err)
+	}
+	return nil
+}
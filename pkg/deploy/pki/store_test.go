@@ -0,0 +1,176 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pki
+
+import (
+	"crypto/x509"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pki")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	store := NewStore(dir)
+
+	// import an existing root ca before the first deployment
+	rootCA, err := NewCA("organization-root-ca")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, store.ImportCA("cluster1", CAKindCluster, EncodeCertPEM(rootCA.Cert), EncodeKeyPEM(rootCA.Key)))
+
+	p, err := store.Get("cluster1")
+	assert.NoError(t, err)
+	assert.True(t, p.CA.Cert.Equal(rootCA.Cert))
+	assert.Equal(t, EtcdCACommonName, p.EtcdCA.Cert.Subject.CommonName)
+	assert.Equal(t, FrontProxyCACommonName, p.FrontProxyCA.Cert.Subject.CommonName)
+
+	for name, mode := range map[string]os.FileMode{"ca.key": 0600, "ca.crt": 0644, "etcd/ca.key": 0600, "front-proxy-ca.crt": 0644, "sa.key": 0600} {
+		info, err := os.Stat(filepath.Join(dir, "cluster1", name))
+		if assert.NoError(t, err, name) {
+			assert.Equal(t, mode, info.Mode().Perm(), name)
+		}
+	}
+
+	// the stored pki is reused
+	again, err := store.Get("cluster1")
+	assert.NoError(t, err)
+	assert.True(t, again.EtcdCA.Cert.Equal(p.EtcdCA.Cert))
+	assert.Equal(t, p.ServiceAccountKey.N, again.ServiceAccountKey.N)
+
+	// other clusters have their own pki
+	other, err := store.Get("cluster2")
+	assert.NoError(t, err)
+	assert.False(t, other.CA.Cert.Equal(p.CA.Cert))
+
+	// a ca can't be replaced by importing
+	assert.NoError(t, store.ImportCA("cluster1", CAKindCluster, EncodeCertPEM(rootCA.Cert), EncodeKeyPEM(rootCA.Key)))
+	assert.Error(t, store.ImportCA("cluster2", CAKindCluster, EncodeCertPEM(rootCA.Cert), EncodeKeyPEM(rootCA.Key)))
+	assert.Error(t, store.ImportCA("cluster3", CAKindCluster, EncodeCertPEM(rootCA.Cert), EncodeKeyPEM(other.CA.Key)))
+
+	// the key of a ca is missing
+	assert.NoError(t, os.Remove(filepath.Join(dir, "cluster2", "etcd", "ca.key")))
+	_, err = store.Get("cluster2")
+	assert.Error(t, err)
+
+	for _, name := range []string{"", "..", "a/b"} {
+		_, err = store.Get(name)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestClusterName(t *testing.T) {
+	assert.Equal(t, DefaultClusterName, ClusterName(nil))
+	assert.Equal(t, "cluster1", ClusterName(&pb.ClusterConfig{ClusterName: "cluster1"}))
+}
+
+func TestStoreNodeCertificates(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pki")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	store := NewStore(dir)
+	p, err := store.Get("cluster1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certOf := func(files []*File, name string) *x509.Certificate {
+		for _, file := range files {
+			if file.Path == name {
+				cert, err := ParseCertPEM(file.Content)
+				assert.NoError(t, err, name)
+				return cert
+			}
+		}
+		t.Fatalf("%v is not found", name)
+		return nil
+	}
+
+	node := &pb.Node{Name: "node1", Ip: "192.168.1.1"}
+	files, err := p.EtcdFiles(node)
+	assert.NoError(t, err)
+	server := certOf(files, EtcdDir+"/server.crt")
+	info, err := os.Stat(filepath.Join(dir, "cluster1", "nodes", "node1", "etcd", "server.key"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	}
+
+	// the stored certificates are reused by the later deployments
+	again, err := store.Get("cluster1")
+	assert.NoError(t, err)
+	files, err = again.EtcdFiles(node)
+	assert.NoError(t, err)
+	assert.True(t, certOf(files, EtcdDir+"/server.crt").Equal(server))
+
+	// the certificates are issued again if the ip of the node is changed
+	files, err = again.EtcdFiles(&pb.Node{Name: "node1", Ip: "192.168.1.2"})
+	assert.NoError(t, err)
+	assert.False(t, certOf(files, EtcdDir+"/server.crt").Equal(server))
+
+	// the apiserver certificate is issued again if a master is added, the client certificates are reused
+	masters := []*pb.Node{node}
+	files, err = p.MasterFiles(node, masters, &pb.ClusterConfig{})
+	assert.NoError(t, err)
+	apiserver := certOf(files, Dir+"/apiserver.crt")
+	client := certOf(files, Dir+"/apiserver-kubelet-client.crt")
+
+	files, err = p.MasterFiles(node, masters, &pb.ClusterConfig{})
+	assert.NoError(t, err)
+	assert.True(t, certOf(files, Dir+"/apiserver.crt").Equal(apiserver))
+
+	masters = append(masters, &pb.Node{Name: "node2", Ip: "192.168.1.3"})
+	files, err = p.MasterFiles(node, masters, &pb.ClusterConfig{})
+	assert.NoError(t, err)
+	assert.False(t, certOf(files, Dir+"/apiserver.crt").Equal(apiserver))
+	assert.NoError(t, certOf(files, Dir+"/apiserver.crt").VerifyHostname("node2"))
+	assert.True(t, certOf(files, Dir+"/apiserver-kubelet-client.crt").Equal(client))
+
+	// a stored certificate which isn't signed by the ca is issued again
+	otherCA, err := NewCA(CACommonName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pair, err := otherCA.Issue(&CertConfig{
+		CommonName:   APIServerKubeletClientCommonName,
+		Organization: []string{mastersGroup},
+		Usages:       []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, saveKeyPair(filepath.Join(dir, "cluster1", "nodes", "node1"), "apiserver-kubelet-client", pair))
+	files, err = p.MasterFiles(node, masters, &pb.ClusterConfig{})
+	assert.NoError(t, err)
+	client = certOf(files, Dir+"/apiserver-kubelet-client.crt")
+	assert.False(t, client.Equal(pair.Cert))
+	assert.NoError(t, p.CA.Verify(client, x509.ExtKeyUsageClientAuth))
+
+	_, err = p.EtcdFiles(&pb.Node{Name: "../node1", Ip: "192.168.1.1"})
+	assert.Error(t, err)
+}
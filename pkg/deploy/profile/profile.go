@@ -0,0 +1,304 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package profile
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// Severity decides how a failed check item affects the result of a node
+type Severity string
+
+const (
+	// SeverityError fails the node if the item failed
+	SeverityError Severity = "error"
+	// SeverityWarning only reports the item as a warning if the item failed
+	SeverityWarning Severity = "warning"
+)
+
+const (
+	// DefaultName is the name of the built-in profile
+	DefaultName = "default"
+	// DefaultRole is the role whose requirement is used for roles not listed in a profile
+	DefaultRole = "default"
+)
+
+// items are the names of the check items registered by the check actions
+var items = make(map[string]bool)
+
+// RegisterItems registers the names of the check items, which are called in the init functions of the check
+// actions. Only the registered items can be skipped or have their severities set by profiles.
+func RegisterItems(names ...string) {
+	for _, name := range names {
+		items[name] = true
+	}
+}
+
+// Registered returns if the check item is registered
+func Registered(item string) bool {
+	return items[item]
+}
+
+// Requirement is the minimum resources of a node role
+type Requirement struct {
+	CPUCores    float64 `yaml:"cpuCores"`
+	MemoryGiB   float64 `yaml:"memoryGiB"`
+	RootDiskGiB float64 `yaml:"rootDiskGiB"`
+}
+
+// Profile customizes the node pre-checking, the versions are constraint expressions, e.g. ">=18.09 <20",
+// or the minimum versions, e.g. "18.09.0"
+type Profile struct {
+	Name              string                  `yaml:"name"`
+	DockerVersion     string                  `yaml:"dockerVersion"`
+	ContainerdVersion string                  `yaml:"containerdVersion"`
+	KernelVersion     string                  `yaml:"kernelVersion"`
+	Roles             map[string]*Requirement `yaml:"roles"`
+	Severities        map[string]Severity     `yaml:"severities"`
+	SkipItems         []string                `yaml:"skipItems"`
+	// MaxClockSkewSeconds is the maximum clock skew between a node and the controller
+	MaxClockSkewSeconds float64 `yaml:"maxClockSkewSeconds"`
+	// EtcdFsyncP99Milliseconds is the maximum 99th percentile of the fdatasync latency of the etcd data directory
+	EtcdFsyncP99Milliseconds float64 `yaml:"etcdFsyncP99Milliseconds"`
+	// EtcdWriteMiBps is the minimum sequential write throughput of the etcd data directory
+	EtcdWriteMiBps float64 `yaml:"etcdWriteMiBps"`
+}
+
+// Default returns the built-in profile, which requires the same resources for all roles
+func Default() *Profile {
+	return &Profile{
+		Name:          DefaultName,
+		DockerVersion: "18.09.0",
+		// the cri plugin is built in since containerd 1.1, and stable since 1.2
+		ContainerdVersion: "1.2.0",
+		KernelVersion:     "4.19.46",
+		// etcd starts to warn when the clock drift is about one second
+		MaxClockSkewSeconds: 1,
+		// etcd suggests the 99th percentile of the wal fsync duration should be less than 10ms
+		EtcdFsyncP99Milliseconds: 10,
+		EtcdWriteMiBps:           20,
+		Roles: map[string]*Requirement{
+			DefaultRole: {
+				CPUCores:    8,
+				MemoryGiB:   16,
+				RootDiskGiB: 200,
+			},
+		},
+	}
+}
+
+// Load reads a profile from a yaml file, the unset fields are taken from the default profile
+func Load(file string) (*Profile, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read check profile %v, error: %v", file, err)
+	}
+
+	profile := &Profile{}
+	if err := yaml.UnmarshalStrict(data, profile); err != nil {
+		return nil, fmt.Errorf("failed to parse check profile %v, error: %v", file, err)
+	}
+
+	if profile.Name == "" {
+		profile.Name = file
+	}
+
+	return profile.complete()
+}
+
+// FromProto converts the profile of a check nodes request, the unset fields are taken from the default profile
+func FromProto(p *pb.CheckProfile) (*Profile, error) {
+	if p == nil {
+		return Default(), nil
+	}
+
+	profile := &Profile{
+		Name:                     p.GetName(),
+		DockerVersion:            p.GetDockerVersion(),
+		ContainerdVersion:        p.GetContainerdVersion(),
+		KernelVersion:            p.GetKernelVersion(),
+		SkipItems:                p.GetSkipItems(),
+		MaxClockSkewSeconds:      p.GetMaxClockSkewSeconds(),
+		EtcdFsyncP99Milliseconds: p.GetEtcdFsyncP99Milliseconds(),
+		EtcdWriteMiBps:           p.GetEtcdWriteMiBps(),
+	}
+
+	if len(p.GetRoles()) > 0 {
+		profile.Roles = make(map[string]*Requirement, len(p.GetRoles()))
+		for role, requirement := range p.GetRoles() {
+			profile.Roles[role] = &Requirement{
+				CPUCores:    requirement.GetCpuCores(),
+				MemoryGiB:   requirement.GetMemoryGiB(),
+				RootDiskGiB: requirement.GetRootDiskGiB(),
+			}
+		}
+	}
+
+	if len(p.GetSeverities()) > 0 {
+		profile.Severities = make(map[string]Severity, len(p.GetSeverities()))
+		for item, severity := range p.GetSeverities() {
+			profile.Severities[item] = Severity(severity)
+		}
+	}
+
+	if profile.Name == "" {
+		profile.Name = "request"
+	}
+
+	return profile.complete()
+}
+
+// complete fills the unset fields with the default profile and validates the profile
+func (p *Profile) complete() (*Profile, error) {
+	defaultProfile := Default()
+	if p.DockerVersion == "" {
+		p.DockerVersion = defaultProfile.DockerVersion
+	}
+	if p.ContainerdVersion == "" {
+		p.ContainerdVersion = defaultProfile.ContainerdVersion
+	}
+	if p.KernelVersion == "" {
+		p.KernelVersion = defaultProfile.KernelVersion
+	}
+	for _, version := range []string{p.DockerVersion, p.ContainerdVersion, p.KernelVersion} {
+		if _, err := operation.ParseConstraint(version); err != nil {
+			return nil, fmt.Errorf("invalid check profile %v: %v", p.Name, err)
+		}
+	}
+	if p.MaxClockSkewSeconds < 0 {
+		return nil, fmt.Errorf("invalid check profile %v: max clock skew is negative", p.Name)
+	}
+	if p.MaxClockSkewSeconds == 0 {
+		p.MaxClockSkewSeconds = defaultProfile.MaxClockSkewSeconds
+	}
+	if p.EtcdFsyncP99Milliseconds < 0 || p.EtcdWriteMiBps < 0 {
+		return nil, fmt.Errorf("invalid check profile %v: etcd disk threshold is negative", p.Name)
+	}
+	if p.EtcdFsyncP99Milliseconds == 0 {
+		p.EtcdFsyncP99Milliseconds = defaultProfile.EtcdFsyncP99Milliseconds
+	}
+	if p.EtcdWriteMiBps == 0 {
+		p.EtcdWriteMiBps = defaultProfile.EtcdWriteMiBps
+	}
+	if p.Roles == nil {
+		p.Roles = make(map[string]*Requirement)
+	}
+	if _, ok := p.Roles[DefaultRole]; !ok {
+		p.Roles[DefaultRole] = &Requirement{}
+	}
+
+	for role, requirement := range p.Roles {
+		if requirement == nil {
+			return nil, fmt.Errorf("invalid check profile %v: requirement of role %v is empty", p.Name, role)
+		}
+		if requirement.CPUCores < 0 || requirement.MemoryGiB < 0 || requirement.RootDiskGiB < 0 {
+			return nil, fmt.Errorf("invalid check profile %v: requirement of role %v is negative", p.Name, role)
+		}
+	}
+
+	// the unset fields of the default role are taken from the default profile,
+	// and the unset fields of the other roles are taken from the default role
+	p.Roles[DefaultRole].complete(defaultProfile.Roles[DefaultRole])
+	for role, requirement := range p.Roles {
+		if role != DefaultRole {
+			requirement.complete(p.Roles[DefaultRole])
+		}
+	}
+
+	for _, item := range p.SkipItems {
+		if !Registered(item) {
+			return nil, fmt.Errorf("invalid check profile %v: unknown skipped item %q", p.Name, item)
+		}
+	}
+
+	for item, severity := range p.Severities {
+		if !Registered(item) {
+			return nil, fmt.Errorf("invalid check profile %v: unknown item %q of severity %v", p.Name, item, severity)
+		}
+		if severity != SeverityError && severity != SeverityWarning {
+			return nil, fmt.Errorf("invalid check profile %v: unknown severity %q of item %v", p.Name, severity, item)
+		}
+	}
+
+	return p, nil
+}
+
+// complete fills the unset fields of the requirement with the base
+func (r *Requirement) complete(base *Requirement) {
+	if r.CPUCores == 0 {
+		r.CPUCores = base.CPUCores
+	}
+	if r.MemoryGiB == 0 {
+		r.MemoryGiB = base.MemoryGiB
+	}
+	if r.RootDiskGiB == 0 {
+		r.RootDiskGiB = base.RootDiskGiB
+	}
+}
+
+// Requirement returns the requirement of a node with the roles, which is the maximum
+// of all roles. The default requirement is used for the roles not listed in the profile.
+func (p *Profile) Requirement(roles []string) *Requirement {
+	result := &Requirement{}
+	merge := func(r *Requirement) {
+		if r.CPUCores > result.CPUCores {
+			result.CPUCores = r.CPUCores
+		}
+		if r.MemoryGiB > result.MemoryGiB {
+			result.MemoryGiB = r.MemoryGiB
+		}
+		if r.RootDiskGiB > result.RootDiskGiB {
+			result.RootDiskGiB = r.RootDiskGiB
+		}
+	}
+
+	if len(roles) == 0 {
+		roles = []string{DefaultRole}
+	}
+
+	for _, role := range roles {
+		requirement, ok := p.Roles[role]
+		if !ok {
+			requirement = p.Roles[DefaultRole]
+		}
+		merge(requirement)
+	}
+
+	return result
+}
+
+// Severity returns the severity of a check item, it's SeverityError if not set
+func (p *Profile) Severity(item string) Severity {
+	if severity, ok := p.Severities[item]; ok {
+		return severity
+	}
+	return SeverityError
+}
+
+// Skipped returns if a check item should be skipped
+func (p *Profile) Skipped(item string) bool {
+	for _, skipped := range p.SkipItems {
+		if skipped == item {
+			return true
+		}
+	}
+	return false
+}
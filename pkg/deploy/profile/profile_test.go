@@ -0,0 +1,237 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package profile
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func init() {
+	// the check items are registered by the check actions, which aren't imported by the tests
+	RegisterItems("kernel version check", "system distribution check", "cpu cores check", "memory capacity check",
+		"docker version check")
+}
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		content string
+		want    *Profile
+		wantErr bool
+	}{
+		{
+			content: `
+name: lab
+dockerVersion: ">=18.09 <20"
+kernelVersion: 3.10.0
+roles:
+  worker:
+    cpuCores: 2
+    memoryGiB: 4
+    rootDiskGiB: 50
+severities:
+  kernel version check: warning
+skipItems:
+- system distribution check
+maxClockSkewSeconds: 0.5
+etcdWriteMiBps: 50
+`,
+			want: &Profile{
+				Name:              "lab",
+				DockerVersion:     ">=18.09 <20",
+				ContainerdVersion: "1.2.0",
+				KernelVersion:     "3.10.0",
+				Roles: map[string]*Requirement{
+					DefaultRole: {CPUCores: 8, MemoryGiB: 16, RootDiskGiB: 200},
+					"worker":    {CPUCores: 2, MemoryGiB: 4, RootDiskGiB: 50},
+				},
+				Severities: map[string]Severity{"kernel version check": SeverityWarning},
+				SkipItems:  []string{"system distribution check"},
+
+				MaxClockSkewSeconds:      0.5,
+				EtcdFsyncP99Milliseconds: 10,
+				EtcdWriteMiBps:           50,
+			},
+		},
+		{
+			// the unset fields of the roles are taken from the default role, whose unset fields are taken from
+			// the default profile
+			content: `
+name: small
+roles:
+  default:
+    cpuCores: 4
+  master:
+    memoryGiB: 32
+`,
+			want: &Profile{
+				Name:              "small",
+				DockerVersion:     "18.09.0",
+				ContainerdVersion: "1.2.0",
+				KernelVersion:     "4.19.46",
+				Roles: map[string]*Requirement{
+					DefaultRole: {CPUCores: 4, MemoryGiB: 16, RootDiskGiB: 200},
+					"master":    {CPUCores: 4, MemoryGiB: 32, RootDiskGiB: 200},
+				},
+
+				MaxClockSkewSeconds:      1,
+				EtcdFsyncP99Milliseconds: 10,
+				EtcdWriteMiBps:           20,
+			},
+		},
+		{
+			content: "severities:\n  cpu cores check: fatal\n",
+			wantErr: true,
+		},
+		{
+			content: "severities:\n  unknown check: warning\n",
+			wantErr: true,
+		},
+		{
+			content: "skipItems:\n- unknown check\n",
+			wantErr: true,
+		},
+		{
+			content: "roles:\n  worker:\n    cpuCores: -1\n",
+			wantErr: true,
+		},
+		{
+			content: "maxClockSkewSeconds: -1\n",
+			wantErr: true,
+		},
+		{
+			content: "etcdFsyncP99Milliseconds: -1\n",
+			wantErr: true,
+		},
+		{
+			content: "dockerVersion: \">=18.09 <\"\n",
+			wantErr: true,
+		},
+		{
+			content: "unknownField: 1\n",
+			wantErr: true,
+		},
+	}
+
+	dir, err := ioutil.TempDir("", "profile")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, test := range tests {
+		file := filepath.Join(dir, "profile.yaml")
+		if err := ioutil.WriteFile(file, []byte(test.content), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		profile, err := Load(file)
+		if test.wantErr {
+			assert.Error(t, err)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, test.want, profile)
+	}
+
+	_, err = Load(filepath.Join(dir, "not-exist.yaml"))
+	assert.Error(t, err)
+}
+
+func TestFromProto(t *testing.T) {
+	profile, err := FromProto(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, Default(), profile)
+
+	profile, err = FromProto(&pb.CheckProfile{
+		Roles: map[string]*pb.CheckRequirement{
+			"etcd": {CpuCores: 4, MemoryGiB: 8, RootDiskGiB: 100},
+		},
+		Severities:        map[string]string{"memory capacity check": "warning"},
+		SkipItems:         []string{"docker version check"},
+		ContainerdVersion: "1.3.0",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "request", profile.Name)
+	assert.Equal(t, Default().DockerVersion, profile.DockerVersion)
+	assert.Equal(t, "1.3.0", profile.ContainerdVersion)
+	assert.Equal(t, Default().MaxClockSkewSeconds, profile.MaxClockSkewSeconds)
+	assert.Equal(t, &Requirement{CPUCores: 4, MemoryGiB: 8, RootDiskGiB: 100}, profile.Requirement([]string{"etcd"}))
+	assert.Equal(t, SeverityWarning, profile.Severity("memory capacity check"))
+	assert.True(t, profile.Skipped("docker version check"))
+
+	_, err = FromProto(&pb.CheckProfile{Severities: map[string]string{"memory capacity check": "info"}})
+	assert.Error(t, err)
+
+	_, err = FromProto(&pb.CheckProfile{Severities: map[string]string{"unknown check": "warning"}})
+	assert.Error(t, err)
+
+	_, err = FromProto(&pb.CheckProfile{SkipItems: []string{"unknown check"}})
+	assert.Error(t, err)
+}
+
+func TestRequirement(t *testing.T) {
+	profile := &Profile{
+		Roles: map[string]*Requirement{
+			DefaultRole: {CPUCores: 8, MemoryGiB: 16, RootDiskGiB: 200},
+			"etcd":      {CPUCores: 4, MemoryGiB: 8, RootDiskGiB: 300},
+			"worker":    {CPUCores: 2, MemoryGiB: 4, RootDiskGiB: 50},
+		},
+	}
+
+	tests := []struct {
+		roles []string
+		want  *Requirement
+	}{
+		{
+			roles: nil,
+			want:  &Requirement{CPUCores: 8, MemoryGiB: 16, RootDiskGiB: 200},
+		},
+		{
+			roles: []string{"worker"},
+			want:  &Requirement{CPUCores: 2, MemoryGiB: 4, RootDiskGiB: 50},
+		},
+		{
+			roles: []string{"worker", "etcd"},
+			want:  &Requirement{CPUCores: 4, MemoryGiB: 8, RootDiskGiB: 300},
+		},
+		{
+			roles: []string{"worker", "master"},
+			want:  &Requirement{CPUCores: 8, MemoryGiB: 16, RootDiskGiB: 200},
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.want, profile.Requirement(test.roles))
+	}
+}
+
+func TestSeverityAndSkipped(t *testing.T) {
+	profile := &Profile{
+		Severities: map[string]Severity{"kernel version check": SeverityWarning},
+		SkipItems:  []string{"system distribution check"},
+	}
+
+	assert.Equal(t, SeverityWarning, profile.Severity("kernel version check"))
+	assert.Equal(t, SeverityError, profile.Severity("cpu cores check"))
+	assert.True(t, profile.Skipped("system distribution check"))
+	assert.False(t, profile.Skipped("cpu cores check"))
+}
@@ -15,11 +15,18 @@ It has these top-level messages:
 	TestConnectionRequest
 	TestConnectionReply
 	NodeCheckConfig
+	CheckRequirement
+	CheckProfile
 	CheckNodesRequest
 	CheckNodesReply
 	CheckItem
 	ItemCheckResult
+	ItemFixResult
 	NodeCheckResult
+	ConnectivityCheck
+	ConnectivityResult
+	ConnectivityMatrixRow
+	ConnectivityMatrix
 	GetCheckNodesResultRequest
 	GetCheckNodesResultReply
 	NodePortRange
@@ -252,15 +259,152 @@ func (m *NodeCheckConfig) GetRoles() []string {
 	return nil
 }
 
+// CheckRequirement contains the minimum resources of a node role.
+type CheckRequirement struct {
+	CpuCores    float64 `protobuf:"fixed64,1,opt,name=cpuCores" json:"cpuCores,omitempty"`
+	MemoryGiB   float64 `protobuf:"fixed64,2,opt,name=memoryGiB" json:"memoryGiB,omitempty"`
+	RootDiskGiB float64 `protobuf:"fixed64,3,opt,name=rootDiskGiB" json:"rootDiskGiB,omitempty"`
+}
+
+func (m *CheckRequirement) Reset()                    { *m = CheckRequirement{} }
+func (m *CheckRequirement) String() string            { return proto.CompactTextString(m) }
+func (*CheckRequirement) ProtoMessage()               {}
+func (*CheckRequirement) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *CheckRequirement) GetCpuCores() float64 {
+	if m != nil {
+		return m.CpuCores
+	}
+	return 0
+}
+
+func (m *CheckRequirement) GetMemoryGiB() float64 {
+	if m != nil {
+		return m.MemoryGiB
+	}
+	return 0
+}
+
+func (m *CheckRequirement) GetRootDiskGiB() float64 {
+	if m != nil {
+		return m.RootDiskGiB
+	}
+	return 0
+}
+
+// CheckProfile customizes the node pre-checking.
+type CheckProfile struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// version constraints, e.g. ">=18.09 <20", or the minimum versions, e.g. "18.09.0"
+	DockerVersion string `protobuf:"bytes,2,opt,name=dockerVersion" json:"dockerVersion,omitempty"`
+	KernelVersion string `protobuf:"bytes,3,opt,name=kernelVersion" json:"kernelVersion,omitempty"`
+	// requirements by role, the "default" one is used for roles not listed
+	Roles map[string]*CheckRequirement `protobuf:"bytes,4,rep,name=roles" json:"roles,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// severities by check item name, could be "error" or "warning"
+	Severities map[string]string `protobuf:"bytes,5,rep,name=severities" json:"severities,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	SkipItems  []string          `protobuf:"bytes,6,rep,name=skipItems" json:"skipItems,omitempty"`
+	// the maximum clock skew between a node and the controller
+	MaxClockSkewSeconds float64 `protobuf:"fixed64,7,opt,name=maxClockSkewSeconds" json:"maxClockSkewSeconds,omitempty"`
+	ContainerdVersion   string  `protobuf:"bytes,8,opt,name=containerdVersion" json:"containerdVersion,omitempty"`
+	// the maximum 99th percentile of the fdatasync latency and the minimum sequential write throughput
+	// of the etcd data directory
+	EtcdFsyncP99Milliseconds float64 `protobuf:"fixed64,9,opt,name=etcdFsyncP99Milliseconds" json:"etcdFsyncP99Milliseconds,omitempty"`
+	EtcdWriteMiBps           float64 `protobuf:"fixed64,10,opt,name=etcdWriteMiBps" json:"etcdWriteMiBps,omitempty"`
+}
+
+func (m *CheckProfile) Reset()                    { *m = CheckProfile{} }
+func (m *CheckProfile) String() string            { return proto.CompactTextString(m) }
+func (*CheckProfile) ProtoMessage()               {}
+func (*CheckProfile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *CheckProfile) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CheckProfile) GetDockerVersion() string {
+	if m != nil {
+		return m.DockerVersion
+	}
+	return ""
+}
+
+func (m *CheckProfile) GetKernelVersion() string {
+	if m != nil {
+		return m.KernelVersion
+	}
+	return ""
+}
+
+func (m *CheckProfile) GetRoles() map[string]*CheckRequirement {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *CheckProfile) GetSeverities() map[string]string {
+	if m != nil {
+		return m.Severities
+	}
+	return nil
+}
+
+func (m *CheckProfile) GetSkipItems() []string {
+	if m != nil {
+		return m.SkipItems
+	}
+	return nil
+}
+
+func (m *CheckProfile) GetMaxClockSkewSeconds() float64 {
+	if m != nil {
+		return m.MaxClockSkewSeconds
+	}
+	return 0
+}
+
+func (m *CheckProfile) GetContainerdVersion() string {
+	if m != nil {
+		return m.ContainerdVersion
+	}
+	return ""
+}
+
+func (m *CheckProfile) GetEtcdFsyncP99Milliseconds() float64 {
+	if m != nil {
+		return m.EtcdFsyncP99Milliseconds
+	}
+	return 0
+}
+
+func (m *CheckProfile) GetEtcdWriteMiBps() float64 {
+	if m != nil {
+		return m.EtcdWriteMiBps
+	}
+	return 0
+}
+
 // CheckNodesRequest contains the request of node pre-checking.
 type CheckNodesRequest struct {
 	Configs []*NodeCheckConfig `protobuf:"bytes,1,rep,name=configs" json:"configs,omitempty"`
+	// the profile configured in deploy controller is used if it's nil
+	Profile *CheckProfile `protobuf:"bytes,2,opt,name=profile" json:"profile,omitempty"`
+	// the cluster config decides some checks, e.g. the node port range to be checked
+	ClusterConfig *ClusterConfig `protobuf:"bytes,3,opt,name=clusterConfig" json:"clusterConfig,omitempty"`
+	// names of the check items to be fixed if they failed, the fixed items are checked again
+	FixItems []string `protobuf:"bytes,4,rep,name=fixItems" json:"fixItems,omitempty"`
+	// only the failed items are checked again, the results of the passed items in the last check are
+	// reused if the nodes are not changed since then
+	RecheckFailedOnly bool `protobuf:"varint,5,opt,name=recheckFailedOnly" json:"recheckFailedOnly,omitempty"`
 }
 
 func (m *CheckNodesRequest) Reset()                    { *m = CheckNodesRequest{} }
 func (m *CheckNodesRequest) String() string            { return proto.CompactTextString(m) }
 func (*CheckNodesRequest) ProtoMessage()               {}
-func (*CheckNodesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*CheckNodesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 func (m *CheckNodesRequest) GetConfigs() []*NodeCheckConfig {
 	if m != nil {
@@ -269,6 +413,34 @@ func (m *CheckNodesRequest) GetConfigs() []*NodeCheckConfig {
 	return nil
 }
 
+func (m *CheckNodesRequest) GetProfile() *CheckProfile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+func (m *CheckNodesRequest) GetClusterConfig() *ClusterConfig {
+	if m != nil {
+		return m.ClusterConfig
+	}
+	return nil
+}
+
+func (m *CheckNodesRequest) GetFixItems() []string {
+	if m != nil {
+		return m.FixItems
+	}
+	return nil
+}
+
+func (m *CheckNodesRequest) GetRecheckFailedOnly() bool {
+	if m != nil {
+		return m.RecheckFailedOnly
+	}
+	return false
+}
+
 // CheckNodesReply contains the result of node pre-checking.
 type CheckNodesReply struct {
 	Acceptd bool   `protobuf:"varint,1,opt,name=acceptd" json:"acceptd,omitempty"`
@@ -278,7 +450,7 @@ type CheckNodesReply struct {
 func (m *CheckNodesReply) Reset()                    { *m = CheckNodesReply{} }
 func (m *CheckNodesReply) String() string            { return proto.CompactTextString(m) }
 func (*CheckNodesReply) ProtoMessage()               {}
-func (*CheckNodesReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*CheckNodesReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
 
 func (m *CheckNodesReply) GetAcceptd() bool {
 	if m != nil {
@@ -303,7 +475,7 @@ type CheckItem struct {
 func (m *CheckItem) Reset()                    { *m = CheckItem{} }
 func (m *CheckItem) String() string            { return proto.CompactTextString(m) }
 func (*CheckItem) ProtoMessage()               {}
-func (*CheckItem) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*CheckItem) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
 func (m *CheckItem) GetName() string {
 	if m != nil {
@@ -325,12 +497,16 @@ type ItemCheckResult struct {
 	Status string     `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
 	Err    *Error     `protobuf:"bytes,3,opt,name=err" json:"err,omitempty"`
 	Logs   string     `protobuf:"bytes,4,opt,name=logs" json:"logs,omitempty"`
+	// it's set if the item was fixed, the status and err above are the result checked again after fixing
+	Fix *ItemFixResult `protobuf:"bytes,5,opt,name=fix" json:"fix,omitempty"`
+	// it's set if the item passed in the last check and was not checked again
+	Cached bool `protobuf:"varint,6,opt,name=cached" json:"cached,omitempty"`
 }
 
 func (m *ItemCheckResult) Reset()                    { *m = ItemCheckResult{} }
 func (m *ItemCheckResult) String() string            { return proto.CompactTextString(m) }
 func (*ItemCheckResult) ProtoMessage()               {}
-func (*ItemCheckResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*ItemCheckResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
 func (m *ItemCheckResult) GetItem() *CheckItem {
 	if m != nil {
@@ -360,6 +536,53 @@ func (m *ItemCheckResult) GetLogs() string {
 	return ""
 }
 
+func (m *ItemCheckResult) GetFix() *ItemFixResult {
+	if m != nil {
+		return m.Fix
+	}
+	return nil
+}
+
+func (m *ItemCheckResult) GetCached() bool {
+	if m != nil {
+		return m.Cached
+	}
+	return false
+}
+
+// ItemFixResult contains the result of a check item before fixing, and the logs of fixing
+type ItemFixResult struct {
+	BeforeStatus string `protobuf:"bytes,1,opt,name=beforeStatus" json:"beforeStatus,omitempty"`
+	BeforeErr    *Error `protobuf:"bytes,2,opt,name=beforeErr" json:"beforeErr,omitempty"`
+	Logs         string `protobuf:"bytes,3,opt,name=logs" json:"logs,omitempty"`
+}
+
+func (m *ItemFixResult) Reset()                    { *m = ItemFixResult{} }
+func (m *ItemFixResult) String() string            { return proto.CompactTextString(m) }
+func (*ItemFixResult) ProtoMessage()               {}
+func (*ItemFixResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *ItemFixResult) GetBeforeStatus() string {
+	if m != nil {
+		return m.BeforeStatus
+	}
+	return ""
+}
+
+func (m *ItemFixResult) GetBeforeErr() *Error {
+	if m != nil {
+		return m.BeforeErr
+	}
+	return nil
+}
+
+func (m *ItemFixResult) GetLogs() string {
+	if m != nil {
+		return m.Logs
+	}
+	return ""
+}
+
 // ItemCheckResult contains the pre-checking reuslt of a node
 type NodeCheckResult struct {
 	NodeName string             `protobuf:"bytes,1,opt,name=nodeName" json:"nodeName,omitempty"`
@@ -371,7 +594,7 @@ type NodeCheckResult struct {
 func (m *NodeCheckResult) Reset()                    { *m = NodeCheckResult{} }
 func (m *NodeCheckResult) String() string            { return proto.CompactTextString(m) }
 func (*NodeCheckResult) ProtoMessage()               {}
-func (*NodeCheckResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*NodeCheckResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
 
 func (m *NodeCheckResult) GetNodeName() string {
 	if m != nil {
@@ -401,6 +624,164 @@ func (m *NodeCheckResult) GetItems() []*ItemCheckResult {
 	return nil
 }
 
+// ConnectivityCheck contains the result of checking a protocol from a node to another node
+type ConnectivityCheck struct {
+	// protocol could be "tcp", "udp", "icmp" or "mtu"
+	Protocol string `protobuf:"bytes,1,opt,name=protocol" json:"protocol,omitempty"`
+	Port     uint32 `protobuf:"varint,2,opt,name=port" json:"port,omitempty"`
+	Status   string `protobuf:"bytes,3,opt,name=status" json:"status,omitempty"`
+	Detail   string `protobuf:"bytes,4,opt,name=detail" json:"detail,omitempty"`
+}
+
+func (m *ConnectivityCheck) Reset()                    { *m = ConnectivityCheck{} }
+func (m *ConnectivityCheck) String() string            { return proto.CompactTextString(m) }
+func (*ConnectivityCheck) ProtoMessage()               {}
+func (*ConnectivityCheck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *ConnectivityCheck) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
+	}
+	return ""
+}
+
+func (m *ConnectivityCheck) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *ConnectivityCheck) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ConnectivityCheck) GetDetail() string {
+	if m != nil {
+		return m.Detail
+	}
+	return ""
+}
+
+// ConnectivityResult contains the connectivity from a node to another node
+type ConnectivityResult struct {
+	Source      string               `protobuf:"bytes,1,opt,name=source" json:"source,omitempty"`
+	Destination string               `protobuf:"bytes,2,opt,name=destination" json:"destination,omitempty"`
+	Status      string               `protobuf:"bytes,3,opt,name=status" json:"status,omitempty"`
+	Checks      []*ConnectivityCheck `protobuf:"bytes,4,rep,name=checks" json:"checks,omitempty"`
+	PathMTU     uint32               `protobuf:"varint,5,opt,name=pathMTU" json:"pathMTU,omitempty"`
+}
+
+func (m *ConnectivityResult) Reset()                    { *m = ConnectivityResult{} }
+func (m *ConnectivityResult) String() string            { return proto.CompactTextString(m) }
+func (*ConnectivityResult) ProtoMessage()               {}
+func (*ConnectivityResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+func (m *ConnectivityResult) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *ConnectivityResult) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *ConnectivityResult) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ConnectivityResult) GetChecks() []*ConnectivityCheck {
+	if m != nil {
+		return m.Checks
+	}
+	return nil
+}
+
+func (m *ConnectivityResult) GetPathMTU() uint32 {
+	if m != nil {
+		return m.PathMTU
+	}
+	return 0
+}
+
+// ConnectivityMatrixRow contains the connectivity from a node to all nodes
+type ConnectivityMatrixRow struct {
+	Source string `protobuf:"bytes,1,opt,name=source" json:"source,omitempty"`
+	// in the same order of the nodes of the matrix, the result to the node itself is empty
+	Results []*ConnectivityResult `protobuf:"bytes,2,rep,name=results" json:"results,omitempty"`
+}
+
+func (m *ConnectivityMatrixRow) Reset()                    { *m = ConnectivityMatrixRow{} }
+func (m *ConnectivityMatrixRow) String() string            { return proto.CompactTextString(m) }
+func (*ConnectivityMatrixRow) ProtoMessage()               {}
+func (*ConnectivityMatrixRow) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+
+func (m *ConnectivityMatrixRow) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *ConnectivityMatrixRow) GetResults() []*ConnectivityResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// ConnectivityMatrix contains the connectivity between every two nodes
+type ConnectivityMatrix struct {
+	Status string                   `protobuf:"bytes,1,opt,name=status" json:"status,omitempty"`
+	Err    *Error                   `protobuf:"bytes,2,opt,name=err" json:"err,omitempty"`
+	Nodes  []string                 `protobuf:"bytes,3,rep,name=nodes" json:"nodes,omitempty"`
+	Rows   []*ConnectivityMatrixRow `protobuf:"bytes,4,rep,name=rows" json:"rows,omitempty"`
+}
+
+func (m *ConnectivityMatrix) Reset()                    { *m = ConnectivityMatrix{} }
+func (m *ConnectivityMatrix) String() string            { return proto.CompactTextString(m) }
+func (*ConnectivityMatrix) ProtoMessage()               {}
+func (*ConnectivityMatrix) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *ConnectivityMatrix) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ConnectivityMatrix) GetErr() *Error {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
+func (m *ConnectivityMatrix) GetNodes() []string {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *ConnectivityMatrix) GetRows() []*ConnectivityMatrixRow {
+	if m != nil {
+		return m.Rows
+	}
+	return nil
+}
+
 // GetCheckNodesResultRequest contains the request of getting nodes check result.
 type GetCheckNodesResultRequest struct {
 	WithLogs bool `protobuf:"varint,1,opt,name=withLogs" json:"withLogs,omitempty"`
@@ -409,7 +790,7 @@ type GetCheckNodesResultRequest struct {
 func (m *GetCheckNodesResultRequest) Reset()                    { *m = GetCheckNodesResultRequest{} }
 func (m *GetCheckNodesResultRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetCheckNodesResultRequest) ProtoMessage()               {}
-func (*GetCheckNodesResultRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*GetCheckNodesResultRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
 
 func (m *GetCheckNodesResultRequest) GetWithLogs() bool {
 	if m != nil {
@@ -423,12 +804,15 @@ type GetCheckNodesResultReply struct {
 	Status string             `protobuf:"bytes,1,opt,name=status" json:"status,omitempty"`
 	Err    *Error             `protobuf:"bytes,2,opt,name=err" json:"err,omitempty"`
 	Nodes  []*NodeCheckResult `protobuf:"bytes,3,rep,name=nodes" json:"nodes,omitempty"`
+	// the name of the applied check profile
+	Profile      string              `protobuf:"bytes,4,opt,name=profile" json:"profile,omitempty"`
+	Connectivity *ConnectivityMatrix `protobuf:"bytes,5,opt,name=connectivity" json:"connectivity,omitempty"`
 }
 
 func (m *GetCheckNodesResultReply) Reset()                    { *m = GetCheckNodesResultReply{} }
 func (m *GetCheckNodesResultReply) String() string            { return proto.CompactTextString(m) }
 func (*GetCheckNodesResultReply) ProtoMessage()               {}
-func (*GetCheckNodesResultReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*GetCheckNodesResultReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
 
 func (m *GetCheckNodesResultReply) GetStatus() string {
 	if m != nil {
@@ -451,6 +835,20 @@ func (m *GetCheckNodesResultReply) GetNodes() []*NodeCheckResult {
 	return nil
 }
 
+func (m *GetCheckNodesResultReply) GetProfile() string {
+	if m != nil {
+		return m.Profile
+	}
+	return ""
+}
+
+func (m *GetCheckNodesResultReply) GetConnectivity() *ConnectivityMatrix {
+	if m != nil {
+		return m.Connectivity
+	}
+	return nil
+}
+
 type NodePortRange struct {
 	From uint32 `protobuf:"varint,1,opt,name=from" json:"from,omitempty"`
 	To   uint32 `protobuf:"varint,2,opt,name=to" json:"to,omitempty"`
@@ -459,7 +857,7 @@ type NodePortRange struct {
 func (m *NodePortRange) Reset()                    { *m = NodePortRange{} }
 func (m *NodePortRange) String() string            { return proto.CompactTextString(m) }
 func (*NodePortRange) ProtoMessage()               {}
-func (*NodePortRange) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*NodePortRange) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
 func (m *NodePortRange) GetFrom() uint32 {
 	if m != nil {
@@ -483,7 +881,7 @@ type Keepalived struct {
 func (m *Keepalived) Reset()                    { *m = Keepalived{} }
 func (m *Keepalived) String() string            { return proto.CompactTextString(m) }
 func (*Keepalived) ProtoMessage()               {}
-func (*Keepalived) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (*Keepalived) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
 func (m *Keepalived) GetVip() string {
 	if m != nil {
@@ -507,7 +905,7 @@ type Loadbalancer struct {
 func (m *Loadbalancer) Reset()                    { *m = Loadbalancer{} }
 func (m *Loadbalancer) String() string            { return proto.CompactTextString(m) }
 func (*Loadbalancer) ProtoMessage()               {}
-func (*Loadbalancer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (*Loadbalancer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
 func (m *Loadbalancer) GetIp() string {
 	if m != nil {
@@ -534,7 +932,7 @@ type KubeAPIServerConnect struct {
 func (m *KubeAPIServerConnect) Reset()                    { *m = KubeAPIServerConnect{} }
 func (m *KubeAPIServerConnect) String() string            { return proto.CompactTextString(m) }
 func (*KubeAPIServerConnect) ProtoMessage()               {}
-func (*KubeAPIServerConnect) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (*KubeAPIServerConnect) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
 func (m *KubeAPIServerConnect) GetType() string {
 	if m != nil {
@@ -568,12 +966,18 @@ type ClusterConfig struct {
 	PodSubnet            string                `protobuf:"bytes,7,opt,name=podSubnet" json:"podSubnet,omitempty"`
 	ServiceSubnet        string                `protobuf:"bytes,8,opt,name=serviceSubnet" json:"serviceSubnet,omitempty"`
 	KubernetesVersion    string                `protobuf:"bytes,9,opt,name=kubernetesVersion" json:"kubernetesVersion,omitempty"`
+	// ntp servers used to synchronize the clocks of nodes
+	NtpServers []string `protobuf:"bytes,10,rep,name=ntpServers" json:"ntpServers,omitempty"`
+	// container runtime of nodes, could be "docker" or "containerd", it's docker if not set
+	ContainerRuntime string `protobuf:"bytes,11,opt,name=containerRuntime" json:"containerRuntime,omitempty"`
+	// data directory of etcd on etcd nodes, it's /var/lib/etcd if not set
+	EtcdDataDir string `protobuf:"bytes,12,opt,name=etcdDataDir" json:"etcdDataDir,omitempty"`
 }
 
 func (m *ClusterConfig) Reset()                    { *m = ClusterConfig{} }
 func (m *ClusterConfig) String() string            { return proto.CompactTextString(m) }
 func (*ClusterConfig) ProtoMessage()               {}
-func (*ClusterConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*ClusterConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
 func (m *ClusterConfig) GetClusterName() string {
 	if m != nil {
@@ -638,6 +1042,27 @@ func (m *ClusterConfig) GetKubernetesVersion() string {
 	return ""
 }
 
+func (m *ClusterConfig) GetNtpServers() []string {
+	if m != nil {
+		return m.NtpServers
+	}
+	return nil
+}
+
+func (m *ClusterConfig) GetContainerRuntime() string {
+	if m != nil {
+		return m.ContainerRuntime
+	}
+	return ""
+}
+
+func (m *ClusterConfig) GetEtcdDataDir() string {
+	if m != nil {
+		return m.EtcdDataDir
+	}
+	return ""
+}
+
 type Taint struct {
 	Key    string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
 	Value  string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
@@ -647,7 +1072,7 @@ type Taint struct {
 func (m *Taint) Reset()                    { *m = Taint{} }
 func (m *Taint) String() string            { return proto.CompactTextString(m) }
 func (*Taint) ProtoMessage()               {}
-func (*Taint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (*Taint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
 func (m *Taint) GetKey() string {
 	if m != nil {
@@ -682,7 +1107,7 @@ type NodeDeployConfig struct {
 func (m *NodeDeployConfig) Reset()                    { *m = NodeDeployConfig{} }
 func (m *NodeDeployConfig) String() string            { return proto.CompactTextString(m) }
 func (*NodeDeployConfig) ProtoMessage()               {}
-func (*NodeDeployConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (*NodeDeployConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
 
 func (m *NodeDeployConfig) GetNode() *Node {
 	if m != nil {
@@ -721,7 +1146,7 @@ type DeployRequest struct {
 func (m *DeployRequest) Reset()                    { *m = DeployRequest{} }
 func (m *DeployRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeployRequest) ProtoMessage()               {}
-func (*DeployRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (*DeployRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
 
 func (m *DeployRequest) GetNodeConfigs() []*NodeDeployConfig {
 	if m != nil {
@@ -746,7 +1171,7 @@ type DeployReply struct {
 func (m *DeployReply) Reset()                    { *m = DeployReply{} }
 func (m *DeployReply) String() string            { return proto.CompactTextString(m) }
 func (*DeployReply) ProtoMessage()               {}
-func (*DeployReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (*DeployReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
 
 func (m *DeployReply) GetAcceptd() bool {
 	if m != nil {
@@ -770,7 +1195,7 @@ type GetDeployResultRequest struct {
 func (m *GetDeployResultRequest) Reset()                    { *m = GetDeployResultRequest{} }
 func (m *GetDeployResultRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetDeployResultRequest) ProtoMessage()               {}
-func (*GetDeployResultRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (*GetDeployResultRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
 
 func (m *GetDeployResultRequest) GetWithLogs() bool {
 	if m != nil {
@@ -789,7 +1214,7 @@ type DeployItem struct {
 func (m *DeployItem) Reset()                    { *m = DeployItem{} }
 func (m *DeployItem) String() string            { return proto.CompactTextString(m) }
 func (*DeployItem) ProtoMessage()               {}
-func (*DeployItem) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (*DeployItem) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
 
 func (m *DeployItem) GetRole() string {
 	if m != nil {
@@ -823,7 +1248,7 @@ type DeployItemResult struct {
 func (m *DeployItemResult) Reset()                    { *m = DeployItemResult{} }
 func (m *DeployItemResult) String() string            { return proto.CompactTextString(m) }
 func (*DeployItemResult) ProtoMessage()               {}
-func (*DeployItemResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (*DeployItemResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
 
 func (m *DeployItemResult) GetDeployItem() *DeployItem {
 	if m != nil {
@@ -863,7 +1288,7 @@ type GetDeployResultReply struct {
 func (m *GetDeployResultReply) Reset()                    { *m = GetDeployResultReply{} }
 func (m *GetDeployResultReply) String() string            { return proto.CompactTextString(m) }
 func (*GetDeployResultReply) ProtoMessage()               {}
-func (*GetDeployResultReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (*GetDeployResultReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
 
 func (m *GetDeployResultReply) GetStatus() string {
 	if m != nil {
@@ -894,7 +1319,7 @@ type FetchKubeConfigRequest struct {
 func (m *FetchKubeConfigRequest) Reset()                    { *m = FetchKubeConfigRequest{} }
 func (m *FetchKubeConfigRequest) String() string            { return proto.CompactTextString(m) }
 func (*FetchKubeConfigRequest) ProtoMessage()               {}
-func (*FetchKubeConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+func (*FetchKubeConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
 
 func (m *FetchKubeConfigRequest) GetNode() *Node {
 	if m != nil {
@@ -912,7 +1337,7 @@ type FetchKubeConfigReply struct {
 func (m *FetchKubeConfigReply) Reset()                    { *m = FetchKubeConfigReply{} }
 func (m *FetchKubeConfigReply) String() string            { return proto.CompactTextString(m) }
 func (*FetchKubeConfigReply) ProtoMessage()               {}
-func (*FetchKubeConfigReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (*FetchKubeConfigReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
 
 func (m *FetchKubeConfigReply) GetKubeConfig() []byte {
 	if m != nil {
@@ -936,11 +1361,18 @@ func init() {
 	proto.RegisterType((*TestConnectionRequest)(nil), "protos.TestConnectionRequest")
 	proto.RegisterType((*TestConnectionReply)(nil), "protos.TestConnectionReply")
 	proto.RegisterType((*NodeCheckConfig)(nil), "protos.NodeCheckConfig")
+	proto.RegisterType((*CheckRequirement)(nil), "protos.CheckRequirement")
+	proto.RegisterType((*CheckProfile)(nil), "protos.CheckProfile")
 	proto.RegisterType((*CheckNodesRequest)(nil), "protos.CheckNodesRequest")
 	proto.RegisterType((*CheckNodesReply)(nil), "protos.CheckNodesReply")
 	proto.RegisterType((*CheckItem)(nil), "protos.CheckItem")
 	proto.RegisterType((*ItemCheckResult)(nil), "protos.ItemCheckResult")
+	proto.RegisterType((*ItemFixResult)(nil), "protos.ItemFixResult")
 	proto.RegisterType((*NodeCheckResult)(nil), "protos.NodeCheckResult")
+	proto.RegisterType((*ConnectivityCheck)(nil), "protos.ConnectivityCheck")
+	proto.RegisterType((*ConnectivityResult)(nil), "protos.ConnectivityResult")
+	proto.RegisterType((*ConnectivityMatrixRow)(nil), "protos.ConnectivityMatrixRow")
+	proto.RegisterType((*ConnectivityMatrix)(nil), "protos.ConnectivityMatrix")
 	proto.RegisterType((*GetCheckNodesResultRequest)(nil), "protos.GetCheckNodesResultRequest")
 	proto.RegisterType((*GetCheckNodesResultReply)(nil), "protos.GetCheckNodesResultReply")
 	proto.RegisterType((*NodePortRange)(nil), "protos.NodePortRange")
@@ -1200,86 +1632,123 @@ var _DeployContoller_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("deploy_controller.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1288 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4d, 0x6f, 0x1b, 0x37,
-	0x13, 0xce, 0x4a, 0xb2, 0x63, 0x8f, 0xac, 0xc8, 0xa1, 0xe5, 0x64, 0x5f, 0xbd, 0x49, 0x6a, 0x10,
-	0x09, 0x60, 0x04, 0xad, 0xd1, 0x2a, 0x45, 0x91, 0x8f, 0xf6, 0xe0, 0xb8, 0xf9, 0x70, 0xe3, 0x1a,
-	0x29, 0x1d, 0x24, 0xa7, 0xa2, 0x58, 0xef, 0x8e, 0xac, 0x85, 0xd7, 0xcb, 0x2d, 0x49, 0xb9, 0xd5,
-	0xa9, 0x40, 0x81, 0x02, 0xbd, 0x17, 0x05, 0xfa, 0xfb, 0x7a, 0xec, 0x6f, 0xe8, 0xa1, 0x20, 0x97,
-	0x5c, 0x51, 0xf2, 0x3a, 0x49, 0x93, 0x9e, 0xb4, 0x9c, 0x8f, 0x87, 0xcf, 0x0c, 0x87, 0xc3, 0x11,
-	0x5c, 0x4d, 0xb0, 0xc8, 0xf8, 0xe4, 0xbb, 0x98, 0xe7, 0x4a, 0xf0, 0x2c, 0x43, 0xb1, 0x55, 0x08,
-	0xae, 0x38, 0x59, 0x34, 0x3f, 0x92, 0xbe, 0x84, 0xd6, 0xf6, 0x58, 0x8d, 0x08, 0x81, 0x96, 0x9a,
-	0x14, 0x18, 0x06, 0x1b, 0xc1, 0xe6, 0x32, 0x33, 0xdf, 0xe4, 0x06, 0x40, 0x2c, 0x30, 0xc1, 0x5c,
-	0xa5, 0x51, 0x16, 0x36, 0x8c, 0xc6, 0x93, 0x90, 0x3e, 0x2c, 0x8d, 0x25, 0x8a, 0x3c, 0x3a, 0xc1,
-	0xb0, 0x69, 0xb4, 0xd5, 0x9a, 0x3e, 0x80, 0xe6, 0xc1, 0xc1, 0x53, 0x0d, 0x5b, 0x70, 0xa1, 0x0c,
-	0x6c, 0x87, 0x99, 0x6f, 0xb2, 0x01, 0xad, 0x68, 0xac, 0x46, 0x06, 0xb0, 0x3d, 0x58, 0x29, 0x09,
-	0xc9, 0x2d, 0x4d, 0x83, 0x19, 0x0d, 0xdd, 0x85, 0xd6, 0x3e, 0x4f, 0x50, 0x7b, 0x1b, 0x70, 0x4b,
-	0x4a, 0x7f, 0x93, 0x4b, 0xd0, 0x48, 0x0b, 0x4b, 0xa6, 0x91, 0x16, 0xe4, 0x3a, 0x34, 0xa5, 0x1c,
-	0x99, 0xfd, 0xdb, 0x83, 0xb6, 0x03, 0x3b, 0x38, 0x78, 0xca, 0xb4, 0x9c, 0xbe, 0x82, 0x85, 0x47,
-	0x42, 0x70, 0x41, 0xae, 0xc0, 0xa2, 0xc0, 0x48, 0xf2, 0xdc, 0xa2, 0xd9, 0x95, 0x96, 0x27, 0xa8,
-	0xa2, 0xd4, 0x05, 0x68, 0x57, 0x3a, 0xf8, 0x61, 0xfa, 0xe3, 0xd7, 0xa8, 0x46, 0x3c, 0x91, 0x36,
-	0x3c, 0x4f, 0x42, 0xef, 0xc1, 0xfa, 0x0b, 0x94, 0x6a, 0x87, 0xe7, 0x39, 0xc6, 0x2a, 0xe5, 0x39,
-	0xc3, 0xef, 0xc7, 0x28, 0x4d, 0x78, 0x39, 0x4f, 0x4a, 0xd2, 0x5e, 0x78, 0x3a, 0x20, 0x66, 0x34,
-	0x74, 0x1f, 0xd6, 0xe6, 0x5d, 0x8b, 0x6c, 0xa2, 0x99, 0x14, 0x91, 0x94, 0x98, 0x18, 0xd7, 0x25,
-	0x66, 0x57, 0xe4, 0x03, 0x68, 0xa2, 0x10, 0x36, 0x5d, 0x1d, 0x87, 0x67, 0xa2, 0x62, 0x5a, 0x43,
-	0x77, 0xa1, 0xab, 0xd1, 0x77, 0x46, 0x18, 0x1f, 0xef, 0xf0, 0x7c, 0x98, 0x1e, 0xbd, 0x99, 0x04,
-	0xe9, 0xc1, 0x82, 0xe0, 0x19, 0xca, 0xb0, 0xb1, 0xd1, 0xdc, 0x5c, 0x66, 0xe5, 0x82, 0x3e, 0x86,
-	0xcb, 0x06, 0x46, 0x1b, 0x4a, 0x17, 0xd1, 0x27, 0x70, 0x31, 0x36, 0xb0, 0x32, 0x0c, 0x36, 0x9a,
-	0x9b, 0xed, 0xc1, 0x55, 0x1f, 0xcf, 0xdb, 0x96, 0x39, 0x3b, 0xba, 0x07, 0x5d, 0x1f, 0x47, 0x87,
-	0x17, 0xc2, 0xc5, 0x28, 0x8e, 0xb1, 0x50, 0x2e, 0x3e, 0xb7, 0x7c, 0x73, 0x80, 0xdb, 0xb0, 0x6c,
-	0xd0, 0x76, 0x15, 0x9e, 0xd4, 0x16, 0xc5, 0x06, 0xb4, 0x13, 0x94, 0xb1, 0x48, 0x0b, 0x9d, 0x4e,
-	0x7b, 0x92, 0xbe, 0x88, 0xfe, 0x12, 0x40, 0x57, 0xbb, 0x1b, 0x1c, 0x86, 0x72, 0x9c, 0x29, 0x72,
-	0x0b, 0x5a, 0xa9, 0xc2, 0x13, 0x9b, 0xa4, 0xcb, 0x6e, 0xe3, 0x6a, 0x2b, 0x66, 0xd4, 0xfa, 0x5c,
-	0xa4, 0x8a, 0xd4, 0x58, 0xba, 0x0a, 0x29, 0x57, 0x8e, 0x76, 0xf3, 0x3c, 0xda, 0x9a, 0x69, 0xc6,
-	0x8f, 0x64, 0xd8, 0x2a, 0x99, 0xea, 0x6f, 0xfa, 0x7b, 0xe0, 0x1d, 0x96, 0xe5, 0xd1, 0x87, 0x25,
-	0x7d, 0x24, 0xfb, 0xd3, 0xa8, 0xaa, 0xf5, 0xbb, 0x6f, 0xfe, 0x11, 0x2c, 0x68, 0xf6, 0x7a, 0xf7,
-	0x99, 0x23, 0x9b, 0x4b, 0x02, 0x2b, 0xad, 0xe8, 0x5d, 0xe8, 0x3f, 0x41, 0xe5, 0x9f, 0x99, 0xd1,
-	0xda, 0x0a, 0xe8, 0xc3, 0xd2, 0x0f, 0xa9, 0x1a, 0xed, 0x71, 0x53, 0x02, 0xfa, 0xf0, 0xaa, 0x35,
-	0xfd, 0x39, 0x80, 0xb0, 0xd6, 0xd5, 0xd6, 0xb4, 0xa5, 0x1f, 0xd4, 0xd1, 0x6f, 0xbc, 0x8e, 0xbe,
-	0xce, 0x81, 0xbe, 0x79, 0xf5, 0x15, 0xe7, 0xe8, 0x1b, 0x2b, 0x7a, 0x07, 0x3a, 0x5a, 0xf3, 0x9c,
-	0x0b, 0xc5, 0xa2, 0xfc, 0xc8, 0xb4, 0x8e, 0xa1, 0xe0, 0x27, 0xae, 0xf1, 0xe8, 0x6f, 0xdd, 0x3a,
-	0x14, 0x37, 0x7b, 0x76, 0x58, 0x43, 0x71, 0xfa, 0x15, 0xc0, 0x33, 0xc4, 0x22, 0xca, 0xd2, 0x53,
-	0x4c, 0xc8, 0x2a, 0x34, 0x4f, 0xd3, 0xc2, 0xf2, 0xd4, 0x9f, 0xe4, 0x36, 0xac, 0xe6, 0xa8, 0x76,
-	0x73, 0x85, 0x62, 0x18, 0xc5, 0xe5, 0xf9, 0x94, 0xa7, 0x70, 0x46, 0x4e, 0x07, 0xb0, 0xb2, 0xc7,
-	0xa3, 0xe4, 0x30, 0xca, 0xa2, 0x3c, 0x46, 0x61, 0xdb, 0x54, 0x50, 0xb5, 0x29, 0xd7, 0x08, 0x1b,
-	0xd3, 0x46, 0x48, 0xff, 0x08, 0xa0, 0xf7, 0x6c, 0x7c, 0x88, 0xdb, 0xcf, 0x77, 0x0f, 0x50, 0x9c,
-	0xa2, 0xb0, 0x1d, 0xa1, 0xb6, 0x19, 0x0f, 0x00, 0x8e, 0x2b, 0xb2, 0x36, 0x71, 0xc4, 0x65, 0x65,
-	0x1a, 0x06, 0xf3, 0xac, 0xc8, 0x5d, 0x58, 0xc9, 0x3c, 0x52, 0xb6, 0x5a, 0x7a, 0xce, 0xcb, 0x27,
-	0xcc, 0x66, 0x2c, 0xe9, 0xdf, 0x2d, 0xe8, 0xec, 0x64, 0x63, 0xa9, 0x0c, 0xa9, 0xb2, 0xa3, 0xb4,
-	0xe3, 0x52, 0xe0, 0xd5, 0xa9, 0x2f, 0x22, 0xcf, 0xa1, 0x77, 0x5c, 0x13, 0x8d, 0xe5, 0x7a, 0xad,
-	0xe2, 0x5a, 0x63, 0xc3, 0x6a, 0x3d, 0xc9, 0x03, 0xe8, 0xe4, 0xfe, 0xa9, 0xda, 0x00, 0xd6, 0xfd,
-	0x62, 0xa8, 0x94, 0x6c, 0xd6, 0x96, 0x3c, 0x02, 0xd0, 0x82, 0xbd, 0xe8, 0x10, 0x33, 0x77, 0x0b,
-	0x6e, 0x55, 0x77, 0xdc, 0x8f, 0xcd, 0xe0, 0x94, 0x76, 0x8f, 0x72, 0x25, 0x26, 0xcc, 0x73, 0x24,
-	0x2f, 0xa0, 0xab, 0x57, 0xdb, 0x79, 0xce, 0x55, 0xa4, 0x5b, 0x89, 0x0c, 0x17, 0x0c, 0xd6, 0xed,
-	0xf3, 0xb1, 0x3c, 0xe3, 0x12, 0x70, 0x1e, 0x82, 0x6c, 0x42, 0x37, 0x3d, 0x89, 0x8e, 0x90, 0x61,
-	0xc1, 0x65, 0xaa, 0xb8, 0x98, 0x84, 0x8b, 0x26, 0xa3, 0xf3, 0x62, 0x72, 0x0d, 0x96, 0x0b, 0x9e,
-	0x1c, 0x8c, 0x0f, 0x73, 0x54, 0xe1, 0x45, 0x63, 0x33, 0x15, 0x90, 0x9b, 0xd0, 0x91, 0x28, 0x4e,
-	0xd3, 0x18, 0xad, 0xc5, 0x92, 0xb1, 0x98, 0x15, 0x92, 0x0f, 0xe1, 0xb2, 0xce, 0xaf, 0xc8, 0x51,
-	0xa1, 0x7c, 0x89, 0x42, 0xea, 0x26, 0xb9, 0x6c, 0x2c, 0xcf, 0x2a, 0xfa, 0x5f, 0x94, 0x1d, 0xca,
-	0x4b, 0x88, 0xbe, 0x1b, 0xc7, 0x38, 0x71, 0x77, 0xe3, 0x18, 0x27, 0xfa, 0xf9, 0x38, 0x8d, 0xb2,
-	0xb1, 0xbb, 0x10, 0xe5, 0xe2, 0x7e, 0xe3, 0x6e, 0xd0, 0x7f, 0x08, 0xbd, 0xba, 0x1c, 0xfc, 0x1b,
-	0x0c, 0xfa, 0x04, 0x16, 0x5e, 0x44, 0x69, 0xae, 0xde, 0xd6, 0x49, 0xf7, 0x19, 0x1c, 0x0e, 0x75,
-	0xb5, 0x95, 0x2f, 0xb5, 0x5d, 0xd1, 0xbf, 0x02, 0x58, 0xd5, 0x6c, 0xbe, 0x34, 0x63, 0xd0, 0xfb,
-	0x3d, 0x8e, 0xe4, 0x73, 0x58, 0xcc, 0xca, 0x6a, 0x2a, 0x9b, 0xd2, 0x4d, 0xdf, 0xd3, 0xdf, 0x61,
-	0xcb, 0x2f, 0x26, 0xeb, 0x43, 0x6e, 0xc1, 0xa2, 0xd2, 0x31, 0xb9, 0x5a, 0xac, 0xba, 0x9e, 0x89,
-	0x94, 0x59, 0x65, 0xff, 0x1e, 0xb4, 0xdf, 0x31, 0xf3, 0xf4, 0xd7, 0x00, 0x3a, 0x25, 0x0d, 0xd7,
-	0xb7, 0xef, 0x43, 0x5b, 0xc7, 0xb3, 0x33, 0xf3, 0x7a, 0x87, 0xe7, 0xd1, 0x66, 0xbe, 0xb1, 0xbe,
-	0x7c, 0xb1, 0x5f, 0xd9, 0xf6, 0x1e, 0xaf, 0xd7, 0x96, 0x3d, 0x9b, 0xb5, 0xa5, 0x4f, 0xa1, 0xed,
-	0x98, 0xbc, 0xe7, 0xdb, 0xff, 0x29, 0x5c, 0x79, 0x82, 0xca, 0x81, 0xbd, 0xed, 0xa3, 0x94, 0x03,
-	0x94, 0x2e, 0x6e, 0x64, 0xd0, 0x27, 0xe8, 0xfa, 0xa9, 0xfe, 0x9e, 0x79, 0x74, 0x1b, 0x73, 0x8f,
-	0xee, 0xc7, 0xb0, 0x36, 0x8c, 0xd2, 0x6c, 0x2c, 0x70, 0x27, 0xca, 0x1f, 0xe2, 0xee, 0x51, 0xce,
-	0x05, 0x26, 0xa6, 0xb4, 0x96, 0x58, 0x9d, 0x8a, 0xfe, 0x16, 0xc0, 0xea, 0x74, 0x43, 0xfb, 0xae,
-	0x0f, 0x00, 0x92, 0x4a, 0x66, 0xab, 0xad, 0x6a, 0xd9, 0x9e, 0xb5, 0x67, 0xf5, 0xdf, 0x0e, 0x1b,
-	0x3f, 0x41, 0xef, 0x4c, 0xee, 0xde, 0xeb, 0x55, 0xde, 0x72, 0x43, 0x45, 0x73, 0xb6, 0x92, 0xe6,
-	0x43, 0x77, 0x53, 0xc5, 0x7d, 0xb8, 0xf2, 0x18, 0x55, 0x3c, 0xd2, 0x3d, 0xdf, 0x16, 0xca, 0x5b,
-	0x4f, 0xc9, 0xaf, 0xa0, 0x77, 0xc6, 0x57, 0x93, 0xbf, 0x01, 0x70, 0x5c, 0x89, 0x8c, 0xff, 0x0a,
-	0xf3, 0x24, 0x6f, 0x0c, 0x62, 0xf0, 0x67, 0x13, 0xba, 0x55, 0xd9, 0x2b, 0xf3, 0xa7, 0x88, 0xec,
-	0xc3, 0xa5, 0xd9, 0x91, 0x9c, 0x5c, 0xaf, 0xae, 0x67, 0xdd, 0x94, 0xdf, 0xff, 0xff, 0x79, 0xea,
-	0x22, 0x9b, 0xd0, 0x0b, 0xe4, 0x21, 0xc0, 0x74, 0x20, 0x22, 0xff, 0x9b, 0x19, 0x2d, 0xfd, 0xd9,
-	0xba, 0x7f, 0xb5, 0x4e, 0x55, 0x62, 0x7c, 0x0b, 0x6b, 0x35, 0x73, 0x15, 0xa1, 0xce, 0xe3, 0xfc,
-	0x79, 0xad, 0xbf, 0xf1, 0x5a, 0x9b, 0x12, 0xfe, 0x33, 0x58, 0x2c, 0xb3, 0x40, 0xd6, 0x67, 0x8f,
-	0xd1, 0x81, 0xac, 0xcd, 0x8b, 0x4b, 0xbf, 0x6f, 0xa0, 0x3b, 0x57, 0x54, 0xe4, 0x86, 0xb7, 0x5d,
-	0xcd, 0x4d, 0xed, 0x5f, 0x3b, 0x57, 0x5f, 0x41, 0xce, 0x1d, 0xf5, 0x14, 0xb2, 0xbe, 0x7e, 0xa6,
-	0x90, 0x75, 0x35, 0x42, 0x2f, 0x1c, 0x96, 0xff, 0x6f, 0xef, 0xfc, 0x13, 0x00, 0x00, 0xff, 0xff,
-	0x4e, 0xcc, 0x5d, 0x60, 0x01, 0x0f, 0x00, 0x00,
+	// 1882 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x5b, 0x73, 0x1b, 0x49,
+	0x15, 0x46, 0x96, 0x7c, 0xd1, 0x91, 0x1d, 0x3b, 0x6d, 0x27, 0x99, 0x15, 0xd9, 0xac, 0x6b, 0x6a,
+	0x03, 0xa9, 0x85, 0x75, 0x6d, 0xbc, 0x0b, 0x95, 0x64, 0x81, 0xaa, 0xd8, 0xce, 0xc5, 0x6c, 0x6c,
+	0x4c, 0x2b, 0x6c, 0x9e, 0x28, 0x6a, 0x3c, 0xd3, 0xb2, 0xbb, 0x34, 0x9a, 0x1e, 0x7a, 0x5a, 0x8e,
+	0xf5, 0xc4, 0x2b, 0xef, 0x14, 0x14, 0xff, 0x83, 0xff, 0xc0, 0xaf, 0xe0, 0x81, 0xe2, 0x71, 0xff,
+	0x03, 0x55, 0xd4, 0xe9, 0xcb, 0xa8, 0x67, 0x3c, 0xda, 0xdc, 0x78, 0xd2, 0xf4, 0xb9, 0xf5, 0xb9,
+	0xf5, 0xe9, 0xaf, 0x05, 0xb7, 0x12, 0x96, 0xa7, 0x62, 0xfa, 0x87, 0x58, 0x64, 0x4a, 0x8a, 0x34,
+	0x65, 0x72, 0x27, 0x97, 0x42, 0x09, 0xb2, 0xa4, 0x7f, 0x8a, 0xf0, 0x5b, 0xe8, 0x3c, 0x9e, 0xa8,
+	0x73, 0x42, 0xa0, 0xa3, 0xa6, 0x39, 0x0b, 0x5a, 0xdb, 0xad, 0x7b, 0x5d, 0xaa, 0xbf, 0xc9, 0x1d,
+	0x80, 0x58, 0xb2, 0x84, 0x65, 0x8a, 0x47, 0x69, 0xb0, 0xa0, 0x39, 0x1e, 0x85, 0xf4, 0x61, 0x65,
+	0x52, 0x30, 0x99, 0x45, 0x63, 0x16, 0xb4, 0x35, 0xb7, 0x5c, 0x87, 0x5f, 0x43, 0x7b, 0x30, 0x78,
+	0x8e, 0x66, 0x73, 0x21, 0x95, 0x36, 0xbb, 0x46, 0xf5, 0x37, 0xd9, 0x86, 0x4e, 0x34, 0x51, 0xe7,
+	0xda, 0x60, 0x6f, 0x77, 0xd5, 0x38, 0x54, 0xec, 0xa0, 0x1b, 0x54, 0x73, 0xc2, 0x43, 0xe8, 0x1c,
+	0x8b, 0x84, 0xa1, 0xb6, 0x36, 0x6e, 0x9d, 0xc2, 0x6f, 0x72, 0x0d, 0x16, 0x78, 0x6e, 0x9d, 0x59,
+	0xe0, 0x39, 0xf9, 0x18, 0xda, 0x45, 0x71, 0xae, 0xf7, 0xef, 0xed, 0xf6, 0x9c, 0xb1, 0xc1, 0xe0,
+	0x39, 0x45, 0x7a, 0xf8, 0x0a, 0x16, 0x9f, 0x48, 0x29, 0x24, 0xb9, 0x09, 0x4b, 0x92, 0x45, 0x85,
+	0xc8, 0xac, 0x35, 0xbb, 0x42, 0x7a, 0xc2, 0x54, 0xc4, 0x5d, 0x80, 0x76, 0x85, 0xc1, 0x0f, 0xf9,
+	0xe5, 0x11, 0x53, 0xe7, 0x22, 0x29, 0x6c, 0x78, 0x1e, 0x25, 0x7c, 0x08, 0x37, 0x5e, 0xb2, 0x42,
+	0xed, 0x8b, 0x2c, 0x63, 0xb1, 0xe2, 0x22, 0xa3, 0xec, 0x8f, 0x13, 0x56, 0xe8, 0xf0, 0x32, 0x91,
+	0x18, 0xa7, 0xbd, 0xf0, 0x30, 0x20, 0xaa, 0x39, 0xe1, 0x31, 0x6c, 0xd6, 0x55, 0xf3, 0x74, 0x8a,
+	0x9e, 0xe4, 0x51, 0x51, 0xb0, 0x44, 0xab, 0xae, 0x50, 0xbb, 0x22, 0x9f, 0x40, 0x9b, 0x49, 0x69,
+	0xd3, 0xb5, 0xe6, 0xec, 0xe9, 0xa8, 0x28, 0x72, 0xc2, 0x43, 0x58, 0x47, 0xeb, 0xfb, 0xe7, 0x2c,
+	0x1e, 0xed, 0x8b, 0x6c, 0xc8, 0xcf, 0xde, 0xec, 0x04, 0xd9, 0x82, 0x45, 0x29, 0x52, 0x56, 0x04,
+	0x0b, 0xdb, 0xed, 0x7b, 0x5d, 0x6a, 0x16, 0x61, 0x06, 0x1b, 0xda, 0x0c, 0x06, 0xc3, 0x25, 0x1b,
+	0xb3, 0x4c, 0x61, 0x99, 0xe3, 0x7c, 0xb2, 0x2f, 0x24, 0x2b, 0xb4, 0xbd, 0x16, 0x2d, 0xd7, 0xe4,
+	0x36, 0x74, 0xc7, 0x6c, 0x2c, 0xe4, 0xf4, 0x19, 0xdf, 0xd3, 0x1e, 0xb6, 0xe8, 0x8c, 0x40, 0xb6,
+	0xa1, 0x27, 0x85, 0x50, 0x07, 0xbc, 0x18, 0x21, 0xbf, 0xad, 0xf9, 0x3e, 0x29, 0xfc, 0x77, 0x07,
+	0x56, 0xf5, 0x86, 0x27, 0x52, 0x0c, 0x79, 0xda, 0x5c, 0xf2, 0x4f, 0x61, 0x2d, 0x11, 0xf1, 0x88,
+	0xc9, 0x6f, 0x99, 0x2c, 0xb8, 0xc8, 0x6c, 0xa5, 0xaa, 0x44, 0x94, 0x1a, 0x31, 0x99, 0xb1, 0xd4,
+	0x49, 0x99, 0x9a, 0x55, 0x89, 0xe4, 0x67, 0x2e, 0xec, 0xce, 0x76, 0xfb, 0x5e, 0x6f, 0xf7, 0x13,
+	0x97, 0x19, 0xdf, 0x89, 0x1d, 0x8a, 0x12, 0x4f, 0x32, 0x25, 0xa7, 0x36, 0x2f, 0xe4, 0x00, 0xa0,
+	0x60, 0x17, 0x4c, 0x72, 0xc5, 0x59, 0x11, 0x2c, 0x6a, 0xdd, 0x4f, 0x1b, 0x75, 0x07, 0xa5, 0x98,
+	0x31, 0xe0, 0xe9, 0x61, 0xb6, 0x8a, 0x11, 0xcf, 0x0f, 0x15, 0x1b, 0x17, 0xc1, 0x92, 0xce, 0xfb,
+	0x8c, 0x40, 0xbe, 0x80, 0xcd, 0x71, 0x74, 0xb9, 0x9f, 0x8a, 0x78, 0x34, 0x18, 0xb1, 0xd7, 0x03,
+	0x16, 0x8b, 0x2c, 0x29, 0x82, 0x65, 0x9d, 0xb5, 0x26, 0x16, 0xf9, 0x29, 0x5c, 0xc7, 0x83, 0x1d,
+	0xf1, 0x8c, 0xc9, 0xc4, 0x85, 0xbd, 0xa2, 0xc3, 0xbe, 0xca, 0x20, 0x8f, 0x20, 0x60, 0x2a, 0x4e,
+	0x9e, 0x16, 0xd3, 0x2c, 0x3e, 0x79, 0xf8, 0xf0, 0x88, 0xa7, 0x29, 0x2f, 0xec, 0x26, 0x5d, 0xbd,
+	0xc9, 0x5c, 0x3e, 0xf9, 0x11, 0x5c, 0x43, 0xde, 0x2b, 0xc9, 0x15, 0x3b, 0xe2, 0x7b, 0x79, 0x11,
+	0x80, 0xd6, 0xa8, 0x51, 0xfb, 0x14, 0x60, 0x96, 0x3c, 0xb2, 0x01, 0xed, 0x11, 0x9b, 0xda, 0x5a,
+	0xe2, 0x27, 0xd9, 0x81, 0xc5, 0x8b, 0x28, 0x9d, 0x30, 0xdb, 0xcd, 0x41, 0x25, 0x85, 0x5e, 0xd3,
+	0x51, 0x23, 0xf6, 0x68, 0xe1, 0x41, 0xab, 0xff, 0x4b, 0x58, 0xaf, 0x25, 0xb5, 0xc1, 0xf0, 0x96,
+	0x6f, 0xb8, 0xeb, 0xa9, 0x87, 0xff, 0x6d, 0xc1, 0x75, 0x6d, 0x1e, 0x9b, 0xbf, 0x70, 0xa7, 0xf4,
+	0x3e, 0x2c, 0xc7, 0xfa, 0xa8, 0x60, 0x4f, 0x63, 0x35, 0x6f, 0xf9, 0x67, 0xc4, 0x3b, 0x4a, 0xd4,
+	0xc9, 0x91, 0x1d, 0x58, 0xce, 0x4d, 0x91, 0xad, 0xf7, 0x5b, 0x4d, 0x0d, 0x40, 0x9d, 0x10, 0xf9,
+	0x1a, 0xd6, 0xe2, 0x74, 0x52, 0x28, 0x26, 0x8d, 0x25, 0x3b, 0xa3, 0x6e, 0x94, 0x5a, 0x3e, 0x93,
+	0x56, 0x65, 0xf1, 0xd0, 0x0d, 0xf9, 0xa5, 0xe9, 0x94, 0x8e, 0xee, 0x94, 0x72, 0x8d, 0x65, 0x97,
+	0x2c, 0xc6, 0x3d, 0x9f, 0x46, 0x3c, 0x65, 0xc9, 0x6f, 0xb2, 0x74, 0x1a, 0x2c, 0xea, 0x99, 0x71,
+	0x95, 0x11, 0xbe, 0x80, 0x75, 0x3f, 0x7c, 0x9c, 0x34, 0x01, 0x2c, 0x47, 0x71, 0xcc, 0x72, 0xe5,
+	0x46, 0x8d, 0x5b, 0xbe, 0x79, 0xd6, 0x3c, 0x86, 0xae, 0xb6, 0x86, 0x9e, 0x34, 0x1e, 0xd6, 0x6d,
+	0xe8, 0x25, 0xac, 0x88, 0x25, 0xcf, 0xd5, 0xec, 0xa8, 0xfa, 0xa4, 0xf0, 0x9f, 0x2d, 0x58, 0x47,
+	0x75, 0x5b, 0xf3, 0x62, 0x92, 0x2a, 0x72, 0x17, 0x3a, 0x5c, 0xb1, 0xb1, 0x9d, 0x57, 0xd7, 0x2b,
+	0x89, 0x45, 0x59, 0xaa, 0xd9, 0x38, 0x22, 0x0b, 0x15, 0xa9, 0x49, 0xe1, 0x86, 0xb5, 0x59, 0x39,
+	0xb7, 0xdb, 0xf3, 0xdc, 0x46, 0x4f, 0x53, 0x71, 0x86, 0xa9, 0xd4, 0x9e, 0xe2, 0x37, 0xf9, 0x31,
+	0xb4, 0x87, 0xfc, 0x32, 0x58, 0xac, 0x56, 0x05, 0x77, 0x7b, 0xca, 0x2f, 0x8d, 0x5f, 0x14, 0x25,
+	0x70, 0xd7, 0x38, 0x8a, 0xcf, 0x59, 0x12, 0x2c, 0x99, 0xc1, 0x6c, 0x56, 0xa1, 0x82, 0xb5, 0x8a,
+	0x34, 0x09, 0x61, 0xf5, 0x94, 0x0d, 0x85, 0x64, 0x03, 0xe3, 0xa4, 0xc9, 0x4b, 0x85, 0x46, 0x7e,
+	0x02, 0x5d, 0xb3, 0x7e, 0x32, 0x2f, 0xcf, 0x33, 0x7e, 0xe9, 0x76, 0x7b, 0xe6, 0x76, 0xf8, 0xd7,
+	0x96, 0x37, 0xee, 0xed, 0xc6, 0x7d, 0x58, 0xc1, 0xa1, 0x7e, 0x3c, 0x2b, 0x46, 0xb9, 0x7e, 0xff,
+	0x9c, 0x7d, 0x0e, 0x8b, 0xbc, 0xec, 0x3f, 0xef, 0x80, 0xd4, 0x6a, 0x47, 0x8d, 0x54, 0x58, 0xc0,
+	0x75, 0x77, 0xa3, 0x5d, 0x70, 0x35, 0xd5, 0x12, 0xe8, 0x98, 0xd6, 0x8a, 0x45, 0xea, 0x1c, 0x73,
+	0xeb, 0x12, 0x1b, 0x2c, 0x78, 0xd8, 0x60, 0xe6, 0x6c, 0xbb, 0xe2, 0xec, 0xec, 0x96, 0xee, 0xf8,
+	0xb7, 0x74, 0xf8, 0x8f, 0x16, 0x10, 0x7f, 0x57, 0x9b, 0x0f, 0x34, 0x23, 0x26, 0x32, 0x76, 0xd9,
+	0xb0, 0x2b, 0xdb, 0x9c, 0x8a, 0x67, 0x51, 0xad, 0x39, 0x1d, 0x69, 0xae, 0x03, 0xf7, 0x61, 0x49,
+	0x1f, 0x2c, 0x97, 0x8d, 0x8f, 0xca, 0x16, 0xad, 0xc7, 0x4c, 0xad, 0x20, 0x9e, 0xb2, 0x3c, 0x52,
+	0xe7, 0x47, 0x2f, 0x7f, 0xa7, 0x7b, 0x6c, 0x8d, 0xba, 0x65, 0xc8, 0xe0, 0x86, 0xaf, 0x76, 0x14,
+	0x29, 0xc9, 0x2f, 0xa9, 0x78, 0x3d, 0xd7, 0xef, 0xaf, 0x60, 0x59, 0xea, 0xc8, 0xcc, 0x75, 0xdd,
+	0xdb, 0xed, 0x37, 0x6d, 0x6f, 0xeb, 0xe1, 0x44, 0xc3, 0xbf, 0xd5, 0x92, 0x63, 0xf6, 0xf1, 0x42,
+	0x6c, 0x35, 0x35, 0xc4, 0xdc, 0xb3, 0x8f, 0x33, 0x16, 0xbb, 0x0a, 0x53, 0xa3, 0x21, 0x83, 0x5e,
+	0x90, 0xfb, 0xd0, 0x91, 0xe2, 0xb5, 0xcb, 0xcb, 0xc7, 0x4d, 0x8e, 0x95, 0x01, 0x52, 0x2d, 0x1a,
+	0x3e, 0x80, 0xfe, 0x33, 0xa6, 0xfc, 0xa9, 0xa4, 0x1d, 0xb7, 0xa3, 0xb9, 0x0f, 0x2b, 0xaf, 0xb9,
+	0x3a, 0x7f, 0x21, 0xce, 0x0a, 0x3b, 0x9e, 0xca, 0x75, 0xf8, 0xaf, 0x16, 0x04, 0x8d, 0xaa, 0x16,
+	0x40, 0xbd, 0x5f, 0x60, 0x9f, 0xfb, 0x81, 0x35, 0x5d, 0x05, 0xae, 0xd3, 0x4d, 0xc4, 0xc1, 0xec,
+	0x22, 0x30, 0xdd, 0xe8, 0x96, 0xe4, 0x57, 0xb0, 0x1a, 0x7b, 0x71, 0xdb, 0xd9, 0xd2, 0xff, 0x9e,
+	0x9c, 0x54, 0xe4, 0xc3, 0x2f, 0x61, 0x0d, 0xf7, 0x3c, 0x11, 0x52, 0xd1, 0x28, 0x3b, 0xd3, 0x70,
+	0x68, 0x28, 0xc5, 0xd8, 0xe1, 0x67, 0xfc, 0x46, 0x04, 0xac, 0x84, 0x3d, 0x35, 0x0b, 0x4a, 0x84,
+	0xbf, 0x06, 0xf8, 0x86, 0xb1, 0x3c, 0x4a, 0xf9, 0x05, 0x4b, 0xf0, 0x6a, 0xbc, 0xe0, 0xb9, 0xbb,
+	0x1a, 0x2f, 0x78, 0x4e, 0x3e, 0x83, 0x8d, 0x8c, 0xa9, 0xc3, 0x4c, 0x31, 0x39, 0x8c, 0x62, 0x33,
+	0x24, 0x4c, 0xe7, 0x5f, 0xa1, 0x87, 0xbb, 0xb0, 0xfa, 0x42, 0x44, 0xc9, 0x69, 0x94, 0x46, 0x59,
+	0xcc, 0xa4, 0x45, 0xdb, 0xad, 0x12, 0x6d, 0x37, 0x9c, 0xd9, 0xf0, 0xef, 0x2d, 0xd8, 0xfa, 0x66,
+	0x72, 0xca, 0x1e, 0x9f, 0x1c, 0x0e, 0x98, 0xbc, 0xd0, 0x57, 0x18, 0x86, 0xd4, 0xf8, 0xa6, 0xd8,
+	0x05, 0x18, 0x95, 0xce, 0xda, 0x92, 0x10, 0x97, 0x9f, 0x59, 0x18, 0xd4, 0x93, 0x22, 0x0f, 0x60,
+	0x35, 0xf5, 0x9c, 0x0a, 0xda, 0xd5, 0xdb, 0xd7, 0x77, 0x98, 0x56, 0x24, 0xc3, 0xef, 0x16, 0x61,
+	0xad, 0x72, 0xcd, 0xe2, 0x04, 0xb0, 0x17, 0xad, 0x37, 0x2c, 0x7d, 0x12, 0x39, 0x81, 0xad, 0x51,
+	0x43, 0x34, 0xd6, 0xd7, 0xdb, 0xa5, 0xaf, 0x0d, 0x32, 0xb4, 0x51, 0x13, 0x81, 0x40, 0xe6, 0x57,
+	0xb5, 0x0e, 0x04, 0x2a, 0x25, 0xa7, 0x55, 0x59, 0xf2, 0x04, 0x00, 0x09, 0x2f, 0xa2, 0x53, 0x96,
+	0xba, 0x43, 0x76, 0xb7, 0x11, 0x42, 0xec, 0x1c, 0x97, 0x72, 0x16, 0x7a, 0xce, 0x14, 0xc9, 0x4b,
+	0x58, 0xc7, 0xd5, 0xe3, 0x2c, 0x13, 0x4a, 0x4f, 0x3a, 0x87, 0x62, 0x3f, 0x9b, 0x6f, 0xcb, 0x13,
+	0x36, 0x06, 0xeb, 0x26, 0xc8, 0x3d, 0x58, 0xe7, 0xe3, 0xe8, 0x8c, 0x51, 0x96, 0x8b, 0x82, 0x2b,
+	0x21, 0xa7, 0xfa, 0x8a, 0xec, 0xd2, 0x3a, 0x19, 0xa1, 0x6f, 0x2e, 0x92, 0xc1, 0xe4, 0x34, 0x63,
+	0x4a, 0x43, 0xda, 0x2e, 0x9d, 0x11, 0x10, 0xbb, 0x17, 0x4c, 0x5e, 0xf0, 0x98, 0x59, 0x09, 0x03,
+	0x62, 0xab, 0x44, 0xc4, 0x3d, 0x98, 0x5f, 0x99, 0x31, 0xc5, 0x0a, 0x07, 0x77, 0xbb, 0x06, 0xee,
+	0x5e, 0x61, 0xe0, 0x03, 0x2e, 0x53, 0xb9, 0xa9, 0x04, 0xc2, 0x55, 0x1c, 0x59, 0x1e, 0x05, 0x8f,
+	0x45, 0x89, 0x91, 0xe9, 0x24, 0x53, 0x7c, 0xcc, 0x82, 0x9e, 0x39, 0x16, 0x75, 0x3a, 0x76, 0x0d,
+	0x02, 0xdd, 0x83, 0x48, 0x45, 0x07, 0x5c, 0x06, 0xab, 0xa6, 0x6b, 0x3c, 0x12, 0x82, 0xd4, 0x5a,
+	0xfa, 0xdf, 0x05, 0xa4, 0xf6, 0xf7, 0x60, 0xab, 0x29, 0xe3, 0xef, 0x04, 0x74, 0x9f, 0xc1, 0xe2,
+	0xcb, 0x88, 0x67, 0xea, 0x6d, 0x95, 0x70, 0x5e, 0xb2, 0xe1, 0x10, 0x7b, 0xdb, 0xde, 0x75, 0x66,
+	0x15, 0x7e, 0xd7, 0x82, 0x0d, 0xf4, 0xe6, 0x40, 0xff, 0x77, 0xf0, 0x61, 0x2f, 0x4a, 0xf2, 0x0b,
+	0x58, 0x4a, 0x4d, 0xef, 0xb6, 0xab, 0xaf, 0xa6, 0xfa, 0x0e, 0x3b, 0x7e, 0xeb, 0x5a, 0x1d, 0x72,
+	0x17, 0x96, 0xb0, 0x12, 0xca, 0x75, 0x7e, 0x39, 0xbd, 0x75, 0xa4, 0xd4, 0x32, 0xfb, 0x0f, 0xa1,
+	0xf7, 0x9e, 0x99, 0x0f, 0xff, 0xdc, 0x82, 0x35, 0xe3, 0x86, 0xbb, 0x7f, 0x1e, 0x41, 0x0f, 0xe3,
+	0xd9, 0xaf, 0x3c, 0x0f, 0x82, 0x79, 0x6e, 0x53, 0x5f, 0xf8, 0x2a, 0xe6, 0x5f, 0x78, 0x7b, 0xcc,
+	0x1f, 0x3e, 0x87, 0x9e, 0xf3, 0xe4, 0x03, 0x51, 0xfa, 0x57, 0x70, 0xf3, 0x19, 0x53, 0xce, 0xd8,
+	0xdb, 0x5e, 0xae, 0x19, 0x80, 0x51, 0x71, 0xe0, 0x1e, 0x2b, 0xe8, 0xa6, 0x37, 0x7e, 0x57, 0x70,
+	0xe6, 0x42, 0x0d, 0x67, 0x7e, 0x01, 0x9b, 0xc3, 0x88, 0xa7, 0x13, 0xc9, 0xf6, 0xa3, 0x6c, 0x8f,
+	0x1d, 0x9e, 0x65, 0x42, 0xb2, 0x44, 0xb7, 0xd6, 0x0a, 0x6d, 0x62, 0x85, 0x7f, 0x69, 0xc1, 0xc6,
+	0x6c, 0x43, 0x0b, 0xdd, 0x76, 0x01, 0x92, 0x92, 0x16, 0xb4, 0xaa, 0x17, 0x84, 0x27, 0xed, 0x49,
+	0xfd, 0x5f, 0x9f, 0x05, 0xe1, 0x9f, 0x60, 0xeb, 0x4a, 0xee, 0x3e, 0x08, 0x5d, 0xec, 0x38, 0x1c,
+	0xdd, 0xae, 0x76, 0x52, 0x3d, 0x74, 0x07, 0xa4, 0x1f, 0xc1, 0xcd, 0xa7, 0x4c, 0xc5, 0xe7, 0x78,
+	0xc3, 0xd8, 0x46, 0x79, 0xeb, 0xbf, 0x96, 0x5e, 0xc1, 0xd6, 0x15, 0x5d, 0x74, 0xfe, 0x0e, 0xc0,
+	0xa8, 0x24, 0x69, 0xfd, 0x55, 0xea, 0x51, 0xde, 0x18, 0xc4, 0xee, 0x7f, 0xda, 0xb0, 0x5e, 0xb6,
+	0xbd, 0xd2, 0xff, 0x24, 0x92, 0x63, 0xb8, 0x56, 0xfd, 0x1f, 0x8b, 0x94, 0xe8, 0xaf, 0xf1, 0xaf,
+	0xb1, 0xfe, 0x0f, 0xe7, 0xb1, 0xf3, 0x74, 0x1a, 0xfe, 0x80, 0xec, 0x01, 0xcc, 0x80, 0x1d, 0xf9,
+	0xa8, 0xf2, 0x08, 0xf4, 0x1f, 0xef, 0xfd, 0x5b, 0x4d, 0x2c, 0x63, 0xe3, 0xf7, 0xb0, 0xd9, 0x80,
+	0x0f, 0x49, 0xe8, 0x34, 0xe6, 0xe3, 0xce, 0xfe, 0xf6, 0xf7, 0xca, 0x18, 0xf3, 0x3f, 0x87, 0x25,
+	0x93, 0x05, 0x72, 0xa3, 0x5a, 0x46, 0x67, 0x64, 0xb3, 0x4e, 0x36, 0x7a, 0xbf, 0x85, 0xf5, 0x5a,
+	0x53, 0x91, 0x3b, 0xde, 0x76, 0x0d, 0x27, 0xb5, 0x7f, 0x7b, 0x2e, 0xbf, 0x34, 0x59, 0x2b, 0xf5,
+	0xcc, 0x64, 0x73, 0xff, 0xf4, 0x6f, 0xcf, 0xe5, 0x6b, 0x93, 0xa7, 0xe6, 0x4f, 0xe1, 0x2f, 0xff,
+	0x37, 0x00, 0xa3, 0x76, 0x87, 0x12, 0x36, 0x16, 0x00, 0x00,
 }
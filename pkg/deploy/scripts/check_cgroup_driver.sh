@@ -0,0 +1,51 @@
+#!/bin/bash
+## Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+##
+## Licensed under the Apache License, Version 2.0 (the "License");
+## you may not use this file except in compliance with the License.
+## You may obtain a copy of the License at
+##
+##      http://www.apache.org/licenses/LICENSE-2.0
+##
+## Unless required by applicable law or agreed to in writing, software
+## distributed under the License is distributed on an "AS IS" BASIS,
+## WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+## See the License for the specific language governing permissions and
+## limitations under the License.
+
+# This script prints the cgroup driver of the container runtime and the init system of the node,
+# kubelet uses the same cgroup driver as the runtime, which should be systemd if systemd is the init system.
+# usage: check_cgroup_driver.sh <docker|containerd>
+# output:
+#   driver=<systemd|cgroupfs|unknown>
+#   init=<systemd|other>
+
+runtime=$1
+containerd_config=/etc/containerd/config.toml
+
+driver=
+case "${runtime}" in
+    docker)
+        driver=$(docker info --format '{{.CgroupDriver}}' 2> /dev/null)
+        ;;
+    containerd)
+        # SystemdCgroup is the option of the runc v2 shim, systemd_cgroup is the one of the v1 shim
+        if grep -Eq '^\s*(SystemdCgroup|systemd_cgroup)\s*=\s*true' "${containerd_config}" 2> /dev/null; then
+            driver=systemd
+        elif command -v containerd > /dev/null 2>&1; then
+            driver=cgroupfs
+        fi
+        ;;
+    *)
+        echo "unknown container runtime ${runtime}" >&2
+        exit 1
+        ;;
+esac
+
+init=other
+if [ "$(ps -p 1 -o comm= 2> /dev/null)" = "systemd" ]; then
+    init=systemd
+fi
+
+echo "driver=${driver:-unknown}"
+echo "init=${init}"
@@ -0,0 +1,69 @@
+#!/bin/bash
+## Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+##
+## Licensed under the Apache License, Version 2.0 (the "License");
+## you may not use this file except in compliance with the License.
+## You may obtain a copy of the License at
+##
+##      http://www.apache.org/licenses/LICENSE-2.0
+##
+## Unless required by applicable law or agreed to in writing, software
+## distributed under the License is distributed on an "AS IS" BASIS,
+## WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+## See the License for the specific language governing permissions and
+## limitations under the License.
+
+# This script checks if the container runtime serves on its socket, which kubelet talks to.
+# usage: check_cri_socket.sh <docker|containerd>
+# output:
+#   socket=<path>
+#   ready=<yes|no>
+#   error=<why it's not ready>
+
+runtime=$1
+case "${runtime}" in
+    docker)
+        socket=/var/run/docker.sock
+        ;;
+    containerd)
+        socket=/run/containerd/containerd.sock
+        ;;
+    *)
+        echo "unknown container runtime ${runtime}" >&2
+        exit 1
+        ;;
+esac
+
+echo "socket=${socket}"
+
+if [ ! -S "${socket}" ]; then
+    echo "ready=no"
+    echo "error=${socket} is not a socket, is ${runtime} running?"
+    exit 0
+fi
+
+case "${runtime}" in
+    docker)
+        if ! docker info > /dev/null 2>&1; then
+            echo "ready=no"
+            echo "error=docker does not answer on ${socket}"
+            exit 0
+        fi
+        ;;
+    containerd)
+        # the cri plugin is disabled by the config shipped with the containerd.io package
+        cri=$(ctr plugins ls 2> /dev/null | awk '$2 == "cri" {print $NF}')
+        if [ -z "${cri}" ]; then
+            echo "ready=no"
+            echo "error=the cri plugin of containerd is not loaded"
+            exit 0
+        fi
+        if [ "${cri}" != "ok" ]; then
+            echo "ready=no"
+            echo "error=the cri plugin of containerd is ${cri}"
+            exit 0
+        fi
+        ;;
+esac
+
+echo "ready=yes"
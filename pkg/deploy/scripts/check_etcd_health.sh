@@ -0,0 +1,45 @@
+#!/bin/bash
+## Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+##
+## Licensed under the Apache License, Version 2.0 (the "License");
+## you may not use this file except in compliance with the License.
+## You may obtain a copy of the License at
+##
+##      http://www.apache.org/licenses/LICENSE-2.0
+##
+## Unless required by applicable law or agreed to in writing, software
+## distributed under the License is distributed on an "AS IS" BASIS,
+## WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+## See the License for the specific language governing permissions and
+## limitations under the License.
+
+# This script lists the members of the etcd cluster and checks the health of the endpoints with the
+# healthcheck client certificate in the pki directory.
+# usage: check_etcd_health.sh <pki dir> <endpoint>[,<endpoint>]...
+# output:
+#   member=<id>, <status>, <name>, <peer urls>, <client urls>, <is learner>
+#   health=<endpoint> is healthy|unhealthy: <detail>
+#   error=<message> if the members can't be listed
+
+pki_dir=$1
+endpoints=$2
+etcdctl=/usr/local/bin/etcdctl
+
+if [ ! -x "${etcdctl}" ]; then
+    echo "error=${etcdctl} is not installed"
+    exit 0
+fi
+
+export ETCDCTL_API=3
+flags=(--endpoints="${endpoints}" --cacert="${pki_dir}/ca.crt" --cert="${pki_dir}/healthcheck-client.crt"
+    --key="${pki_dir}/healthcheck-client.key" --dial-timeout=5s --command-timeout=10s)
+
+members=$("${etcdctl}" "${flags[@]}" member list 2>&1)
+if [ $? -ne 0 ]; then
+    echo "error="${members}
+    exit 0
+fi
+echo "${members}" | sed 's/^/member=/'
+
+"${etcdctl}" "${flags[@]}" endpoint health 2>&1 | grep -E ' is (un)?healthy' | sed 's/^/health=/'
+exit 0
@@ -0,0 +1,42 @@
+#!/bin/bash
+## Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+##
+## Licensed under the Apache License, Version 2.0 (the "License");
+## you may not use this file except in compliance with the License.
+## You may obtain a copy of the License at
+##
+##      http://www.apache.org/licenses/LICENSE-2.0
+##
+## Unless required by applicable law or agreed to in writing, software
+## distributed under the License is distributed on an "AS IS" BASIS,
+## WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+## See the License for the specific language governing permissions and
+## limitations under the License.
+
+# This script checks the highly available endpoint of the apiservers after the masters are deployed: haproxy and
+# keepalived are running on this master so it's able to take over the vip, and the vip answers on both the
+# apiserver port and the haproxy port.
+# usage: check_ha.sh <vip> <apiserver port> <haproxy port>
+
+. lib.sh
+
+vip=$1
+apiserver_port=$2
+haproxy_port=$3
+
+for service in haproxy keepalived; do
+    systemctl is-active -q "${service}" || error_exit "${service} is not running"
+done
+
+if ip -o addr show | grep -q "inet ${vip}/"; then
+    echo "vip ${vip} is held by this node"
+fi
+
+# any response of the apiserver shows the endpoint works, the healthz may be forbidden to anonymous users
+for port in "${apiserver_port}" "${haproxy_port}"; do
+    code=$(curl -sk --max-time 5 -o /dev/null -w "%{http_code}" "https://${vip}:${port}/healthz")
+    if [ "${code}" == "000" ]; then
+        error_exit "vip ${vip} doesn't answer on port ${port}"
+    fi
+    echo "vip ${vip} answers on port ${port} with status ${code}"
+done
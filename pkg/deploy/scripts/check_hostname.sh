@@ -0,0 +1,36 @@
+#!/bin/bash
+## Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+##
+## Licensed under the Apache License, Version 2.0 (the "License");
+## you may not use this file except in compliance with the License.
+## You may obtain a copy of the License at
+##
+##      http://www.apache.org/licenses/LICENSE-2.0
+##
+## Unless required by applicable law or agreed to in writing, software
+## distributed under the License is distributed on an "AS IS" BASIS,
+## WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+## See the License for the specific language governing permissions and
+## limitations under the License.
+
+# This script prints the hostname, fqdn and primary ip of the node, the entries of /etc/hosts,
+# and the ipv4 addresses the given names are resolved to on the node.
+# usage: check_hostname.sh <name>...
+# output:
+#   hostname=<hostname>
+#   fqdn=<fqdn>
+#   ip=<primary ip>
+#   hosts=<ip> <name>...     one line for each entry of /etc/hosts
+#   resolve=<name> <ip>...   one line for each given name, there is no ip if it can't be resolved
+
+echo "hostname=$(hostname)"
+# the fqdn is looked up by the resolver, which may hang if the dns server is unreachable
+echo "fqdn=$(timeout 5 hostname -f 2> /dev/null)"
+# the primary ip is the source address of the default route
+echo "ip=$(ip -4 route get 8.8.8.8 2> /dev/null | sed -n 's/.* src \([0-9.]*\).*/\1/p')"
+
+sed -e 's/#.*//' /etc/hosts 2> /dev/null | awk 'NF >= 2 {$1 = $1; print "hosts=" $0}'
+
+for name in "$@"; do
+    echo "resolve=${name} $(timeout 5 getent ahostsv4 "${name}" 2> /dev/null | awk '{print $1}' | sort -u | tr '\n' ' ')"
+done
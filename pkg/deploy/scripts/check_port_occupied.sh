@@ -0,0 +1,36 @@
+#!/bin/bash
+## Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+##
+## Licensed under the Apache License, Version 2.0 (the "License");
+## you may not use this file except in compliance with the License.
+## You may obtain a copy of the License at
+##
+##      http://www.apache.org/licenses/LICENSE-2.0
+##
+## Unless required by applicable law or agreed to in writing, software
+## distributed under the License is distributed on an "AS IS" BASIS,
+## WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+## See the License for the specific language governing permissions and
+## limitations under the License.
+
+# This script prints the listening tcp ports in the given port ranges, one port per line.
+# usage: check_port_occupied.sh <port or from-to>...
+
+listening_ports() {
+    if command -v ss > /dev/null 2>&1; then
+        ss -ltn | awk 'NR > 1 {print $4}'
+    else
+        netstat -ltn | awk 'NR > 2 {print $4}'
+    fi | awk -F ':' '{print $NF}' | sort -n -u
+}
+
+for port in $(listening_ports); do
+    for range in "$@"; do
+        from=${range%-*}
+        to=${range#*-}
+        if [ "${port}" -ge "${from}" ] && [ "${port}" -le "${to}" ]; then
+            echo "${port}"
+            break
+        fi
+    done
+done
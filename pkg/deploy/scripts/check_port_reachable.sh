@@ -0,0 +1,30 @@
+#!/bin/bash
+## Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+##
+## Licensed under the Apache License, Version 2.0 (the "License");
+## you may not use this file except in compliance with the License.
+## You may obtain a copy of the License at
+##
+##      http://www.apache.org/licenses/LICENSE-2.0
+##
+## Unless required by applicable law or agreed to in writing, software
+## distributed under the License is distributed on an "AS IS" BASIS,
+## WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+## See the License for the specific language governing permissions and
+## limitations under the License.
+
+# This script checks if a tcp port of a host is reachable from this node,
+# prints "reachable" or "unreachable: <reason>".
+# usage: check_port_reachable.sh <ip> <port> <timeout seconds>
+
+ip=$1
+port=$2
+timeout_seconds=${3:-3}
+
+if output=$(timeout "${timeout_seconds}" bash -c "exec 3<>/dev/tcp/${ip}/${port}" 2>&1); then
+    echo "reachable"
+elif [ $? -eq 124 ]; then
+    echo "unreachable: connection timed out"
+else
+    echo "unreachable: ${output##*: }"
+fi
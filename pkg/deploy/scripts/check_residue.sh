@@ -0,0 +1,32 @@
+#!/bin/bash
+## Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+##
+## Licensed under the Apache License, Version 2.0 (the "License");
+## you may not use this file except in compliance with the License.
+## You may obtain a copy of the License at
+##
+##      http://www.apache.org/licenses/LICENSE-2.0
+##
+## Unless required by applicable law or agreed to in writing, software
+## distributed under the License is distributed on an "AS IS" BASIS,
+## WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+## See the License for the specific language governing permissions and
+## limitations under the License.
+
+# This script inventories what a previous kubernetes or etcd installation left on the node.
+# output, one line for each residue, nothing if the node is clean:
+#   directory=<path>
+#   process=<name>
+#   iptables=<chain prefix> <number of chains>
+#   interface=<name>
+#   mount=<path>
+
+. lib_residue.sh
+
+residue_directories | sed 's/^/directory=/'
+residue_processes | sed 's/^/process=/'
+residue_iptables | sed 's/^/iptables=/'
+residue_interfaces | sed 's/^/interface=/'
+residue_mounts | sed 's/^/mount=/'
+
+exit 0
@@ -13,4 +13,4 @@
 ## See the License for the specific language governing permissions and
 ## limitations under the License.
 
-cat /etc/*-release | grep -w "ID" | awk '/ID/{print $1}' | awk -F "=" '{print $2}'
+cat /etc/*-release | grep -w "ID" | awk '/ID/{print $1}' | awk -F "=" '{print $2}' | tr -d '"' | head -n 1
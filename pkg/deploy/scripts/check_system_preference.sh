@@ -13,6 +13,24 @@
 ## See the License for the specific language governing permissions and
 ## limitations under the License.
 
+# usage: check_system_preference.sh [--fix]
+# the unsatisfied sysctl keys are set if --fix is given, and persisted except the ones of cali* interfaces
+if [[ $1 = "--fix" ]]; then
+	fix_sysctl="true"
+fi
+sysctl_conf=/etc/sysctl.d/99-kpaas.conf
+
+function persist_sysctl {
+	local key=$1
+	local value=$2
+	if [[ ${key} == *.cali* ]]; then
+		return 0
+	fi
+	touch ${sysctl_conf}
+	sed -i "/^${key//./\\.} *=/d" ${sysctl_conf}
+	echo "${key} = ${value}" >> ${sysctl_conf}
+}
+
 function check_sysctl {
 	sysctl_key=$1
 	expected_value=$2
@@ -29,6 +47,7 @@ function check_sysctl {
 				return 1
 			else
 				echo "successfully set ${sysctl_key} to ${expected_value}"
+				persist_sysctl ${sysctl_key} ${expected_value}
 			fi
 		else
 			return 1
@@ -60,7 +79,7 @@ if [ ${all_forwarding_ok} -ne 0 ]; then
 fi
 
 # check proxy_arp and forwarding of cali* interfaces
-cali_interfaces=$(ifconfig | grep ^cali | awk '{print $1}')
+cali_interfaces=$(ls /sys/class/net | grep ^cali)
 for interface in ${cali_interfaces}; do
 	echo "check configuration of interface ${interface}..."
 	check_sysctl "net.ipv4.conf.${interface}.proxy_arp" 1
@@ -0,0 +1,51 @@
+#!/bin/bash
+## Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+##
+## Licensed under the Apache License, Version 2.0 (the "License");
+## you may not use this file except in compliance with the License.
+## You may obtain a copy of the License at
+##
+##      http://www.apache.org/licenses/LICENSE-2.0
+##
+## Unless required by applicable law or agreed to in writing, software
+## distributed under the License is distributed on an "AS IS" BASIS,
+## WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+## See the License for the specific language governing permissions and
+## limitations under the License.
+
+# This script prints the time synchronization state of the node and the time of the node.
+# The time is printed at last so that it's sampled as close as possible to the moment the
+# controller receives the output.
+# output:
+#   service=<chronyd|ntpd|systemd-timesyncd|none>
+#   synchronized=<yes|no>
+#   time=<seconds since epoch>
+
+service_active() {
+    systemctl is-active --quiet "$1" > /dev/null 2>&1 || pgrep -x "$1" > /dev/null 2>&1
+}
+
+service=none
+synchronized=no
+if service_active chronyd; then
+    service=chronyd
+    # the leap status is "Not synchronised" until chronyd has selected a source
+    if chronyc tracking 2> /dev/null | grep -q '^Leap status *: Normal'; then
+        synchronized=yes
+    fi
+elif service_active ntpd; then
+    service=ntpd
+    if ntpstat > /dev/null 2>&1 || ntpq -pn 2> /dev/null | grep -q '^\*'; then
+        synchronized=yes
+    fi
+elif service_active systemd-timesyncd; then
+    service=systemd-timesyncd
+    # older versions of timedatectl print "NTP synchronized" instead of "System clock synchronized"
+    if timedatectl status 2> /dev/null | grep -q 'synchronized: yes'; then
+        synchronized=yes
+    fi
+fi
+
+echo "service=${service}"
+echo "synchronized=${synchronized}"
+echo "time=$(date +%s.%N)"
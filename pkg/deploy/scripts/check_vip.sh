@@ -0,0 +1,62 @@
+#!/bin/bash
+## Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+##
+## Licensed under the Apache License, Version 2.0 (the "License");
+## you may not use this file except in compliance with the License.
+## You may obtain a copy of the License at
+##
+##      http://www.apache.org/licenses/LICENSE-2.0
+##
+## Unless required by applicable law or agreed to in writing, software
+## distributed under the License is distributed on an "AS IS" BASIS,
+## WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+## See the License for the specific language governing permissions and
+## limitations under the License.
+
+# This script checks the keepalived vip can be used on the master before haproxy and keepalived are deployed:
+# the network interface to bind the vip exists, and the vip is unused or held by the keepalived on one of the
+# masters. The vip is held by a master if it's assigned to this node, or it answers by the mac of a master.
+# usage: check_vip.sh <vip> <interface> <master ip>...
+
+. lib.sh
+
+vip=$1
+interface=$2
+shift 2
+master_ips="$*"
+
+# mac_of prints the mac of the ip in the neighbor table of the interface
+mac_of() {
+    ip neigh show "$1" dev "${interface}" | awk '{for (i = 1; i < NF; i++) if ($i == "lladdr") print $(i + 1)}'
+}
+
+# local_ip checks if the ip is assigned to this node
+local_ip() {
+    ip -o addr show | grep -q "inet $1/"
+}
+
+ip link show dev "${interface}" > /dev/null 2>&1 || error_exit "network interface ${interface} is not found"
+
+if local_ip "${vip}"; then
+    echo "vip ${vip} is held by this node"
+    exit 0
+fi
+
+if ! ping -c 3 -W 1 -I "${interface}" "${vip}" > /dev/null 2>&1; then
+    echo "vip ${vip} is unused"
+    exit 0
+fi
+
+vip_mac=$(mac_of "${vip}")
+for master_ip in ${master_ips}; do
+    if local_ip "${master_ip}"; then
+        continue
+    fi
+    ping -c 1 -W 1 -I "${interface}" "${master_ip}" > /dev/null 2>&1
+    if [ -n "${vip_mac}" ] && [ "$(mac_of "${master_ip}")" == "${vip_mac}" ]; then
+        echo "vip ${vip} is held by master ${master_ip}"
+        exit 0
+    fi
+done
+
+error_exit "vip ${vip} is used by another host with mac ${vip_mac:-unknown}"
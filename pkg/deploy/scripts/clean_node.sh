@@ -0,0 +1,82 @@
+#!/bin/bash
+## Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+##
+## Licensed under the Apache License, Version 2.0 (the "License");
+## you may not use this file except in compliance with the License.
+## You may obtain a copy of the License at
+##
+##      http://www.apache.org/licenses/LICENSE-2.0
+##
+## Unless required by applicable law or agreed to in writing, software
+## distributed under the License is distributed on an "AS IS" BASIS,
+## WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+## See the License for the specific language governing permissions and
+## limitations under the License.
+
+# This script removes what a previous kubernetes or etcd installation left on the node, all the data
+# of the previous cluster is lost, so it refuses to run without the explicit confirmation.
+# usage: clean_node.sh --confirm
+
+. lib_residue.sh
+
+if [ "$1" != "--confirm" ]; then
+    echo "refuse to clean the node without --confirm" >&2
+    exit 1
+fi
+
+for service in ${RESIDUE_SERVICES}; do
+    if systemctl is-active --quiet "${service}" > /dev/null 2>&1 || systemctl is-enabled --quiet "${service}" > /dev/null 2>&1; then
+        systemctl disable --now "${service}" > /dev/null 2>&1 && echo "stopped service ${service}"
+    fi
+done
+
+if command -v kubeadm > /dev/null 2>&1; then
+    kubeadm reset -f > /dev/null 2>&1 && echo "reset by kubeadm"
+fi
+
+# the control plane runs as static pods, their containers would be left after kubelet is stopped
+if command -v docker > /dev/null 2>&1; then
+    containers=$(docker ps -aq --filter name=k8s_ 2> /dev/null)
+    if [ -n "${containers}" ]; then
+        docker rm -f ${containers} > /dev/null 2>&1 && echo "removed kubernetes containers"
+    fi
+fi
+if command -v crictl > /dev/null 2>&1 && [ -S /run/containerd/containerd.sock ]; then
+    crictl --runtime-endpoint unix:///run/containerd/containerd.sock rmp --all --force > /dev/null 2>&1 &&
+        echo "removed kubernetes pods of containerd"
+fi
+
+for process in $(residue_processes); do
+    pkill -x "${process}" && echo "killed process ${process}"
+done
+
+# the volumes of pods must be unmounted before the kubelet directory is removed, the deepest first
+for mount in $(residue_mounts | sort -r); do
+    umount "${mount}" > /dev/null 2>&1 || umount -l "${mount}" && echo "unmounted ${mount}"
+done
+
+for dir in $(residue_directories); do
+    rm -rf "${dir}" && echo "removed directory ${dir}"
+done
+
+if [ -n "$(residue_iptables)" ]; then
+    # iptables-restore replaces all the rules, so nothing is restored if the rules can't be saved,
+    # otherwise the ruleset of the node would be flushed
+    if rules=$(iptables-save) && [ -n "${rules}" ]; then
+        # the rules referring to the chains are dropped together with the chains
+        printf '%s\n' "${rules}" | grep -v -E "${RESIDUE_IPTABLES_PATTERN}" | iptables-restore &&
+            echo "removed iptables chains"
+    else
+        echo "failed to save the iptables rules, the chains are not removed" >&2
+    fi
+fi
+if command -v ipvsadm > /dev/null 2>&1; then
+    ipvsadm --clear > /dev/null 2>&1
+fi
+
+for interface in $(residue_interfaces); do
+    ip link delete "${interface}" > /dev/null 2>&1 && echo "removed interface ${interface}"
+done
+
+# the check is run again after cleaning, the result is decided by it
+exit 0
@@ -0,0 +1,37 @@
+#!/bin/bash
+## Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+##
+## Licensed under the Apache License, Version 2.0 (the "License");
+## you may not use this file except in compliance with the License.
+## You may obtain a copy of the License at
+##
+##      http://www.apache.org/licenses/LICENSE-2.0
+##
+## Unless required by applicable law or agreed to in writing, software
+## distributed under the License is distributed on an "AS IS" BASIS,
+## WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+## See the License for the specific language governing permissions and
+## limitations under the License.
+
+# This script creates the bootstrap token with the ttl on an initialized master, so the workers can join
+# the cluster by it. It succeeds if the token already exists, including when it's created by another
+# task at the same time.
+# usage: create_bootstrap_token.sh <bootstrap token> <ttl>
+
+. lib.sh
+. lib_kubeadm.sh
+
+token=$1
+ttl=$2
+
+test -f "${KUBEADM_ADMIN_CONF}" || error_exit "${KUBEADM_ADMIN_CONF} is not found, the master is not initialized"
+
+if bootstrap_token_exists "${token}"; then
+    echo "bootstrap token already exists"
+    exit 0
+fi
+
+if ! kubeadm token create "${token}" --ttl "${ttl}" --kubeconfig "${KUBEADM_ADMIN_CONF}" > /dev/null; then
+    bootstrap_token_exists "${token}" || error_exit "failed to create the bootstrap token"
+fi
+echo "bootstrap token is created"
@@ -0,0 +1,138 @@
+#!/bin/bash
+## Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+##
+## Licensed under the Apache License, Version 2.0 (the "License");
+## you may not use this file except in compliance with the License.
+## You may obtain a copy of the License at
+##
+##      http://www.apache.org/licenses/LICENSE-2.0
+##
+## Unless required by applicable law or agreed to in writing, software
+## distributed under the License is distributed on an "AS IS" BASIS,
+## WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+## See the License for the specific language governing permissions and
+## limitations under the License.
+
+# This script installs etcd from the etcd image by the container runtime, and runs it as the systemd
+# service etcd with the environment file. The binaries are installed to /usr/local/bin, they are
+# replaced if the version is different from the image. The service is restarted only if the binaries,
+# the unit or the environment file is changed, or it's not active. The service is started without
+# waiting, since a new member waits for the quorum of the cluster, use check_etcd_health.sh to check it.
+# usage: deploy_etcd.sh <docker|containerd> <image> <environment file>
+
+. lib.sh
+
+runtime=$1
+image=$2
+config=$3
+bin_dir=/usr/local/bin
+unit=/etc/systemd/system/etcd.service
+# a copy of the environment file which the running service was started with
+applied_config=${config}.applied
+# the image tag is <etcd version>-<build>
+tag=${image##*:}
+version=${tag%%-*}
+changed=no
+
+test -s "${config}" || error_exit "etcd environment file ${config} is not found"
+
+installed_version() {
+    "${bin_dir}/etcd" --version 2>/dev/null | awk '/^etcd Version:/{print $3}'
+}
+
+pull_image() {
+    case "${runtime}" in
+        docker)
+            command_exists docker || error_exit "docker is not installed"
+            docker image inspect "${image}" > /dev/null 2>&1 && return
+            docker pull "${image}" > /dev/null || error_exit "failed to pull ${image}"
+            ;;
+        containerd)
+            command_exists ctr || error_exit "containerd is not installed"
+            ctr --namespace k8s.io images list --quiet | grep -qxF "${image}" && return
+            ctr --namespace k8s.io images pull "${image}" > /dev/null || error_exit "failed to pull ${image}"
+            ;;
+        *)
+            error_exit "unknown container runtime ${runtime}"
+            ;;
+    esac
+}
+
+install_binaries() {
+    pull_image
+
+    local out=$(mktemp -d)
+    case "${runtime}" in
+        docker)
+            docker run --rm --entrypoint cp -v "${out}:/out" "${image}" \
+                /usr/local/bin/etcd /usr/local/bin/etcdctl /out/
+            ;;
+        containerd)
+            ctr --namespace k8s.io run --rm --mount "type=bind,src=${out},dst=/out,options=rbind:rw" \
+                "${image}" "kpaas-etcd-install-$$" cp /usr/local/bin/etcd /usr/local/bin/etcdctl /out/
+            ;;
+    esac
+    if [ ! -x "${out}/etcd" ] || [ ! -x "${out}/etcdctl" ]; then
+        rm -rf "${out}"
+        error_exit "failed to copy etcd binaries from ${image}"
+    fi
+
+    install -m 0755 "${out}/etcd" "${out}/etcdctl" "${bin_dir}/"
+    rm -rf "${out}"
+    echo "etcd ${version} is installed"
+}
+
+install_unit() {
+    local tmp_unit=$(mktemp)
+    cat > "${tmp_unit}" <<UNIT
+[Unit]
+Description=etcd key-value store
+Documentation=https://github.com/etcd-io/etcd
+After=network-online.target
+Wants=network-online.target
+
+[Service]
+Type=notify
+EnvironmentFile=${config}
+ExecStart=${bin_dir}/etcd
+Restart=always
+RestartSec=5s
+LimitNOFILE=65536
+
+[Install]
+WantedBy=multi-user.target
+UNIT
+
+    if cmp -s "${tmp_unit}" "${unit}"; then
+        rm -f "${tmp_unit}"
+        return
+    fi
+    install -m 0644 "${tmp_unit}" "${unit}"
+    rm -f "${tmp_unit}"
+    changed=yes
+    echo "etcd service is installed"
+}
+
+if [ "$(installed_version)" != "${version}" ]; then
+    install_binaries
+    changed=yes
+fi
+
+install_unit
+
+data_dir=$(. "${config}" && echo "${ETCD_DATA_DIR}")
+test -n "${data_dir}" || error_exit "ETCD_DATA_DIR is not set in ${config}"
+mkdir -p "${data_dir}" && chmod 0700 "${data_dir}" || error_exit "failed to create data directory ${data_dir}"
+
+cmp -s "${config}" "${applied_config}" || changed=yes
+systemctl is-active --quiet etcd || changed=yes
+
+if [ "${changed}" == "yes" ]; then
+    systemctl daemon-reload
+    systemctl enable etcd > /dev/null 2>&1
+    systemctl restart --no-block etcd || error_exit "failed to restart etcd"
+    cp -p "${config}" "${applied_config}"
+    echo "etcd service is restarted"
+else
+    echo "etcd service is unchanged"
+fi
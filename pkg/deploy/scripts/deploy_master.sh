@@ -0,0 +1,83 @@
+#!/bin/bash
+## Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+##
+## Licensed under the Apache License, Version 2.0 (the "License");
+## you may not use this file except in compliance with the License.
+## You may obtain a copy of the License at
+##
+##      http://www.apache.org/licenses/LICENSE-2.0
+##
+## Unless required by applicable law or agreed to in writing, software
+## distributed under the License is distributed on an "AS IS" BASIS,
+## WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+## See the License for the specific language governing permissions and
+## limitations under the License.
+
+# This script installs kubeadm, kubelet and kubectl of the kubernetes version, then initializes the
+# control plane on the first master or joins the control plane by the kubeadm config. A master which is
+# already initialized or joined is not changed. On the first master the bootstrap token is recreated if
+# it's missing, e.g. expired, so the other nodes can join by it. It waits for the local apiserver to be
+# healthy at the end.
+# usage: deploy_master.sh <init|join> <kubeadm config> <kubernetes version> <bootstrap token>
+
+. lib.sh
+. lib_kubeadm.sh
+
+mode=$1
+config=$2
+version=$3
+token=$4
+apiserver_healthz=https://127.0.0.1:6443/healthz
+
+test -s "${config}" || error_exit "kubeadm config ${config} is not found"
+
+ensure_token() {
+    if ! bootstrap_token_exists "${token}"; then
+        kubeadm token create "${token}" --ttl 24h --kubeconfig "${KUBEADM_ADMIN_CONF}" > /dev/null ||
+            error_exit "failed to create the bootstrap token"
+        echo "bootstrap token is created"
+    fi
+}
+
+setup_kubectl() {
+    mkdir -p "${HOME}/.kube"
+    cp -f "${KUBEADM_ADMIN_CONF}" "${HOME}/.kube/config"
+}
+
+wait_apiserver() {
+    local i
+    for i in $(seq 60); do
+        if [ "$(curl -sk --max-time 5 "${apiserver_healthz}")" == "ok" ]; then
+            echo "apiserver is healthy"
+            return
+        fi
+        sleep 2
+    done
+    error_exit "apiserver is not healthy after 2 minutes"
+}
+
+install_kubeadm "${version}"
+
+case "${mode}" in
+    init)
+        if [ -f "${KUBEADM_ADMIN_CONF}" ]; then
+            echo "control plane is already initialized"
+        else
+            kubeadm init --config "${config}" || error_exit "failed to initialize the control plane"
+        fi
+        ensure_token
+        ;;
+    join)
+        if [ -f "${KUBEADM_KUBELET_CONF}" ]; then
+            echo "master has already joined the cluster"
+        else
+            kubeadm join --config "${config}" || error_exit "failed to join the control plane"
+        fi
+        ;;
+    *)
+        error_exit "unknown mode ${mode}"
+        ;;
+esac
+
+setup_kubectl
+wait_apiserver
@@ -0,0 +1,131 @@
+#!/usr/bin/env python
+# Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#      http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# disk_probe is a tiny helper to measure the disk performance which etcd relies on, it works with python 2.7 and 3.
+# etcd writes small records to its wal and fdatasync-s each of them, so the latency of fdatasync decides how fast
+# etcd commits, and the sequential write throughput decides how fast it writes snapshots.
+#
+# usage:
+#   disk_probe.py <dir> <fsync count> <write MiB>
+#       writes <fsync count> records of the typical wal record size to a file in dir, each is followed by
+#       fdatasync, then writes <write MiB> MiB sequentially followed by one fsync, and prints the result in json.
+# the dir is created if it doesn't exist, and the created directories are removed after probing.
+
+import json
+import os
+import sys
+import time
+
+# the size of a wal record suggested by the etcd documents for benchmarking
+RECORD_SIZE = 2300
+BLOCK_SIZE = 1024 * 1024
+PROBE_FILE = ".kpaas-disk-probe"
+
+
+def percentile(sorted_values, p):
+    if not sorted_values:
+        return 0.0
+    index = int(round(p / 100.0 * len(sorted_values) + 0.5)) - 1
+    return sorted_values[max(0, min(index, len(sorted_values) - 1))]
+
+
+def sync(fd):
+    if hasattr(os, "fdatasync"):
+        os.fdatasync(fd)
+    else:
+        os.fsync(fd)
+
+
+def probe_fsync(path, count):
+    record = b"k" * RECORD_SIZE
+    latencies = []
+    fd = os.open(path, os.O_WRONLY | os.O_CREAT | os.O_TRUNC, 0o600)
+    try:
+        for _ in range(count):
+            os.write(fd, record)
+            start = time.time()
+            sync(fd)
+            latencies.append((time.time() - start) * 1000)
+    finally:
+        os.close(fd)
+
+    latencies.sort()
+    return {
+        "count": count,
+        "p50Ms": percentile(latencies, 50),
+        "p99Ms": percentile(latencies, 99),
+        "maxMs": latencies[-1] if latencies else 0.0,
+    }
+
+
+def probe_write(path, mib):
+    block = b"k" * BLOCK_SIZE
+    fd = os.open(path, os.O_WRONLY | os.O_CREAT | os.O_TRUNC, 0o600)
+    try:
+        start = time.time()
+        for _ in range(mib):
+            os.write(fd, block)
+        os.fsync(fd)
+        seconds = time.time() - start
+    finally:
+        os.close(fd)
+
+    return {
+        "mib": mib,
+        "seconds": seconds,
+        "mibps": mib / seconds if seconds > 0 else 0.0,
+    }
+
+
+def missing_dirs(directory):
+    """returns the directories to be created for directory, the deepest first"""
+    missing = []
+    directory = os.path.abspath(directory)
+    while not os.path.isdir(directory):
+        missing.append(directory)
+        directory = os.path.dirname(directory)
+    return missing
+
+
+def main():
+    if len(sys.argv) != 4:
+        sys.stderr.write("usage: %s <dir> <fsync count> <write MiB>\n" % sys.argv[0])
+        return 1
+
+    directory, count, mib = sys.argv[1], int(sys.argv[2]), int(sys.argv[3])
+    result = {"dir": directory, "fsync": None, "write": None, "error": ""}
+
+    created = missing_dirs(directory)
+    path = os.path.join(directory, PROBE_FILE)
+    try:
+        if created:
+            os.makedirs(directory)
+        result["fsync"] = probe_fsync(path, count)
+        result["write"] = probe_write(path, mib)
+    except (IOError, OSError) as e:
+        result["error"] = str(e)
+    finally:
+        if os.path.exists(path):
+            os.remove(path)
+        for d in created:
+            if os.path.isdir(d):
+                os.rmdir(d)
+
+    print(json.dumps(result))
+    return 0
+
+
+if __name__ == "__main__":
+    sys.exit(main())
@@ -0,0 +1,24 @@
+#!/bin/bash
+## Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+##
+## Licensed under the Apache License, Version 2.0 (the "License");
+## you may not use this file except in compliance with the License.
+## You may obtain a copy of the License at
+##
+##      http://www.apache.org/licenses/LICENSE-2.0
+##
+## Unless required by applicable law or agreed to in writing, software
+## distributed under the License is distributed on an "AS IS" BASIS,
+## WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+## See the License for the specific language governing permissions and
+## limitations under the License.
+
+# This script runs the disk_probe.py helper by python, see disk_probe.py for the usage.
+
+python_bin=$(command -v python3 || command -v python || command -v python2)
+if [ -z "${python_bin}" ]; then
+    echo "error: python is required to check the disk" >&2
+    exit 1
+fi
+
+exec "${python_bin}" "$(dirname "$0")/disk_probe.py" "$@"
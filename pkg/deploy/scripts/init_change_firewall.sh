@@ -18,4 +18,8 @@
 systemctl disable firewalld &>/dev/null
 systemctl stop firewalld &>/dev/null
 setenforce 0 &>/dev/null
-sed -i 's/enforcing/disabled/' /etc/selinux/config
+# selinux is not installed on some distributions, e.g. ubuntu
+if [ -f /etc/selinux/config ]; then
+    sed -i 's/^SELINUX=enforcing/SELINUX=disabled/' /etc/selinux/config || exit 1
+fi
+exit 0
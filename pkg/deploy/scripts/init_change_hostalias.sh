@@ -13,7 +13,42 @@
 ## See the License for the specific language governing permissions and
 ## limitations under the License.
 
-# This script is aim to set hostaliases
+# This script is aim to set hostaliases, and the given host entries of /etc/hosts.
+# The host entries are kept in a block of /etc/hosts which is replaced by the next run,
+# and the given names are removed from the other entries so that they resolve to the given ips.
+# usage: init_change_hostalias.sh [<ip> <name>]...
+
+HOSTS_FILE=/etc/hosts
+BLOCK_BEGIN="# BEGIN kpaas host aliases"
+BLOCK_END="# END kpaas host aliases"
+
+if [ $# -gt 0 ]; then
+    names=""
+    entries=""
+    while [ $# -ge 2 ]; do
+        names="${names} $2"
+        entries="${entries}$1 $2"$'\n'
+        shift 2
+    done
+
+    test -f ${HOSTS_FILE}.kpaas.bak || cp ${HOSTS_FILE} ${HOSTS_FILE}.kpaas.bak
+    hosts=$(awk -v names="${names}" -v begin="${BLOCK_BEGIN}" -v end="${BLOCK_END}" '
+        BEGIN { split(names, list, " "); for (i in list) managed[list[i]] = 1 }
+        $0 == begin { skip = 1 }
+        skip { if ($0 == end) skip = 0; next }
+        /^[[:space:]]*#/ || NF < 2 { print; next }
+        {
+            line = $1; kept = 0
+            for (i = 2; i <= NF; i++) {
+                if ($i ~ /^#/) break
+                if (!($i in managed)) { line = line " " $i; kept++ }
+            }
+            if (kept > 0) print line
+        }' ${HOSTS_FILE}) || exit 1
+
+    # /etc/hosts may be bind mounted, so it's rewritten in place instead of being replaced
+    printf "%s\n%s\n%s%s\n" "${hosts}" "${BLOCK_BEGIN}" "${entries}" "${BLOCK_END}" > ${HOSTS_FILE} || exit 1
+fi
 
 test -f ~/.bashrc || touch ~/.bashrc
 test -f ~/.bash_aliases || touch ~/.bash_aliases
@@ -27,12 +62,11 @@ alias kce='kubectl -n qce'
 EOF
 }
 
-grep "bash_aliases" ~/.bashrc || {
-    echo `
+grep -q "bash_aliases" ~/.bashrc || {
+    cat >>~/.bashrc<<EOF
 if [ -f ~/.bash_aliases ]; then
     . ~/.bash_aliases
-fi` >> ~/.bashrc
+fi
+EOF
 }
-
-source ~/.bash_aliases
-source ~/.bashrc
+exit 0
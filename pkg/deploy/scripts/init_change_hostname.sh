@@ -17,5 +17,5 @@
 
 HOSTNAME=$1
 
-test -n $HOSTNAME || (echo "hostname can not be empty" && exit 1)
-hostnamectl set-hostname $HOSTNAME
+test -n "$HOSTNAME" || { echo "hostname can not be empty" >&2; exit 1; }
+hostnamectl set-hostname "$HOSTNAME"
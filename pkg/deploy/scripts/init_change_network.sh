@@ -13,12 +13,20 @@
 ## See the License for the specific language governing permissions and
 ## limitations under the License.
 
-# This script is aim to change basic network env
+# This script is aim to change basic network env, the kernel parameters are persisted in the same
+# file as the fixes of the system preference check.
 
-grep "net.ipv4.ip_forward" /etc/sysctl.conf && {
-    sed -i -E 's/(.*)net.ipv4.ip_forward(.*)/net.ipv4.ip_forward\2/1' /etc/sysctl.conf
-}
+sysctl_conf=/etc/sysctl.d/99-kpaas.conf
+modules_conf=/etc/modules-load.d/kpaas.conf
 
-sysctl -w net.ipv4.ip_forward = 1
-echo 1 > /proc/sys/net/ipv4/ip_forward
-sysctl -p /etc/sysctl.conf
+# bridged traffic is seen by iptables only if br_netfilter is loaded
+modprobe br_netfilter || exit 1
+grep -qx "br_netfilter" ${modules_conf} 2> /dev/null || echo "br_netfilter" >> ${modules_conf}
+
+touch ${sysctl_conf}
+for parameter in net.ipv4.ip_forward net.ipv4.conf.all.forwarding net.bridge.bridge-nf-call-iptables net.bridge.bridge-nf-call-ip6tables; do
+    sed -i "/^${parameter//./\\.} *=/d" ${sysctl_conf}
+    echo "${parameter} = 1" >> ${sysctl_conf}
+done
+
+sysctl -p ${sysctl_conf}
@@ -0,0 +1,85 @@
+#!/bin/bash
+## Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+##
+## Licensed under the Apache License, Version 2.0 (the "License");
+## you may not use this file except in compliance with the License.
+## You may obtain a copy of the License at
+##
+##      http://www.apache.org/licenses/LICENSE-2.0
+##
+## Unless required by applicable law or agreed to in writing, software
+## distributed under the License is distributed on an "AS IS" BASIS,
+## WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+## See the License for the specific language governing permissions and
+## limitations under the License.
+
+# This script configures the given ntp servers for chronyd, or ntpd if only ntpd is installed,
+# and steps the clock to the servers. chrony is installed if neither of them is installed.
+# It's safe to run the script again, the servers added by the last run are replaced.
+# usage: init_change_ntp.sh <server>...
+
+set -o pipefail
+
+marker="# added by kpaas"
+
+if [ $# -eq 0 ]; then
+    echo "no ntp server is given" >&2
+    exit 1
+fi
+
+# configure_servers comments out the existing servers and pools in the config file and appends the given servers
+configure_servers() {
+    local conf=$1
+    shift
+
+    sed -i -e "/${marker}\$/d" -e 's/^\(server\|pool\|peer\)[[:space:]]/#&/' "${conf}" || return 1
+    for server in "$@"; do
+        echo "server ${server} iburst ${marker}" >> "${conf}"
+    done
+}
+
+install_chrony() {
+    if command -v yum > /dev/null 2>&1; then
+        yum install -y chrony
+    elif command -v apt-get > /dev/null 2>&1; then
+        apt-get install -y chrony
+    else
+        echo "no package manager to install chrony" >&2
+        return 1
+    fi
+}
+
+if ! command -v chronyd > /dev/null 2>&1 && ! command -v ntpd > /dev/null 2>&1; then
+    install_chrony > /dev/null || exit 1
+fi
+
+if command -v chronyd > /dev/null 2>&1; then
+    conf=/etc/chrony.conf
+    [ -f "${conf}" ] || conf=/etc/chrony/chrony.conf
+    configure_servers "${conf}" "$@" || exit 1
+
+    # the service is named chrony on debian and ubuntu
+    unit=chronyd
+    systemctl cat "${unit}" > /dev/null 2>&1 || unit=chrony
+    systemctl stop ntpd > /dev/null 2>&1
+    systemctl disable ntpd > /dev/null 2>&1
+    systemctl enable "${unit}" > /dev/null 2>&1
+    systemctl restart "${unit}" || exit 1
+
+    # wait for a source to be selected, then step the clock instead of slewing it slowly
+    chronyc waitsync 15 > /dev/null 2>&1
+    chronyc -a makestep > /dev/null || exit 1
+    echo "configured chronyd with ntp servers: $*"
+else
+    conf=/etc/ntp.conf
+    configure_servers "${conf}" "$@" || exit 1
+
+    unit=ntpd
+    systemctl cat "${unit}" > /dev/null 2>&1 || unit=ntp
+    systemctl stop "${unit}" > /dev/null 2>&1
+    # set the clock once without the panic threshold, ntpd keeps it synchronized later
+    ntpd -gq > /dev/null || exit 1
+    systemctl enable "${unit}" > /dev/null 2>&1
+    systemctl start "${unit}" || exit 1
+    echo "configured ntpd with ntp servers: $*"
+fi
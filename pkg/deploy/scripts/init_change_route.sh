@@ -13,7 +13,14 @@
 ## See the License for the specific language governing permissions and
 ## limitations under the License.
 
-# This script is aim to delete invalid route
+# This script is aim to delete invalid routes, which are the routes to the given subnets, e.g. the
+# pod and service subnets of the cluster, they are left by other networks and conflict with the cluster.
+# usage: init_change_route.sh [<subnet>]...
 
-invalid_route=`ip route | awk '/172.16.0.0/{print $1}'`
-test -n $invalid_route && ip route delete $invalid_route
+for subnet in "$@"; do
+    ip route show to exact "$subnet" | while read -r route; do
+        echo "delete route: ${route}"
+        ip route delete ${route} || exit 1
+    done || exit 1
+done
+exit 0
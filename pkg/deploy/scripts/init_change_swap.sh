@@ -13,7 +13,7 @@
 ## See the License for the specific language governing permissions and
 ## limitations under the License.
 
-# This script is aim to close swap
+# This script is aim to close swap, the swap entries of /etc/fstab are commented out
 
-swapoff -a
-sed -i '/swap/d' /etc/fstab
+swapoff -a || exit 1
+sed -i -E 's/^([^#].*[[:space:]]swap[[:space:]].*)$/#\1/' /etc/fstab
@@ -13,7 +13,10 @@
 ## See the License for the specific language governing permissions and
 ## limitations under the License.
 
-# This script is aim to change time zone to default
+# This script is aim to change time zone, it's the default time zone if not given
+# usage: init_change_timezone.sh [<time zone>]
 
 DEFAULTTIMEZONE="Asia/Shanghai"
-timedatectl set-timezone $DEFAULTTIMEZONE
+TIMEZONE=${1:-$DEFAULTTIMEZONE}
+
+timedatectl set-timezone "$TIMEZONE"
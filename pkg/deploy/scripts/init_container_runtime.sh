@@ -0,0 +1,117 @@
+#!/bin/bash
+## Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+##
+## Licensed under the Apache License, Version 2.0 (the "License");
+## you may not use this file except in compliance with the License.
+## You may obtain a copy of the License at
+##
+##      http://www.apache.org/licenses/LICENSE-2.0
+##
+## Unless required by applicable law or agreed to in writing, software
+## distributed under the License is distributed on an "AS IS" BASIS,
+## WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+## See the License for the specific language governing permissions and
+## limitations under the License.
+
+# This script installs and configures the container runtime for kubelet: the cri socket is served
+# and the cgroup driver is systemd. The original config is kept as <config>.kpaas.bak.
+# usage: init_container_runtime.sh <docker|containerd>
+
+. lib.sh
+
+runtime=$1
+docker_config=/etc/docker/daemon.json
+containerd_config=/etc/containerd/config.toml
+
+backup() {
+    if [ -f "$1" ] && [ ! -f "$1.kpaas.bak" ]; then
+        cp -p "$1" "$1.kpaas.bak"
+    fi
+}
+
+python_cmd() {
+    if command_exists python3; then
+        echo python3
+    elif command_exists python; then
+        echo python
+    fi
+}
+
+init_docker() {
+    command_exists docker || error_exit "docker is not installed"
+
+    mkdir -p "$(dirname ${docker_config})"
+    if [ ! -s "${docker_config}" ]; then
+        echo '{"exec-opts": ["native.cgroupdriver=systemd"]}' > "${docker_config}"
+    elif ! grep -q 'native.cgroupdriver=systemd' "${docker_config}"; then
+        local python=$(python_cmd)
+        test -n "${python}" || error_exit "python is required to update ${docker_config}"
+        backup "${docker_config}"
+        ${python} - "${docker_config}" <<'PYTHON' || error_exit "failed to update ${docker_config}"
+import json
+import sys
+
+path = sys.argv[1]
+with open(path) as f:
+    config = json.load(f)
+opts = [opt for opt in config.get("exec-opts", []) if not opt.startswith("native.cgroupdriver=")]
+config["exec-opts"] = opts + ["native.cgroupdriver=systemd"]
+with open(path, "w") as f:
+    json.dump(config, f, indent=2)
+PYTHON
+    fi
+
+    systemctl enable docker > /dev/null 2>&1
+    systemctl restart docker || error_exit "failed to restart docker"
+    echo "docker is configured with the systemd cgroup driver"
+}
+
+init_containerd() {
+    if ! command_exists containerd; then
+        case "$(GetOS)" in
+            *centos*|*rhel*)
+                yum install -y containerd.io > /dev/null || error_exit "failed to install containerd.io"
+                ;;
+            *ubuntu*|*debian*)
+                apt-get install -y containerd > /dev/null || error_exit "failed to install containerd"
+                ;;
+            *)
+                error_exit "containerd is not installed"
+                ;;
+        esac
+        echo "containerd is installed"
+    fi
+
+    mkdir -p "$(dirname ${containerd_config})"
+    backup "${containerd_config}"
+    # the config of the containerd.io package disables the cri plugin, the default one enables it
+    if [ ! -s "${containerd_config}" ] || grep -Eq '^\s*disabled_plugins\s*=.*"cri"' "${containerd_config}"; then
+        containerd config default > "${containerd_config}" || error_exit "failed to generate ${containerd_config}"
+    fi
+
+    # SystemdCgroup is the option of the runc v2 shim, systemd_cgroup only works with the v1 shim
+    if grep -Eq '^\s*SystemdCgroup\s*=' "${containerd_config}"; then
+        sed -i -E 's/^(\s*)SystemdCgroup\s*=.*/\1SystemdCgroup = true/' "${containerd_config}"
+    elif grep -Eq 'runtime_type\s*=\s*"io.containerd.runc.v2"' "${containerd_config}" &&
+        grep -q 'containerd.runtimes.runc.options\]' "${containerd_config}"; then
+        sed -i '/containerd.runtimes.runc.options\]/a\            SystemdCgroup = true' "${containerd_config}"
+    else
+        sed -i -E 's/^(\s*)systemd_cgroup\s*=.*/\1systemd_cgroup = true/' "${containerd_config}"
+    fi
+
+    systemctl enable containerd > /dev/null 2>&1
+    systemctl restart containerd || error_exit "failed to restart containerd"
+    echo "containerd is configured with the cri plugin and the systemd cgroup driver"
+}
+
+case "${runtime}" in
+    docker)
+        init_docker
+        ;;
+    containerd)
+        init_containerd
+        ;;
+    *)
+        error_exit "unknown container runtime ${runtime}"
+        ;;
+esac
@@ -13,24 +13,27 @@
 ## See the License for the specific language governing permissions and
 ## limitations under the License.
 
-# This script is aim to deploy haproxy
+# This script is aim to deploy haproxy, which proxies the apiservers on the masters.
+# usage: init_deploy_haproxy.sh "<apiserver ip:port> [<apiserver ip:port>]..."
 
 . lib.sh
 
-OS=GetOS
 HAPROXYADDR=$1
 
-test -n $HAPROXYADDR || (echo "haproxy addr is not specific" && exit 1)
+test -n "$HAPROXYADDR" || error_exit "haproxy addr is not specific"
 
-if [[ $OS -eq "ubuntu" ]]; then
-    apt-get install -y haproxy
-    dpkg -l | grep -q haproxy || (echo "haproxy install failed" && exit 1)
-elif [[ $OS -eq "centos" ]] || [[ $OS -eq "rhel" ]]; then
-    yum install -y haproxy
-    rpm -qa | grep -q haproxy || (echo "haproxy install failed" && exit 1)
-else
-    echo "system not support"
-    exit 1
-fi
+case "$(GetOS)" in
+    *ubuntu*|*debian*)
+        apt-get install -y haproxy > /dev/null
+        dpkg -l | grep -q haproxy || error_exit "haproxy install failed"
+        ;;
+    *centos*|*rhel*)
+        yum install -y haproxy > /dev/null
+        rpm -qa | grep -q haproxy || error_exit "haproxy install failed"
+        ;;
+    *)
+        error_exit "system not support"
+        ;;
+esac
 
-/bin/bash /tmp/init_deploy_haproxy_keepalived/setup.sh -u "$HAPROXYADDR" haproxy run 2>&1
+/bin/bash init_deploy_haproxy_keepalived/setup.sh -u "$HAPROXYADDR" haproxy run 2>&1
@@ -108,7 +108,8 @@ haproxy::status() {
 haproxy::run() {
     haproxy::config
     haproxy::install
-    haproxy::start
+    # restart to apply the config if it's already running
+    haproxy::restart
     haproxy::enable
 }
 
@@ -146,7 +147,8 @@ keepalived::status() {
 keepalived::run() {
     keepalived::config
     keepalived::install
-    keepalived::start
+    # restart to apply the config if it's already running
+    keepalived::restart
     keepalived::enable
 }
 
@@ -13,26 +13,30 @@
 ## See the License for the specific language governing permissions and
 ## limitations under the License.
 
-# This script is aim to deploy keepalived
+# This script is aim to deploy keepalived, which holds the vip on one of the masters running haproxy.
+# nc is installed for keepalived to check haproxy.
+# usage: init_deploy_keepalived.sh <vip> <interface>
 
 . lib.sh
 
-OS=GetOS
 KEEPALIVEDARRD=$1
 KEEPALIVEDETH=$2
 
-test -n $KEEPALIVEDARRD || (echo "keepalived addr is not specific" && exit 1)
-test -n $KEEPALIVEDETH || (echo "keepalived ethernet is not specific" && exit 1)
+test -n "$KEEPALIVEDARRD" || error_exit "keepalived addr is not specific"
+test -n "$KEEPALIVEDETH" || error_exit "keepalived ethernet is not specific"
 
-if [[ $OS -eq "ubuntu" ]]; then
-    apt-get install -y keepalived
-    dpkg -l | grep -q keepalived || (echo "keepalived install failed" && exit 1)
-elif [[ $OS -eq "centos" ]] || [[ $OS -eq "rhel" ]]; then
-    yum install -y keepalived
-    rpm -qa | grep -q keepalived || (echo "keepalived install failed" && exit 1)
-else
-    echo "system not support"
-    exit 1
-fi
+case "$(GetOS)" in
+    *ubuntu*|*debian*)
+        apt-get install -y keepalived netcat-openbsd > /dev/null
+        dpkg -l | grep -q keepalived || error_exit "keepalived install failed"
+        ;;
+    *centos*|*rhel*)
+        yum install -y keepalived nmap-ncat > /dev/null
+        rpm -qa | grep -q keepalived || error_exit "keepalived install failed"
+        ;;
+    *)
+        error_exit "system not support"
+        ;;
+esac
 
-/bin/bash /tmp/init_deploy_haproxy_keepalived/setup.sh -n "$KEEPALIVEDARRD" -i "$KEEPALIVEDETH" keepalived run 2>&1
+/bin/bash init_deploy_haproxy_keepalived/setup.sh -n "$KEEPALIVEDARRD" -i "$KEEPALIVEDETH" keepalived run 2>&1
@@ -0,0 +1,36 @@
+#!/bin/bash
+## Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+##
+## Licensed under the Apache License, Version 2.0 (the "License");
+## you may not use this file except in compliance with the License.
+## You may obtain a copy of the License at
+##
+##      http://www.apache.org/licenses/LICENSE-2.0
+##
+## Unless required by applicable law or agreed to in writing, software
+## distributed under the License is distributed on an "AS IS" BASIS,
+## WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+## See the License for the specific language governing permissions and
+## limitations under the License.
+
+# This script installs kubeadm, kubelet and kubectl of the kubernetes version, then joins the node to the
+# cluster as a worker by the kubeadm config. A node which has already joined is not changed.
+# usage: join_worker.sh <kubeadm config> <kubernetes version>
+
+. lib.sh
+. lib_kubeadm.sh
+
+config=$1
+version=$2
+
+test -s "${config}" || error_exit "kubeadm config ${config} is not found"
+
+install_kubeadm "${version}"
+
+if [ -f "${KUBEADM_KUBELET_CONF}" ]; then
+    echo "node has already joined the cluster"
+    exit 0
+fi
+
+kubeadm join --config "${config}" || error_exit "failed to join the cluster"
+echo "node has joined the cluster"
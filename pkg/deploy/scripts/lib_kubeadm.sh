@@ -0,0 +1,52 @@
+#!/bin/bash
+## Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+##
+## Licensed under the Apache License, Version 2.0 (the "License");
+## you may not use this file except in compliance with the License.
+## You may obtain a copy of the License at
+##
+##      http://www.apache.org/licenses/LICENSE-2.0
+##
+## Unless required by applicable law or agreed to in writing, software
+## distributed under the License is distributed on an "AS IS" BASIS,
+## WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+## See the License for the specific language governing permissions and
+## limitations under the License.
+
+# This script provides the functions to install and run kubeadm, it's sourced by deploy_master.sh and
+# join_worker.sh after lib.sh.
+
+KUBEADM_ADMIN_CONF=/etc/kubernetes/admin.conf
+KUBEADM_KUBELET_CONF=/etc/kubernetes/kubelet.conf
+
+# install_kubeadm installs kubeadm, kubelet and kubectl of the kubernetes version <v1.x.y> by the
+# package manager if they are missing or of another version, and enables kubelet.
+install_kubeadm() {
+    local version=$1
+    if [ "$(kubeadm version -o short 2>/dev/null)" == "${version}" ] && command_exists kubelet && command_exists kubectl; then
+        systemctl enable kubelet > /dev/null 2>&1
+        return
+    fi
+
+    local package_version=${version#v}
+    case "$(GetOS)" in
+        *centos*|*rhel*)
+            yum install -y --disableexcludes=kubernetes "kubelet-${package_version}" "kubeadm-${package_version}" \
+                "kubectl-${package_version}" > /dev/null || error_exit "failed to install kubeadm ${version}"
+            ;;
+        *ubuntu*|*debian*)
+            apt-get install -y --allow-downgrades "kubelet=${package_version}-00" "kubeadm=${package_version}-00" \
+                "kubectl=${package_version}-00" > /dev/null || error_exit "failed to install kubeadm ${version}"
+            ;;
+        *)
+            error_exit "kubeadm ${version} is not installed"
+            ;;
+    esac
+    systemctl enable kubelet > /dev/null 2>&1
+    echo "kubeadm ${version} is installed"
+}
+
+# bootstrap_token_exists checks if the bootstrap token exists in the cluster
+bootstrap_token_exists() {
+    kubeadm token list --kubeconfig "${KUBEADM_ADMIN_CONF}" 2>/dev/null | grep -q "^$1 "
+}
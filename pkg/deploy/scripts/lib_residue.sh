@@ -0,0 +1,61 @@
+#!/bin/bash
+## Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+##
+## Licensed under the Apache License, Version 2.0 (the "License");
+## you may not use this file except in compliance with the License.
+## You may obtain a copy of the License at
+##
+##      http://www.apache.org/licenses/LICENSE-2.0
+##
+## Unless required by applicable law or agreed to in writing, software
+## distributed under the License is distributed on an "AS IS" BASIS,
+## WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+## See the License for the specific language governing permissions and
+## limitations under the License.
+
+# This script provides the lists of what a previous kubernetes or etcd installation leaves on a node,
+# it's sourced by check_residue.sh and clean_node.sh.
+
+# directories which are not empty after an installation
+RESIDUE_DIRECTORIES="/etc/kubernetes /var/lib/etcd /var/lib/kubelet /etc/cni/net.d /var/lib/cni /var/lib/calico /run/flannel"
+# processes which may be left running, they are run by systemd or as static pods
+RESIDUE_PROCESSES="kubelet etcd kube-apiserver kube-controller-manager kube-scheduler kube-proxy"
+# systemd services which would start the processes again
+RESIDUE_SERVICES="kubelet etcd"
+# prefixes of the iptables chains created by kube-proxy and the network plugins
+RESIDUE_IPTABLES_PATTERN='KUBE-|cali-|cali:|FLANNEL'
+# names of the network interfaces created by kube-proxy and the network plugins
+RESIDUE_INTERFACES_PATTERN='^(cni0|flannel\..*|cali.*|tunl0|vxlan\.calico|kube-ipvs0|kube-bridge|weave|dummy0)$'
+
+residue_directories() {
+    local dir
+    for dir in ${RESIDUE_DIRECTORIES}; do
+        if [ -d "${dir}" ] && [ -n "$(ls -A "${dir}" 2> /dev/null)" ]; then
+            echo "${dir}"
+        fi
+    done
+}
+
+residue_processes() {
+    local process
+    for process in ${RESIDUE_PROCESSES}; do
+        if pgrep -x "${process}" > /dev/null 2>&1; then
+            echo "${process}"
+        fi
+    done
+}
+
+# prints "<prefix> <count>" for each kind of the chains, there may be hundreds of KUBE- chains
+residue_iptables() {
+    command -v iptables-save > /dev/null 2>&1 || return 0
+    iptables-save 2> /dev/null | grep -oE "^:(${RESIDUE_IPTABLES_PATTERN})" | cut -c2- | sort | uniq -c |
+        awk '{print $2" "$1}'
+}
+
+residue_interfaces() {
+    ip -o link show 2> /dev/null | awk -F': ' '{print $2}' | cut -d@ -f1 | grep -E "${RESIDUE_INTERFACES_PATTERN}"
+}
+
+residue_mounts() {
+    awk '$2 ~ "^/var/lib/kubelet/" {print $2}' /proc/mounts 2> /dev/null
+}
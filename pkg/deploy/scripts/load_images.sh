@@ -0,0 +1,48 @@
+#!/bin/bash
+## Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+##
+## Licensed under the Apache License, Version 2.0 (the "License");
+## you may not use this file except in compliance with the License.
+## You may obtain a copy of the License at
+##
+##      http://www.apache.org/licenses/LICENSE-2.0
+##
+## Unless required by applicable law or agreed to in writing, software
+## distributed under the License is distributed on an "AS IS" BASIS,
+## WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+## See the License for the specific language governing permissions and
+## limitations under the License.
+
+# This script loads the image tarballs, which are created by "docker save", by the container runtime, so that
+# the images are not pulled from the registries when deploying.
+# usage: load_images.sh <docker|containerd> <tarball>...
+
+. lib.sh
+
+runtime=$1
+shift
+
+case "${runtime}" in
+    docker)
+        command_exists docker || error_exit "docker is not installed"
+        ;;
+    containerd)
+        command_exists ctr || error_exit "containerd is not installed"
+        ;;
+    *)
+        error_exit "unknown container runtime ${runtime}"
+        ;;
+esac
+
+for tarball in "$@"; do
+    test -s "${tarball}" || error_exit "image tarball ${tarball} is not found"
+
+    case "${runtime}" in
+        docker)
+            docker load -i "${tarball}" || error_exit "failed to load ${tarball}"
+            ;;
+        containerd)
+            ctr --namespace k8s.io images import "${tarball}" || error_exit "failed to load ${tarball}"
+            ;;
+    esac
+done
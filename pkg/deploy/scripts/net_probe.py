@@ -0,0 +1,210 @@
+#!/usr/bin/env python
+# Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#      http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# net_probe is a tiny helper to check the connectivity between nodes, it works with python 2.7 and 3.
+#
+# usage:
+#   net_probe.py serve <seconds> <tcp ports> <udp ports>
+#       listens on the ports in background and prints the bound ports in json, the listener
+#       accepts tcp connections and echoes udp packets until seconds passed.
+#   net_probe.py stop <pid>
+#   net_probe.py probe <ip> <tcp ports> <udp ports> <mtu>
+#       checks the tcp and udp ports, icmp and the path mtu to ip, and prints the result in json.
+# the ports are separated by comma, "-" means no port.
+
+import errno
+import json
+import os
+import select
+import signal
+import socket
+import subprocess
+import sys
+import time
+
+PROBE_MESSAGE = b"kpaas-net-probe"
+TIMEOUT_SECONDS = 2
+UDP_RETRIES = 3
+# the ip header and the icmp header
+ICMP_OVERHEAD = 28
+MIN_MTU = 576
+
+
+def parse_ports(ports):
+    if ports == "-" or ports == "":
+        return []
+    return [int(p) for p in ports.split(",")]
+
+
+def serve(seconds, tcp_ports, udp_ports):
+    result = {"tcp": [], "udp": [], "errors": []}
+    sockets = []
+    for port in tcp_ports:
+        s = socket.socket(socket.AF_INET, socket.SOCK_STREAM)
+        s.setsockopt(socket.SOL_SOCKET, socket.SO_REUSEADDR, 1)
+        try:
+            s.bind(("0.0.0.0", port))
+            s.listen(128)
+        except socket.error as e:
+            result["errors"].append("tcp/%d: %s" % (port, e))
+            s.close()
+            continue
+        sockets.append(s)
+        result["tcp"].append(port)
+
+    for port in udp_ports:
+        s = socket.socket(socket.AF_INET, socket.SOCK_DGRAM)
+        try:
+            s.bind(("0.0.0.0", port))
+        except socket.error as e:
+            result["errors"].append("udp/%d: %s" % (port, e))
+            s.close()
+            continue
+        sockets.append(s)
+        result["udp"].append(port)
+
+    pid = os.fork()
+    if pid > 0:
+        result["pid"] = pid
+        print(json.dumps(result))
+        return
+
+    # the child serves in background, detached from the session
+    os.setsid()
+    devnull = os.open(os.devnull, os.O_RDWR)
+    for fd in (0, 1, 2):
+        os.dup2(devnull, fd)
+
+    deadline = time.time() + seconds
+    while time.time() < deadline:
+        readable, _, _ = select.select(sockets, [], [], 1)
+        for s in readable:
+            try:
+                if s.type == socket.SOCK_STREAM:
+                    conn, _ = s.accept()
+                    conn.close()
+                else:
+                    data, addr = s.recvfrom(65535)
+                    s.sendto(data, addr)
+            except socket.error:
+                pass
+    os._exit(0)
+
+
+def stop(pid):
+    try:
+        os.kill(pid, signal.SIGTERM)
+    except OSError as e:
+        if e.errno != errno.ESRCH:
+            raise
+
+
+def probe_tcp(ip, port):
+    s = socket.socket(socket.AF_INET, socket.SOCK_STREAM)
+    s.settimeout(TIMEOUT_SECONDS)
+    try:
+        s.connect((ip, port))
+        return {"port": port, "ok": True, "error": ""}
+    except socket.timeout:
+        return {"port": port, "ok": False, "error": "connection timed out"}
+    except socket.error as e:
+        return {"port": port, "ok": False, "error": str(e)}
+    finally:
+        s.close()
+
+
+def probe_udp(ip, port):
+    s = socket.socket(socket.AF_INET, socket.SOCK_DGRAM)
+    s.settimeout(TIMEOUT_SECONDS)
+    try:
+        for _ in range(UDP_RETRIES):
+            try:
+                s.sendto(PROBE_MESSAGE, (ip, port))
+                data, _ = s.recvfrom(65535)
+                if data == PROBE_MESSAGE:
+                    return {"port": port, "ok": True, "error": ""}
+            except socket.timeout:
+                continue
+        return {"port": port, "ok": False, "error": "no echo received"}
+    except socket.error as e:
+        return {"port": port, "ok": False, "error": str(e)}
+    finally:
+        s.close()
+
+
+def ping(ip, size=None):
+    cmd = ["ping", "-c", "1", "-W", str(TIMEOUT_SECONDS)]
+    if size is not None:
+        # prohibit fragmentation to probe the path mtu
+        cmd += ["-M", "do", "-s", str(size)]
+    cmd.append(ip)
+    try:
+        p = subprocess.Popen(cmd, stdout=subprocess.PIPE, stderr=subprocess.STDOUT)
+    except OSError as e:
+        return False, "failed to run ping: %s" % e
+    output = p.communicate()[0]
+    if p.returncode == 0:
+        return True, ""
+    lines = output.decode("utf-8", "replace").strip().splitlines()
+    return False, lines[-1] if lines else "ping failed"
+
+
+def probe_mtu(ip, mtu):
+    ok, error = ping(ip, mtu - ICMP_OVERHEAD)
+    if ok:
+        return {"ok": True, "pathMTU": mtu, "error": ""}
+
+    # search the largest mtu which works
+    low, high = MIN_MTU, mtu - 1
+    path_mtu = 0
+    while low <= high:
+        middle = (low + high) // 2
+        if ping(ip, middle - ICMP_OVERHEAD)[0]:
+            path_mtu = middle
+            low = middle + 1
+        else:
+            high = middle - 1
+    return {"ok": False, "pathMTU": path_mtu, "error": error}
+
+
+def probe(ip, tcp_ports, udp_ports, mtu):
+    icmp_ok, icmp_error = ping(ip)
+    result = {
+        "tcp": [probe_tcp(ip, port) for port in tcp_ports],
+        "udp": [probe_udp(ip, port) for port in udp_ports],
+        "icmp": {"ok": icmp_ok, "error": icmp_error},
+    }
+    if icmp_ok:
+        result["mtu"] = probe_mtu(ip, mtu)
+    else:
+        result["mtu"] = {"ok": False, "pathMTU": 0, "error": "icmp is not reachable"}
+    print(json.dumps(result))
+
+
+def main(argv):
+    if len(argv) == 5 and argv[1] == "serve":
+        serve(int(argv[2]), parse_ports(argv[3]), parse_ports(argv[4]))
+    elif len(argv) == 3 and argv[1] == "stop":
+        stop(int(argv[2]))
+    elif len(argv) == 6 and argv[1] == "probe":
+        probe(argv[2], parse_ports(argv[3]), parse_ports(argv[4]), int(argv[5]))
+    else:
+        sys.stderr.write("error: invalid arguments %s\n" % " ".join(argv[1:]))
+        return 1
+    return 0
+
+
+if __name__ == "__main__":
+    sys.exit(main(sys.argv))
@@ -0,0 +1,35 @@
+#!/bin/bash
+## Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+##
+## Licensed under the Apache License, Version 2.0 (the "License");
+## you may not use this file except in compliance with the License.
+## You may obtain a copy of the License at
+##
+##      http://www.apache.org/licenses/LICENSE-2.0
+##
+## Unless required by applicable law or agreed to in writing, software
+## distributed under the License is distributed on an "AS IS" BASIS,
+## WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+## See the License for the specific language governing permissions and
+## limitations under the License.
+
+# This script prints the facts of the node which the results of the check items depend on, the
+# results of the last check are reused only if the facts are not changed.
+# output, one line for each fact:
+#   <fact>=<value>
+
+. lib.sh
+
+echo "hostname=$(hostname)"
+# the boot id changes on every reboot
+echo "boot=$(cat /proc/sys/kernel/random/boot_id 2> /dev/null)"
+echo "kernel=$(uname -r)"
+echo "os=$(. /etc/os-release 2> /dev/null && echo "${ID} ${VERSION_ID}")"
+echo "cpus=$(nproc 2> /dev/null)"
+echo "memory=$(awk '/^MemTotal:/ {print $2}' /proc/meminfo 2> /dev/null)"
+echo "rootdisk=$(df -k / 2> /dev/null | awk 'NR == 2 {print $2}')"
+command_exists docker && echo "docker=$(docker version --format '{{.Server.Version}}' 2> /dev/null)"
+command_exists containerd && echo "containerd=$(containerd --version 2> /dev/null | awk '{print $3}')"
+command_exists kubelet && echo "kubelet=$(kubelet --version 2> /dev/null | awk '{print $2}')"
+
+exit 0
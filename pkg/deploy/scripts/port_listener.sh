@@ -0,0 +1,75 @@
+#!/bin/bash
+## Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+##
+## Licensed under the Apache License, Version 2.0 (the "License");
+## you may not use this file except in compliance with the License.
+## You may obtain a copy of the License at
+##
+##      http://www.apache.org/licenses/LICENSE-2.0
+##
+## Unless required by applicable law or agreed to in writing, software
+## distributed under the License is distributed on an "AS IS" BASIS,
+## WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+## See the License for the specific language governing permissions and
+## limitations under the License.
+
+# This script starts or stops a temporary tcp listener used to check the port reachability.
+# usage:
+#   port_listener.sh start <port> <seconds>, prints "listening <pid>" if the listener is started
+#   port_listener.sh stop <pid>
+
+listener_program='
+import socket, sys, time
+s = socket.socket(socket.AF_INET, socket.SOCK_STREAM)
+s.setsockopt(socket.SOL_SOCKET, socket.SO_REUSEADDR, 1)
+s.bind(("0.0.0.0", int(sys.argv[1])))
+s.listen(128)
+s.settimeout(1)
+deadline = time.time() + int(sys.argv[2])
+while time.time() < deadline:
+    try:
+        conn, _ = s.accept()
+        conn.close()
+    except socket.timeout:
+        pass
+'
+
+start() {
+    local port=$1
+    local seconds=$2
+    local python_bin
+    python_bin=$(command -v python3 || command -v python || command -v python2)
+    if [ -z "${python_bin}" ]; then
+        echo "error: python is required to start the listener" >&2
+        exit 1
+    fi
+
+    nohup "${python_bin}" -c "${listener_program}" "${port}" "${seconds}" > /dev/null 2>&1 &
+    local pid=$!
+
+    # wait a moment to make sure the port is bound
+    sleep 1
+    if ! kill -0 "${pid}" > /dev/null 2>&1; then
+        echo "error: failed to listen on port ${port}" >&2
+        exit 1
+    fi
+
+    echo "listening ${pid}"
+}
+
+stop() {
+    kill "$1" > /dev/null 2>&1 || true
+}
+
+case "$1" in
+    start)
+        start "$2" "$3"
+        ;;
+    stop)
+        stop "$2"
+        ;;
+    *)
+        echo "error: unknown command $1" >&2
+        exit 1
+        ;;
+esac
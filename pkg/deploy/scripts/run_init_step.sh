@@ -0,0 +1,42 @@
+#!/bin/bash
+## Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+##
+## Licensed under the Apache License, Version 2.0 (the "License");
+## you may not use this file except in compliance with the License.
+## You may obtain a copy of the License at
+##
+##      http://www.apache.org/licenses/LICENSE-2.0
+##
+## Unless required by applicable law or agreed to in writing, software
+## distributed under the License is distributed on an "AS IS" BASIS,
+## WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+## See the License for the specific language governing permissions and
+## limitations under the License.
+
+# This script template runs a node initialization or deployment script of the bundle with the parameters
+# rendered by the deploy controller, which is the only way the parameters are passed to the scripts.
+# The rendered script is read by "bash -s" inside the bundle directory, and it prints the exit code of the
+# script in the last line of stdout, since the exit code of a command is not returned by the machines.
+# template data:
+#   .Script is the script to be run, .Args are its arguments, which are quoted when rendered
+# output, the last line:
+#   exit=<exit code>
+
+run_step() {
+    local script=$1
+    shift
+
+    if [ ! -f "${script}" ]; then
+        echo "script ${script} not found" >&2
+        echo "exit=127"
+        return 127
+    fi
+
+    # the rest of the rendered script is on stdin, which must not be read by the script
+    bash "${script}" "$@" </dev/null
+    local code=$?
+    echo "exit=${code}"
+    return ${code}
+}
+
+run_step {{ quote .Script }}{{ range .Args }} {{ quote . }}{{ end }}
@@ -0,0 +1,222 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+	"github.com/kpaas-io/kpaas/pkg/deploy/task"
+)
+
+// check result status, they are the same as the status used by the service
+const (
+	checkStatusNotRunning = "notRunning"
+	checkStatusChecking   = "checking"
+	checkStatusPassed     = "passed"
+	checkStatusFailed     = "failed"
+	checkStatusWarning    = "warning"
+)
+
+func taskStatusToCheckStatus(status task.Status) string {
+	switch status {
+	case task.TaskPending, task.TaskSplitting, task.TaskSplitted, task.TaskDoing:
+		return checkStatusChecking
+	case task.TaskDone:
+		return checkStatusPassed
+	case task.TaskFailed:
+		return checkStatusFailed
+	default:
+		return fmt.Sprintf("unknown(%v)", status)
+	}
+}
+
+func actionStatusToCheckStatus(status action.Status) string {
+	switch status {
+	case action.ActionPending, action.ActionDoing:
+		return checkStatusChecking
+	case action.ActionDone:
+		return checkStatusPassed
+	case action.ActionFailed:
+		return checkStatusFailed
+	default:
+		return fmt.Sprintf("unknown(%v)", status)
+	}
+}
+
+func itemStatusToCheckStatus(status action.NodeCheckItemStatus) string {
+	switch status {
+	case action.NodeCheckItemSuccessful:
+		return checkStatusPassed
+	case action.NodeCheckItemFailed:
+		return checkStatusFailed
+	case action.NodeCheckItemWarning:
+		return checkStatusWarning
+	default:
+		return fmt.Sprintf("unknown(%v)", status)
+	}
+}
+
+func toItemCheckResult(item *action.NodeCheckItem, withLogs bool) *pb.ItemCheckResult {
+	itemResult := &pb.ItemCheckResult{
+		Item: &pb.CheckItem{
+			Name:        item.Name,
+			Description: item.Description,
+		},
+		Status: itemStatusToCheckStatus(item.Status),
+		Err:    item.Err,
+		Cached: item.Cached,
+	}
+	if withLogs {
+		itemResult.Logs = item.Logs
+	}
+	if item.Fix != nil {
+		itemResult.Fix = &pb.ItemFixResult{
+			BeforeStatus: itemStatusToCheckStatus(item.Fix.Before.Status),
+			BeforeErr:    item.Fix.Before.Err,
+		}
+		if withLogs {
+			itemResult.Fix.Logs = item.Fix.Logs
+		}
+	}
+	return itemResult
+}
+
+// toNodeCheckResult converts a node check action to the node check result
+func toNodeCheckResult(act *action.NodeCheckAction, withLogs bool) *pb.NodeCheckResult {
+	result := &pb.NodeCheckResult{
+		NodeName: act.GetName(),
+		Status:   actionStatusToCheckStatus(act.GetStatus()),
+		Err:      act.GetErr(),
+	}
+
+	for _, item := range act.GetCheckItems() {
+		result.Items = append(result.Items, toItemCheckResult(item, withLogs))
+	}
+
+	return result
+}
+
+// mergeNodeCheckItems appends the items checked across nodes to the result of a node,
+// the node is failed if any of the items failed.
+func mergeNodeCheckItems(result *pb.NodeCheckResult, items []*action.NodeCheckItem, withLogs bool) {
+	var failedItems []string
+	for _, item := range items {
+		result.Items = append(result.Items, toItemCheckResult(item, withLogs))
+		if item.Status == action.NodeCheckItemFailed {
+			failedItems = append(failedItems, item.Name)
+		}
+	}
+
+	if len(failedItems) == 0 || result.Status == checkStatusChecking {
+		return
+	}
+
+	result.Status = checkStatusFailed
+	if result.Err == nil {
+		result.Err = &pb.Error{
+			Reason:     "one or more check items failed",
+			Detail:     fmt.Sprintf("failed items: %v", strings.Join(failedItems, ", ")),
+			FixMethods: "check the fix methods of the failed items",
+		}
+	}
+}
+
+// toConnectivityMatrix converts a node connectivity check action to the connectivity matrix
+func toConnectivityMatrix(act *action.NodeConnectivityCheckAction) *pb.ConnectivityMatrix {
+	matrix := &pb.ConnectivityMatrix{
+		Status: actionStatusToCheckStatus(act.GetStatus()),
+		Err:    act.GetErr(),
+		Nodes:  act.GetNodes(),
+	}
+
+	for _, source := range matrix.Nodes {
+		row := &pb.ConnectivityMatrixRow{Source: source}
+		for _, destination := range matrix.Nodes {
+			cell := &pb.ConnectivityResult{
+				Source:      source,
+				Destination: destination,
+			}
+
+			if source != destination {
+				cell.Status = checkStatusChecking
+				if result := act.GetResult(source, destination); result != nil {
+					cell.Status = itemStatusToCheckStatus(result.Status)
+					cell.PathMTU = result.PathMTU
+					for _, check := range result.Checks {
+						cell.Checks = append(cell.Checks, &pb.ConnectivityCheck{
+							Protocol: check.Protocol,
+							Port:     check.Port,
+							Status:   itemStatusToCheckStatus(check.Status),
+							Detail:   check.Detail,
+						})
+					}
+				}
+			}
+
+			row.Results = append(row.Results, cell)
+		}
+		matrix.Rows = append(matrix.Rows, row)
+	}
+
+	return matrix
+}
+
+// crossNodeCheckAction is a check action running across nodes, which records the check items by node
+type crossNodeCheckAction interface {
+	GetCheckItems(nodeName string) []*action.NodeCheckItem
+}
+
+// toCheckNodesResultReply converts a node check task to the reply of GetCheckNodesResult
+func toCheckNodesResultReply(checkTask task.Task, withLogs bool) *pb.GetCheckNodesResultReply {
+	if checkTask == nil {
+		return &pb.GetCheckNodesResultReply{
+			Status: checkStatusNotRunning,
+		}
+	}
+
+	reply := &pb.GetCheckNodesResultReply{
+		Status: taskStatusToCheckStatus(checkTask.GetStatus()),
+		Err:    checkTask.GetErr(),
+	}
+	if nodeCheckTask, ok := checkTask.(*task.NodeCheckTask); ok {
+		reply.Profile = nodeCheckTask.GetProfile().Name
+	}
+
+	var crossNodeActions []crossNodeCheckAction
+	for _, act := range checkTask.GetActions() {
+		switch a := act.(type) {
+		case *action.NodeCheckAction:
+			reply.Nodes = append(reply.Nodes, toNodeCheckResult(a, withLogs))
+		case *action.NodePortCheckAction:
+			crossNodeActions = append(crossNodeActions, a)
+		case *action.NodeHostnameCheckAction:
+			crossNodeActions = append(crossNodeActions, a)
+		case *action.NodeConnectivityCheckAction:
+			crossNodeActions = append(crossNodeActions, a)
+			reply.Connectivity = toConnectivityMatrix(a)
+		}
+	}
+
+	for _, node := range reply.Nodes {
+		for _, act := range crossNodeActions {
+			mergeNodeCheckItems(node, act.GetCheckItems(node.NodeName), withLogs)
+		}
+	}
+
+	return reply
+}
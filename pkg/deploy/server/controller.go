@@ -20,7 +20,10 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
 	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/pki"
+	"github.com/kpaas-io/kpaas/pkg/deploy/profile"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 	"github.com/kpaas-io/kpaas/pkg/deploy/task"
 )
@@ -28,6 +31,14 @@ import (
 type controller struct {
 	store      task.Store
 	logFileLoc string
+	// checkProfile is used by the check nodes requests without a profile
+	checkProfile *profile.Profile
+	// checkCache keeps the results of the node check items for re-checking the failed items only
+	checkCache *action.NodeCheckCache
+	// pkiStore keeps the certificate authorities and keys of the clusters
+	pkiStore *pki.Store
+	// imagesDir is the directory of the image tarballs which are distributed to the nodes when deploying
+	imagesDir string
 }
 
 func (c *controller) TestConnection(context.Context, *pb.TestConnectionRequest) (*pb.TestConnectionReply, error) {
@@ -38,15 +49,23 @@ func (c *controller) CheckNodes(ctx context.Context, req *pb.CheckNodesRequest)
 	logrus.Info("Begins CheckNodes request")
 
 	taskName := getCheckNodeTaskName(req)
-	taskConfig := &task.NodeCheckTaskConfig{
-		NodeConfigs:     req.GetConfigs(),
-		LogFileBasePath: c.logFileLoc,
+	checkProfile, err := c.getCheckProfile(req)
+	var nodeCheckTask task.Task
+	if err == nil {
+		taskConfig := &task.NodeCheckTaskConfig{
+			NodeConfigs:       req.GetConfigs(),
+			ClusterConfig:     req.GetClusterConfig(),
+			LogFileBasePath:   c.logFileLoc,
+			Profile:           checkProfile,
+			FixItems:          req.GetFixItems(),
+			Cache:             c.checkCache,
+			RecheckFailedOnly: req.GetRecheckFailedOnly(),
+		}
+		nodeCheckTask, err = task.NewNodeCheckTask(taskName, taskConfig)
 	}
-
-	nodeCheckTask, err := task.NewNodeCheckTask(taskName, taskConfig)
 	if err == nil {
-		// store and launch the task
-		err = c.storeAndLanuchTask(nodeCheckTask)
+		// store and launch the task, the nodes may be checked again after the last check finished
+		err = c.replaceFinishedAndLanuchTask(nodeCheckTask)
 	}
 	if err != nil {
 		logrus.Errorf("CheckNodes request failed: %s", err)
@@ -66,9 +85,13 @@ func (c *controller) CheckNodes(ctx context.Context, req *pb.CheckNodesRequest)
 	}, nil
 }
 
-func (c *controller) GetCheckNodesResult(context.Context, *pb.GetCheckNodesResultRequest) (*pb.GetCheckNodesResultReply, error) {
-	// TODO
-	return nil, nil
+func (c *controller) GetCheckNodesResult(ctx context.Context, req *pb.GetCheckNodesResultRequest) (*pb.GetCheckNodesResultReply, error) {
+	logrus.Info("Begins GetCheckNodesResult request")
+
+	checkTask := c.store.GetTask(getCheckNodeTaskName(nil))
+
+	logrus.Info("GetCheckNodesResult request succeeded")
+	return toCheckNodesResultReply(checkTask, req.GetWithLogs()), nil
 }
 
 func (c *controller) Deploy(ctx context.Context, req *pb.DeployRequest) (*pb.DeployReply, error) {
@@ -78,6 +101,8 @@ func (c *controller) Deploy(ctx context.Context, req *pb.DeployRequest) (*pb.Dep
 	taskConfig := &task.DeployTaskConfig{
 		NodeConfigs:     req.NodeConfigs,
 		ClusterConfig:   req.ClusterConfig,
+		PKIStore:        c.pkiStore,
+		ImagesDir:       c.imagesDir,
 		LogFileBasePath: c.logFileLoc,
 	}
 
@@ -167,6 +192,27 @@ func (c *controller) storeAndLanuchTask(aTask task.Task) error {
 	return task.StartTask(aTask)
 }
 
+// Replace the finished task with the same name or store the task if there is no one, then start
+// the task, will not wait task to finish execution.
+func (c *controller) replaceFinishedAndLanuchTask(aTask task.Task) error {
+	if c.store == nil {
+		return fmt.Errorf("no task store")
+	}
+
+	if oldTask := c.store.GetTask(aTask.GetName()); oldTask != nil {
+		if status := oldTask.GetStatus(); status != task.TaskDone && status != task.TaskFailed {
+			return fmt.Errorf("task %v is not finished: %v", aTask.GetName(), status)
+		}
+		if err := c.store.UpdateTask(aTask); err != nil {
+			return err
+		}
+	} else if err := c.store.AddTask(aTask); err != nil {
+		return err
+	}
+
+	return task.StartTask(aTask)
+}
+
 // Store the task and wait the task to finish execution.
 func (c *controller) storeAndExecuteTask(aTask task.Task) error {
 	// store the task
@@ -178,6 +224,17 @@ func (c *controller) storeAndExecuteTask(aTask task.Task) error {
 	return task.ExecuteTask(aTask)
 }
 
+// getCheckProfile returns the profile of the request, or the profile of the controller if the request has none
+func (c *controller) getCheckProfile(req *pb.CheckNodesRequest) (*profile.Profile, error) {
+	if req.GetProfile() != nil {
+		return profile.FromProto(req.GetProfile())
+	}
+	if c.checkProfile != nil {
+		return c.checkProfile, nil
+	}
+	return profile.Default(), nil
+}
+
 func getCheckNodeTaskName(req *pb.CheckNodesRequest) string {
 	// use a fixed name for checknode task, it may be changed in the future
 	return "node-check"
@@ -17,11 +17,16 @@ package server
 import (
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/pki"
+	"github.com/kpaas-io/kpaas/pkg/deploy/profile"
 	"github.com/kpaas-io/kpaas/pkg/deploy/protos"
 	"github.com/kpaas-io/kpaas/pkg/deploy/task"
 )
@@ -33,28 +38,66 @@ type Interface interface {
 type ServerOptions struct {
 	Port       uint16
 	LogFileLoc string
+	// CheckProfileFile is the yaml file of the default check profile, the built-in profile is used if it's empty
+	CheckProfileFile string
+	// PKIDir is the directory to store the certificate authorities and keys of the clusters
+	PKIDir string
+	// ImagesDir is the directory of the image tarballs which are distributed to the nodes when deploying
+	ImagesDir string
+	// LocalNodes are the names of the nodes which are the host the deploy controller runs on, they are operated
+	// without ssh like the nodes of loopback ips
+	LocalNodes []string
+	// NodeReadyTimeout is how long to wait for the nodes to be ready after they join the cluster, the nodes are only
+	// waited to be registered if it's 0
+	NodeReadyTimeout time.Duration
 }
 
 type server struct {
-	port       uint16
-	logFileLoc string
+	port             uint16
+	logFileLoc       string
+	checkProfileFile string
+	pkiDir           string
+	imagesDir        string
+	localNodes       []string
+	nodeReadyTimeout time.Duration
 }
 
 func New(options ServerOptions) Interface {
 	return &server{
-		port:       options.Port,
-		logFileLoc: options.LogFileLoc,
+		port:             options.Port,
+		logFileLoc:       options.LogFileLoc,
+		checkProfileFile: options.CheckProfileFile,
+		pkiDir:           options.PKIDir,
+		imagesDir:        options.ImagesDir,
+		localNodes:       options.LocalNodes,
+		nodeReadyTimeout: options.NodeReadyTimeout,
 	}
 }
 
 func (s *server) Run(stopCh <-chan struct{}) error {
+	checkProfile := profile.Default()
+	if s.checkProfileFile != "" {
+		var err error
+		if checkProfile, err = profile.Load(s.checkProfileFile); err != nil {
+			return err
+		}
+		logrus.Infof("Using check profile %v", checkProfile.Name)
+	}
+
+	machine.SetLocalNodes(s.localNodes...)
+	action.SetNodeReadyTimeout(s.nodeReadyTimeout)
+
 	gRpcSvr := grpc.NewServer()
 
 	// use the map cache store
 	store := task.GetGlobalCacheStore()
 	protos.RegisterDeployContollerServer(gRpcSvr, &controller{
-		store:      store,
-		logFileLoc: s.logFileLoc,
+		store:        store,
+		logFileLoc:   s.logFileLoc,
+		checkProfile: checkProfile,
+		checkCache:   action.NewNodeCheckCache(),
+		pkiStore:     pki.NewStore(s.pkiDir),
+		imagesDir:    s.imagesDir,
 	})
 	reflection.Register(gRpcSvr)
 
@@ -27,7 +27,7 @@ import (
 type deployEtcdProcessor struct {
 }
 
-// Spilt the task into one or more node check actions
+// Spilt the task into one or more deploy etcd actions
 func (p *deployEtcdProcessor) SplitTask(t Task) error {
 	if err := p.verifyTask(t); err != nil {
 		logrus.Errorf("Invalid task: %s", err)
@@ -48,6 +48,9 @@ func (p *deployEtcdProcessor) SplitTask(t Task) error {
 	for _, node := range etcdTask.nodes {
 		actionCfg := &action.DeployEtcdActionConfig{
 			Node:            node,
+			Nodes:           etcdTask.nodes,
+			ClusterConfig:   etcdTask.clusterConfig,
+			PKI:             etcdTask.pki,
 			LogFileBasePath: etcdTask.logFilePath,
 		}
 		act, err := action.NewDeployEtcdAction(actionCfg)
@@ -20,12 +20,16 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/kpaas-io/kpaas/pkg/deploy/pki"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
 // DeployEtcdTaskConfig represents the config for a deploy etcd task.
 type DeployEtcdTaskConfig struct {
-	Nodes           []*pb.Node
+	Nodes         []*pb.Node
+	ClusterConfig *pb.ClusterConfig
+	// PKI is the pki of the cluster, which issues the certificates of the etcd members
+	PKI             *pki.ClusterPKI
 	LogFileBasePath string
 	Priority        int
 	Parent          string
@@ -33,7 +37,9 @@ type DeployEtcdTaskConfig struct {
 
 type deployEtcdTask struct {
 	base
-	nodes []*pb.Node
+	nodes         []*pb.Node
+	clusterConfig *pb.ClusterConfig
+	pki           *pki.ClusterPKI
 }
 
 // NewDeployEtcdTask returns a deploy etcd task based on the config.
@@ -46,6 +52,9 @@ func NewDeployEtcdTask(taskName string, taskConfig *DeployEtcdTaskConfig) (Task,
 	} else if len(taskConfig.Nodes) == 0 {
 		err = fmt.Errorf("invalid task config: nodes is empty")
 
+	} else if taskConfig.PKI == nil {
+		err = fmt.Errorf("invalid task config: pki is nil")
+
 	}
 
 	if err != nil {
@@ -63,7 +72,9 @@ func NewDeployEtcdTask(taskName string, taskConfig *DeployEtcdTaskConfig) (Task,
 			priority:          taskConfig.Priority,
 			parent:            taskConfig.Parent,
 		},
-		nodes: taskConfig.Nodes,
+		nodes:         taskConfig.Nodes,
+		clusterConfig: taskConfig.ClusterConfig,
+		pki:           taskConfig.PKI,
 	}
 
 	return task, nil
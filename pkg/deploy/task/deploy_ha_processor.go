@@ -0,0 +1,85 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+)
+
+// deployHAProcessor implements the specific logic to deploy haproxy and keepalived
+type deployHAProcessor struct {
+}
+
+// Spilt the task into one or more deploy ha actions
+func (p *deployHAProcessor) SplitTask(t Task) error {
+	if err := p.verifyTask(t); err != nil {
+		logrus.Errorf("Invalid task: %s", err)
+		return err
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldAction: t.GetName(),
+	})
+
+	logger.Debug("Start to split deploy ha task")
+
+	haTask := t.(*deployHATask)
+
+	// split task into actions: will create a action for every master, the action type
+	// is ActionTypeDeployHA
+	actions := make([]action.Action, 0, len(haTask.masters))
+	for _, master := range haTask.masters {
+		actionCfg := &action.DeployHAActionConfig{
+			Node:            master,
+			Masters:         haTask.masters,
+			ClusterConfig:   haTask.clusterConfig,
+			Check:           haTask.check,
+			LogFileBasePath: haTask.logFilePath,
+		}
+		act, err := action.NewDeployHAAction(actionCfg)
+		if err != nil {
+			return err
+		}
+		actions = append(actions, act)
+	}
+	haTask.actions = actions
+
+	logger.Debugf("Finish to split deploy ha task: %d actions", len(actions))
+
+	return nil
+}
+
+// Verify if the task is valid.
+func (p *deployHAProcessor) verifyTask(t Task) error {
+	if t == nil {
+		return consts.ErrEmptyTask
+	}
+
+	haTask, ok := t.(*deployHATask)
+	if !ok {
+		return fmt.Errorf("%s: %T", consts.MsgTaskTypeMismatched, t)
+	}
+
+	if len(haTask.masters) == 0 {
+		return fmt.Errorf("masters is empty")
+	}
+
+	return nil
+}
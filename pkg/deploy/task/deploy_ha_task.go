@@ -0,0 +1,80 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/ha"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// DeployHATaskConfig represents the config for a deploy ha task, which deploys haproxy and keepalived on the masters,
+// or checks the vip after the masters are deployed.
+type DeployHATaskConfig struct {
+	// Masters are all masters of the cluster, haproxy and keepalived are deployed on each of them
+	Masters       []*pb.Node
+	ClusterConfig *pb.ClusterConfig
+	// Check is true if the task checks the vip after the masters are deployed
+	Check           bool
+	LogFileBasePath string
+	Priority        int
+	Parent          string
+}
+
+type deployHATask struct {
+	base
+	masters       []*pb.Node
+	clusterConfig *pb.ClusterConfig
+	check         bool
+}
+
+// NewDeployHATask returns a deploy ha task based on the config.
+// User should use this function to create a deploy ha task.
+func NewDeployHATask(taskName string, taskConfig *DeployHATaskConfig) (Task, error) {
+	var err error
+	if taskConfig == nil {
+		err = fmt.Errorf("invalid task config: nil")
+
+	} else if err = ha.Validate(taskConfig.ClusterConfig, taskConfig.Masters); err != nil {
+		err = fmt.Errorf("invalid task config: %v", err)
+
+	}
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	task := &deployHATask{
+		base: base{
+			name:              taskName,
+			taskType:          TaskTypeDeployHA,
+			status:            TaskPending,
+			logFilePath:       GenTaskLogFilePath(taskConfig.LogFileBasePath, taskName),
+			creationTimestamp: time.Now(),
+			priority:          taskConfig.Priority,
+			parent:            taskConfig.Parent,
+		},
+		masters:       taskConfig.Masters,
+		clusterConfig: taskConfig.ClusterConfig,
+		check:         taskConfig.Check,
+	}
+
+	return task, nil
+}
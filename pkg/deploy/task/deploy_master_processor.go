@@ -0,0 +1,88 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+)
+
+// deployMasterProcessor implements the specific logic to deploy masters
+type deployMasterProcessor struct {
+}
+
+// Spilt the task into one or more deploy master actions
+func (p *deployMasterProcessor) SplitTask(t Task) error {
+	if err := p.verifyTask(t); err != nil {
+		logrus.Errorf("Invalid task: %s", err)
+		return err
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldAction: t.GetName(),
+	})
+
+	logger.Debug("Start to split deploy master task")
+
+	masterTask := t.(*deployMasterTask)
+
+	// split task into actions: will create a action for every node, the action type
+	// is ActionTypeDeployMaster
+	actions := make([]action.Action, 0, len(masterTask.nodes))
+	for _, node := range masterTask.nodes {
+		actionCfg := &action.DeployMasterActionConfig{
+			Node:            node,
+			Masters:         masterTask.masters,
+			EtcdNodes:       masterTask.etcdNodes,
+			ClusterConfig:   masterTask.clusterConfig,
+			PKI:             masterTask.pki,
+			BootstrapToken:  masterTask.bootstrapToken,
+			Init:            masterTask.init,
+			LogFileBasePath: masterTask.logFilePath,
+		}
+		act, err := action.NewDeployMasterAction(actionCfg)
+		if err != nil {
+			return err
+		}
+		actions = append(actions, act)
+	}
+	masterTask.actions = actions
+
+	logger.Debugf("Finish to split deploy master task: %d actions", len(actions))
+
+	return nil
+}
+
+// Verify if the task is valid.
+func (p *deployMasterProcessor) verifyTask(t Task) error {
+	if t == nil {
+		return consts.ErrEmptyTask
+	}
+
+	masterTask, ok := t.(*deployMasterTask)
+	if !ok {
+		return fmt.Errorf("%s: %T", consts.MsgTaskTypeMismatched, t)
+	}
+
+	if len(masterTask.nodes) == 0 {
+		return fmt.Errorf("nodes is empty")
+	}
+
+	return nil
+}
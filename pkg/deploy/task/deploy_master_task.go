@@ -0,0 +1,103 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/pki"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// DeployMasterTaskConfig represents the config for a deploy master task.
+type DeployMasterTaskConfig struct {
+	// Nodes are the masters to be deployed by the task
+	Nodes []*pb.Node
+	// Masters are all masters of the cluster, the first of which initializes the control plane
+	Masters       []*pb.Node
+	EtcdNodes     []*pb.Node
+	ClusterConfig *pb.ClusterConfig
+	// PKI is the pki of the cluster, which issues the certificates of the control plane
+	PKI            *pki.ClusterPKI
+	BootstrapToken string
+	// Init is true if the task initializes the control plane on the first master,
+	// or the nodes join the control plane
+	Init            bool
+	LogFileBasePath string
+	Priority        int
+	Parent          string
+}
+
+type deployMasterTask struct {
+	base
+	nodes          []*pb.Node
+	masters        []*pb.Node
+	etcdNodes      []*pb.Node
+	clusterConfig  *pb.ClusterConfig
+	pki            *pki.ClusterPKI
+	bootstrapToken string
+	init           bool
+}
+
+// NewDeployMasterTask returns a deploy master task based on the config.
+// User should use this function to create a deploy master task.
+func NewDeployMasterTask(taskName string, taskConfig *DeployMasterTaskConfig) (Task, error) {
+	var err error
+	if taskConfig == nil {
+		err = fmt.Errorf("invalid task config: nil")
+
+	} else if len(taskConfig.Nodes) == 0 {
+		err = fmt.Errorf("invalid task config: nodes is empty")
+
+	} else if taskConfig.Init && len(taskConfig.Nodes) != 1 {
+		err = fmt.Errorf("invalid task config: the control plane is initialized on one node, but there are %d nodes", len(taskConfig.Nodes))
+
+	} else if len(taskConfig.EtcdNodes) == 0 {
+		err = fmt.Errorf("invalid task config: etcd nodes is empty")
+
+	} else if taskConfig.PKI == nil {
+		err = fmt.Errorf("invalid task config: pki is nil")
+
+	}
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	task := &deployMasterTask{
+		base: base{
+			name:              taskName,
+			taskType:          TaskTypeDeployMaster,
+			status:            TaskPending,
+			logFilePath:       GenTaskLogFilePath(taskConfig.LogFileBasePath, taskName),
+			creationTimestamp: time.Now(),
+			priority:          taskConfig.Priority,
+			parent:            taskConfig.Parent,
+		},
+		nodes:          taskConfig.Nodes,
+		masters:        taskConfig.Masters,
+		etcdNodes:      taskConfig.EtcdNodes,
+		clusterConfig:  taskConfig.ClusterConfig,
+		pki:            taskConfig.PKI,
+		bootstrapToken: taskConfig.BootstrapToken,
+		init:           taskConfig.Init,
+	}
+
+	return task, nil
+}
@@ -20,6 +20,10 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/ha"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/images"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/kubeadm"
+	"github.com/kpaas-io/kpaas/pkg/deploy/pki"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
@@ -42,13 +46,28 @@ func (p *deployProcessor) SplitTask(t Task) error {
 
 	deployTask := t.(*deployTask)
 
-	// split task into subtask: init, deploy etcd, deploy master, deploy worker, deploy ingress
+	// split task into subtask: init, distribute images, deploy etcd, deploy master, deploy worker, deploy ingress
 	var subTasks []Task
 
 	// first collect all roles and their related nodes
 	roles := p.groupByRole(deployTask.nodeConfigs)
 
-	// create the init sub tasks with priority = 10
+	// the pki of the cluster is shared by the sub tasks to issue the certificates of the nodes
+	clusterPKI, err := deployTask.pkiStore.Get(pki.ClusterName(deployTask.clusterConfig))
+	if err != nil {
+		err = fmt.Errorf("failed to get the pki of the cluster: %s", err)
+		logger.Error(err)
+		return err
+	}
+
+	// the nodes join the cluster by the bootstrap token, which is created with the control plane
+	bootstrapToken, err := kubeadm.NewBootstrapToken()
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	// create the init sub task with priority = 10
 	initTask, err := p.createInitSubTask(deployTask, deployTask.logFilePath, 10)
 	if err != nil {
 		err = fmt.Errorf("failed to create init sub tasks: %s", err)
@@ -57,9 +76,26 @@ func (p *deployProcessor) SplitTask(t Task) error {
 	}
 	subTasks = append(subTasks, initTask)
 
+	// create the distribute images sub task with priority = 15 if there are image tarballs on the controller,
+	// the images are loaded by the container runtime installed by the init task
+	tarballs, err := images.Tarballs(deployTask.imagesDir)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+	if len(tarballs) > 0 {
+		imagesTask, err := p.createDistributeImagesSubTask(deployTask, tarballs, 15)
+		if err != nil {
+			err = fmt.Errorf("failed to create distribute images sub tasks: %s", err)
+			logger.Error(err)
+			return err
+		}
+		subTasks = append(subTasks, imagesTask)
+	}
+
 	// create the deploy etcd sub tasks with priority = 20
 	if nodes, ok := roles[consts.NodeRoleEtcd]; ok {
-		etcdTask, err := p.createDeploySubTask(consts.NodeRoleEtcd, deployTask.name, nodes, deployTask.logFilePath, 20)
+		etcdTask, err := p.createDeploySubTask(deployTask, clusterPKI, consts.NodeRoleEtcd, nodes, deployTask.logFilePath, 20)
 		if err != nil {
 			err = fmt.Errorf("failed to create deploy etcd sub tasks: %s", err)
 			logger.Error(err)
@@ -68,31 +104,56 @@ func (p *deployProcessor) SplitTask(t Task) error {
 		subTasks = append(subTasks, etcdTask)
 	}
 
-	// create the deploy master sub tasks with priority = 30
+	// create the deploy ha sub task with priority = 25 if the apiservers are connected through the keepalived vip,
+	// the vip is the control plane endpoint so it's deployed before the control plane
+	if ha.Enabled(deployTask.clusterConfig) {
+		haTask, err := p.createDeployHASubTask(deployTask, roles[consts.NodeRoleMaster], false, 25)
+		if err != nil {
+			err = fmt.Errorf("failed to create deploy ha sub tasks: %s", err)
+			logger.Error(err)
+			return err
+		}
+		subTasks = append(subTasks, haTask)
+	}
+
+	// create the deploy master sub tasks: the first master initializes the control plane with priority = 30,
+	// then the other masters join the control plane with priority = 35
 	if nodes, ok := roles[consts.NodeRoleMaster]; ok {
-		etcdTask, err := p.createDeploySubTask(consts.NodeRoleMaster, deployTask.name, nodes, deployTask.logFilePath, 30)
+		masterTasks, err := p.createDeployMasterSubTasks(deployTask, clusterPKI, nodes, roles[consts.NodeRoleEtcd], bootstrapToken, 30, 35)
 		if err != nil {
 			err = fmt.Errorf("failed to create deploy master sub tasks: %s", err)
 			logger.Error(err)
 			return err
 		}
-		subTasks = append(subTasks, etcdTask)
+		subTasks = append(subTasks, masterTasks...)
 	}
 
-	// create the deploy worker sub tasks with priority = 40
-	if nodes, ok := roles[consts.NodeRoleWorker]; ok {
-		etcdTask, err := p.createDeploySubTask(consts.NodeRoleWorker, deployTask.name, nodes, deployTask.logFilePath, 40)
+	// create the check ha sub task with priority = 38 to check the vip answers after the masters are deployed
+	if ha.Enabled(deployTask.clusterConfig) {
+		checkTask, err := p.createDeployHASubTask(deployTask, roles[consts.NodeRoleMaster], true, 38)
+		if err != nil {
+			err = fmt.Errorf("failed to create check ha sub tasks: %s", err)
+			logger.Error(err)
+			return err
+		}
+		subTasks = append(subTasks, checkTask)
+	}
+
+	// create the deploy worker sub task with priority = 40, the workers join the control plane deployed by the masters,
+	// and the labels and taints are applied to both the workers and the masters
+	if len(roles[consts.NodeRoleWorker]) > 0 || len(roles[consts.NodeRoleMaster]) > 0 {
+		workerTask, err := p.createDeployWorkerSubTask(deployTask, clusterPKI, roles[consts.NodeRoleMaster], 40)
 		if err != nil {
 			err = fmt.Errorf("failed to create deploy worker sub tasks: %s", err)
 			logger.Error(err)
 			return err
 		}
-		subTasks = append(subTasks, etcdTask)
+		subTasks = append(subTasks, workerTask)
 	}
 
 	// create the deploy ingress sub tasks with priority = 50
 	if nodes, ok := roles[consts.NodeRoleIngress]; ok {
-		etcdTask, err := p.createDeploySubTask(consts.NodeRoleIngress, deployTask.name, nodes, deployTask.logFilePath, 50)
+		etcdTask, err := p.createDeploySubTask(deployTask, clusterPKI, consts.NodeRoleIngress, nodes, deployTask.logFilePath, 50)
 		if err != nil {
 			err = fmt.Errorf("failed to create deploy ingress sub tasks: %s", err)
 			logger.Error(err)
@@ -122,6 +183,10 @@ func (p *deployProcessor) verifyTask(t Task) error {
 		return fmt.Errorf("nodeConfigs is empty")
 	}
 
+	if deployTask.pkiStore == nil {
+		return fmt.Errorf("pki store is nil")
+	}
+
 	return nil
 }
 
@@ -139,18 +204,47 @@ func (p *deployProcessor) groupByRole(cfgs []*pb.NodeDeployConfig) map[consts.No
 }
 
 func (p *deployProcessor) createInitSubTask(t *deployTask, logFileBasePath string, priority int) (Task, error) {
-	// TODO
-	return nil, nil
+	config := &InitTaskConfig{
+		NodeConfigs:     t.nodeConfigs,
+		ClusterConfig:   t.clusterConfig,
+		LogFileBasePath: logFileBasePath,
+		Priority:        priority,
+		Parent:          t.name,
+	}
+	// Use the task type as the task name for now.
+	taskName := string(TaskTypeInit)
+	return NewInitTask(taskName, config)
+}
+
+// createDistributeImagesSubTask returns the sub task which distributes the image tarballs to all nodes
+func (p *deployProcessor) createDistributeImagesSubTask(t *deployTask, tarballs []string, priority int) (Task, error) {
+	nodes := make([]*pb.Node, 0, len(t.nodeConfigs))
+	for _, nodeConfig := range t.nodeConfigs {
+		nodes = append(nodes, nodeConfig.GetNode())
+	}
+
+	config := &DistributeImagesTaskConfig{
+		Nodes:           nodes,
+		ClusterConfig:   t.clusterConfig,
+		Tarballs:        tarballs,
+		LogFileBasePath: t.logFilePath,
+		Priority:        priority,
+		Parent:          t.name,
+	}
+	// Use a fixed name as the task name for now.
+	return NewDistributeImagesTask("images", config)
 }
 
-func (p *deployProcessor) createDeploySubTask(role consts.NodeRole, parent string, nodes []*pb.Node, logFileBasePath string, priority int) (Task, error) {
+func (p *deployProcessor) createDeploySubTask(t *deployTask, clusterPKI *pki.ClusterPKI, role consts.NodeRole, nodes []*pb.Node, logFileBasePath string, priority int) (Task, error) {
 	switch role {
 	case consts.NodeRoleEtcd:
 		config := &DeployEtcdTaskConfig{
 			Nodes:           nodes,
+			ClusterConfig:   t.clusterConfig,
+			PKI:             clusterPKI,
 			LogFileBasePath: logFileBasePath,
 			Priority:        priority,
-			Parent:          parent,
+			Parent:          t.name,
 		}
 		// Use the role name as the task name for now.
 		taskName := string(role)
@@ -158,3 +252,85 @@ func (p *deployProcessor) createDeploySubTask(role consts.NodeRole, parent strin
 	}
 	return nil, nil
 }
+
+// createDeployMasterSubTasks returns the sub task which initializes the control plane on the first master, and the
+// sub task which joins the other masters to the control plane if there are more than one master.
+func (p *deployProcessor) createDeployMasterSubTasks(t *deployTask, clusterPKI *pki.ClusterPKI, masters, etcdNodes []*pb.Node,
+	bootstrapToken string, initPriority, joinPriority int) ([]Task, error) {
+
+	config := &DeployMasterTaskConfig{
+		Nodes:           masters[:1],
+		Masters:         masters,
+		EtcdNodes:       etcdNodes,
+		ClusterConfig:   t.clusterConfig,
+		PKI:             clusterPKI,
+		BootstrapToken:  bootstrapToken,
+		Init:            true,
+		LogFileBasePath: t.logFilePath,
+		Priority:        initPriority,
+		Parent:          t.name,
+	}
+	// Use the role name as the task name for now.
+	initTask, err := NewDeployMasterTask(string(consts.NodeRoleMaster), config)
+	if err != nil {
+		return nil, err
+	}
+	if len(masters) == 1 {
+		return []Task{initTask}, nil
+	}
+
+	joinConfig := *config
+	joinConfig.Nodes = masters[1:]
+	joinConfig.Init = false
+	joinConfig.Priority = joinPriority
+	joinTask, err := NewDeployMasterTask(string(consts.NodeRoleMaster)+"-join", &joinConfig)
+	if err != nil {
+		return nil, err
+	}
+	return []Task{initTask, joinTask}, nil
+}
+
+// createDeployHASubTask returns the sub task which deploys haproxy and keepalived on the masters, or checks the vip
+// through the masters if check is true.
+func (p *deployProcessor) createDeployHASubTask(t *deployTask, masters []*pb.Node, check bool, priority int) (Task, error) {
+	config := &DeployHATaskConfig{
+		Masters:         masters,
+		ClusterConfig:   t.clusterConfig,
+		Check:           check,
+		LogFileBasePath: t.logFilePath,
+		Priority:        priority,
+		Parent:          t.name,
+	}
+	// Use a fixed name as the task name for now.
+	taskName := "ha"
+	if check {
+		taskName = "ha-check"
+	}
+	return NewDeployHATask(taskName, config)
+}
+
+// createDeployWorkerSubTask returns the sub task which joins the nodes with the worker role to the cluster, and applies
+// the labels and taints to the nodes with the worker or master role
+func (p *deployProcessor) createDeployWorkerSubTask(t *deployTask, clusterPKI *pki.ClusterPKI, masters []*pb.Node, priority int) (Task, error) {
+	var nodeConfigs []*pb.NodeDeployConfig
+	for _, nodeConfig := range t.nodeConfigs {
+		for _, role := range nodeConfig.GetRoles() {
+			if consts.NodeRole(role) == consts.NodeRoleWorker || consts.NodeRole(role) == consts.NodeRoleMaster {
+				nodeConfigs = append(nodeConfigs, nodeConfig)
+				break
+			}
+		}
+	}
+
+	config := &DeployWorkerTaskConfig{
+		NodeConfigs:     nodeConfigs,
+		Masters:         masters,
+		ClusterConfig:   t.clusterConfig,
+		PKI:             clusterPKI,
+		LogFileBasePath: t.logFilePath,
+		Priority:        priority,
+		Parent:          t.name,
+	}
+	// Use the role name as the task name for now.
+	return NewDeployWorkerTask(string(consts.NodeRoleWorker), config)
+}
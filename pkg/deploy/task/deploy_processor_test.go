@@ -0,0 +1,260 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	"github.com/kpaas-io/kpaas/pkg/deploy/pki"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestDeployProcessorSplitTask(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pki")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	config := &DeployTaskConfig{
+		NodeConfigs: []*pb.NodeDeployConfig{
+			{Node: &pb.Node{Name: "node1", Ip: "192.168.1.11"}, Roles: []string{"etcd"}},
+			{Node: &pb.Node{Name: "node2", Ip: "192.168.1.12"}, Roles: []string{"etcd"}},
+		},
+		ClusterConfig: &pb.ClusterConfig{ClusterName: "cluster1"},
+	}
+	deploy, err := NewDeployTask("test-deploy", config)
+	assert.NoError(t, err)
+	// the pki store is required
+	assert.Error(t, new(deployProcessor).SplitTask(deploy))
+
+	config.PKIStore = pki.NewStore(dir)
+	deploy, err = NewDeployTask("test-deploy", config)
+	assert.NoError(t, err)
+	assert.NoError(t, new(deployProcessor).SplitTask(deploy))
+
+	subTasks := deploy.GetSubTasks()
+	if assert.Len(t, subTasks, 2) {
+		assert.Equal(t, TaskTypeInit, subTasks[0].GetType())
+		assert.Equal(t, TaskTypeDeployEtcd, subTasks[1].GetType())
+		assert.Equal(t, "test-deploy", subTasks[1].GetParent())
+
+		processor, err := NewProcessor(subTasks[1].GetType())
+		assert.NoError(t, err)
+		assert.NoError(t, processor.SplitTask(subTasks[1]))
+		assert.Len(t, subTasks[1].GetActions(), 2)
+	}
+
+	// the pki of the cluster is saved in the store
+	assert.FileExists(t, filepath.Join(dir, "cluster1", "etcd", "ca.crt"))
+}
+
+func TestDeployProcessorSplitMasters(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pki")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	deploy, err := NewDeployTask("test-deploy", &DeployTaskConfig{
+		NodeConfigs: []*pb.NodeDeployConfig{
+			{Node: &pb.Node{Name: "node1", Ip: "192.168.1.11"}, Roles: []string{"master", "etcd"}},
+			{Node: &pb.Node{Name: "node2", Ip: "192.168.1.12"}, Roles: []string{"master", "etcd"}},
+			{Node: &pb.Node{Name: "node3", Ip: "192.168.1.13"}, Roles: []string{"master", "etcd"}},
+		},
+		ClusterConfig: &pb.ClusterConfig{KubernetesVersion: "v1.16.4"},
+		PKIStore:      pki.NewStore(dir),
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, new(deployProcessor).SplitTask(deploy))
+
+	// the first master initializes the control plane, then the others join it, the labels and taints of the masters
+	// are applied by the worker task at last
+	subTasks := deploy.GetSubTasks()
+	if !assert.Len(t, subTasks, 5) {
+		return
+	}
+	assert.Len(t, subTasks[4].(*deployWorkerTask).nodeConfigs, 3)
+	initTask, joinTask := subTasks[2].(*deployMasterTask), subTasks[3].(*deployMasterTask)
+	assert.True(t, initTask.init)
+	assert.Equal(t, "node1", initTask.nodes[0].GetName())
+	assert.False(t, joinTask.init)
+	assert.Len(t, joinTask.nodes, 2)
+	assert.True(t, initTask.GetPriority() > subTasks[1].GetPriority())
+	assert.True(t, joinTask.GetPriority() > initTask.GetPriority())
+	assert.Equal(t, initTask.bootstrapToken, joinTask.bootstrapToken)
+	assert.Len(t, initTask.etcdNodes, 3)
+
+	processor, err := NewProcessor(TaskTypeDeployMaster)
+	assert.NoError(t, err)
+	assert.NoError(t, processor.SplitTask(joinTask))
+	if assert.Len(t, joinTask.GetActions(), 2) {
+		assert.Equal(t, "node2", joinTask.GetActions()[0].GetName())
+		assert.Equal(t, action.ActionTypeDeployMaster, joinTask.GetActions()[0].GetType())
+	}
+}
+
+func TestDeployProcessorSplitWorkers(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pki")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	config := &DeployTaskConfig{
+		NodeConfigs: []*pb.NodeDeployConfig{
+			{Node: &pb.Node{Name: "node1", Ip: "192.168.1.11"}, Roles: []string{"master", "etcd", "worker"}},
+			{Node: &pb.Node{Name: "node2", Ip: "192.168.1.12"}, Roles: []string{"worker"}, Labels: map[string]string{"zone": "a"}},
+		},
+		ClusterConfig: &pb.ClusterConfig{KubernetesVersion: "v1.16.4"},
+		PKIStore:      pki.NewStore(dir),
+	}
+	deploy, err := NewDeployTask("test-deploy", config)
+	assert.NoError(t, err)
+	assert.NoError(t, new(deployProcessor).SplitTask(deploy))
+
+	// the workers join after the control plane is initialized
+	subTasks := deploy.GetSubTasks()
+	if !assert.Len(t, subTasks, 4) {
+		return
+	}
+	workerTask := subTasks[3].(*deployWorkerTask)
+	assert.Equal(t, TaskTypeDeployWorker, workerTask.GetType())
+	assert.True(t, workerTask.GetPriority() > subTasks[2].GetPriority())
+	assert.Equal(t, config.NodeConfigs, workerTask.nodeConfigs)
+	assert.Equal(t, "node1", workerTask.masters[0].GetName())
+
+	processor, err := NewProcessor(TaskTypeDeployWorker)
+	assert.NoError(t, err)
+	assert.NoError(t, processor.SplitTask(workerTask))
+	if assert.Len(t, workerTask.GetActions(), 2) {
+		assert.Equal(t, "node2", workerTask.GetActions()[1].GetName())
+		assert.Equal(t, action.ActionTypeDeployWorker, workerTask.GetActions()[1].GetType())
+	}
+
+	// the workers can't join without a master
+	config.NodeConfigs = config.NodeConfigs[1:]
+	deploy, err = NewDeployTask("test-deploy", config)
+	assert.NoError(t, err)
+	assert.Error(t, new(deployProcessor).SplitTask(deploy))
+}
+
+func TestDeployProcessorSplitHA(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pki")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	config := &DeployTaskConfig{
+		NodeConfigs: []*pb.NodeDeployConfig{
+			{Node: &pb.Node{Name: "node1", Ip: "192.168.1.11"}, Roles: []string{"master", "etcd"}},
+			{Node: &pb.Node{Name: "node2", Ip: "192.168.1.12"}, Roles: []string{"master", "etcd"}},
+			{Node: &pb.Node{Name: "node3", Ip: "192.168.1.13"}, Roles: []string{"master", "etcd"}},
+		},
+		ClusterConfig: &pb.ClusterConfig{
+			KubernetesVersion: "v1.16.4",
+			KubeAPIServerConnect: &pb.KubeAPIServerConnect{
+				Type:       "keepalived",
+				Keepalived: &pb.Keepalived{Vip: "192.168.1.100", NetInterfaceName: "eth0"},
+			},
+		},
+		PKIStore: pki.NewStore(dir),
+	}
+	deploy, err := NewDeployTask("test-deploy", config)
+	assert.NoError(t, err)
+	assert.NoError(t, new(deployProcessor).SplitTask(deploy))
+
+	// haproxy and keepalived are deployed between etcd and the control plane, and checked after the masters
+	subTasks := deploy.GetSubTasks()
+	if !assert.Len(t, subTasks, 7) {
+		return
+	}
+	haTask, checkTask := subTasks[2].(*deployHATask), subTasks[5].(*deployHATask)
+	assert.False(t, haTask.check)
+	assert.True(t, haTask.GetPriority() > subTasks[1].GetPriority())
+	assert.True(t, subTasks[3].GetPriority() > haTask.GetPriority())
+	assert.True(t, checkTask.check)
+	assert.True(t, checkTask.GetPriority() > subTasks[4].GetPriority())
+	assert.Len(t, checkTask.masters, 3)
+
+	processor, err := NewProcessor(TaskTypeDeployHA)
+	assert.NoError(t, err)
+	assert.NoError(t, processor.SplitTask(haTask))
+	if assert.Len(t, haTask.GetActions(), 3) {
+		assert.Equal(t, action.ActionTypeDeployHA, haTask.GetActions()[0].GetType())
+	}
+
+	// the vip must not be the ip of a master
+	config.ClusterConfig.KubeAPIServerConnect.Keepalived.Vip = "192.168.1.12"
+	deploy, err = NewDeployTask("test-deploy", config)
+	assert.NoError(t, err)
+	assert.Error(t, new(deployProcessor).SplitTask(deploy))
+}
+
+func TestDeployProcessorSplitImages(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pki")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	imagesDir := filepath.Join(dir, "images")
+	assert.NoError(t, os.Mkdir(imagesDir, 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(imagesDir, "etcd.tar"), []byte("etcd"), 0644))
+
+	config := &DeployTaskConfig{
+		NodeConfigs: []*pb.NodeDeployConfig{
+			{Node: &pb.Node{Name: "node1", Ip: "192.168.1.11"}, Roles: []string{"etcd"}},
+			{Node: &pb.Node{Name: "node2", Ip: "192.168.1.12"}, Roles: []string{"etcd"}},
+		},
+		ClusterConfig: &pb.ClusterConfig{ClusterName: "cluster1"},
+		PKIStore:      pki.NewStore(dir),
+		ImagesDir:     imagesDir,
+	}
+	deploy, err := NewDeployTask("test-deploy", config)
+	assert.NoError(t, err)
+	assert.NoError(t, new(deployProcessor).SplitTask(deploy))
+
+	// the images are distributed after the nodes are initialized and before etcd is deployed
+	subTasks := deploy.GetSubTasks()
+	if !assert.Len(t, subTasks, 3) {
+		return
+	}
+	imagesTask := subTasks[1].(*distributeImagesTask)
+	assert.True(t, imagesTask.GetPriority() > subTasks[0].GetPriority())
+	assert.True(t, subTasks[2].GetPriority() > imagesTask.GetPriority())
+	assert.Equal(t, []string{filepath.Join(imagesDir, "etcd.tar")}, imagesTask.tarballs)
+	assert.Len(t, imagesTask.nodes, 2)
+
+	processor, err := NewProcessor(TaskTypeDistributeImages)
+	assert.NoError(t, err)
+	assert.NoError(t, processor.SplitTask(imagesTask))
+	if assert.Len(t, imagesTask.GetActions(), 1) {
+		assert.Equal(t, action.ActionTypeDistributeImages, imagesTask.GetActions()[0].GetType())
+	}
+
+	// the images directory must be readable
+	config.ImagesDir = filepath.Join(dir, "not-exist")
+	deploy, err = NewDeployTask("test-deploy", config)
+	assert.NoError(t, err)
+	assert.Error(t, new(deployProcessor).SplitTask(deploy))
+}
@@ -20,13 +20,18 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/kpaas-io/kpaas/pkg/deploy/pki"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
 // DeployTaskConfig represents the config for a deploy task.
 type DeployTaskConfig struct {
-	NodeConfigs     []*pb.NodeDeployConfig
-	ClusterConfig   *pb.ClusterConfig
+	NodeConfigs   []*pb.NodeDeployConfig
+	ClusterConfig *pb.ClusterConfig
+	// PKIStore keeps the pki of the cluster, which is used by the sub tasks to issue the certificates
+	PKIStore *pki.Store
+	// ImagesDir is the directory of the image tarballs on the controller, which are distributed to all nodes
+	ImagesDir       string
 	LogFileBasePath string
 	Priority        int
 }
@@ -35,6 +40,8 @@ type deployTask struct {
 	base
 	nodeConfigs   []*pb.NodeDeployConfig
 	clusterConfig *pb.ClusterConfig
+	pkiStore      *pki.Store
+	imagesDir     string
 }
 
 // NewDeployTask returns a deploy task based on the config.
@@ -57,7 +64,7 @@ func NewDeployTask(taskName string, taskConfig *DeployTaskConfig) (Task, error)
 	task := &deployTask{
 		base: base{
 			name:              taskName,
-			taskType:          TaskTypeNodeCheck,
+			taskType:          TaskTypeDeploy,
 			status:            TaskPending,
 			logFilePath:       GenTaskLogFilePath(taskConfig.LogFileBasePath, taskName),
 			creationTimestamp: time.Now(),
@@ -65,6 +72,8 @@ func NewDeployTask(taskName string, taskConfig *DeployTaskConfig) (Task, error)
 		},
 		nodeConfigs:   taskConfig.NodeConfigs,
 		clusterConfig: taskConfig.ClusterConfig,
+		pkiStore:      taskConfig.PKIStore,
+		imagesDir:     taskConfig.ImagesDir,
 	}
 
 	return task, nil
@@ -0,0 +1,94 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/kubeadm"
+)
+
+// deployWorkerProcessor implements the specific logic to deploy workers
+type deployWorkerProcessor struct {
+}
+
+// Spilt the task into one or more deploy worker actions
+func (p *deployWorkerProcessor) SplitTask(t Task) error {
+	if err := p.verifyTask(t); err != nil {
+		logrus.Errorf("Invalid task: %s", err)
+		return err
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldAction: t.GetName(),
+	})
+
+	logger.Debug("Start to split deploy worker task")
+
+	workerTask := t.(*deployWorkerTask)
+
+	// the workers join the cluster by a new bootstrap token, which is short-lived and only used by this task
+	bootstrapToken, err := kubeadm.NewBootstrapToken()
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	// split task into actions: will create a action for every node, the action type
+	// is ActionTypeDeployWorker
+	actions := make([]action.Action, 0, len(workerTask.nodeConfigs))
+	for _, nodeConfig := range workerTask.nodeConfigs {
+		actionCfg := &action.DeployWorkerActionConfig{
+			NodeConfig:      nodeConfig,
+			Masters:         workerTask.masters,
+			ClusterConfig:   workerTask.clusterConfig,
+			PKI:             workerTask.pki,
+			BootstrapToken:  bootstrapToken,
+			LogFileBasePath: workerTask.logFilePath,
+		}
+		act, err := action.NewDeployWorkerAction(actionCfg)
+		if err != nil {
+			return err
+		}
+		actions = append(actions, act)
+	}
+	workerTask.actions = actions
+
+	logger.Debugf("Finish to split deploy worker task: %d actions", len(actions))
+
+	return nil
+}
+
+// Verify if the task is valid.
+func (p *deployWorkerProcessor) verifyTask(t Task) error {
+	if t == nil {
+		return consts.ErrEmptyTask
+	}
+
+	workerTask, ok := t.(*deployWorkerTask)
+	if !ok {
+		return fmt.Errorf("%s: %T", consts.MsgTaskTypeMismatched, t)
+	}
+
+	if len(workerTask.nodeConfigs) == 0 {
+		return fmt.Errorf("nodeConfigs is empty")
+	}
+
+	return nil
+}
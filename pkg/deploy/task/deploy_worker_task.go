@@ -0,0 +1,90 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/pki"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// DeployWorkerTaskConfig represents the config for a deploy worker task.
+type DeployWorkerTaskConfig struct {
+	// NodeConfigs are the deploy configs of the workers to be joined by the task, and the masters,
+	// the labels and taints of all of them are applied
+	NodeConfigs []*pb.NodeDeployConfig
+	// Masters are all masters of the cluster, the bootstrap token for the workers is created on the first one
+	Masters       []*pb.Node
+	ClusterConfig *pb.ClusterConfig
+	// PKI is the pki of the cluster, which issues the client certificate to talk to the apiservers
+	PKI             *pki.ClusterPKI
+	LogFileBasePath string
+	Priority        int
+	Parent          string
+}
+
+type deployWorkerTask struct {
+	base
+	nodeConfigs   []*pb.NodeDeployConfig
+	masters       []*pb.Node
+	clusterConfig *pb.ClusterConfig
+	pki           *pki.ClusterPKI
+}
+
+// NewDeployWorkerTask returns a deploy worker task based on the config.
+// User should use this function to create a deploy worker task.
+func NewDeployWorkerTask(taskName string, taskConfig *DeployWorkerTaskConfig) (Task, error) {
+	var err error
+	if taskConfig == nil {
+		err = fmt.Errorf("invalid task config: nil")
+
+	} else if len(taskConfig.NodeConfigs) == 0 {
+		err = fmt.Errorf("invalid task config: nodeConfigs is empty")
+
+	} else if len(taskConfig.Masters) == 0 {
+		err = fmt.Errorf("invalid task config: masters is empty")
+
+	} else if taskConfig.PKI == nil {
+		err = fmt.Errorf("invalid task config: pki is nil")
+
+	}
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	task := &deployWorkerTask{
+		base: base{
+			name:              taskName,
+			taskType:          TaskTypeDeployWorker,
+			status:            TaskPending,
+			logFilePath:       GenTaskLogFilePath(taskConfig.LogFileBasePath, taskName),
+			creationTimestamp: time.Now(),
+			priority:          taskConfig.Priority,
+			parent:            taskConfig.Parent,
+		},
+		nodeConfigs:   taskConfig.NodeConfigs,
+		masters:       taskConfig.Masters,
+		clusterConfig: taskConfig.ClusterConfig,
+		pki:           taskConfig.PKI,
+	}
+
+	return task, nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+)
+
+// distributeImagesProcessor implements the specific logic to distribute the image tarballs
+type distributeImagesProcessor struct {
+}
+
+// Spilt the task into one distribute images action
+func (p *distributeImagesProcessor) SplitTask(t Task) error {
+	if err := p.verifyTask(t); err != nil {
+		logrus.Errorf("Invalid task: %s", err)
+		return err
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldAction: t.GetName(),
+	})
+
+	logger.Debug("Start to split distribute images task")
+
+	imagesTask := t.(*distributeImagesTask)
+
+	// the tarballs are distributed to all nodes by one action, which relays them among the nodes
+	actionCfg := &action.DistributeImagesActionConfig{
+		Nodes:           imagesTask.nodes,
+		ClusterConfig:   imagesTask.clusterConfig,
+		Tarballs:        imagesTask.tarballs,
+		LogFileBasePath: imagesTask.logFilePath,
+	}
+	act, err := action.NewDistributeImagesAction(actionCfg)
+	if err != nil {
+		return err
+	}
+	imagesTask.actions = []action.Action{act}
+
+	logger.Debug("Finish to split distribute images task: 1 action")
+
+	return nil
+}
+
+// Verify if the task is valid.
+func (p *distributeImagesProcessor) verifyTask(t Task) error {
+	if t == nil {
+		return consts.ErrEmptyTask
+	}
+
+	imagesTask, ok := t.(*distributeImagesTask)
+	if !ok {
+		return fmt.Errorf("%s: %T", consts.MsgTaskTypeMismatched, t)
+	}
+
+	if len(imagesTask.nodes) == 0 {
+		return fmt.Errorf("nodes is empty")
+	}
+
+	return nil
+}
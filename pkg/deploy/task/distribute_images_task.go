@@ -0,0 +1,81 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// DistributeImagesTaskConfig represents the config for a distribute images task, which distributes the image
+// tarballs on the controller to all nodes and loads them before deploying.
+type DistributeImagesTaskConfig struct {
+	Nodes         []*pb.Node
+	ClusterConfig *pb.ClusterConfig
+	// Tarballs are the paths of the image tarballs on the controller
+	Tarballs        []string
+	LogFileBasePath string
+	Priority        int
+	Parent          string
+}
+
+type distributeImagesTask struct {
+	base
+	nodes         []*pb.Node
+	clusterConfig *pb.ClusterConfig
+	tarballs      []string
+}
+
+// NewDistributeImagesTask returns a distribute images task based on the config.
+// User should use this function to create a distribute images task.
+func NewDistributeImagesTask(taskName string, taskConfig *DistributeImagesTaskConfig) (Task, error) {
+	var err error
+	if taskConfig == nil {
+		err = fmt.Errorf("invalid task config: nil")
+
+	} else if len(taskConfig.Nodes) == 0 {
+		err = fmt.Errorf("invalid task config: nodes is empty")
+
+	} else if len(taskConfig.Tarballs) == 0 {
+		err = fmt.Errorf("invalid task config: tarballs is empty")
+
+	}
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	task := &distributeImagesTask{
+		base: base{
+			name:              taskName,
+			taskType:          TaskTypeDistributeImages,
+			status:            TaskPending,
+			logFilePath:       GenTaskLogFilePath(taskConfig.LogFileBasePath, taskName),
+			creationTimestamp: time.Now(),
+			priority:          taskConfig.Priority,
+			parent:            taskConfig.Parent,
+		},
+		nodes:         taskConfig.Nodes,
+		clusterConfig: taskConfig.ClusterConfig,
+		tarballs:      taskConfig.Tarballs,
+	}
+
+	return task, nil
+}
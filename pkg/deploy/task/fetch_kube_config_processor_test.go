@@ -33,7 +33,7 @@ func TestVerifyTask(t *testing.T) {
 			want: false,
 		},
 		{
-			task: new(nodeCheckTask),
+			task: new(NodeCheckTask),
 			want: false,
 		},
 		{
@@ -0,0 +1,89 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// initProcessor implements the specific logic to initialize the nodes
+type initProcessor struct {
+}
+
+// Spilt the task into one init action for every node
+func (p *initProcessor) SplitTask(t Task) error {
+	if err := p.verifyTask(t); err != nil {
+		logrus.Errorf("Invalid task: %s", err)
+		return err
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldAction: t.GetName(),
+	})
+
+	logger.Debug("Start to split init task")
+
+	initTask := t.(*initTask)
+
+	// all nodes are added to /etc/hosts of every node
+	nodes := make([]*pb.Node, 0, len(initTask.nodeConfigs))
+	for _, nodeCfg := range initTask.nodeConfigs {
+		nodes = append(nodes, nodeCfg.GetNode())
+	}
+
+	actions := make([]action.Action, 0, len(nodes))
+	for _, node := range nodes {
+		actionCfg := &action.InitActionConfig{
+			Node:            node,
+			Nodes:           nodes,
+			ClusterConfig:   initTask.clusterConfig,
+			LogFileBasePath: initTask.logFilePath,
+		}
+		act, err := action.NewInitAction(actionCfg)
+		if err != nil {
+			return err
+		}
+		actions = append(actions, act)
+	}
+	initTask.actions = actions
+
+	logger.Debugf("Finish to split init task: %d actions", len(actions))
+
+	return nil
+}
+
+// Verify if the task is valid.
+func (p *initProcessor) verifyTask(t Task) error {
+	if t == nil {
+		return consts.ErrEmptyTask
+	}
+
+	initTask, ok := t.(*initTask)
+	if !ok {
+		return fmt.Errorf("%s: %T", consts.MsgTaskTypeMismatched, t)
+	}
+
+	if len(initTask.nodeConfigs) == 0 {
+		return fmt.Errorf("nodeConfigs is empty")
+	}
+
+	return nil
+}
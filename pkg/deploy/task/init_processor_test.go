@@ -0,0 +1,55 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestInitProcessorSplitTask(t *testing.T) {
+	deploy, err := NewDeployTask("test-deploy", &DeployTaskConfig{
+		NodeConfigs: []*pb.NodeDeployConfig{
+			{Node: &pb.Node{Name: "node1", Ip: "192.168.1.11"}, Roles: []string{"master", "etcd"}},
+			{Node: &pb.Node{Name: "node2", Ip: "192.168.1.12"}, Roles: []string{"worker"}},
+		},
+		ClusterConfig: &pb.ClusterConfig{PodSubnet: "172.31.0.0/16"},
+	})
+	assert.NoError(t, err)
+
+	initTask, err := new(deployProcessor).createInitSubTask(deploy.(*deployTask), "", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, TaskTypeInit, initTask.GetType())
+	assert.Equal(t, 10, initTask.GetPriority())
+	assert.Equal(t, "test-deploy", initTask.GetParent())
+
+	processor, err := NewProcessor(initTask.GetType())
+	assert.NoError(t, err)
+	assert.NoError(t, processor.SplitTask(initTask))
+
+	// one action for every node
+	actions := initTask.GetActions()
+	if assert.Len(t, actions, 2) {
+		assert.Equal(t, "node1", actions[0].GetName())
+		assert.Equal(t, "node2", actions[1].GetName())
+		assert.Equal(t, action.ActionTypeInit, actions[0].GetType())
+	}
+
+	assert.Error(t, new(initProcessor).verifyTask(deploy))
+}
@@ -0,0 +1,74 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// InitTaskConfig represents the config for an init task.
+type InitTaskConfig struct {
+	NodeConfigs     []*pb.NodeDeployConfig
+	ClusterConfig   *pb.ClusterConfig
+	LogFileBasePath string
+	Priority        int
+	Parent          string
+}
+
+// initTask initializes the os of the nodes before deploying
+type initTask struct {
+	base
+	nodeConfigs   []*pb.NodeDeployConfig
+	clusterConfig *pb.ClusterConfig
+}
+
+// NewInitTask returns an init task based on the config.
+// User should use this function to create an init task.
+func NewInitTask(taskName string, taskConfig *InitTaskConfig) (Task, error) {
+	var err error
+	if taskConfig == nil {
+		err = fmt.Errorf("invalid task config: nil")
+
+	} else if len(taskConfig.NodeConfigs) == 0 {
+		err = fmt.Errorf("invalid task config: node configs is empty")
+
+	}
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	task := &initTask{
+		base: base{
+			name:              taskName,
+			taskType:          TaskTypeInit,
+			status:            TaskPending,
+			logFilePath:       GenTaskLogFilePath(taskConfig.LogFileBasePath, taskName),
+			creationTimestamp: time.Now(),
+			priority:          taskConfig.Priority,
+			parent:            taskConfig.Parent,
+		},
+		nodeConfigs:   taskConfig.NodeConfigs,
+		clusterConfig: taskConfig.ClusterConfig,
+	}
+
+	return task, nil
+}
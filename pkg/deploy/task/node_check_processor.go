@@ -21,6 +21,7 @@ import (
 
 	"github.com/kpaas-io/kpaas/pkg/deploy/action"
 	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/profile"
 )
 
 // nodeCheckProcessor implements the specific logic for the node check task.
@@ -40,15 +41,21 @@ func (p *nodeCheckProcessor) SplitTask(t Task) error {
 
 	logger.Debug("Start to split node check task")
 
-	checkTask := t.(*nodeCheckTask)
+	checkTask := t.(*NodeCheckTask)
 
 	// split task into actions: will create a action for every node, the action type
-	// is NodeCheckAction
-	actions := make([]action.Action, 0, len(checkTask.nodeConfigs))
+	// is NodeCheckAction, and the NodePortCheckAction, NodeConnectivityCheckAction and NodeHostnameCheckAction
+	// across all nodes
+	actions := make([]action.Action, 0, len(checkTask.nodeConfigs)+3)
 	for _, subConfig := range checkTask.nodeConfigs {
 		actionCfg := &action.NodeCheckActionConfig{
-			NodeCheckConfig: subConfig,
-			LogFileBasePath: checkTask.logFilePath,
+			NodeCheckConfig:   subConfig,
+			ClusterConfig:     checkTask.clusterConfig,
+			LogFileBasePath:   checkTask.logFilePath,
+			Profile:           checkTask.profile,
+			FixItems:          checkTask.fixItems,
+			Cache:             checkTask.cache,
+			RecheckFailedOnly: checkTask.recheckFailedOnly,
 		}
 		act, err := action.NewNodeCheckAction(actionCfg)
 		if err != nil {
@@ -56,6 +63,44 @@ func (p *nodeCheckProcessor) SplitTask(t Task) error {
 		}
 		actions = append(actions, act)
 	}
+
+	portCheckAction, err := action.NewNodePortCheckAction(&action.NodePortCheckActionConfig{
+		NodeCheckConfigs: checkTask.nodeConfigs,
+		ClusterConfig:    checkTask.clusterConfig,
+		LogFileBasePath:  checkTask.logFilePath,
+		Profile:          checkTask.profile,
+	})
+	if err != nil {
+		return err
+	}
+	actions = append(actions, portCheckAction)
+
+	// the connectivity check runs between nodes, it's meaningless for a single node
+	if len(checkTask.nodeConfigs) > 1 && !checkTask.profile.Skipped(action.NodeConnectivityCheckItemName) {
+		connectivityCheckAction, err := action.NewNodeConnectivityCheckAction(&action.NodeConnectivityCheckActionConfig{
+			NodeCheckConfigs: checkTask.nodeConfigs,
+			LogFileBasePath:  checkTask.logFilePath,
+			Profile:          checkTask.profile,
+		})
+		if err != nil {
+			return err
+		}
+		actions = append(actions, connectivityCheckAction)
+	}
+
+	if !skippedAll(checkTask.profile, action.NodeHostnameCheckItemNames) {
+		hostnameCheckAction, err := action.NewNodeHostnameCheckAction(&action.NodeHostnameCheckActionConfig{
+			NodeCheckConfigs: checkTask.nodeConfigs,
+			LogFileBasePath:  checkTask.logFilePath,
+			Profile:          checkTask.profile,
+			FixItems:         checkTask.fixItems,
+		})
+		if err != nil {
+			return err
+		}
+		actions = append(actions, hostnameCheckAction)
+	}
+
 	checkTask.actions = actions
 
 	logrus.Debugf("Finish to split node check task: %d actions", len(actions))
@@ -68,7 +113,7 @@ func (p *nodeCheckProcessor) verifyTask(t Task) error {
 		return consts.ErrEmptyTask
 	}
 
-	nodeCheckTask, ok := t.(*nodeCheckTask)
+	nodeCheckTask, ok := t.(*NodeCheckTask)
 	if !ok {
 		return fmt.Errorf("%s: %T", consts.MsgTaskTypeMismatched, t)
 	}
@@ -79,3 +124,13 @@ func (p *nodeCheckProcessor) verifyTask(t Task) error {
 
 	return nil
 }
+
+// skippedAll returns if all the check items are skipped by the profile
+func skippedAll(checkProfile *profile.Profile, items []string) bool {
+	for _, item := range items {
+		if !checkProfile.Skipped(item) {
+			return false
+		}
+	}
+	return true
+}
@@ -20,19 +20,39 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check/cri"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check/kubelet"
+	"github.com/kpaas-io/kpaas/pkg/deploy/profile"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
 // NodeCheckTaskConfig represents the config for a node check task.
 type NodeCheckTaskConfig struct {
 	NodeConfigs     []*pb.NodeCheckConfig
+	ClusterConfig   *pb.ClusterConfig
 	LogFileBasePath string
 	Priority        int
+	// Profile is applied to all nodes, the default profile is used if it's nil
+	Profile *profile.Profile
+	// FixItems are the names of the check items to be fixed if they failed
+	FixItems []string
+	// Cache keeps the results of the node check items between tasks, it's optional
+	Cache *action.NodeCheckCache
+	// RecheckFailedOnly reuses the passed node check items in the cache, the checks across nodes
+	// are always run since their results depend on all the nodes
+	RecheckFailedOnly bool
 }
 
-type nodeCheckTask struct {
+// NodeCheckTask checks if the nodes satisfy the requirements of deploying
+type NodeCheckTask struct {
 	base
-	nodeConfigs []*pb.NodeCheckConfig
+	nodeConfigs       []*pb.NodeCheckConfig
+	clusterConfig     *pb.ClusterConfig
+	profile           *profile.Profile
+	fixItems          []string
+	cache             *action.NodeCheckCache
+	recheckFailedOnly bool
 }
 
 // NewNodeCheckTask returns a node check task based on the config.
@@ -45,6 +65,13 @@ func NewNodeCheckTask(taskName string, taskConfig *NodeCheckTaskConfig) (Task, e
 	} else if len(taskConfig.NodeConfigs) == 0 {
 		err = fmt.Errorf("invalid task config: node configs is empty")
 
+	} else if _, runtimeErr := cri.Runtime(taskConfig.ClusterConfig); runtimeErr != nil {
+		err = fmt.Errorf("invalid task config: %v", runtimeErr)
+
+	} else if version := taskConfig.ClusterConfig.GetKubernetesVersion(); version != "" {
+		if _, versionErr := kubelet.VersionConstraint(version); versionErr != nil {
+			err = fmt.Errorf("invalid task config: kubernetes version: %v", versionErr)
+		}
 	}
 
 	if err != nil {
@@ -52,7 +79,12 @@ func NewNodeCheckTask(taskName string, taskConfig *NodeCheckTaskConfig) (Task, e
 		return nil, err
 	}
 
-	task := &nodeCheckTask{
+	checkProfile := taskConfig.Profile
+	if checkProfile == nil {
+		checkProfile = profile.Default()
+	}
+
+	task := &NodeCheckTask{
 		base: base{
 			name:              taskName,
 			taskType:          TaskTypeNodeCheck,
@@ -61,8 +93,18 @@ func NewNodeCheckTask(taskName string, taskConfig *NodeCheckTaskConfig) (Task, e
 			creationTimestamp: time.Now(),
 			priority:          taskConfig.Priority,
 		},
-		nodeConfigs: taskConfig.NodeConfigs,
+		nodeConfigs:       taskConfig.NodeConfigs,
+		clusterConfig:     taskConfig.ClusterConfig,
+		profile:           checkProfile,
+		fixItems:          taskConfig.FixItems,
+		cache:             taskConfig.Cache,
+		recheckFailedOnly: taskConfig.RecheckFailedOnly,
 	}
 
 	return task, nil
 }
+
+// GetProfile returns the check profile applied to the task
+func (t *NodeCheckTask) GetProfile() *profile.Profile {
+	return t.profile
+}
@@ -45,8 +45,20 @@ func NewProcessor(taskType Type) (Processor, error) {
 	switch taskType {
 	case TaskTypeNodeCheck:
 		processor = &nodeCheckProcessor{}
+	case TaskTypeInit:
+		processor = &initProcessor{}
+	case TaskTypeDistributeImages:
+		processor = &distributeImagesProcessor{}
 	case TaskTypeDeploy:
 		processor = &deployProcessor{}
+	case TaskTypeDeployEtcd:
+		processor = &deployEtcdProcessor{}
+	case TaskTypeDeployHA:
+		processor = &deployHAProcessor{}
+	case TaskTypeDeployMaster:
+		processor = &deployMasterProcessor{}
+	case TaskTypeDeployWorker:
+		processor = &deployWorkerProcessor{}
 	case TaskTypeFetchKubeConfig:
 		processor = &fetchKubeConfigProcessor{}
 	default:
@@ -244,6 +256,9 @@ func executeActions(t Task) error {
 	}
 	wg.Wait()
 
+	// the fixes change the nodes, so they are run after all actions finish checking the nodes
+	action.FixActions(t.GetActions())
+
 	logger.Debug("Finish to execute actions")
 	return nil
 }
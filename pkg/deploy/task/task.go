@@ -47,14 +47,16 @@ type Task interface {
 type Type string
 
 const (
-	TaskTypeNodeCheck       Type = "NodeCheck"
-	TaskTypeInit            Type = "init"
-	TaskTypeDeploy          Type = "Deploy"
-	TaskTypeDeployEtcd      Type = "DeployEtcd"
-	TaskTypeDeployMaster    Type = "DeployMaster"
-	TaskTypeDeployWorker    Type = "DeployWorker"
-	TaskTypeDeployIngress   Type = "DeployIngess"
-	TaskTypeFetchKubeConfig Type = "FetchKubeConfig"
+	TaskTypeNodeCheck        Type = "NodeCheck"
+	TaskTypeInit             Type = "init"
+	TaskTypeDistributeImages Type = "DistributeImages"
+	TaskTypeDeploy           Type = "Deploy"
+	TaskTypeDeployEtcd       Type = "DeployEtcd"
+	TaskTypeDeployHA         Type = "DeployHA"
+	TaskTypeDeployMaster     Type = "DeployMaster"
+	TaskTypeDeployWorker     Type = "DeployWorker"
+	TaskTypeDeployIngress    Type = "DeployIngess"
+	TaskTypeFetchKubeConfig  Type = "FetchKubeConfig"
 )
 
 // Status represents the status of a task
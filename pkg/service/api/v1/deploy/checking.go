@@ -30,17 +30,30 @@ import (
 	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
 	"github.com/kpaas-io/kpaas/pkg/utils/h"
 	"github.com/kpaas-io/kpaas/pkg/utils/log"
+	"github.com/kpaas-io/kpaas/pkg/utils/validator"
 )
 
 // @ID CheckNodeList
 // @Summary check node list
 // @Description Check if the node meets the pre-deployment requirements
 // @Tags checking
+// @Accept application/json
 // @Produce application/json
+// @Param body body api.CheckNodeListRequest false "check options"
 // @Success 201 {object} api.SuccessfulOption
 // @Router /api/v1/deploy/wizard/checks [post]
 func CheckNodeList(c *gin.Context) {
 
+	// the request body is optional
+	requestData := new(api.CheckNodeListRequest)
+	if c.Request.ContentLength != 0 {
+		if err := validator.Params(c, requestData); err != nil {
+			log.ReqEntry(c).Info(err)
+			h.E(c, err)
+			return
+		}
+	}
+
 	wizardData := wizard.GetCurrentWizard()
 	if len(wizardData.Nodes) <= 0 {
 		h.E(c, h.ENotFound.WithPayload("No node information, node list is empty, please add node information"))
@@ -52,7 +65,15 @@ func CheckNodeList(c *gin.Context) {
 		return
 	}
 
-	wizardData.ClearClusterCheckingData()
+	// the check points are mapped before the last report is cleared
+	fixItems := convertFixItemsToDeployControllerItemNames(wizardData, requestData.FixItems)
+
+	if requestData.RecheckFailedOnly {
+		// the results of the check points checked again are merged into the last report
+		wizardData.ResetClusterCheckingResult()
+	} else {
+		wizardData.ClearClusterCheckingData()
+	}
 
 	if err := wizardData.MarkNodeChecking(); err != nil {
 		h.E(c, h.EStatusError.WithPayload(err))
@@ -64,7 +85,10 @@ func CheckNodeList(c *gin.Context) {
 	grpcContext, cancel := context.WithTimeout(context.Background(), config.Config.DeployController.GetTimeout())
 	defer cancel()
 
-	resp, err := client.CheckNodes(grpcContext, getCallCheckNodesData())
+	checkNodesData := getCallCheckNodesData()
+	checkNodesData.FixItems = fixItems
+	checkNodesData.RecheckFailedOnly = requestData.RecheckFailedOnly
+	resp, err := client.CheckNodes(grpcContext, checkNodesData)
 	if err != nil {
 		h.E(c, h.EDeployControllerError.WithPayload(err))
 		log.ReqEntry(c).Errorf("call deploy controller error, errorMessage: %v", err)
@@ -96,6 +120,8 @@ func GetCheckingNodeListResult(c *gin.Context) {
 	checkResults := getWizardCheckingData()
 	responseData.Nodes = *checkResults
 	responseData.Result = wizardData.GetCheckResult()
+	responseData.Profile = wizardData.GetCheckProfile()
+	responseData.Connectivity = convertModelConnectivityMatrixToAPIConnectivityMatrix(wizardData.GetConnectivityMatrix())
 
 	h.R(c, responseData)
 }
@@ -121,6 +147,8 @@ func getCallCheckNodesData() *protos.CheckNodesRequest {
 		requestData.Configs = append(requestData.Configs, nodeConfig)
 	}
 
+	requestData.ClusterConfig = buildCallDeployDataClusterPart()
+
 	return requestData
 }
 
@@ -154,6 +182,8 @@ func refreshCheckResultOneTime() {
 	wizardData.SetClusterCheckResult(
 		convertDeployControllerCheckResultToModelCheckResult(resp.GetStatus()),
 		convertDeployControllerErrorToFailureDetail(resp.GetErr()))
+	wizardData.SetCheckProfile(resp.GetProfile())
+	wizardData.SetConnectivityMatrix(convertDeployControllerConnectivityMatrixToModelConnectivityMatrix(resp.GetConnectivity()))
 
 	for _, node := range resp.Nodes {
 
@@ -168,8 +198,10 @@ func refreshCheckResultOneTime() {
 			convertDeployControllerCheckResultToModelCheckResult(node.GetStatus()),
 			convertDeployControllerErrorToFailureDetail(node.GetErr()))
 
+		itemNames := make([]string, 0, len(node.Items))
 		for _, item := range node.Items {
 			itemName := getItemNameFromDeployControllerCheckItem(item.Item)
+			itemNames = append(itemNames, itemName)
 			failureDetail := convertDeployControllerErrorToFailureDetail(item.Err)
 			if failureDetail != nil && item.Logs != "" {
 				var setLogContentError error
@@ -179,6 +211,28 @@ func refreshCheckResultOneTime() {
 				}
 			}
 			wizardNode.SetCheckItem(itemName, convertDeployControllerCheckResultToModelCheckResult(item.Status), failureDetail)
+			wizardNode.SetCheckItemName(itemName, item.GetItem().GetName())
+
+			if fix := item.GetFix(); fix != nil {
+				// the log of the error before fixing is the output of fixing
+				beforeDetail := convertDeployControllerErrorToFailureDetail(fix.BeforeErr)
+				if beforeDetail != nil && fix.Logs != "" {
+					var setLogContentError error
+					beforeDetail.LogId, setLogContentError = wizard.SetLogByString(fix.Logs)
+					if setLogContentError != nil {
+						logrus.Errorf("Store fixing log error, %s", setLogContentError)
+					}
+				}
+				wizardNode.SetCheckItemFix(itemName, convertDeployControllerCheckResultToModelCheckResult(fix.BeforeStatus), beforeDetail)
+			}
+			if item.GetCached() {
+				wizardNode.SetCheckItemCached(itemName)
+			}
+		}
+
+		// the items of the last check are kept while checking, and removed after all checks finished
+		if convertDeployControllerCheckResultToModelCheckResult(resp.GetStatus()) != constant.CheckResultChecking {
+			wizardNode.RetainCheckItems(itemNames)
 		}
 	}
 }
@@ -204,3 +258,34 @@ func getItemNameFromDeployControllerCheckItem(item *protos.CheckItem) string {
 
 	return item.Description
 }
+
+// convertFixItemsToDeployControllerItemNames maps the check points shown in the check result back to the item names
+// of the deploy controller, which are used to select the items to be fixed. A fix item which is not a check point is
+// taken as an item name.
+func convertFixItemsToDeployControllerItemNames(wizardData *wizard.Cluster, fixItems []string) []string {
+
+	if len(fixItems) == 0 {
+		return nil
+	}
+
+	itemNames := make([]string, 0, len(fixItems))
+	added := make(map[string]bool, len(fixItems))
+	for _, fixItem := range fixItems {
+
+		itemName := fixItem
+		for _, node := range wizardData.Nodes {
+
+			if name := node.GetCheckItemName(fixItem); name != "" {
+				itemName = name
+				break
+			}
+		}
+
+		if !added[itemName] {
+			added[itemName] = true
+			itemNames = append(itemNames, itemName)
+		}
+	}
+
+	return itemNames
+}
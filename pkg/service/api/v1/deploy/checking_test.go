@@ -15,19 +15,24 @@
 package deploy
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 
 	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/deploy/protos"
 	grpcClient "github.com/kpaas-io/kpaas/pkg/service/grpcutils/client"
 	"github.com/kpaas-io/kpaas/pkg/service/grpcutils/mock"
 	"github.com/kpaas-io/kpaas/pkg/service/model/api"
+	"github.com/kpaas-io/kpaas/pkg/service/model/common"
 	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
 	"github.com/kpaas-io/kpaas/pkg/utils/h"
 )
@@ -82,6 +87,130 @@ func TestCheckNodeList2(t *testing.T) {
 	assert.Equal(t, http.StatusNotFound, resp.Code)
 }
 
+func TestCheckNodeListWithFixItems(t *testing.T) {
+
+	wizard.ClearCurrentWizardData()
+	wizardData := wizard.GetCurrentWizard()
+	mockNode := wizard.NewNode()
+	mockNode.Name = "master1"
+	wizardData.Nodes = []*wizard.Node{mockNode}
+
+	grpcClient.SetDeployController(mock.NewDeployController())
+
+	tests := []struct {
+		Body     string
+		WantCode int
+	}{
+		{
+			Body:     `{"fixItems": ["system preference check", "clock synchronization check"]}`,
+			WantCode: http.StatusCreated,
+		},
+		{
+			Body:     `{"fixItems": [""]}`,
+			WantCode: http.StatusBadRequest,
+		},
+		{
+			Body:     `{"fixItems": ["system preference check"], "recheckFailedOnly": true}`,
+			WantCode: http.StatusCreated,
+		},
+	}
+
+	for _, test := range tests {
+
+		wizardData.ClearClusterCheckingData()
+		resp := httptest.NewRecorder()
+		gin.SetMode(gin.TestMode)
+		ctx, _ := gin.CreateTestContext(resp)
+		ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/checks", strings.NewReader(test.Body))
+		ctx.Request.Header.Set("Content-Type", "application/json")
+
+		CheckNodeList(ctx)
+		resp.Flush()
+		fmt.Printf("result: %s\n", resp.Body.String())
+		assert.Equal(t, test.WantCode, resp.Code)
+	}
+}
+
+// checkNodesRecorder records the request of checking nodes sent to the deploy controller
+type checkNodesRecorder struct {
+	protos.DeployContollerClient
+	request *protos.CheckNodesRequest
+}
+
+func (recorder *checkNodesRecorder) CheckNodes(ctx context.Context, in *protos.CheckNodesRequest, opts ...grpc.CallOption) (*protos.CheckNodesReply, error) {
+
+	recorder.request = in
+	return recorder.DeployContollerClient.CheckNodes(ctx, in, opts...)
+}
+
+func TestCheckNodeListFixCheckingPoint(t *testing.T) {
+
+	wizard.ClearCurrentWizardData()
+	wizardData := wizard.GetCurrentWizard()
+	mockNode := wizard.NewNode()
+	mockNode.Name = "master1"
+	wizardData.Nodes = []*wizard.Node{mockNode}
+
+	recorder := &checkNodesRecorder{DeployContollerClient: mock.NewDeployController()}
+	grpcClient.SetDeployController(recorder)
+
+	refreshCheckResultOneTime()
+
+	// get the check point shown in the check result
+	resp := httptest.NewRecorder()
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(resp)
+	ctx.Request = httptest.NewRequest("GET", "/api/v1/deploy/wizard/checks", nil)
+
+	GetCheckingNodeListResult(ctx)
+	resp.Flush()
+	responseData := new(api.GetCheckingResultResponse)
+	assert.Nil(t, json.Unmarshal(resp.Body.Bytes(), responseData))
+	assert.Len(t, responseData.Nodes, 1)
+	assert.Len(t, responseData.Nodes[0].Items, 2)
+	checkingItem := responseData.Nodes[0].Items[0]
+	assert.Equal(t, "Root disk size（Check root disk size > 50G）", checkingItem.CheckingPoint)
+	assert.Equal(t, "Root disk size", checkingItem.Name)
+
+	tests := []struct {
+		FixItems []string
+		Want     []string
+	}{
+		{
+			FixItems: []string{checkingItem.CheckingPoint},
+			Want:     []string{"Root disk size"},
+		},
+		{
+			FixItems: []string{checkingItem.Name},
+			Want:     []string{"Root disk size"},
+		},
+		{
+			FixItems: []string{checkingItem.CheckingPoint, checkingItem.Name, "clock synchronization check"},
+			Want:     []string{"Root disk size", "clock synchronization check"},
+		},
+	}
+
+	for _, test := range tests {
+
+		wizardData.SetClusterCheckResult(constant.CheckResultPassed, nil)
+		body, err := json.Marshal(api.CheckNodeListRequest{FixItems: test.FixItems, RecheckFailedOnly: true})
+		assert.Nil(t, err)
+
+		resp := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(resp)
+		ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/checks", strings.NewReader(string(body)))
+		ctx.Request.Header.Set("Content-Type", "application/json")
+
+		recorder.request = nil
+		CheckNodeList(ctx)
+		resp.Flush()
+		assert.Equal(t, http.StatusCreated, resp.Code)
+		if assert.NotNil(t, recorder.request) {
+			assert.Equal(t, test.Want, recorder.request.FixItems)
+		}
+	}
+}
+
 func TestGetCheckingNodeListResult(t *testing.T) {
 
 	wizard.ClearCurrentWizardData()
@@ -95,10 +224,18 @@ func TestGetCheckingNodeListResult(t *testing.T) {
 					{
 						ItemName:    "check 1",
 						CheckResult: constant.CheckResultPassed,
+						Cached:      true,
 					},
 					{
 						ItemName:    "check 2",
 						CheckResult: constant.CheckResultPassed,
+						Fix: &wizard.CheckItemFix{
+							CheckResult: constant.CheckResultFailed,
+							Error: &common.FailureDetail{
+								Reason: "reason",
+								Detail: "detail",
+							},
+						},
 					},
 				},
 				CheckResult: constant.CheckResultPassed,
@@ -127,10 +264,18 @@ func TestGetCheckingNodeListResult(t *testing.T) {
 		{
 			CheckingPoint: "check 1",
 			Result:        constant.CheckResultPassed,
+			Cached:        true,
 		},
 		{
 			CheckingPoint: "check 2",
 			Result:        constant.CheckResultPassed,
+			Fix: &api.CheckingItemFix{
+				BeforeResult: constant.CheckResultFailed,
+				BeforeError: &api.Error{
+					Reason: "reason",
+					Detail: "detail",
+				},
+			},
 		},
 	}, checkData.Items)
 
@@ -19,6 +19,7 @@ package deploy
 import (
 	"github.com/gin-gonic/gin"
 
+	"github.com/kpaas-io/kpaas/pkg/constant"
 	"github.com/kpaas-io/kpaas/pkg/service/model/api"
 	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
 	"github.com/kpaas-io/kpaas/pkg/utils/h"
@@ -77,6 +78,15 @@ func SetCluster(c *gin.Context) {
 			Value: annotation.Value,
 		})
 	}
+	wizardData.Info.NTPServers = append([]string(nil), requestData.NTPServers...)
+	wizardData.Info.ContainerRuntime = requestData.ContainerRuntime
+	if wizardData.Info.ContainerRuntime == "" {
+		wizardData.Info.ContainerRuntime = constant.ContainerRuntimeDocker
+	}
+	wizardData.Info.EtcdDataDir = requestData.EtcdDataDir
+	if wizardData.Info.EtcdDataDir == "" {
+		wizardData.Info.EtcdDataDir = wizard.DefaultEtcdDataDir
+	}
 
 	h.R(c, api.SuccessfulOption{Success: true})
 }
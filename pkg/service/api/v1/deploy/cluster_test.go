@@ -24,6 +24,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/kpaas-io/kpaas/pkg/constant"
 	"github.com/kpaas-io/kpaas/pkg/service/model/api"
 	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
 )
@@ -152,6 +153,9 @@ func TestSetCluster3(t *testing.T) {
 		LoadbalancerPort:         uint16(4332),
 		NodePortMinimum:          uint16(16000),
 		NodePortMaximum:          uint16(16999),
+		NTPServers:               []string{"ntp.example.com", "192.168.31.1"},
+		ContainerRuntime:         constant.ContainerRuntimeContainerd,
+		EtcdDataDir:              "/data/etcd",
 	}
 	bodyContent, err := json.Marshal(body)
 	assert.Nil(t, err)
@@ -178,6 +182,9 @@ func TestSetCluster3(t *testing.T) {
 	assert.Equal(t, uint16(4332), wizardData.Info.KubeAPIServerConnection.LoadbalancerPort)
 	assert.Equal(t, uint16(16000), wizardData.Info.NodePortMinimum)
 	assert.Equal(t, uint16(16999), wizardData.Info.NodePortMaximum)
+	assert.Equal(t, []string{"ntp.example.com", "192.168.31.1"}, wizardData.Info.NTPServers)
+	assert.Equal(t, constant.ContainerRuntimeContainerd, wizardData.Info.ContainerRuntime)
+	assert.Equal(t, "/data/etcd", wizardData.Info.EtcdDataDir)
 }
 
 func TestGetCluster(t *testing.T) {
@@ -208,6 +215,8 @@ func TestGetCluster(t *testing.T) {
 	assert.Equal(t, wizard.DefaultNodePortMaximum, responseData.NodePortMaximum)
 	assert.Empty(t, responseData.Labels)
 	assert.Empty(t, responseData.Annotations)
+	assert.Equal(t, constant.ContainerRuntimeDocker, responseData.ContainerRuntime)
+	assert.Equal(t, wizard.DefaultEtcdDataDir, responseData.EtcdDataDir)
 }
 
 func TestGetCluster2(t *testing.T) {
@@ -233,6 +242,7 @@ func TestGetCluster2(t *testing.T) {
 			Value: "Icanspeakenglish",
 		},
 	}
+	wizardData.Info.NTPServers = []string{"ntp.example.com"}
 
 	var err error
 	resp := httptest.NewRecorder()
@@ -259,4 +269,5 @@ func TestGetCluster2(t *testing.T) {
 	assert.Equal(t, uint16(29999), responseData.NodePortMaximum)
 	assert.Equal(t, []api.Label{{Key: "for-test", Value: "yes"}}, responseData.Labels)
 	assert.Equal(t, []api.Annotation{{Key: "comment", Value: "Icanspeakenglish"}}, responseData.Annotations)
+	assert.Equal(t, []string{"ntp.example.com"}, responseData.NTPServers)
 }
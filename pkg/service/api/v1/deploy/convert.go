@@ -238,7 +238,7 @@ func convertDeployControllerCheckResultToModelCheckResult(status string) constan
 
 	s := constant.CheckResult(status)
 	switch s {
-	case constant.CheckResultNotRunning, constant.CheckResultChecking, constant.CheckResultPassed, constant.CheckResultFailed:
+	case constant.CheckResultNotRunning, constant.CheckResultChecking, constant.CheckResultPassed, constant.CheckResultFailed, constant.CheckResultWarning:
 		return s
 	}
 	return constant.CheckResult(fmt.Sprintf("unknown(%s)", status))
@@ -277,3 +277,87 @@ func convertDeployControllerDeployResultToModelDeployResult(status string) wizar
 
 	return wizard.DeployStatus(fmt.Sprintf("unknown(%s)", status))
 }
+
+func convertDeployControllerConnectivityMatrixToModelConnectivityMatrix(matrix *protos.ConnectivityMatrix) *wizard.ConnectivityMatrix {
+
+	if matrix == nil {
+		return nil
+	}
+
+	modelMatrix := &wizard.ConnectivityMatrix{
+		CheckResult: convertDeployControllerCheckResultToModelCheckResult(matrix.GetStatus()),
+		Nodes:       matrix.GetNodes(),
+		Rows:        make([]*wizard.ConnectivityRow, 0, len(matrix.GetRows())),
+	}
+
+	for _, row := range matrix.GetRows() {
+		modelRow := &wizard.ConnectivityRow{
+			Source:  row.GetSource(),
+			Results: make([]*wizard.ConnectivityResult, 0, len(row.GetResults())),
+		}
+
+		for _, result := range row.GetResults() {
+			modelResult := &wizard.ConnectivityResult{
+				Destination: result.GetDestination(),
+				PathMTU:     result.GetPathMTU(),
+			}
+			if result.GetStatus() != "" {
+				modelResult.CheckResult = convertDeployControllerCheckResultToModelCheckResult(result.GetStatus())
+			}
+
+			for _, check := range result.GetChecks() {
+				modelResult.Checks = append(modelResult.Checks, &wizard.ConnectivityCheck{
+					Protocol:    check.GetProtocol(),
+					Port:        check.GetPort(),
+					CheckResult: convertDeployControllerCheckResultToModelCheckResult(check.GetStatus()),
+					Detail:      check.GetDetail(),
+				})
+			}
+			modelRow.Results = append(modelRow.Results, modelResult)
+		}
+		modelMatrix.Rows = append(modelMatrix.Rows, modelRow)
+	}
+
+	return modelMatrix
+}
+
+func convertModelConnectivityMatrixToAPIConnectivityMatrix(matrix *wizard.ConnectivityMatrix) *api.ConnectivityMatrix {
+
+	if matrix == nil {
+		return nil
+	}
+
+	apiMatrix := &api.ConnectivityMatrix{
+		Result: matrix.CheckResult,
+		Nodes:  matrix.Nodes,
+		Rows:   make([]api.ConnectivityRow, 0, len(matrix.Rows)),
+	}
+
+	for _, row := range matrix.Rows {
+		apiRow := api.ConnectivityRow{
+			Source:  row.Source,
+			Results: make([]api.ConnectivityResult, 0, len(row.Results)),
+		}
+
+		for _, result := range row.Results {
+			apiResult := api.ConnectivityResult{
+				Destination: result.Destination,
+				Result:      result.CheckResult,
+				PathMTU:     result.PathMTU,
+			}
+
+			for _, check := range result.Checks {
+				apiResult.Checks = append(apiResult.Checks, api.ConnectivityCheck{
+					Protocol: check.Protocol,
+					Port:     check.Port,
+					Result:   check.CheckResult,
+					Detail:   check.Detail,
+				})
+			}
+			apiRow.Results = append(apiRow.Results, apiResult)
+		}
+		apiMatrix.Rows = append(apiMatrix.Rows, apiRow)
+	}
+
+	return apiMatrix
+}
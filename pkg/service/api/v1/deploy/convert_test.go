@@ -220,6 +220,7 @@ func TestConvertDeployControllerCheckResultToModelCheckResult(t *testing.T) {
 	assert.Equal(t, constant.CheckResultChecking, convertDeployControllerCheckResultToModelCheckResult(string(constant.CheckResultChecking)))
 	assert.Equal(t, constant.CheckResultPassed, convertDeployControllerCheckResultToModelCheckResult(string(constant.CheckResultPassed)))
 	assert.Equal(t, constant.CheckResultFailed, convertDeployControllerCheckResultToModelCheckResult(string(constant.CheckResultFailed)))
+	assert.Equal(t, constant.CheckResultWarning, convertDeployControllerCheckResultToModelCheckResult(string(constant.CheckResultWarning)))
 	assert.Equal(t, constant.CheckResult("unknown(OtherType)"), convertDeployControllerCheckResultToModelCheckResult("OtherType"))
 }
 
@@ -242,3 +243,55 @@ func TestConvertDeployControllerDeployResultToModelDeployResult(t *testing.T) {
 	assert.Equal(t, wizard.DeployStatusAborted, convertDeployControllerDeployResultToModelDeployResult(string(wizard.DeployStatusAborted)))
 	assert.Equal(t, wizard.DeployStatus("unknown(OtherType)"), convertDeployControllerDeployResultToModelDeployResult("OtherType"))
 }
+
+func TestConvertConnectivityMatrix(t *testing.T) {
+
+	assert.Nil(t, convertDeployControllerConnectivityMatrixToModelConnectivityMatrix(nil))
+	assert.Nil(t, convertModelConnectivityMatrixToAPIConnectivityMatrix(nil))
+
+	matrix := convertDeployControllerConnectivityMatrixToModelConnectivityMatrix(&protos.ConnectivityMatrix{
+		Status: string(constant.CheckResultFailed),
+		Nodes:  []string{"a", "b"},
+		Rows: []*protos.ConnectivityMatrixRow{
+			{
+				Source: "a",
+				Results: []*protos.ConnectivityResult{
+					{Source: "a", Destination: "a"},
+					{Source: "a", Destination: "b", Status: string(constant.CheckResultFailed), PathMTU: 1400, Checks: []*protos.ConnectivityCheck{
+						{Protocol: "mtu", Status: string(constant.CheckResultFailed), Detail: "path mtu 1400 is less than 1450"},
+					}},
+				},
+			},
+			{
+				Source: "b",
+				Results: []*protos.ConnectivityResult{
+					{Source: "b", Destination: "a", Status: string(constant.CheckResultPassed), PathMTU: 1450},
+					{Source: "b", Destination: "b"},
+				},
+			},
+		},
+	})
+
+	assert.Equal(t, &api.ConnectivityMatrix{
+		Result: constant.CheckResultFailed,
+		Nodes:  []string{"a", "b"},
+		Rows: []api.ConnectivityRow{
+			{
+				Source: "a",
+				Results: []api.ConnectivityResult{
+					{Destination: "a"},
+					{Destination: "b", Result: constant.CheckResultFailed, PathMTU: 1400, Checks: []api.ConnectivityCheck{
+						{Protocol: "mtu", Result: constant.CheckResultFailed, Detail: "path mtu 1400 is less than 1450"},
+					}},
+				},
+			},
+			{
+				Source: "b",
+				Results: []api.ConnectivityResult{
+					{Destination: "a", Result: constant.CheckResultPassed, PathMTU: 1450},
+					{Destination: "b"},
+				},
+			},
+		},
+	}, convertModelConnectivityMatrixToAPIConnectivityMatrix(matrix))
+}
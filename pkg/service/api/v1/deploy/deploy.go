@@ -170,8 +170,11 @@ func buildCallDeployDataClusterPart() (clusterConfig *protos.ClusterConfig) {
 			From: uint32(wizardData.Info.NodePortMinimum),
 			To:   uint32(wizardData.Info.NodePortMaximum),
 		},
-		NodeLabels:      make(map[string]string),
-		NodeAnnotations: make(map[string]string),
+		NodeLabels:       make(map[string]string),
+		NodeAnnotations:  make(map[string]string),
+		NtpServers:       wizardData.Info.NTPServers,
+		ContainerRuntime: string(wizardData.Info.ContainerRuntime),
+		EtcdDataDir:      wizardData.Info.EtcdDataDir,
 	}
 
 	switch wizardData.Info.KubeAPIServerConnection.KubeAPIServerConnectType {
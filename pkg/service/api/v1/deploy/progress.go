@@ -70,10 +70,13 @@ func getWizardClusterInfo() *api.Cluster {
 
 	wizardData := wizard.GetCurrentWizard()
 	clusterInfo := &api.Cluster{
-		ShortName:       wizardData.Info.ShortName,
-		Name:            wizardData.Info.Name,
-		NodePortMinimum: wizardData.Info.NodePortMinimum,
-		NodePortMaximum: wizardData.Info.NodePortMaximum,
+		ShortName:        wizardData.Info.ShortName,
+		Name:             wizardData.Info.Name,
+		NodePortMinimum:  wizardData.Info.NodePortMinimum,
+		NodePortMaximum:  wizardData.Info.NodePortMaximum,
+		NTPServers:       wizardData.Info.NTPServers,
+		ContainerRuntime: wizardData.Info.ContainerRuntime,
+		EtcdDataDir:      wizardData.Info.EtcdDataDir,
 	}
 
 	switch wizardData.Info.KubeAPIServerConnection.KubeAPIServerConnectType {
@@ -134,11 +137,20 @@ func getWizardCheckingData() *[]api.CheckingResultResponseData {
 
 		for _, checkItem := range node.CheckReport.CheckItems {
 
-			checkingResult.Items = append(checkingResult.Items, api.CheckingItem{
+			checkingItem := api.CheckingItem{
 				CheckingPoint: checkItem.ItemName,
+				Name:          checkItem.Name,
 				Result:        checkItem.CheckResult,
 				Error:         convertModelErrorToAPIError(checkItem.Error),
-			})
+				Cached:        checkItem.Cached,
+			}
+			if checkItem.Fix != nil {
+				checkingItem.Fix = &api.CheckingItemFix{
+					BeforeResult: checkItem.Fix.CheckResult,
+					BeforeError:  convertModelErrorToAPIError(checkItem.Fix.Error),
+				}
+			}
+			checkingResult.Items = append(checkingResult.Items, checkingItem)
 		}
 
 		*responseData = append(*responseData, checkingResult)
@@ -16,12 +16,41 @@ package api
 
 import (
 	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/utils/validator"
 )
 
 type (
 	GetCheckingResultResponse struct {
-		Nodes  []CheckingResultResponseData `json:"nodes"`
-		Result constant.CheckResult         `json:"result" enums:"notRunning,checking,passed,failed"` // Overall inspection status
+		Nodes   []CheckingResultResponseData `json:"nodes"`
+		Result  constant.CheckResult         `json:"result" enums:"notRunning,checking,passed,failed"` // Overall inspection status
+		Profile string                       `json:"profile,omitempty"`                                // The name of the applied check profile
+		// The connectivity between every two nodes, the rows are the sources and the columns are the destinations
+		Connectivity *ConnectivityMatrix `json:"connectivity,omitempty"`
+	}
+
+	ConnectivityMatrix struct {
+		Result constant.CheckResult `json:"result" enums:"checking,passed,failed"` // Overall connectivity check status
+		Nodes  []string             `json:"nodes"`                                 // Node names of the rows and columns
+		Rows   []ConnectivityRow    `json:"rows"`
+	}
+
+	ConnectivityRow struct {
+		Source  string               `json:"source"`
+		Results []ConnectivityResult `json:"results"` // Results to each node, in the same order of the matrix nodes
+	}
+
+	ConnectivityResult struct {
+		Destination string               `json:"destination"`
+		Result      constant.CheckResult `json:"result,omitempty" enums:"checking,passed,failed,warning"` // Empty if the destination is the source itself
+		PathMTU     uint32               `json:"pathMTU,omitempty"`
+		Checks      []ConnectivityCheck  `json:"checks,omitempty"`
+	}
+
+	ConnectivityCheck struct {
+		Protocol string               `json:"protocol" enums:"tcp,udp,icmp,mtu,probe"`
+		Port     uint32               `json:"port,omitempty"`
+		Result   constant.CheckResult `json:"result" enums:"passed,failed,warning"`
+		Detail   string               `json:"detail,omitempty"`
 	}
 
 	CheckingResultResponseData struct {
@@ -30,8 +59,35 @@ type (
 	}
 
 	CheckingItem struct {
-		CheckingPoint string               `json:"point"`                                            // Check point
-		Result        constant.CheckResult `json:"result" enums:"notRunning,checking,passed,failed"` // Checking Result
+		CheckingPoint string               `json:"point"`                                                    // Check point
+		Name          string               `json:"name,omitempty"`                                           // Check item name, which is used in fixItems to fix the check point
+		Result        constant.CheckResult `json:"result" enums:"notRunning,checking,passed,failed,warning"` // Checking Result
 		Error         *Error               `json:"error,omitempty"`
+		Fix           *CheckingItemFix     `json:"fix,omitempty"`    // Set if the check point was fixed, the result and error above are checked again after fixing
+		Cached        bool                 `json:"cached,omitempty"` // Set if the check point passed in the last check and was not checked again
+	}
+
+	CheckingItemFix struct {
+		BeforeResult constant.CheckResult `json:"beforeResult" enums:"failed,warning"` // Checking result before fixing
+		BeforeError  *Error               `json:"beforeError,omitempty"`               // Checking error before fixing, the log is the output of fixing
+	}
+
+	CheckNodeListRequest struct {
+		FixItems []string `json:"fixItems,omitempty"` // Check item names or check points to be fixed if they failed, they are checked again after fixing
+		// Only the failed check points are checked again, the passed ones are kept if the nodes are not changed since the last check
+		RecheckFailedOnly bool `json:"recheckFailedOnly,omitempty"`
 	}
 )
+
+func (request *CheckNodeListRequest) Validate() error {
+
+	wrapper := validator.NewWrapper()
+	for _, item := range request.FixItems {
+
+		wrapper.AddValidateFunc(
+			validator.ValidateString(item, "fixItems", validator.ItemNotEmptyLimit, validator.ItemNoLimit),
+		)
+	}
+
+	return wrapper.Validate()
+}
@@ -18,22 +18,26 @@ import (
 	"fmt"
 	"regexp"
 
+	"github.com/kpaas-io/kpaas/pkg/constant"
 	"github.com/kpaas-io/kpaas/pkg/utils/validator"
 )
 
 type (
 	Cluster struct {
-		ShortName                string                   `json:"shortName" binding:"required" minLength:"1" maxLength:"20"`
-		Name                     string                   `json:"name" binding:"required"`
-		KubeAPIServerConnectType KubeAPIServerConnectType `json:"kubeAPIServerConnectType" binding:"required" enums:"firstMasterIP,keepalived,loadbalancer"` // kube-apiserver connect type
-		VIP                      string                   `json:"vip,omitempty" maxLength:"15"`                                                              // keepalived listen virtual ip
-		NetInterfaceName         string                   `json:"netInterfaceName,omitempty" maxLength:"30"`                                                 // keepalived listen net interface name
-		LoadbalancerIP           string                   `json:"loadbalancerIP,omitempty" maxLength:"15"`                                                   // kube-apiserver loadbalancer ip when kubeAPIServerConnectType is loadbalancer required
-		LoadbalancerPort         uint16                   `json:"loadbalancerPort,omitempty" minimum:"1" maximum:"65535"`                                    // kube-apiserver loadbalancer port when kubeAPIServerConnectType is loadbalancer required
-		NodePortMinimum          uint16                   `json:"nodePortMinimum" minimum:"1" default:"30000"`
-		NodePortMaximum          uint16                   `json:"nodePortMaximum" maximum:"65535" default:"32767"`
-		Labels                   []Label                  `json:"labels"`
-		Annotations              []Annotation             `json:"annotations"`
+		ShortName                string                    `json:"shortName" binding:"required" minLength:"1" maxLength:"20"`
+		Name                     string                    `json:"name" binding:"required"`
+		KubeAPIServerConnectType KubeAPIServerConnectType  `json:"kubeAPIServerConnectType" binding:"required" enums:"firstMasterIP,keepalived,loadbalancer"` // kube-apiserver connect type
+		VIP                      string                    `json:"vip,omitempty" maxLength:"15"`                                                              // keepalived listen virtual ip
+		NetInterfaceName         string                    `json:"netInterfaceName,omitempty" maxLength:"30"`                                                 // keepalived listen net interface name
+		LoadbalancerIP           string                    `json:"loadbalancerIP,omitempty" maxLength:"15"`                                                   // kube-apiserver loadbalancer ip when kubeAPIServerConnectType is loadbalancer required
+		LoadbalancerPort         uint16                    `json:"loadbalancerPort,omitempty" minimum:"1" maximum:"65535"`                                    // kube-apiserver loadbalancer port when kubeAPIServerConnectType is loadbalancer required
+		NodePortMinimum          uint16                    `json:"nodePortMinimum" minimum:"1" default:"30000"`
+		NodePortMaximum          uint16                    `json:"nodePortMaximum" maximum:"65535" default:"32767"`
+		Labels                   []Label                   `json:"labels"`
+		Annotations              []Annotation              `json:"annotations"`
+		NTPServers               []string                  `json:"ntpServers,omitempty"`                                                  // ntp servers used to synchronize the clocks of nodes
+		ContainerRuntime         constant.ContainerRuntime `json:"containerRuntime,omitempty" enums:"docker,containerd" default:"docker"` // container runtime of nodes
+		EtcdDataDir              string                    `json:"etcdDataDir,omitempty" maxLength:"255" default:"/var/lib/etcd"`         // data directory of etcd on etcd nodes, its disk performance is checked
 	}
 
 	KubeAPIServerConnectType string
@@ -66,6 +70,8 @@ const (
 	LabelKeySegmentLengthLimit      = 63
 	AnnotationKeyLengthLimit        = 253
 	AnnotationKeySegmentLengthLimit = 63
+	NTPServerLengthLimit            = 253
+	EtcdDataDirLengthLimit          = 255
 )
 
 func (cluster *Cluster) Validate() error {
@@ -119,6 +125,29 @@ func (cluster *Cluster) Validate() error {
 		)
 	}
 
+	if cluster.ContainerRuntime != "" {
+		wrapper.AddValidateFunc(
+			validator.ValidateStringOptions(string(cluster.ContainerRuntime),
+				"containerRuntime",
+				[]string{string(constant.ContainerRuntimeDocker), string(constant.ContainerRuntimeContainerd)}),
+		)
+	}
+
+	if cluster.EtcdDataDir != "" {
+		wrapper.AddValidateFunc(
+			validator.ValidateString(cluster.EtcdDataDir, "etcdDataDir", validator.ItemNotEmptyLimit, EtcdDataDirLengthLimit),
+			validator.ValidateRegexp(regexp.MustCompile(`^/[\w\-./]*$`), cluster.EtcdDataDir, "etcdDataDir"),
+		)
+	}
+
+	for _, server := range cluster.NTPServers {
+
+		wrapper.AddValidateFunc(
+			validator.ValidateString(server, "ntpServers", validator.ItemNotEmptyLimit, NTPServerLengthLimit),
+			validator.ValidateRegexp(regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9\-.]*[a-zA-Z0-9])?$`), server, "ntpServers"),
+		)
+	}
+
 	return wrapper.Validate()
 }
 
@@ -28,7 +28,7 @@ type (
 		MachineRoles        []constant.MachineRole `json:"roles" default:"master" enums:"master,worker,etcd"`    // machine role, Master and worker roles are mutually exclusive.
 		Labels              []Label                `json:"labels"`                                               // Node labels
 		Taints              []Taint                `json:"taints"`                                               // Node taints
-		DockerRootDirectory string                 `json:"dockerRootDirectory" default:"/var/lib/docker"`        // Docker Root Directory
+		DockerRootDirectory string                 `json:"dockerRootDirectory" default:"/var/lib/docker"`        // Docker Root Directory, not used if the container runtime of the cluster is containerd
 	}
 
 	NodeData struct {
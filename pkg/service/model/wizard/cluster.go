@@ -35,6 +35,8 @@ type (
 		DeployClusterError  *common.FailureDetail
 		ClusterCheckResult  constant.CheckResult
 		ClusterCheckError   *common.FailureDetail
+		ClusterCheckProfile string
+		ConnectivityMatrix  *ConnectivityMatrix
 		Wizard              *WizardData
 		KubeConfig          *string
 		lock                *sync.RWMutex
@@ -48,6 +50,9 @@ type (
 		NodePortMaximum         uint16
 		Labels                  []*Label
 		Annotations             []*Annotation
+		NTPServers              []string
+		ContainerRuntime        constant.ContainerRuntime
+		EtcdDataDir             string
 	}
 
 	KubeAPIServerConnectionData struct {
@@ -76,6 +81,8 @@ const (
 
 	DefaultNodePortMinimum uint16 = 30000
 	DefaultNodePortMaximum uint16 = 32767
+
+	DefaultEtcdDataDir = "/var/lib/etcd"
 )
 
 var (
@@ -113,6 +120,22 @@ func (cluster *Cluster) GetCheckResult() constant.CheckResult {
 	return cluster.ClusterCheckResult
 }
 
+func (cluster *Cluster) GetCheckProfile() string {
+
+	cluster.lock.RLock()
+	defer cluster.lock.RUnlock()
+
+	return cluster.ClusterCheckProfile
+}
+
+func (cluster *Cluster) SetCheckProfile(profile string) {
+
+	cluster.lock.Lock()
+	defer cluster.lock.Unlock()
+
+	cluster.ClusterCheckProfile = profile
+}
+
 func (cluster *Cluster) GetDeployClusterStatus() DeployClusterStatus {
 
 	cluster.lock.RLock()
@@ -278,6 +301,8 @@ func (cluster *Cluster) ClearClusterCheckingData() {
 
 	cluster.ClusterCheckResult = constant.CheckResultNotRunning
 	cluster.ClusterCheckError = nil
+	cluster.ClusterCheckProfile = ""
+	cluster.ConnectivityMatrix = nil
 
 	for _, node := range cluster.Nodes {
 
@@ -287,6 +312,28 @@ func (cluster *Cluster) ClearClusterCheckingData() {
 	return
 }
 
+// ResetClusterCheckingResult resets the checking results but keeps the check items of nodes,
+// the items checked again are merged into them.
+func (cluster *Cluster) ResetClusterCheckingResult() {
+
+	cluster.lock.Lock()
+	defer cluster.lock.Unlock()
+
+	if len(cluster.Nodes) <= 0 {
+		return
+	}
+
+	cluster.ClusterCheckResult = constant.CheckResultNotRunning
+	cluster.ClusterCheckError = nil
+	cluster.ClusterCheckProfile = ""
+	cluster.ConnectivityMatrix = nil
+
+	for _, node := range cluster.Nodes {
+
+		node.CheckReport.resetResult()
+	}
+}
+
 func (cluster *Cluster) SetClusterCheckResult(result constant.CheckResult, failureDetail *common.FailureDetail) {
 
 	cluster.lock.Lock()
@@ -361,6 +408,8 @@ func (info *ClusterInfo) init() {
 	info.Annotations = make([]*Annotation, 0, 0)
 	info.NodePortMinimum = DefaultNodePortMinimum
 	info.NodePortMaximum = DefaultNodePortMaximum
+	info.ContainerRuntime = constant.ContainerRuntimeDocker
+	info.EtcdDataDir = DefaultEtcdDataDir
 }
 
 func NewKubeAPIServerConnectionData() *KubeAPIServerConnectionData {
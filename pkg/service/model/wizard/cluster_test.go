@@ -911,6 +911,46 @@ func TestCluster_ClearClusterCheckingData(t *testing.T) {
 	}
 }
 
+func TestCluster_ResetClusterCheckingResult(t *testing.T) {
+
+	items := []*CheckItem{
+		{
+			ItemName:    "item 1",
+			CheckResult: constant.CheckResultFailed,
+		},
+	}
+	cluster := Cluster{
+		Nodes: []*Node{
+			{
+				Name: "node2",
+				CheckReport: &CheckReport{
+					CheckItems:   items,
+					CheckResult:  constant.CheckResultFailed,
+					CheckedError: &common.FailureDetail{Reason: "reason"},
+				},
+			},
+		},
+		ClusterCheckResult: constant.CheckResultFailed,
+		ClusterCheckError:  &common.FailureDetail{Reason: "reason"},
+		lock:               new(sync.RWMutex),
+	}
+
+	cluster.ResetClusterCheckingResult()
+	assert.Equal(t, Cluster{
+		Nodes: []*Node{
+			{
+				Name: "node2",
+				CheckReport: &CheckReport{
+					CheckItems:  items,
+					CheckResult: constant.CheckResultNotRunning,
+				},
+			},
+		},
+		ClusterCheckResult: constant.CheckResultNotRunning,
+		lock:               new(sync.RWMutex),
+	}, cluster)
+}
+
 func TestCluster_SetClusterCheckResult(t *testing.T) {
 
 	tests := []struct {
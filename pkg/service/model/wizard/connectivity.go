@@ -0,0 +1,62 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wizard
+
+import (
+	"github.com/kpaas-io/kpaas/pkg/constant"
+)
+
+type (
+	ConnectivityMatrix struct {
+		CheckResult constant.CheckResult // Overall connectivity check status
+		Nodes       []string             // Node names of the rows and columns
+		Rows        []*ConnectivityRow
+	}
+
+	ConnectivityRow struct {
+		Source  string
+		Results []*ConnectivityResult // Results to each node, in the same order of the matrix nodes
+	}
+
+	ConnectivityResult struct {
+		Destination string
+		CheckResult constant.CheckResult // Empty if the destination is the source itself
+		PathMTU     uint32
+		Checks      []*ConnectivityCheck
+	}
+
+	ConnectivityCheck struct {
+		Protocol    string // tcp, udp, icmp or mtu
+		Port        uint32
+		CheckResult constant.CheckResult
+		Detail      string
+	}
+)
+
+func (cluster *Cluster) GetConnectivityMatrix() *ConnectivityMatrix {
+
+	cluster.lock.RLock()
+	defer cluster.lock.RUnlock()
+
+	return cluster.ConnectivityMatrix
+}
+
+func (cluster *Cluster) SetConnectivityMatrix(matrix *ConnectivityMatrix) {
+
+	cluster.lock.Lock()
+	defer cluster.lock.Unlock()
+
+	cluster.ConnectivityMatrix = matrix
+}
@@ -59,8 +59,16 @@ type (
 
 	CheckItem struct {
 		ItemName    string // Check Item Name
+		Name        string // Item name of the deploy controller, which selects the item to be fixed
 		CheckResult constant.CheckResult
 		Error       *common.FailureDetail
+		Fix         *CheckItemFix // Set if the item was fixed, the result above is checked again after fixing
+		Cached      bool          // Set if the item passed in the last check and was not checked again
+	}
+
+	CheckItemFix struct {
+		CheckResult constant.CheckResult  // Check result before fixing
+		Error       *common.FailureDetail // Check error before fixing
 	}
 
 	Annotation struct {
@@ -163,6 +171,88 @@ func (node *Node) SetCheckItem(itemName string, result constant.CheckResult, det
 
 	item.CheckResult = result
 	item.Error = detail
+	// the fix and the cached flag are set again if they are still in the new result
+	item.Fix = nil
+	item.Cached = false
+}
+
+func (node *Node) SetCheckItemFix(itemName string, beforeResult constant.CheckResult, beforeDetail *common.FailureDetail) {
+
+	node.rwLock.Lock()
+	defer node.rwLock.Unlock()
+
+	for _, item := range node.CheckReport.CheckItems {
+
+		if item.ItemName == itemName {
+			item.Fix = &CheckItemFix{
+				CheckResult: beforeResult,
+				Error:       beforeDetail,
+			}
+		}
+	}
+}
+
+func (node *Node) SetCheckItemCached(itemName string) {
+
+	node.rwLock.Lock()
+	defer node.rwLock.Unlock()
+
+	for _, item := range node.CheckReport.CheckItems {
+
+		if item.ItemName == itemName {
+			item.Cached = true
+		}
+	}
+}
+
+// SetCheckItemName sets the item name of the deploy controller of the check item
+func (node *Node) SetCheckItemName(itemName string, name string) {
+
+	node.rwLock.Lock()
+	defer node.rwLock.Unlock()
+
+	for _, item := range node.CheckReport.CheckItems {
+
+		if item.ItemName == itemName {
+			item.Name = name
+		}
+	}
+}
+
+// GetCheckItemName returns the item name of the deploy controller of the check item, which is empty if not found
+func (node *Node) GetCheckItemName(itemName string) string {
+
+	node.rwLock.RLock()
+	defer node.rwLock.RUnlock()
+
+	for _, item := range node.CheckReport.CheckItems {
+
+		if item.ItemName == itemName {
+			return item.Name
+		}
+	}
+	return ""
+}
+
+// RetainCheckItems removes the check items not in the names, which are left by the last check
+func (node *Node) RetainCheckItems(itemNames []string) {
+
+	node.rwLock.Lock()
+	defer node.rwLock.Unlock()
+
+	retained := make(map[string]bool, len(itemNames))
+	for _, itemName := range itemNames {
+		retained[itemName] = true
+	}
+
+	items := make([]*CheckItem, 0, len(itemNames))
+	for _, item := range node.CheckReport.CheckItems {
+
+		if retained[item.ItemName] {
+			items = append(items, item)
+		}
+	}
+	node.CheckReport.CheckItems = items
 }
 
 func (node *Node) SetDeployResult(role constant.MachineRole, status DeployStatus, detail *common.FailureDetail) {
@@ -205,7 +295,12 @@ func (item *CheckItem) init() {
 
 func (report *CheckReport) init() {
 
+	report.resetResult()
+	report.CheckItems = make([]*CheckItem, 0, 0)
+}
+
+func (report *CheckReport) resetResult() {
+
 	report.CheckResult = constant.CheckResultNotRunning
 	report.CheckedError = nil
-	report.CheckItems = make([]*CheckItem, 0, 0)
 }
@@ -294,6 +294,82 @@ func TestNode_SetCheckItem(t *testing.T) {
 	}
 }
 
+func TestNode_SetCheckItemFix(t *testing.T) {
+
+	node := NewNode()
+	node.SetCheckItem("item 1", constant.CheckResultPassed, nil)
+	node.SetCheckItem("item 2", constant.CheckResultPassed, nil)
+
+	beforeDetail := &common.FailureDetail{
+		Reason: "reason",
+		Detail: "detail",
+		LogId:  1,
+	}
+	node.SetCheckItemFix("item 2", constant.CheckResultFailed, beforeDetail)
+	node.SetCheckItemFix("item 3", constant.CheckResultFailed, nil)
+
+	assert.Equal(t, []*CheckItem{
+		{
+			ItemName:    "item 1",
+			CheckResult: constant.CheckResultPassed,
+		},
+		{
+			ItemName:    "item 2",
+			CheckResult: constant.CheckResultPassed,
+			Fix: &CheckItemFix{
+				CheckResult: constant.CheckResultFailed,
+				Error:       beforeDetail,
+			},
+		},
+	}, node.CheckReport.CheckItems)
+}
+
+func TestNode_RecheckItems(t *testing.T) {
+
+	node := NewNode()
+	node.SetCheckItem("item 1", constant.CheckResultPassed, nil)
+	node.SetCheckItem("item 2", constant.CheckResultPassed, nil)
+	node.SetCheckItemFix("item 2", constant.CheckResultFailed, nil)
+	node.SetCheckItem("item 3", constant.CheckResultFailed, nil)
+
+	// item 1 is reused, item 2 is checked again without fixing, and item 3 is renamed
+	node.SetCheckItem("item 1", constant.CheckResultPassed, nil)
+	node.SetCheckItemCached("item 1")
+	node.SetCheckItem("item 2", constant.CheckResultPassed, nil)
+	node.SetCheckItem("item 3, measured", constant.CheckResultPassed, nil)
+	node.RetainCheckItems([]string{"item 1", "item 2", "item 3, measured"})
+
+	assert.Equal(t, []*CheckItem{
+		{
+			ItemName:    "item 1",
+			CheckResult: constant.CheckResultPassed,
+			Cached:      true,
+		},
+		{
+			ItemName:    "item 2",
+			CheckResult: constant.CheckResultPassed,
+		},
+		{
+			ItemName:    "item 3, measured",
+			CheckResult: constant.CheckResultPassed,
+		},
+	}, node.CheckReport.CheckItems)
+}
+
+func TestNode_CheckItemName(t *testing.T) {
+
+	node := NewNode()
+	node.SetCheckItem("item 1（description 1）", constant.CheckResultFailed, nil)
+	node.SetCheckItemName("item 1（description 1）", "item 1")
+
+	assert.Equal(t, "item 1", node.GetCheckItemName("item 1（description 1）"))
+	assert.Equal(t, "", node.GetCheckItemName("item 1"))
+
+	// the name is kept if the item is checked again
+	node.SetCheckItem("item 1（description 1）", constant.CheckResultPassed, nil)
+	assert.Equal(t, "item 1", node.GetCheckItemName("item 1（description 1）"))
+}
+
 func TestNode_SetDeployResult(t *testing.T) {
 
 	tests := []struct {
@@ -18,6 +18,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -32,12 +33,25 @@ var (
 	port       uint16
 	logLevel   string
 	logFileLoc string
+	// checkProfile is the yaml file of the default check profile
+	checkProfile string
+	// pkiDir is the directory to store the certificate authorities and keys of the clusters
+	pkiDir string
+	// imagesDir is the directory of the image tarballs which are distributed to the nodes when deploying
+	imagesDir string
+	// localNodes are the names of the nodes which are the host the deploy controller runs on
+	localNodes []string
+	// nodeReadyTimeout is how long to wait for the nodes to be ready after they join the cluster
+	nodeReadyTimeout time.Duration
 )
 
 const (
 	defaultPort       uint16 = 8081
 	defaultLogLevel   string = "info"
 	defaultLogFileLoc string = "/app/deploy/logs"
+	defaultPKIDir     string = "/app/deploy/pki"
+
+	defaultNodeReadyTimeout = 5 * time.Minute
 )
 
 // rootCmd represents the base command when called without any subcommands
@@ -48,10 +62,18 @@ var rootCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		setupLogLevel()
 		options := server.ServerOptions{
-			Port:       port,
-			LogFileLoc: logFileLoc,
+			Port:             port,
+			LogFileLoc:       logFileLoc,
+			CheckProfileFile: checkProfile,
+			PKIDir:           pkiDir,
+			ImagesDir:        imagesDir,
+			LocalNodes:       localNodes,
+			NodeReadyTimeout: nodeReadyTimeout,
+		}
+		if err := server.New(options).Run(SetupSignalHandler()); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
 		}
-		server.New(options).Run(SetupSignalHandler())
 	},
 }
 
@@ -69,6 +91,15 @@ func init() {
 	rootCmd.Flags().Uint16VarP(&port, "port", "p", defaultPort, "gRPC service listening port")
 	rootCmd.Flags().StringVarP(&logLevel, "log-level", "l", defaultLogLevel, "log level(options: trace, debug, info, warn|warning, error, fatal, panic)")
 	rootCmd.Flags().StringVar(&logFileLoc, "log-file-location", defaultLogFileLoc, "the location to store the detail logs")
+	rootCmd.Flags().StringVar(&checkProfile, "check-profile", "", "the yaml file of the node check profile, the built-in profile is used if it's empty")
+	rootCmd.Flags().StringVar(&pkiDir, "pki-dir", defaultPKIDir, "the directory to store the certificate authorities and keys of the clusters, "+
+		"an existing ca can be imported by putting its ca.crt and ca.key to <pki-dir>/<cluster name>")
+	rootCmd.Flags().StringVar(&imagesDir, "images-dir", "", "the directory of the image tarballs created by \"docker save\", "+
+		"which are distributed to all nodes and loaded before deploying, so that the nodes don't pull the images from the registries")
+	rootCmd.Flags().StringSliceVar(&localNodes, "local-nodes", nil, "the names of the nodes which are the host the deploy controller runs on, "+
+		"they are operated without ssh like the nodes of loopback ips, e.g. the node of an all-in-one install")
+	rootCmd.Flags().DurationVar(&nodeReadyTimeout, "node-ready-timeout", defaultNodeReadyTimeout, "how long to wait for the nodes to be ready "+
+		"after they join the cluster, the nodes are only waited to be registered if it's 0, e.g. the network plugin is installed after the cluster is deployed")
 }
 
 // initConfig reads in config file and ENV variables if set.